@@ -29,6 +29,7 @@ import (
 type Command struct {
 	opts     options.Command
 	procs    []Process
+	results  []CommandResult
 	runFunc  func(options.Command) error
 	makeProc ProcessConstructor
 }
@@ -72,14 +73,24 @@ func (c *Command) ProcConstructor(processConstructor ProcessConstructor) *Comman
 
 func (c *Command) GetProcessConstructor() ProcessConstructor { return c.makeProc }
 
+// GetRetryOptions returns the retry options for the sub-command at the index,
+// or nil if it is not retried.
+func (c *Command) GetRetryOptions(idx int) *options.Retry { return c.opts.RetryFor(idx) }
+
 // SetRunFunc sets the function that overrides the default behavior when a
 // command is run, allowing the caller to run the command with their own custom
 // function given all the given inputs to the command.
 func (c *Command) SetRunFunc(f func(options.Command) error) *Command { c.runFunc = f; return c }
 
+// Results returns the outcome of each sub-command that has run in the
+// foreground, including each of its attempts, in the order that they
+// finished.
+func (c *Command) Results() []CommandResult { return c.results }
+
 // GetProcIDs returns an array of Process IDs associated with the sub-commands
 // being run. This method will return a nil slice until processes have actually
-// been created by the Command for execution.
+// been created by the Command for execution. For sub-commands that were
+// retried, only the process of the last attempt is included.
 func (c *Command) GetProcIDs() []string {
 	ids := []string{}
 	for _, proc := range c.procs {
@@ -311,6 +322,22 @@ func (c *Command) ContinueOnError(cont bool) *Command { c.opts.ContinueOnError =
 // error despite errors in its sub-command executions.
 func (c *Command) IgnoreError(ignore bool) *Command { c.opts.IgnoreError = ignore; return c }
 
+// Retry sets the retry options for all sub-commands, which are run again if
+// they fail, as the options allow. Retries are not attempted for commands
+// that run in the background.
+func (c *Command) Retry(opts *options.Retry) *Command { c.opts.Retry = opts; return c }
+
+// RetryStep sets the retry options for the sub-command at the index, which
+// override the retry options for all sub-commands. If the options are nil,
+// the sub-command is not retried.
+func (c *Command) RetryStep(idx int, opts *options.Retry) *Command {
+	if c.opts.StepRetry == nil {
+		c.opts.StepRetry = map[int]*options.Retry{}
+	}
+	c.opts.StepRetry[idx] = opts
+	return c
+}
+
 // SuppressStandardError sets a flag for determining if the Command should
 // discard all standard error content.
 func (c *Command) SuppressStandardError(v bool) *Command {
@@ -443,6 +470,35 @@ func (c *Command) AppendWhen(cond bool, cmds ...string) *Command {
 	return c.Append(cmds...)
 }
 
+// Pipeline adds on a sub-command that pipes the standard output of each of
+// the given commands into the standard input of the next one, without using a
+// shell. The sub-command only succeeds if all of the commands in the pipeline
+// succeed.
+func (c *Command) Pipeline(cmds ...[]string) *Command {
+	if len(cmds) == 0 {
+		return c
+	}
+
+	c.opts.Commands = append(c.opts.Commands, cmds[0])
+	if len(cmds) > 1 {
+		if c.opts.Pipelines == nil {
+			c.opts.Pipelines = map[int][][]string{}
+		}
+		c.opts.Pipelines[len(c.opts.Commands)-1] = cmds[1:]
+	}
+	return c
+}
+
+// AppendPipeline is the same as Pipeline, but splits each of the strings into
+// the arguments of a command in the pipeline.
+func (c *Command) AppendPipeline(cmds ...string) *Command {
+	args := make([][]string, 0, len(cmds))
+	for _, cmd := range cmds {
+		args = append(args, splitCmdToArgs(cmd))
+	}
+	return c.Pipeline(args...)
+}
+
 // ShellScript adds an operation to the command that runs a shell script, using
 // the shell's "-c" option).
 func (c *Command) ShellScript(shell, script string) *Command {
@@ -551,7 +607,7 @@ func (c *Command) Run(ctx context.Context) error {
 		return catcher.Resolve()
 	}
 
-	for _, opt := range opts {
+	for idx, opt := range opts {
 		if err := ctx.Err(); err != nil {
 			catcher.Push(fmt.Errorf("operation canceled: %w", err))
 			catcher.Push(c.Close())
@@ -562,7 +618,21 @@ func (c *Command) Run(ctx context.Context) error {
 			c.opts.PreHook(&c.opts, opt)
 		}
 
-		err := c.exec(ctx, opt)
+		var retry *options.Retry
+		if !c.opts.RunBackground {
+			retry = c.opts.RetryFor(idx)
+		}
+		numProcs := len(c.procs)
+		attempts, err := runWithRetry(ctx, retry, opt, c.exec)
+		if len(c.procs) > numProcs+1 {
+			// Only the process of the last attempt is kept, so that Wait
+			// does not report the errors of the attempts that were
+			// retried. Every attempt is still described by the results.
+			c.procs = append(c.procs[:numProcs], c.procs[len(c.procs)-1])
+		}
+		if !c.opts.RunBackground {
+			c.results = append(c.results, CommandResult{Args: opt.Args, Attempts: attempts})
+		}
 
 		if !c.opts.IgnoreError {
 			if c.opts.PostHook != nil {
@@ -594,13 +664,17 @@ func (c *Command) RunParallel(ctx context.Context) error {
 		optsCopy := c.opts.Process.Copy()
 		splitCmd.opts.Process = *optsCopy
 		splitCmd.opts.Commands = [][]string{cmd}
+		splitCmd.opts.StepRetry = map[int]*options.Retry{0: c.opts.RetryFor(idx)}
+		splitCmd.opts.Pipelines = map[int][][]string{0: c.opts.Pipelines[idx]}
 		splitCmd.procs = []Process{}
+		splitCmd.results = nil
 		parallelCmds[idx] = splitCmd
 	}
 
 	type cmdResult struct {
-		procs []Process
-		err   error
+		procs   []Process
+		results []CommandResult
+		err     error
 	}
 	cmdResults := make(chan cmdResult, len(c.opts.Commands))
 	for _, parallelCmd := range parallelCmds {
@@ -613,7 +687,7 @@ func (c *Command) RunParallel(ctx context.Context) error {
 			}()
 			err := innerCmd.Run(ctx)
 			select {
-			case cmdResults <- cmdResult{procs: innerCmd.procs, err: err}:
+			case cmdResults <- cmdResult{procs: innerCmd.procs, results: innerCmd.results, err: err}:
 			case <-ctx.Done():
 			}
 		}(parallelCmd)
@@ -627,6 +701,7 @@ func (c *Command) RunParallel(ctx context.Context) error {
 				catcher.Push(cmdRes.err)
 			}
 			c.procs = append(c.procs, cmdRes.procs...)
+			c.results = append(c.results, cmdRes.results...)
 		case <-ctx.Done():
 			c.procs = []Process{}
 			catcher.Push(c.Close())
@@ -719,16 +794,19 @@ func (c *Command) SetCombinedWriter(writer io.WriteCloser) *Command {
 func (c *Command) getCmd() string {
 	env := strings.Join(c.opts.Process.ResolveEnvironment(), " ")
 	out := []string{}
-	for _, cmd := range c.opts.Commands {
-		if c.opts.Sudo {
-			cmd = append(c.sudoCmd(), cmd...)
-		}
-		var formattedCmd string
-		if len(env) != 0 {
-			formattedCmd = fmt.Sprintf("%s%s ", formattedCmd, env)
+	for idx, cmd := range c.opts.Commands {
+		stages := []string{}
+		for _, stage := range append([][]string{cmd}, c.opts.Pipelines[idx]...) {
+			if c.opts.Sudo {
+				stage = append(c.sudoCmd(), stage...)
+			}
+			formattedCmd := strings.Join(stage, " ")
+			if len(env) != 0 {
+				formattedCmd = fmt.Sprintf("%s %s", env, formattedCmd)
+			}
+			stages = append(stages, formattedCmd)
 		}
-		formattedCmd = strings.Join(append([]string{formattedCmd}, cmd...), " ")
-		out = append(out, formattedCmd)
+		out = append(out, strings.Join(stages, " | "))
 	}
 	return strings.Join(out, "\n")
 }
@@ -762,13 +840,27 @@ func (c *Command) getCreateOpt(args []string) (*options.Create, error) {
 func (c *Command) ExportCreateOptions() ([]*options.Create, error) {
 	out := make([]*options.Create, 0, len(c.opts.Commands))
 	catcher := &erc.Collector{}
-	for _, args := range c.opts.Commands {
+	for idx, args := range c.opts.Commands {
 		cmd, err := c.getCreateOpt(args)
 		if err != nil {
 			catcher.Push(err)
 			continue
 		}
 
+		for _, stageArgs := range c.opts.Pipelines[idx] {
+			stage, err := c.getCreateOpt(stageArgs)
+			if err != nil {
+				catcher.Push(fmt.Errorf("problem with pipeline of command %d: %w", idx, err))
+				continue
+			}
+			stage.StandardInput = nil
+			stage.StandardInputBytes = nil
+			stage.OnSuccess = nil
+			stage.OnFailure = nil
+			stage.OnTimeout = nil
+			cmd.Pipeline = append(cmd.Pipeline, stage)
+		}
+
 		out = append(out, cmd)
 	}
 
@@ -779,11 +871,11 @@ func (c *Command) ExportCreateOptions() ([]*options.Create, error) {
 	return out, nil
 }
 
-func (c *Command) exec(ctx context.Context, opts *options.Create) error {
+func (c *Command) exec(ctx context.Context, opts *options.Create) (Process, error) {
 	writeOutput := getMsgOutput(opts.Output)
 	proc, err := c.makeProc(ctx, opts)
 	if err != nil {
-		return fmt.Errorf("problem starting command: %w", err)
+		return nil, fmt.Errorf("problem starting command: %w", err)
 	}
 	c.procs = append(c.procs, proc)
 	msg := message.Fields{
@@ -805,21 +897,14 @@ func (c *Command) exec(ctx context.Context, opts *options.Create) error {
 	}
 
 	if !c.opts.RunBackground {
-		ec := &erc.Collector{}
-		for _, proc := range c.procs {
-			_, err = proc.Wait(ctx)
-			if err != nil {
-				ec.Push(fmt.Errorf("process(%s) group(%s): %w", proc.ID(), c.opts.ID, err))
-			}
-		}
-		err = ec.Resolve()
-		if err != nil {
+		if _, err = proc.Wait(ctx); err != nil {
+			err = fmt.Errorf("process(%s) group(%s): %w", proc.ID(), c.opts.ID, err)
 			msg["err"] = err
 		}
 
 		grip.Log(c.opts.Priority, writeOutput(msg))
 	}
-	return err
+	return proc, err
 }
 
 func getMsgOutput(opts options.Output) func(msg message.Fields) message.Fields {
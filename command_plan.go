@@ -0,0 +1,492 @@
+package jasper
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tychoish/jasper/options"
+)
+
+// CommandPlan describes what a Command does when it runs, as resolved from
+// its options without creating any processes.
+type CommandPlan struct {
+	ID              string
+	ContinueOnError bool
+	IgnoreError     bool
+	RunBackground   bool
+	// Notes describe the parts of the command that are only known once it
+	// runs, such as its hooks.
+	Notes []string
+	Steps []CommandPlanStep
+}
+
+// CommandPlanStep describes the process that a Command creates for one of
+// its sub-commands.
+type CommandPlanStep struct {
+	// Args are the arguments of the sub-command, including the sudo prefix.
+	Args []string
+	// Pipeline are the arguments of the commands that the output of the
+	// sub-command is piped into.
+	Pipeline [][]string
+	// Exec are the arguments of the local processes that run the
+	// sub-command and each command of its pipeline, which wrap them in SSH
+	// or Docker if they run remotely. For SSH, the last argument is the
+	// command exactly as jasper sends it to the remote shell, which does not
+	// quote the directory, environment, or arguments, so it is not safe for
+	// values that contain shell syntax.
+	Exec            [][]string
+	Directory       string
+	Environment     []string
+	OverrideEnviron bool
+	Remote          string
+	Docker          string
+	Timeout         time.Duration
+	Retry           *options.Retry
+	Tags            []string
+	// StandardInput describes the standard input of the sub-command, if it
+	// has one.
+	StandardInput string
+	// Output and Error describe where the standard output and standard
+	// error of the sub-command go.
+	Output string
+	Error  string
+
+	stages []*options.Create
+}
+
+// DryRun resolves the processes that the command creates when it runs,
+// including their sudo prefix, SSH or Docker wrapping, environment, working
+// directory, and output, without running anything.
+func (c *Command) DryRun() (*CommandPlan, error) {
+	opts, err := c.ExportCreateOptions()
+	if err != nil {
+		return nil, fmt.Errorf("problem resolving command: %w", err)
+	}
+
+	plan := &CommandPlan{
+		ID:              c.opts.ID,
+		ContinueOnError: c.opts.ContinueOnError,
+		IgnoreError:     c.opts.IgnoreError,
+		RunBackground:   c.opts.RunBackground,
+	}
+	if c.runFunc != nil {
+		plan.Notes = append(plan.Notes, "runs a custom function instead of the steps")
+	}
+	if c.opts.Prerequisite != nil {
+		plan.Notes = append(plan.Notes, "only runs if its prerequisite is met when it runs")
+	}
+	if c.opts.PreHook != nil {
+		plan.Notes = append(plan.Notes, "runs a hook before each step, which may modify the step")
+	}
+	if c.opts.PostHook != nil {
+		plan.Notes = append(plan.Notes, "runs a hook on the error of each step")
+	}
+
+	for idx, opt := range opts {
+		step := makeCommandPlanStep(opt)
+		if !c.opts.RunBackground {
+			step.Retry = c.opts.RetryFor(idx)
+		}
+		plan.Steps = append(plan.Steps, step)
+	}
+
+	return plan, nil
+}
+
+// RenderScript renders the command as an equivalent POSIX shell script.
+func (c *Command) RenderScript() (string, error) {
+	plan, err := c.DryRun()
+	if err != nil {
+		return "", err
+	}
+	return plan.Script(), nil
+}
+
+func makeCommandPlanStep(opts *options.Create) CommandPlanStep {
+	step := CommandPlanStep{
+		Args:            opts.Args,
+		Directory:       opts.WorkingDirectory,
+		Environment:     opts.ResolveEnvironment(),
+		OverrideEnviron: opts.OverrideEnviron,
+		Timeout:         opts.Timeout,
+		Tags:            opts.Tags,
+		Output:          describeOutput(opts.Output),
+		Error:           describeError(opts.Output),
+		stages:          append([]*options.Create{opts}, opts.Pipeline...),
+	}
+	if step.Timeout == 0 && opts.TimeoutSecs != 0 {
+		step.Timeout = time.Duration(opts.TimeoutSecs) * time.Second
+	}
+
+	local := opts.Remote == nil && opts.Docker == nil
+	if step.Directory == "" && local {
+		step.Directory, _ = os.Getwd()
+	}
+
+	switch {
+	case len(opts.StandardInputBytes) != 0:
+		step.StandardInput = fmt.Sprintf("%d bytes", len(opts.StandardInputBytes))
+	case opts.StandardInput != nil:
+		step.StandardInput = "a reader"
+	}
+
+	if opts.Remote != nil {
+		step.Remote = opts.Remote.String()
+	}
+	if opts.Docker != nil {
+		step.Docker = opts.Docker.Image
+	}
+
+	for _, stage := range opts.Pipeline {
+		step.Pipeline = append(step.Pipeline, stage.Args)
+	}
+	for _, stage := range step.stages {
+		step.Exec = append(step.Exec, resolveStageExec(opts, stage))
+	}
+
+	return step
+}
+
+// resolveStageExec returns the arguments of the local process that runs the
+// stage of the process.
+func resolveStageExec(opts, stage *options.Create) []string {
+	switch {
+	case opts.Remote != nil:
+		// This matches the command that the SSH binary sends to the remote
+		// shell, which interprets the arguments without further quoting.
+		// It is shown as-is rather than quoted so that the plan describes
+		// what actually runs.
+		var remote string
+		if opts.WorkingDirectory != "" {
+			remote += fmt.Sprintf("cd '%s' && ", opts.WorkingDirectory)
+		}
+		if env := stage.ResolveEnvironment(); len(env) != 0 {
+			remote += strings.Join(env, " ") + " "
+		}
+		remote += strings.Join(stage.Args, " ")
+		return append(sshArgs(opts.Remote), remote)
+	case opts.Docker != nil:
+		args := []string{"docker"}
+		if opts.Docker.Host != "" {
+			host := opts.Docker.Host
+			if opts.Docker.Port != 0 {
+				host = fmt.Sprintf("%s:%d", host, opts.Docker.Port)
+			}
+			args = append(args, "-H", "tcp://"+host)
+		}
+		args = append(args, "run", "--rm")
+		if opts.WorkingDirectory != "" {
+			args = append(args, "-w", opts.WorkingDirectory)
+		}
+		for _, e := range stage.ResolveEnvironment() {
+			args = append(args, "-e", e)
+		}
+		args = append(args, opts.Docker.Image)
+		return append(args, stage.Args...)
+	default:
+		return stage.Args
+	}
+}
+
+// sshArgs returns the arguments of the SSH binary that connects to the
+// remote host, without any of the secrets used to authenticate. The SSH
+// library settings are translated into the equivalent flags.
+func sshArgs(remote *options.Remote) []string {
+	args := []string{"ssh"}
+	args = append(args, remote.Args...)
+	if remote.UseSSHLibrary {
+		if remote.Port != 0 {
+			args = append(args, "-p", strconv.Itoa(remote.Port))
+		}
+		if remote.KeyFile != "" {
+			args = append(args, "-i", remote.KeyFile)
+		}
+		if remote.Proxy != nil {
+			proxy := remote.Proxy.Host
+			if remote.Proxy.User != "" {
+				proxy = fmt.Sprintf("%s@%s", remote.Proxy.User, proxy)
+			}
+			if remote.Proxy.Port != 0 {
+				proxy = fmt.Sprintf("%s:%d", proxy, remote.Proxy.Port)
+			}
+			args = append(args, "-J", proxy)
+		}
+	}
+	return append(args, remote.String())
+}
+
+func describeOutput(opts options.Output) string {
+	switch {
+	case opts.SuppressOutput:
+		return "discarded"
+	case opts.SendOutputToError:
+		return "redirected to standard error"
+	}
+	return describeDestinations(opts.Output != nil, opts.Loggers)
+}
+
+func describeError(opts options.Output) string {
+	switch {
+	case opts.SuppressError:
+		return "discarded"
+	case opts.SendErrorToOutput:
+		return "redirected to standard output"
+	}
+	return describeDestinations(opts.Error != nil, opts.Loggers)
+}
+
+func describeDestinations(hasWriter bool, loggers []*options.LoggerConfig) string {
+	out := []string{}
+	if hasWriter {
+		out = append(out, "a writer")
+	}
+	for _, logger := range loggers {
+		out = append(out, fmt.Sprintf("logger '%s'", logger.Type()))
+	}
+	if len(out) == 0 {
+		return "discarded"
+	}
+	return strings.Join(out, ", ")
+}
+
+// String returns a human-readable description of the plan.
+func (p *CommandPlan) String() string {
+	buf := &strings.Builder{}
+
+	fmt.Fprintf(buf, "command '%s'", p.ID)
+	switch {
+	case p.RunBackground:
+		buf.WriteString(" starts each step in the background without waiting for it")
+	case p.ContinueOnError:
+		buf.WriteString(" runs every step even if a step fails")
+	default:
+		buf.WriteString(" stops at the first step that fails")
+	}
+	if p.IgnoreError {
+		buf.WriteString(", ignoring errors")
+	}
+	buf.WriteString("\n")
+	for _, note := range p.Notes {
+		fmt.Fprintf(buf, "  note: %s\n", note)
+	}
+
+	for idx, step := range p.Steps {
+		fmt.Fprintf(buf, "step %d: %s\n", idx+1, step.commandLine())
+		if step.Remote != "" || step.Docker != "" {
+			stages := make([]string, 0, len(step.Exec))
+			for _, args := range step.Exec {
+				stages = append(stages, shellJoin(args))
+			}
+			fmt.Fprintf(buf, "  runs: %s\n", strings.Join(stages, " | "))
+		}
+		if step.Remote != "" {
+			fmt.Fprintf(buf, "  remote host: %s\n", step.Remote)
+		}
+		if step.Docker != "" {
+			fmt.Fprintf(buf, "  docker image: %s\n", step.Docker)
+		}
+		if step.Directory != "" {
+			fmt.Fprintf(buf, "  directory: %s\n", step.Directory)
+		}
+		switch {
+		case step.OverrideEnviron:
+			fmt.Fprintf(buf, "  environment: only %s\n", shellJoin(step.Environment))
+		case len(step.Environment) != 0:
+			fmt.Fprintf(buf, "  environment: inherited, with %s\n", shellJoin(step.Environment))
+		}
+		if step.StandardInput != "" {
+			fmt.Fprintf(buf, "  standard input: %s\n", step.StandardInput)
+		}
+		fmt.Fprintf(buf, "  standard output: %s\n", step.Output)
+		fmt.Fprintf(buf, "  standard error: %s\n", step.Error)
+		if step.Timeout != 0 {
+			fmt.Fprintf(buf, "  timeout: %s\n", step.Timeout)
+		}
+		if step.Retry != nil {
+			fmt.Fprintf(buf, "  retry: up to %d attempts\n", step.Retry.Attempts)
+		}
+		if len(step.Tags) != 0 {
+			fmt.Fprintf(buf, "  tags: %s\n", strings.Join(step.Tags, ", "))
+		}
+	}
+
+	return buf.String()
+}
+
+func (s *CommandPlanStep) commandLine() string {
+	stages := []string{shellJoin(s.Args)}
+	for _, args := range s.Pipeline {
+		stages = append(stages, shellJoin(args))
+	}
+	return strings.Join(stages, " | ")
+}
+
+// Script renders the plan as a POSIX shell script that runs the same
+// processes. The parts of the plan that the shell cannot reproduce, such as
+// timeouts, retries, and the writers and loggers of the output, are described
+// in comments, and secrets used to connect to remote hosts are omitted.
+func (p *CommandPlan) Script() string {
+	buf := &strings.Builder{}
+	buf.WriteString("#!/bin/sh\n")
+	fmt.Fprintf(buf, "# rendered from jasper command %s\n", scriptComment(p.ID))
+	for _, note := range p.Notes {
+		fmt.Fprintf(buf, "# note: this command %s\n", scriptComment(note))
+	}
+	if p.ContinueOnError && !p.RunBackground {
+		buf.WriteString("\nstatus=0\n")
+	}
+
+	for idx, step := range p.Steps {
+		fmt.Fprintf(buf, "\n# step %d\n", idx+1)
+		for _, comment := range step.scriptComments() {
+			fmt.Fprintf(buf, "# %s\n", scriptComment(comment))
+		}
+
+		line := step.scriptLine()
+		switch {
+		case p.RunBackground:
+			fmt.Fprintf(buf, "%s &\n", line)
+		case p.ContinueOnError:
+			fmt.Fprintf(buf, "%s || status=$?\n", line)
+		case p.IgnoreError:
+			fmt.Fprintf(buf, "%s || exit 0\n", line)
+		default:
+			fmt.Fprintf(buf, "%s || exit $?\n", line)
+		}
+	}
+
+	if p.ContinueOnError && !p.RunBackground {
+		if p.IgnoreError {
+			buf.WriteString("\nexit 0\n")
+		} else {
+			buf.WriteString("\nexit $status\n")
+		}
+	}
+
+	return buf.String()
+}
+
+func (s *CommandPlanStep) scriptComments() []string {
+	comments := []string{}
+	if s.Remote != "" {
+		comments = append(comments, "the remote shell runs the command as shown, without quoting its directory, environment, or arguments")
+		for _, stage := range s.stages {
+			if stage.Remote == nil {
+				continue
+			}
+			if stage.Remote.Key != "" || stage.Remote.Password != "" || stage.Remote.KeyPassphrase != "" {
+				comments = append(comments, "jasper authenticates to the remote host with secrets that are not included")
+			}
+			if stage.Remote.Proxy != nil && (stage.Remote.Proxy.Key != "" || stage.Remote.Proxy.Password != "" || stage.Remote.Proxy.KeyPassphrase != "") {
+				comments = append(comments, "jasper authenticates to the proxy with secrets that are not included")
+			}
+			break
+		}
+	}
+	if len(s.Pipeline) != 0 {
+		comments = append(comments, "jasper fails the pipeline if any of its commands fail, while the shell only checks the last command")
+	}
+	if s.StandardInput != "" && len(s.stages[0].StandardInputBytes) == 0 {
+		comments = append(comments, "jasper reads standard input from a reader that is not included")
+	}
+	if s.Output != "discarded" && !strings.HasPrefix(s.Output, "redirected") {
+		comments = append(comments, fmt.Sprintf("jasper writes standard output to %s", s.Output))
+	}
+	if s.Error != "discarded" && !strings.HasPrefix(s.Error, "redirected") {
+		comments = append(comments, fmt.Sprintf("jasper writes standard error to %s", s.Error))
+	}
+	if s.Timeout != 0 {
+		comments = append(comments, fmt.Sprintf("jasper stops the step after %s", s.Timeout))
+	}
+	if s.Retry != nil {
+		comments = append(comments, fmt.Sprintf("jasper retries the step up to %d attempts", s.Retry.Attempts))
+	}
+	if len(s.Tags) != 0 {
+		tags := make([]string, 0, len(s.Tags))
+		for _, tag := range s.Tags {
+			tags = append(tags, scriptComment(tag))
+		}
+		comments = append(comments, fmt.Sprintf("tags: %s", strings.Join(tags, ", ")))
+	}
+	return comments
+}
+
+func (s *CommandPlanStep) scriptLine() string {
+	local := s.Remote == "" && s.Docker == ""
+
+	stages := []string{}
+	if input := s.stages[0].StandardInputBytes; len(input) != 0 {
+		stages = append(stages, fmt.Sprintf("printf '%%s' %s", shellQuote(string(input))))
+	}
+	for idx, args := range s.Exec {
+		stage := s.stages[idx]
+		parts := []string{}
+		if local {
+			env := stage.ResolveEnvironment()
+			if s.OverrideEnviron {
+				parts = append(parts, "env", "-i")
+			} else if len(env) != 0 {
+				parts = append(parts, "env")
+			}
+			for _, e := range env {
+				parts = append(parts, shellQuote(e))
+			}
+		}
+		parts = append(parts, shellJoin(args))
+		if idx == len(s.Exec)-1 {
+			switch {
+			case stage.Output.SuppressOutput || (stage.Output.Output == nil && len(stage.Output.Loggers) == 0 && !stage.Output.SendOutputToError):
+				parts = append(parts, ">/dev/null")
+			case stage.Output.SendOutputToError:
+				parts = append(parts, ">&2")
+			}
+		}
+		switch {
+		case stage.Output.SuppressError || (stage.Output.Error == nil && len(stage.Output.Loggers) == 0 && !stage.Output.SendErrorToOutput):
+			parts = append(parts, "2>/dev/null")
+		case stage.Output.SendErrorToOutput:
+			parts = append(parts, "2>&1")
+		}
+		stages = append(stages, strings.Join(parts, " "))
+	}
+
+	line := strings.Join(stages, " | ")
+	if local && s.Directory != "" {
+		line = fmt.Sprintf("(cd %s && %s)", shellQuote(s.Directory), line)
+	}
+	return line
+}
+
+// scriptComment escapes line breaks in the text, so that it cannot end the
+// shell comment that it is written in.
+func scriptComment(s string) string {
+	if !strings.ContainsAny(s, "\r\n") {
+		return s
+	}
+	return strconv.Quote(s)
+}
+
+var shellSafe = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+// shellQuote quotes the string so that a POSIX shell reads it as one word.
+func shellQuote(s string) string {
+	if s == "" {
+		return "''"
+	}
+	if shellSafe.MatchString(s) {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func shellJoin(args []string) string {
+	quoted := make([]string, 0, len(args))
+	for _, arg := range args {
+		quoted = append(quoted, shellQuote(arg))
+	}
+	return strings.Join(quoted, " ")
+}
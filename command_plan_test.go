@@ -0,0 +1,254 @@
+package jasper
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/testutil"
+	"github.com/tychoish/jasper/util"
+)
+
+func TestShellQuote(t *testing.T) {
+	for input, expected := range map[string]string{
+		"":               "''",
+		"ls":             "ls",
+		"/usr/bin/a-b.c": "/usr/bin/a-b.c",
+		"KEY=value":      "KEY=value",
+		"a b":            "'a b'",
+		"$HOME":          "'$HOME'",
+		"it's":           `'it'\''s'`,
+		"a\nb":           "'a\nb'",
+		"*":              "'*'",
+	} {
+		check.Equal(t, shellQuote(input), expected)
+	}
+}
+
+func TestCommandDryRun(t *testing.T) {
+	cwd, err := os.Getwd()
+	assert.NotError(t, err)
+
+	for name, testCase := range map[string]func(t *testing.T, cmd *Command){
+		"ResolvesLocalSteps": func(t *testing.T, cmd *Command) {
+			cmd.ID("plan").Sudo(true).Extend([][]string{{"echo", "a b"}, {"true"}}).AddEnv("KEY", "value").ExtendTags([]string{"tag"})
+
+			plan, err := cmd.DryRun()
+			assert.NotError(t, err)
+			check.Equal(t, plan.ID, "plan")
+			assert.Equal(t, len(plan.Steps), 2)
+
+			step := plan.Steps[0]
+			check.EqualItems(t, step.Args, []string{"sudo", "echo", "a b"})
+			check.Equal(t, len(step.Exec), 1)
+			check.EqualItems(t, step.Exec[0], step.Args)
+			check.Equal(t, step.Directory, cwd)
+			check.EqualItems(t, step.Environment, []string{"KEY=value"})
+			check.EqualItems(t, step.Tags, []string{"tag"})
+			check.Equal(t, step.Output, "discarded")
+			check.Equal(t, step.Error, "discarded")
+
+			out := plan.String()
+			check.Substring(t, out, "command 'plan' stops at the first step that fails")
+			check.Substring(t, out, "step 1: sudo echo 'a b'")
+			check.Substring(t, out, "environment: inherited, with KEY=value")
+		},
+		"DescribesOutput": func(t *testing.T, cmd *Command) {
+			logger, err := NewInMemoryLogger(10)
+			assert.NotError(t, err)
+			cmd.Append("true").SetOutputWriter(util.NewLocalBuffer(&bytes.Buffer{})).AppendLoggers(logger).RedirectErrorToOutput(true)
+
+			plan, err := cmd.DryRun()
+			assert.NotError(t, err)
+			check.Equal(t, plan.Steps[0].Output, "a writer, logger 'in-memory'")
+			check.Equal(t, plan.Steps[0].Error, "redirected to standard output")
+
+			cmd.SuppressStandardOutput(true)
+			plan, err = cmd.DryRun()
+			assert.NotError(t, err)
+			check.Equal(t, plan.Steps[0].Output, "discarded")
+		},
+		"WrapsRemoteSteps": func(t *testing.T, cmd *Command) {
+			cmd.Append("echo hi").Directory("/tmp").AddEnv("KEY", "value").
+				SetRemoteOptions(&options.Remote{RemoteConfig: options.RemoteConfig{Host: "example.com", User: "user", Args: []string{"-v"}}})
+
+			plan, err := cmd.DryRun()
+			assert.NotError(t, err)
+			step := plan.Steps[0]
+			check.Equal(t, step.Remote, "user@example.com")
+			check.EqualItems(t, step.Exec[0], []string{"ssh", "-v", "user@example.com", "cd '/tmp' && KEY=value echo hi"})
+			check.Substring(t, plan.String(), "runs: ssh -v user@example.com 'cd '\\''/tmp'\\'' && KEY=value echo hi'")
+		},
+		"TranslatesSSHLibraryOptions": func(t *testing.T, cmd *Command) {
+			remote := &options.Remote{RemoteConfig: options.RemoteConfig{
+				Host: "example.com", UseSSHLibrary: true, Port: 2222, KeyFile: "/id", Password: "hunter2",
+			}}
+			remote.Proxy = &options.Proxy{RemoteConfig: options.RemoteConfig{Host: "proxy", User: "jump"}}
+			cmd.Append("true").SetRemoteOptions(remote)
+
+			plan, err := cmd.DryRun()
+			assert.NotError(t, err)
+			check.EqualItems(t, plan.Steps[0].Exec[0], []string{"ssh", "-p", "2222", "-i", "/id", "-J", "jump@proxy", "example.com", "true"})
+
+			script := plan.Script()
+			check.Substring(t, script, "secrets that are not included")
+			check.NotSubstring(t, script, "hunter2")
+		},
+		"WrapsDockerSteps": func(t *testing.T, cmd *Command) {
+			cmd.Append("true").Directory("/work").AddEnv("KEY", "value").WithOptions(func(opts *options.Command) {
+				opts.Process.Docker = &options.Docker{Host: "docker", Port: 2376, Image: "alpine"}
+			})
+
+			plan, err := cmd.DryRun()
+			assert.NotError(t, err)
+			check.Equal(t, plan.Steps[0].Docker, "alpine")
+			check.EqualItems(t, plan.Steps[0].Exec[0], []string{"docker", "-H", "tcp://docker:2376", "run", "--rm", "-w", "/work", "-e", "KEY=value", "alpine", "true"})
+		},
+		"IncludesPipelinesAndPolicies": func(t *testing.T, cmd *Command) {
+			cmd.Pipeline([]string{"ls"}, []string{"wc", "-l"}).
+				Retry(&options.Retry{Attempts: 3}).
+				Prerequisite(func() bool { return true }).
+				WithOptions(func(opts *options.Command) { opts.Process.Timeout = time.Minute })
+
+			plan, err := cmd.DryRun()
+			assert.NotError(t, err)
+			step := plan.Steps[0]
+			check.Equal(t, len(step.Pipeline), 1)
+			check.Equal(t, len(step.Exec), 2)
+			check.Equal(t, step.Timeout, time.Minute)
+			assert.True(t, step.Retry != nil)
+			check.Equal(t, step.Retry.Attempts, 3)
+			check.Equal(t, len(plan.Notes), 1)
+
+			out := plan.String()
+			check.Substring(t, out, "step 1: ls | wc -l")
+			check.Substring(t, out, "retry: up to 3 attempts")
+			check.Substring(t, out, "timeout: 1m0s")
+		},
+		"InvalidCommandErrors": func(t *testing.T, cmd *Command) {
+			cmd.Add([]string{})
+			_, err := cmd.DryRun()
+			check.Error(t, err)
+			_, err = cmd.RenderScript()
+			check.Error(t, err)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			testCase(t, NewCommand())
+		})
+	}
+}
+
+func TestCommandRenderScript(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires a POSIX shell")
+	}
+
+	runScript := func(t *testing.T, script string) (string, error) {
+		out, err := exec.Command("sh", "-c", script).CombinedOutput()
+		return string(out), err
+	}
+
+	for name, testCase := range map[string]func(ctx context.Context, t *testing.T, cmd *Command){
+		"MatchesTheOutputOfTheCommand": func(ctx context.Context, t *testing.T, cmd *Command) {
+			dir := t.TempDir()
+			cmd.Directory(dir).AddEnv("GREETING", "it's $HOME").
+				Add([]string{"sh", "-c", `printf '%s|%s\n' "$GREETING" "$(basename "$PWD")"`}).
+				Add([]string{"printf", `%s\n`, "a b", "*"}).
+				Pipeline([]string{"printf", `b\na\n`}, []string{"sort"})
+			var buf bytes.Buffer
+			cmd.SetCombinedWriter(util.NewLocalBuffer(&buf))
+
+			script, err := cmd.RenderScript()
+			assert.NotError(t, err)
+			check.Substring(t, script, "#!/bin/sh\n")
+			assert.NotError(t, cmd.Run(ctx))
+
+			out, err := runScript(t, script)
+			assert.NotError(t, err)
+			check.Equal(t, out, buf.String())
+			check.Substring(t, out, "it's $HOME|")
+			check.Substring(t, out, "a b\n*\n")
+			check.Substring(t, out, "a\nb\n")
+		},
+		"DiscardsOutputWithoutWriters": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Append("echo hidden")
+			script, err := cmd.RenderScript()
+			assert.NotError(t, err)
+
+			out, err := runScript(t, script)
+			assert.NotError(t, err)
+			check.Equal(t, out, "")
+		},
+		"PassesStandardInput": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Append("cat").SetOutputWriter(util.NewLocalBuffer(&bytes.Buffer{})).SetInputBytes([]byte("it's\n%s input"))
+			script, err := cmd.RenderScript()
+			assert.NotError(t, err)
+
+			out, err := runScript(t, script)
+			assert.NotError(t, err)
+			check.Equal(t, out, "it's\n%s input")
+		},
+		"StopsAtTheFirstError": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Extend([][]string{{"sh", "-c", "exit 3"}, {"echo", "after"}}).SetCombinedWriter(util.NewLocalBuffer(&bytes.Buffer{}))
+			script, err := cmd.RenderScript()
+			assert.NotError(t, err)
+
+			out, err := runScript(t, script)
+			assert.Error(t, err)
+			check.Equal(t, err.(*exec.ExitError).ExitCode(), 3)
+			check.Equal(t, out, "")
+		},
+		"ContinuesOnError": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Extend([][]string{{"sh", "-c", "exit 3"}, {"echo", "after"}}).
+				SetCombinedWriter(util.NewLocalBuffer(&bytes.Buffer{})).ContinueOnError(true)
+			script, err := cmd.RenderScript()
+			assert.NotError(t, err)
+
+			out, err := runScript(t, script)
+			assert.Error(t, err)
+			check.Equal(t, out, "after\n")
+
+			script, err = cmd.IgnoreError(true).RenderScript()
+			assert.NotError(t, err)
+			out, err = runScript(t, script)
+			assert.NotError(t, err)
+			check.Equal(t, out, "after\n")
+		},
+		"EscapesLineBreaksInComments": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.ID("id\necho injected").SetTags([]string{"tag\necho injected"}).Append("true")
+			script, err := cmd.RenderScript()
+			assert.NotError(t, err)
+			check.Substring(t, script, `"id\necho injected"`)
+			check.Substring(t, script, `"tag\necho injected"`)
+
+			out, err := runScript(t, script)
+			assert.NotError(t, err)
+			check.Equal(t, out, "")
+		},
+		"OverridesTheEnvironment": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Append("/usr/bin/env").SetOutputWriter(util.NewLocalBuffer(&bytes.Buffer{})).AddEnv("ONLY", "this").
+				WithOptions(func(opts *options.Command) { opts.Process.OverrideEnviron = true })
+			script, err := cmd.RenderScript()
+			assert.NotError(t, err)
+
+			out, err := runScript(t, script)
+			assert.NotError(t, err)
+			check.Equal(t, out, "ONLY=this\n")
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+			defer cancel()
+
+			testCase(ctx, t, NewCommand())
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package jasper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tychoish/grip"
+	"github.com/tychoish/grip/message"
+	"github.com/tychoish/jasper/options"
+)
+
+// CommandAttempt describes one attempt to run a sub-command of a Command.
+type CommandAttempt struct {
+	// ProcessID is the ID of the process of the attempt. It is empty if
+	// the process could not be created.
+	ProcessID string    `bson:"process_id,omitempty" json:"process_id,omitempty" yaml:"process_id,omitempty"`
+	ExitCode  int       `bson:"exit_code" json:"exit_code" yaml:"exit_code"`
+	Error     string    `bson:"error,omitempty" json:"error,omitempty" yaml:"error,omitempty"`
+	TimedOut  bool      `bson:"timed_out,omitempty" json:"timed_out,omitempty" yaml:"timed_out,omitempty"`
+	StartAt   time.Time `bson:"start_at" json:"start_at" yaml:"start_at"`
+	EndAt     time.Time `bson:"end_at" json:"end_at" yaml:"end_at"`
+}
+
+// CommandResult describes the outcome of a sub-command of a Command, which
+// is the outcome of its last attempt.
+type CommandResult struct {
+	Args     []string         `bson:"args" json:"args" yaml:"args"`
+	Attempts []CommandAttempt `bson:"attempts" json:"attempts" yaml:"attempts"`
+}
+
+// Successful returns whether the last attempt of the sub-command succeeded.
+func (r CommandResult) Successful() bool {
+	return len(r.Attempts) != 0 && r.Attempts[len(r.Attempts)-1].Error == ""
+}
+
+// RunProcessWithRetry creates a process with the options and waits for it to
+// exit. If the process fails, it is created again for as long as the retry
+// options allow. If the retry options are nil, the process is only run once.
+// It returns every attempt and the error of the last attempt; the exit code
+// of the last attempt is the exit code of the process. The output patterns of
+// the retry options are only matched against processes created locally, so
+// attempts created by a remote service never match them.
+func RunProcessWithRetry(ctx context.Context, makeProc ProcessConstructor, opts *options.Create, retry *options.Retry) ([]CommandAttempt, error) {
+	return runWithRetry(ctx, retry, opts, func(ctx context.Context, opts *options.Create) (Process, error) {
+		proc, err := makeProc(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		_, err = proc.Wait(ctx)
+		return proc, err
+	})
+}
+
+// runWithRetry uses run to create a process with the options and wait for
+// it to exit, until it succeeds or the retry options do not allow another
+// attempt. The process is nil if it could not be created, which is never
+// retried.
+func runWithRetry(ctx context.Context, retry *options.Retry, opts *options.Create, run func(context.Context, *options.Create) (Process, error)) ([]CommandAttempt, error) {
+	if retry == nil {
+		attempt, _, err := runAttempt(ctx, opts, run)
+		return []CommandAttempt{attempt}, err
+	}
+	if err := retry.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid retry options: %w", err)
+	}
+
+	attempts := make([]CommandAttempt, 0, retry.Attempts)
+	for num := 1; ; num++ {
+		probe := newOutputProbe(retry.OutputPatterns)
+		attempt, proc, err := runAttempt(withOutputProbe(ctx, probe), makeAttemptOptions(opts, retry), run)
+		attempts = append(attempts, attempt)
+		if err == nil || proc == nil || num >= retry.Attempts || ctx.Err() != nil {
+			return attempts, err
+		}
+		if !retry.ShouldRetry(attempt.ExitCode, attempt.TimedOut, probe.hasMatched()) {
+			return attempts, err
+		}
+
+		backoff := retry.BackoffAfter(num)
+		grip.Debug(message.WrapError(err, message.Fields{
+			"message": "retrying failed attempt",
+			"proc":    proc.ID(),
+			"attempt": num,
+			"backoff": backoff,
+		}))
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return attempts, err
+		case <-timer.C:
+		}
+	}
+}
+
+func runAttempt(ctx context.Context, opts *options.Create, run func(context.Context, *options.Create) (Process, error)) (CommandAttempt, Process, error) {
+	attempt := CommandAttempt{StartAt: time.Now(), ExitCode: -1}
+	proc, err := run(ctx, opts)
+	attempt.EndAt = time.Now()
+	if proc != nil {
+		info := proc.Info(ctx)
+		attempt.ProcessID = proc.ID()
+		attempt.ExitCode = info.ExitCode
+		attempt.TimedOut = info.Timeout
+	}
+	if err != nil {
+		attempt.Error = err.Error()
+	}
+	return attempt, proc, err
+}
+
+// makeAttemptOptions returns a copy of the options for one attempt, which
+// applies the attempt timeout of the retry options.
+func makeAttemptOptions(opts *options.Create, retry *options.Retry) *options.Create {
+	attemptOpts := opts.Copy()
+
+	if retry.AttemptTimeout > 0 {
+		timeout := attemptOpts.Timeout
+		if timeout == 0 {
+			timeout = time.Duration(attemptOpts.TimeoutSecs) * time.Second
+		}
+		if timeout == 0 || retry.AttemptTimeout < timeout {
+			attemptOpts.Timeout = retry.AttemptTimeout
+			attemptOpts.TimeoutSecs = 0
+		}
+	}
+
+	return attemptOpts
+}
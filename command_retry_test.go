@@ -0,0 +1,174 @@
+package jasper
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/testutil"
+)
+
+// failOnceScript returns a shell script that fails with the exit code the
+// first time that it runs and succeeds after that.
+func failOnceScript(t *testing.T, exitCode int) string {
+	marker := filepath.Join(t.TempDir(), "marker")
+	return fmt.Sprintf("if [ -f %s ]; then exit 0; fi; touch %s; exit %d", marker, marker, exitCode)
+}
+
+func TestCommandRetry(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires a POSIX shell")
+	}
+
+	for name, testCase := range map[string]func(context.Context, *testing.T, *Command){
+		"RetriesUntilSuccess": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Sh(failOnceScript(t, 3)).Retry(&options.Retry{Attempts: 3})
+			assert.NotError(t, cmd.Run(ctx))
+
+			results := cmd.Results()
+			assert.Equal(t, len(results), 1)
+			check.True(t, results[0].Successful())
+			assert.Equal(t, len(results[0].Attempts), 2)
+			check.Equal(t, results[0].Attempts[0].ExitCode, 3)
+			check.NotZero(t, results[0].Attempts[0].Error)
+			check.Equal(t, results[0].Attempts[1].ExitCode, 0)
+			check.EqualItems(t, cmd.GetProcIDs(), []string{results[0].Attempts[1].ProcessID})
+			_, err := cmd.Wait(ctx)
+			check.NotError(t, err)
+		},
+		"StopsAfterAllAttemptsFail": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Add([]string{"false"}).Retry(&options.Retry{Attempts: 3, Backoff: 10 * time.Millisecond})
+			assert.Error(t, cmd.Run(ctx))
+
+			results := cmd.Results()
+			assert.Equal(t, len(results), 1)
+			check.True(t, !results[0].Successful())
+			check.Equal(t, len(results[0].Attempts), 3)
+		},
+		"RetriesOnlyMatchingExitCodes": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Sh(failOnceScript(t, 2)).Retry(&options.Retry{Attempts: 3, ExitCodes: []int{3}})
+			assert.Error(t, cmd.Run(ctx))
+			check.Equal(t, len(cmd.Results()[0].Attempts), 1)
+		},
+		"RetriesOnMatchingOutput": func(ctx context.Context, t *testing.T, cmd *Command) {
+			manager := NewManager(ManagerOptionSetSynchronized())
+			defer func() { check.NotError(t, manager.Close(ctx)) }()
+
+			cmd.Sh("echo 'connection reset by peer'; " + failOnceScript(t, 1)).
+				Retry(&options.Retry{Attempts: 3, OutputPatterns: []string{"connection reset"}}).
+				ProcConstructor(manager.CreateProcess)
+			assert.NotError(t, cmd.Run(ctx))
+			attempts := cmd.Results()[0].Attempts
+			assert.Equal(t, len(attempts), 2)
+
+			// Matching the output does not change the attempts.
+			for _, attempt := range attempts {
+				proc, err := manager.Get(ctx, attempt.ProcessID)
+				assert.NotError(t, err)
+				check.Equal(t, len(proc.GetTags()), 0)
+				check.Equal(t, len(proc.Info(ctx).Options.OutputTriggers), 0)
+			}
+		},
+		"DoesNotRetryOnOtherOutput": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Sh("echo 'permission denied'; " + failOnceScript(t, 1)).
+				Retry(&options.Retry{Attempts: 3, OutputPatterns: []string{"connection reset"}})
+			assert.Error(t, cmd.Run(ctx))
+			check.Equal(t, len(cmd.Results()[0].Attempts), 1)
+		},
+		"RetriesAttemptsThatTimeOut": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Add([]string{"sleep", "10"}).Retry(&options.Retry{Attempts: 2, ExitCodes: []int{3}, AttemptTimeout: time.Second})
+			assert.Error(t, cmd.Run(ctx))
+
+			attempts := cmd.Results()[0].Attempts
+			assert.Equal(t, len(attempts), 2)
+			for _, attempt := range attempts {
+				check.True(t, attempt.TimedOut)
+				check.True(t, attempt.EndAt.Sub(attempt.StartAt) < 5*time.Second)
+			}
+		},
+		"StepRetryOverridesCommandRetry": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Add([]string{"false"}).Sh(failOnceScript(t, 1)).
+				Retry(&options.Retry{Attempts: 3}).
+				RetryStep(0, nil).
+				ContinueOnError(true)
+			assert.Error(t, cmd.Run(ctx))
+
+			results := cmd.Results()
+			assert.Equal(t, len(results), 2)
+			check.Equal(t, len(results[0].Attempts), 1)
+			check.Equal(t, len(results[1].Attempts), 2)
+			check.True(t, results[1].Successful())
+		},
+		"RunParallelRetriesSteps": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Sh(failOnceScript(t, 1)).Sh(failOnceScript(t, 1)).
+				RetryStep(1, &options.Retry{Attempts: 2})
+			assert.Error(t, cmd.RunParallel(ctx))
+
+			results := cmd.Results()
+			assert.Equal(t, len(results), 2)
+			attempts := map[bool]int{}
+			for _, result := range results {
+				attempts[result.Successful()] = len(result.Attempts)
+			}
+			check.Equal(t, attempts[true], 2)
+			check.Equal(t, attempts[false], 1)
+		},
+		"InvalidRetryOptionsError": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Add([]string{"true"}).Retry(&options.Retry{})
+			assert.Error(t, cmd.Run(ctx))
+			check.Equal(t, len(cmd.GetProcIDs()), 0)
+		},
+		"BackgroundCommandsAreNotRetried": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Add([]string{"false"}).Retry(&options.Retry{Attempts: 3}).Background(true)
+			assert.NotError(t, cmd.Run(ctx))
+			_, err := cmd.Wait(ctx)
+			check.Error(t, err)
+			check.Equal(t, len(cmd.GetProcIDs()), 1)
+			check.Equal(t, len(cmd.Results()), 0)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+			defer cancel()
+
+			testCase(ctx, t, NewCommand())
+		})
+	}
+}
+
+func TestRunProcessWithRetry(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires a POSIX shell")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+	defer cancel()
+
+	t.Run("RunsOnceWithoutRetryOptions", func(t *testing.T) {
+		attempts, err := RunProcessWithRetry(ctx, NewBasicProcess, testutil.FalseCreateOpts(), nil)
+		assert.Error(t, err)
+		assert.Equal(t, len(attempts), 1)
+		check.Equal(t, attempts[0].ExitCode, 1)
+	})
+	t.Run("RetriesFailedProcesses", func(t *testing.T) {
+		opts := &options.Create{Args: []string{"sh", "-c", failOnceScript(t, 4)}}
+		attempts, err := RunProcessWithRetry(ctx, NewBasicProcess, opts, &options.Retry{Attempts: 2})
+		assert.NotError(t, err)
+		assert.Equal(t, len(attempts), 2)
+		check.Equal(t, attempts[0].ExitCode, 4)
+		check.Equal(t, attempts[1].ExitCode, 0)
+	})
+	t.Run("DoesNotRetryCreationErrors", func(t *testing.T) {
+		attempts, err := RunProcessWithRetry(ctx, NewBasicProcess, &options.Create{}, &options.Retry{Attempts: 3})
+		assert.Error(t, err)
+		assert.Equal(t, len(attempts), 1)
+		check.Zero(t, attempts[0].ProcessID)
+		check.Equal(t, attempts[0].ExitCode, -1)
+	})
+}
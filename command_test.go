@@ -190,6 +190,19 @@ func TestCommandImplementation(t *testing.T) {
 								})
 							}
 						},
+						"ContinueOnErrorReportsEachFailureOnce": func(ctx context.Context, t *testing.T, cmd Command) {
+							cmd.Extend([][]string{{"false"}, {"true"}, {"true"}}).ContinueOnError(true)
+							err := runFunc(&cmd, ctx)
+							assert.Error(t, err)
+
+							ids := cmd.GetProcIDs()
+							assert.Equal(t, len(ids), 3)
+							failed := 0
+							for _, id := range ids {
+								failed += strings.Count(err.Error(), id)
+							}
+							check.Equal(t, failed, 1)
+						},
 						"InvalidArgsCommandErrors": func(ctx context.Context, t *testing.T, cmd Command) {
 							cmd.Add([]string{})
 							check.Equal(t, runFunc(&cmd, ctx).Error(), "cannot have empty args")
@@ -413,6 +426,12 @@ func TestCommandImplementation(t *testing.T) {
 							assert.Equal(t, ev.Key, "foo")
 							assert.Equal(t, ev.Value, "bar")
 						},
+						"StringIncludesEnvironmentOfRemoteCommand": func(ctx context.Context, t *testing.T, cmd Command) {
+							cmd.Host("localhost").User("user").AddEnv("foo", "bar").AddEnv("baz", "qux").Append("echo hi")
+
+							check.Substring(t, cmd.String(), "remote='user@localhost'")
+							check.Substring(t, cmd.String(), "cmd='foo=bar baz=qux echo hi'")
+						},
 						"TagFunctions": func(ctx context.Context, t *testing.T, cmd Command) {
 							tags := []string{"tag0", "tag1"}
 							sort.Strings(tags)
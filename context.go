@@ -8,7 +8,12 @@ import (
 
 type ctxKey string
 
-const defaultContextKey ctxKey = "__JASPER_STD_MANAGER"
+const (
+	defaultContextKey ctxKey = "__JASPER_STD_MANAGER"
+	processManagerKey ctxKey = "__JASPER_PROCESS_MANAGER"
+	detachFollowUpKey ctxKey = "__JASPER_DETACH_FOLLOW_UPS"
+	outputMatchKey    ctxKey = "__JASPER_OUTPUT_MATCH"
+)
 
 // WithManager attaches a Manager instance to the context
 func WithManager(ctx context.Context, mgr Manager) context.Context {
@@ -65,3 +70,38 @@ func HasContextManager(ctx context.Context, name string) bool {
 // HasManager returns true when the default context Manager is
 // attached.
 func HasManager(ctx context.Context) bool { return HasContextManager(ctx, string(defaultContextKey)) }
+
+// WithProcessManager attaches the manager that creates a process to the
+// context passed to CreateProcess, so that the processes created by its
+// output triggers and liveness restarts are created by the same manager.
+// Managers that wrap other managers attach themselves first, so the outermost
+// manager is not overridden. Such managers should also implement
+// Unwrap() Manager to return the manager they wrap, so that triggers that
+// need the underlying manager can find it.
+func WithProcessManager(ctx context.Context, m Manager) context.Context {
+	if _, ok := processManager(ctx); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, processManagerKey, m)
+}
+
+// processManager returns the manager attached to the context by
+// WithProcessManager, if any.
+func processManager(ctx context.Context) (Manager, bool) {
+	m, ok := ctx.Value(processManagerKey).(Manager)
+	return m, ok
+}
+
+// WithDetachedFollowUps marks the context passed to CreateProcess so that the
+// follow-up processes in the OnSuccess, OnFailure and OnTimeout options of
+// the process keep the values of the context but not its cancellation. It is
+// for callers, such as the remote services, that cancel the context of a
+// process once it exits, which would otherwise stop its follow-up processes.
+func WithDetachedFollowUps(ctx context.Context) context.Context {
+	return context.WithValue(ctx, detachFollowUpKey, true)
+}
+
+func hasDetachedFollowUps(ctx context.Context) bool {
+	detach, _ := ctx.Value(detachFollowUpKey).(bool)
+	return detach
+}
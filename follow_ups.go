@@ -0,0 +1,118 @@
+package jasper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/tychoish/fun/erc"
+)
+
+// FollowUpTrigger identifies the creation option that created a follow-up
+// process.
+type FollowUpTrigger string
+
+const (
+	// FollowUpOnSuccess identifies follow-up processes created from the
+	// OnSuccess options of a process that succeeded.
+	FollowUpOnSuccess FollowUpTrigger = "on-success"
+	// FollowUpOnFailure identifies follow-up processes created from the
+	// OnFailure options of a process that failed.
+	FollowUpOnFailure FollowUpTrigger = "on-failure"
+	// FollowUpOnTimeout identifies follow-up processes created from the
+	// OnTimeout options of a process that timed out.
+	FollowUpOnTimeout FollowUpTrigger = "on-timeout"
+)
+
+// FollowUpInfo describes a follow-up process that a manager created, or
+// failed to create, once a process exited.
+type FollowUpInfo struct {
+	Trigger FollowUpTrigger `json:"trigger" bson:"trigger"`
+	// ID is the ID of the follow-up process. It is empty if the process
+	// could not be created.
+	ID string `json:"id,omitempty" bson:"id,omitempty"`
+	// Error describes why the follow-up process could not be created.
+	Error string `json:"error,omitempty" bson:"error,omitempty"`
+	// Complete, Successful and ExitCode describe the outcome of the
+	// follow-up process once it exits.
+	Complete   bool `json:"complete,omitempty" bson:"complete,omitempty"`
+	Successful bool `json:"successful,omitempty" bson:"successful,omitempty"`
+	ExitCode   int  `json:"exit_code,omitempty" bson:"exit_code,omitempty"`
+}
+
+// followUpRecorder is implemented by processes that record their follow-up
+// processes in their info.
+type followUpRecorder interface {
+	recordFollowUp(FollowUpInfo)
+}
+
+// followUpLog records the follow-up processes of a process. It has its own
+// lock since it is updated by the triggers of the process, which run while
+// the process is locked, and by the triggers of the follow-up processes.
+type followUpLog struct {
+	mu        sync.Mutex
+	followUps []FollowUpInfo
+}
+
+// record adds the follow-up process, or updates it if a follow-up process
+// with the same ID was already recorded.
+func (l *followUpLog) record(followUp FollowUpInfo) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if followUp.ID != "" {
+		for idx := range l.followUps {
+			if l.followUps[idx].ID == followUp.ID {
+				l.followUps[idx] = followUp
+				return
+			}
+		}
+	}
+	l.followUps = append(l.followUps, followUp)
+}
+
+// list returns a copy of the recorded follow-up processes.
+func (l *followUpLog) list() []FollowUpInfo {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.followUps) == 0 {
+		return nil
+	}
+	return append([]FollowUpInfo(nil), l.followUps...)
+}
+
+// WaitWithFollowUps waits for the process to exit and then for each of the
+// follow-up processes that its manager created from its OnSuccess,
+// OnFailure and OnTimeout options, and for their follow-up processes in
+// turn. The follow-up processes are retrieved from the manager, which may
+// be a remote client. It returns the exit code of the process and an error
+// if the process or any of its follow-up processes failed or could not be
+// created.
+func WaitWithFollowUps(ctx context.Context, m Manager, proc Process) (int, error) {
+	exitCode, err := proc.Wait(ctx)
+	if ctx.Err() != nil {
+		return exitCode, err
+	}
+
+	catcher := &erc.Collector{}
+	catcher.Push(err)
+	for _, followUp := range proc.Info(ctx).FollowUps {
+		if followUp.ID == "" {
+			catcher.Push(fmt.Errorf("could not create %s follow-up process: %w", followUp.Trigger, errors.New(followUp.Error)))
+			continue
+		}
+
+		followUpProc, err := m.Get(ctx, followUp.ID)
+		if err != nil {
+			catcher.Push(fmt.Errorf("problem finding %s follow-up process '%s': %w", followUp.Trigger, followUp.ID, err))
+			continue
+		}
+		if _, err := WaitWithFollowUps(ctx, m, followUpProc); err != nil {
+			catcher.Push(fmt.Errorf("%s follow-up process '%s': %w", followUp.Trigger, followUp.ID, err))
+		}
+	}
+
+	return exitCode, catcher.Resolve()
+}
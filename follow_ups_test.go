@@ -0,0 +1,153 @@
+package jasper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/testutil"
+)
+
+func TestFollowUps(t *testing.T) {
+	tests := map[string]func(ctx context.Context, t *testing.T, manager Manager, impl string){
+		"RecordsFollowUpOutcomes": func(ctx context.Context, t *testing.T, manager Manager, impl string) {
+			opts := testutil.TrueCreateOpts()
+			opts.Implementation = impl
+			opts.OnSuccess = []*options.Create{testutil.TrueCreateOpts(), testutil.FalseCreateOpts()}
+			proc, err := manager.CreateProcess(ctx, opts)
+			assert.NotError(t, err)
+
+			_, err = WaitWithFollowUps(ctx, manager, proc)
+			assert.Error(t, err)
+
+			followUps := proc.Info(ctx).FollowUps
+			assert.Equal(t, len(followUps), 2)
+			for _, followUp := range followUps {
+				check.Equal(t, followUp.Trigger, FollowUpOnSuccess)
+				check.NotZero(t, followUp.ID)
+				check.True(t, followUp.Complete)
+				check.Zero(t, followUp.Error)
+			}
+			check.True(t, followUps[0].Successful)
+			check.True(t, !followUps[1].Successful)
+
+			child, err := manager.Get(ctx, followUps[0].ID)
+			assert.NotError(t, err)
+			check.EqualItems(t, child.GetTags(), []string{proc.ID()})
+		},
+		"WaitsForNestedFollowUps": func(ctx context.Context, t *testing.T, manager Manager, impl string) {
+			followUp := testutil.FalseCreateOpts()
+			followUp.OnFailure = []*options.Create{testutil.TrueCreateOpts()}
+			opts := testutil.FalseCreateOpts()
+			opts.Implementation = impl
+			opts.OnFailure = []*options.Create{followUp}
+			proc, err := manager.CreateProcess(ctx, opts)
+			assert.NotError(t, err)
+
+			exitCode, err := WaitWithFollowUps(ctx, manager, proc)
+			assert.Error(t, err)
+			check.NotZero(t, exitCode)
+
+			followUps := proc.Info(ctx).FollowUps
+			assert.Equal(t, len(followUps), 1)
+			check.Equal(t, followUps[0].Trigger, FollowUpOnFailure)
+			child, err := manager.Get(ctx, followUps[0].ID)
+			assert.NotError(t, err)
+
+			nested := child.Info(ctx).FollowUps
+			assert.Equal(t, len(nested), 1)
+			check.True(t, nested[0].Complete)
+			check.True(t, nested[0].Successful)
+		},
+		"SurfacesCreationErrors": func(ctx context.Context, t *testing.T, manager Manager, impl string) {
+			opts := testutil.TrueCreateOpts()
+			opts.Implementation = impl
+			opts.OnSuccess = []*options.Create{{}}
+			proc, err := manager.CreateProcess(ctx, opts)
+			assert.NotError(t, err)
+
+			_, err = WaitWithFollowUps(ctx, manager, proc)
+			assert.Error(t, err)
+
+			followUps := proc.Info(ctx).FollowUps
+			assert.Equal(t, len(followUps), 1)
+			check.Equal(t, followUps[0].Trigger, FollowUpOnSuccess)
+			check.Zero(t, followUps[0].ID)
+			check.NotZero(t, followUps[0].Error)
+		},
+		"CancelingTheContextStopsFollowUps": func(ctx context.Context, t *testing.T, manager Manager, impl string) {
+			pctx, pcancel := context.WithCancel(ctx)
+			defer pcancel()
+			opts := testutil.TrueCreateOpts()
+			opts.Implementation = impl
+			opts.OnSuccess = []*options.Create{testutil.SleepCreateOpts(10)}
+			proc, err := manager.CreateProcess(pctx, opts)
+			assert.NotError(t, err)
+			_, err = proc.Wait(ctx)
+			assert.NotError(t, err)
+
+			followUps := proc.Info(ctx).FollowUps
+			assert.Equal(t, len(followUps), 1)
+			child, err := manager.Get(ctx, followUps[0].ID)
+			assert.NotError(t, err)
+
+			pcancel()
+			_, err = child.Wait(ctx)
+			check.Error(t, err)
+			check.True(t, !child.Info(ctx).Successful)
+		},
+		"DetachedFollowUpsOutliveTheContext": func(ctx context.Context, t *testing.T, manager Manager, impl string) {
+			pctx, pcancel := context.WithCancel(WithDetachedFollowUps(ctx))
+			defer pcancel()
+			opts := testutil.TrueCreateOpts()
+			opts.Implementation = impl
+			opts.OnSuccess = []*options.Create{testutil.SleepCreateOpts(1)}
+			proc, err := manager.CreateProcess(pctx, opts)
+			assert.NotError(t, err)
+			_, err = proc.Wait(ctx)
+			assert.NotError(t, err)
+
+			followUps := proc.Info(ctx).FollowUps
+			assert.Equal(t, len(followUps), 1)
+			child, err := manager.Get(ctx, followUps[0].ID)
+			assert.NotError(t, err)
+
+			pcancel()
+			_, err = child.Wait(ctx)
+			check.NotError(t, err)
+			check.True(t, child.Info(ctx).Successful)
+		},
+		"SucceedsWithoutFollowUps": func(ctx context.Context, t *testing.T, manager Manager, impl string) {
+			opts := testutil.TrueCreateOpts()
+			opts.Implementation = impl
+			proc, err := manager.CreateProcess(ctx, opts)
+			assert.NotError(t, err)
+
+			exitCode, err := WaitWithFollowUps(ctx, manager, proc)
+			assert.NotError(t, err)
+			check.Zero(t, exitCode)
+			check.Equal(t, len(proc.Info(ctx).FollowUps), 0)
+		},
+	}
+
+	for implName, impl := range map[string]string{
+		"Basic":    options.ProcessImplementationBasic,
+		"Blocking": options.ProcessImplementationBlocking,
+	} {
+		t.Run(implName, func(t *testing.T) {
+			for name, test := range tests {
+				t.Run(name, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), testutil.ManagerTestTimeout)
+					defer cancel()
+
+					manager := NewManager(ManagerOptionSetSynchronized())
+					defer func() { check.NotError(t, manager.Close(ctx)) }()
+
+					test(ctx, t, manager, impl)
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package jasper
+
+import (
+	"io"
+	"runtime"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/tychoish/grip"
+	"github.com/tychoish/grip/message"
+	"github.com/tychoish/jasper/executor"
+	"github.com/tychoish/jasper/options"
+)
+
+// TimeoutReason describes why a process timed out.
+type TimeoutReason string
+
+const (
+	// TimeoutReasonDeadline indicates that the process ran for longer than
+	// its timeout.
+	TimeoutReasonDeadline TimeoutReason = "deadline"
+	// TimeoutReasonIdle indicates that the process did not produce output
+	// for longer than its idle timeout.
+	TimeoutReasonIdle TimeoutReason = "idle"
+)
+
+// idleMonitor tracks when a process last wrote to its standard output or
+// standard error and stops the process once it has been idle for longer
+// than its idle timeout.
+type idleMonitor struct {
+	opts     options.IdleTimeout
+	last     atomic.Int64
+	timedOut atomic.Bool
+}
+
+// newIdleMonitor returns a monitor for the idle timeout in the options, or
+// nil if there is none. The executor's output writers are wrapped to record
+// output, so it must be called before the executor is started.
+func newIdleMonitor(opts *options.Create, exec executor.Executor) *idleMonitor {
+	if opts.IdleTimeout == nil {
+		return nil
+	}
+
+	m := &idleMonitor{opts: *opts.IdleTimeout}
+	m.last.Store(time.Now().UnixNano())
+	exec.SetStdout(m.wrap(exec.Stdout()))
+	exec.SetStderr(m.wrap(exec.Stderr()))
+
+	return m
+}
+
+func (m *idleMonitor) wrap(w io.Writer) io.Writer {
+	return &idleMonitorWriter{monitor: m, writer: w}
+}
+
+// watch stops the process once it is idle. It returns when the done channel
+// is closed.
+func (m *idleMonitor) watch(proc Process, done <-chan struct{}) {
+	if m == nil {
+		return
+	}
+
+	timer := time.NewTimer(m.opts.Duration)
+	defer timer.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-timer.C:
+		}
+
+		idle := time.Since(time.Unix(0, m.last.Load()))
+		if idle < m.opts.Duration {
+			timer.Reset(m.opts.Duration - idle)
+			continue
+		}
+
+		m.timedOut.Store(true)
+		grip.Info(message.Fields{
+			"message":  "process reached idle timeout",
+			"id":       proc.ID(),
+			"idle":     idle.String(),
+			"duration": m.opts.Duration.String(),
+		})
+		m.stop(proc, done)
+		return
+	}
+}
+
+// stop signals the process with increasing severity until it exits.
+func (m *idleMonitor) stop(proc Process, done <-chan struct{}) {
+	sigs := []syscall.Signal{syscall.SIGTERM, syscall.SIGKILL}
+	if m.opts.StackDump && runtime.GOOS != "windows" {
+		sigs = append([]syscall.Signal{syscall.SIGQUIT}, sigs...)
+	}
+	signalUntilExit(proc, done, m.opts.GracePeriod, sigs...)
+}
+
+// timeoutReason returns the reason that the process timed out, if it did.
+// The monitor may be nil.
+func (m *idleMonitor) timeoutReason(deadlineExceeded bool) TimeoutReason {
+	switch {
+	case m != nil && m.timedOut.Load():
+		return TimeoutReasonIdle
+	case deadlineExceeded:
+		return TimeoutReasonDeadline
+	default:
+		return ""
+	}
+}
+
+type idleMonitorWriter struct {
+	monitor *idleMonitor
+	writer  io.Writer
+}
+
+func (w *idleMonitorWriter) Write(p []byte) (int, error) {
+	w.monitor.last.Store(time.Now().UnixNano())
+	if w.writer == nil {
+		return len(p), nil
+	}
+	return w.writer.Write(p)
+}
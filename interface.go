@@ -83,6 +83,12 @@ type Process interface {
 	// and instead is returned as -1.
 	Wait(context.Context) (int, error)
 
+	// WaitReady blocks until the readiness probes of the process
+	// succeed, returning an error if the process exits before it is
+	// ready or the context is canceled. Processes without readiness
+	// probes are ready as soon as they start.
+	WaitReady(context.Context) error
+
 	// Respawn respawns a near-identical version of the process on
 	// which it is called. It will spawn a new process with the same
 	// options and return the new, "respawned" process.
@@ -107,6 +113,11 @@ type Process interface {
 	// complete.
 	RegisterTrigger(context.Context, ProcessTrigger) error
 
+	// RegisterTriggerID associates a trigger represented by an
+	// identifier with a process, which executes after the process
+	// completes. The parameters configure the trigger.
+	RegisterTriggerID(context.Context, ProcessTriggerID, map[string]string) error
+
 	// Tag adds a tag to a process. Implementations should avoid
 	// allowing duplicate tags to exist.
 	Tag(string)
@@ -135,4 +146,19 @@ type ProcessInfo struct {
 	Options    options.Create `json:"options" bson:"options"`
 	StartAt    time.Time      `json:"start_at" bson:"start_at"`
 	EndAt      time.Time      `json:"end_at" bson:"end_at"`
+
+	// TimeoutReason describes why the process timed out if Timeout is set.
+	TimeoutReason TimeoutReason `json:"timeout_reason,omitempty" bson:"timeout_reason,omitempty"`
+	// Ready is set once the readiness probes of the process succeed, or
+	// when the process starts if it has none.
+	Ready bool `json:"ready" bson:"ready"`
+	// Liveness describes the liveness checks of the process, if it has
+	// any.
+	Liveness *LivenessInfo `json:"liveness,omitempty" bson:"liveness,omitempty"`
+	// FollowUps describes the processes that the manager created from the
+	// OnSuccess, OnFailure or OnTimeout options once the process exited.
+	FollowUps []FollowUpInfo `json:"follow_ups,omitempty" bson:"follow_ups,omitempty"`
+	// Stages describes each stage of the pipeline of the process, if it has
+	// one, starting with the process itself.
+	Stages []ProcessInfo `json:"stages,omitempty" bson:"stages,omitempty"`
 }
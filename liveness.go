@@ -0,0 +1,250 @@
+package jasper
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/tychoish/grip"
+	"github.com/tychoish/grip/message"
+	"github.com/tychoish/jasper/options"
+)
+
+// livenessHistorySize is the number of recent liveness checks recorded in
+// the info of a process.
+const livenessHistorySize = 10
+
+// LivenessCheck records the outcome of a single liveness check.
+type LivenessCheck struct {
+	Time   time.Time `json:"time" bson:"time"`
+	Passed bool      `json:"passed" bson:"passed"`
+	Error  string    `json:"error,omitempty" bson:"error,omitempty"`
+}
+
+// LivenessInfo describes the liveness checks of a process and its
+// restarts.
+type LivenessInfo struct {
+	// Checks are the most recent checks, oldest first.
+	Checks []LivenessCheck `json:"checks,omitempty" bson:"checks,omitempty"`
+	// ConsecutiveFailures is the number of checks that have failed since
+	// the last one passed.
+	ConsecutiveFailures int `json:"consecutive_failures,omitempty" bson:"consecutive_failures,omitempty"`
+	// Restarts is the number of times the process and the processes it
+	// replaced have been restarted.
+	Restarts int `json:"restarts,omitempty" bson:"restarts,omitempty"`
+	// RestartedFrom is the ID of the process that this process replaced.
+	RestartedFrom string `json:"restarted_from,omitempty" bson:"restarted_from,omitempty"`
+	// RestartedAs is the ID of the process that replaced this process.
+	RestartedAs string `json:"restarted_as,omitempty" bson:"restarted_as,omitempty"`
+}
+
+const livenessRestartKey ctxKey = "__JASPER_LIVENESS_RESTART"
+
+// livenessRestart is attached to the context of a process that is respawned
+// because it failed its liveness checks, so that the new process continues
+// counting restarts. It is claimed by the first process that reads it so
+// that it does not apply to the processes that the new process creates.
+type livenessRestart struct {
+	restarts int
+	from     string
+	claimed  atomic.Bool
+}
+
+// livenessMonitor periodically checks that a process is live, and
+// terminates and respawns it if it fails too many checks in a row.
+type livenessMonitor struct {
+	ctx  context.Context
+	opts *options.Liveness
+	proc Process
+	// info is only accessed by the goroutine that runs the checks.
+	info LivenessInfo
+}
+
+// newLivenessMonitor returns a monitor for the liveness checks in the
+// options, or nil if there are none.
+func newLivenessMonitor(ctx context.Context, proc Process, opts *options.Create) *livenessMonitor {
+	if opts.Liveness == nil {
+		return nil
+	}
+
+	m := &livenessMonitor{ctx: ctx, opts: opts.Liveness, proc: proc}
+	if restart, ok := ctx.Value(livenessRestartKey).(*livenessRestart); ok && restart.claimed.CompareAndSwap(false, true) {
+		m.info.Restarts = restart.restarts
+		m.info.RestartedFrom = restart.from
+	}
+
+	return m
+}
+
+// initialInfo returns the liveness info of the process when it starts. The
+// monitor may be nil.
+func (m *livenessMonitor) initialInfo() *LivenessInfo {
+	if m == nil {
+		return nil
+	}
+	return m.snapshot()
+}
+
+// run checks the process once it is ready, calling update with the new
+// liveness info after each check, until the process fails too many checks
+// or done is closed. The monitor may be nil.
+func (m *livenessMonitor) run(done <-chan struct{}, readiness *readinessMonitor, update func(*LivenessInfo)) {
+	if m == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(m.ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	if err := readiness.wait(ctx, done); err != nil {
+		return
+	}
+
+	timer := time.NewTimer(m.opts.InitialDelay)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		err := m.check(ctx)
+		if ctx.Err() != nil {
+			// The process exited during the check.
+			return
+		}
+		m.record(err)
+		update(m.snapshot())
+
+		if err != nil {
+			contextManagerEvents(m.ctx).emit(ManagerEvent{
+				Type:      ManagerEventLivenessCheckFailed,
+				ProcessID: m.proc.ID(),
+				Error:     err.Error(),
+			})
+			if m.info.ConsecutiveFailures >= m.opts.FailureThreshold {
+				m.restart(done, update)
+				return
+			}
+		}
+
+		timer.Reset(m.opts.Interval)
+	}
+}
+
+// check returns an error if any of the checks fail.
+func (m *livenessMonitor) check(ctx context.Context) error {
+	if m.opts.TCP != "" {
+		if err := probeTCP(ctx, m.opts.TCP, m.opts.Timeout); err != nil {
+			return err
+		}
+	}
+	if m.opts.HTTP != "" {
+		if err := probeHTTP(ctx, m.opts.HTTP, m.opts.Timeout); err != nil {
+			return err
+		}
+	}
+	if m.opts.Command != nil {
+		if err := probeCommand(ctx, m.opts.Command, m.opts.Timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *livenessMonitor) record(err error) {
+	check := LivenessCheck{Time: time.Now(), Passed: err == nil}
+	if err != nil {
+		check.Error = err.Error()
+		m.info.ConsecutiveFailures++
+	} else {
+		m.info.ConsecutiveFailures = 0
+	}
+
+	m.info.Checks = append(m.info.Checks, check)
+	if len(m.info.Checks) > livenessHistorySize {
+		m.info.Checks = m.info.Checks[len(m.info.Checks)-livenessHistorySize:]
+	}
+}
+
+// snapshot returns a copy of the liveness info that does not share memory
+// with the monitor, since it is returned to callers of Info.
+func (m *livenessMonitor) snapshot() *LivenessInfo {
+	info := m.info
+	info.Checks = append([]LivenessCheck(nil), m.info.Checks...)
+	return &info
+}
+
+// restart terminates the process, waits for it to exit and respawns it.
+// If a manager created the process, the new process is created by the same
+// manager, so that it is tracked by the manager and the manager can refuse
+// to create it.
+func (m *livenessMonitor) restart(done <-chan struct{}, update func(*LivenessInfo)) {
+	id := m.proc.ID()
+	grip.Warning(message.Fields{
+		"message":  "process failed liveness checks",
+		"id":       id,
+		"failures": m.info.ConsecutiveFailures,
+		"restarts": m.info.Restarts,
+	})
+
+	signalUntilExit(m.proc, done, m.opts.GracePeriod, syscall.SIGTERM, syscall.SIGKILL)
+	<-done
+
+	events := contextManagerEvents(m.ctx)
+	if m.opts.MaxRestarts > 0 && m.info.Restarts >= m.opts.MaxRestarts {
+		events.emit(ManagerEvent{
+			Type:      ManagerEventLivenessRestartFailed,
+			ProcessID: id,
+			Error:     fmt.Sprintf("process reached the maximum of %d restarts", m.opts.MaxRestarts),
+		})
+		return
+	}
+
+	// Callers such as the remote services cancel the context of a process
+	// once it exits, so the new process keeps the values of the context
+	// but not its cancellation.
+	ctx := context.WithValue(context.WithoutCancel(m.ctx), livenessRestartKey, &livenessRestart{restarts: m.info.Restarts + 1, from: id})
+	newProc, err := m.respawn(ctx)
+	if err != nil {
+		grip.Warning(message.WrapError(err, message.Fields{
+			"message": "problem respawning process that failed liveness checks",
+			"id":      id,
+		}))
+		events.emit(ManagerEvent{
+			Type:      ManagerEventLivenessRestartFailed,
+			ProcessID: id,
+			Error:     fmt.Errorf("problem respawning process: %w", err).Error(),
+		})
+		return
+	}
+
+	m.info.RestartedAs = newProc.ID()
+	update(m.snapshot())
+	events.emit(ManagerEvent{
+		Type:         ManagerEventLivenessRestarted,
+		ProcessID:    id,
+		NewProcessID: newProc.ID(),
+	})
+}
+
+// respawn creates a new process with the options of the process, using the
+// manager that created the process if there is one.
+func (m *livenessMonitor) respawn(ctx context.Context) (Process, error) {
+	manager, ok := processManager(m.ctx)
+	if !ok {
+		return m.proc.Respawn(ctx)
+	}
+	opts := m.proc.Info(ctx).Options
+	return manager.CreateProcess(ctx, opts.Copy())
+}
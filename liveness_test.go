@@ -0,0 +1,101 @@
+package jasper
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/testutil"
+)
+
+func TestLiveness(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.ProcessTestTimeout)
+	defer cancel()
+
+	var (
+		mu     sync.Mutex
+		events []ManagerEvent
+	)
+	manager := NewManager(ManagerOptionSetSynchronized(), ManagerOptionEventHandler(func(event ManagerEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event)
+	}))
+	defer func() { check.NotError(t, manager.Close(ctx)) }()
+
+	findEvent := func(eventType ManagerEventType) (ManagerEvent, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, event := range events {
+			if event.Type == eventType {
+				return event, true
+			}
+		}
+		return ManagerEvent{}, false
+	}
+
+	opts := testutil.SleepCreateOpts(5)
+	opts.Liveness = &options.Liveness{
+		Command:          testutil.FalseCreateOpts(),
+		Interval:         10 * time.Millisecond,
+		FailureThreshold: 2,
+		GracePeriod:      time.Second,
+		MaxRestarts:      1,
+	}
+	proc, err := manager.CreateProcess(ctx, opts)
+	assert.NotError(t, err)
+	id := proc.ID()
+
+	created, ok := findEvent(ManagerEventProcessCreated)
+	assert.True(t, ok)
+	check.Equal(t, created.ProcessID, id)
+	check.Equal(t, created.ManagerID, manager.ID())
+
+	var restarted ManagerEvent
+	for {
+		if restarted, ok = findEvent(ManagerEventLivenessRestarted); ok {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for process to restart")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	check.Equal(t, restarted.ProcessID, id)
+
+	failed, ok := findEvent(ManagerEventLivenessCheckFailed)
+	assert.True(t, ok)
+	check.Equal(t, failed.ProcessID, id)
+	check.True(t, failed.Error != "")
+	exited, ok := findEvent(ManagerEventProcessExited)
+	assert.True(t, ok)
+	check.Equal(t, exited.ProcessID, id)
+
+	newProc, err := manager.Get(ctx, restarted.NewProcessID)
+	assert.NotError(t, err)
+	info := newProc.Info(ctx)
+	assert.True(t, info.Liveness != nil)
+	check.Equal(t, info.Liveness.Restarts, 1)
+	check.Equal(t, info.Liveness.RestartedFrom, id)
+
+	// The respawned process has reached its maximum number of restarts, so
+	// it is terminated without being respawned once it fails.
+	for {
+		if event, ok := findEvent(ManagerEventLivenessRestartFailed); ok {
+			check.Equal(t, event.ProcessID, restarted.NewProcessID)
+			break
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for restart limit")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	_, err = newProc.Wait(ctx)
+	check.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -30,6 +31,13 @@ type LoggingCache interface {
 	Len() int
 }
 
+// LoggingCacheLister is implemented by logging caches that can list the
+// loggers that they contain.
+type LoggingCacheLister interface {
+	// List returns copies of the cached loggers sorted by ID.
+	List() []options.CachedLogger
+}
+
 // NewLoggingCache produces a thread-safe implementation of a logging
 // cache for use in manager implementations.
 func NewLoggingCache() LoggingCache {
@@ -64,6 +72,18 @@ func (c *loggingCacheImpl) Len() int {
 	return len(c.cache)
 }
 
+func (c *loggingCacheImpl) List() []options.CachedLogger {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	loggers := make([]options.CachedLogger, 0, len(c.cache))
+	for _, logger := range c.cache {
+		loggers = append(loggers, *logger)
+	}
+	sort.Slice(loggers, func(i, j int) bool { return loggers[i].ID < loggers[j].ID })
+	return loggers
+}
+
 func (c *loggingCacheImpl) Prune(ts time.Time) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
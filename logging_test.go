@@ -60,6 +60,22 @@ func TestLogging(t *testing.T) {
 				check.Equal(t, 0, cache.Len())
 			},
 		},
+		{
+			Name: "List",
+			Case: func(t *testing.T, cache LoggingCache) {
+				lister, ok := cache.(LoggingCacheLister)
+				assert.True(t, ok)
+				check.Equal(t, len(lister.List()), 0)
+
+				check.NotError(t, cache.Put("b", &options.CachedLogger{ID: "b"}))
+				check.NotError(t, cache.Put("a", &options.CachedLogger{ID: "a"}))
+				loggers := lister.List()
+				assert.Equal(t, len(loggers), 2)
+				check.Equal(t, loggers[0].ID, "a")
+				check.Equal(t, loggers[1].ID, "b")
+				check.True(t, !loggers[0].Accessed.IsZero())
+			},
+		},
 		{
 			Name: "CreateDuplicateProtection",
 			Case: func(t *testing.T, cache LoggingCache) {
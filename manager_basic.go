@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/tychoish/fun/dt"
@@ -29,6 +30,7 @@ func NewManager(opts ...ManagerOptionProvider) Manager {
 		remote:   conf.Remote,
 		executor: conf.ExecutorResolver,
 		env:      conf.EnvVars.Copy(),
+		events:   newManagerEvents(conf.ID, conf.EventHandler),
 	}
 	mgr = m
 
@@ -51,13 +53,20 @@ func NewManager(opts ...ManagerOptionProvider) Manager {
 }
 
 type basicProcessManager struct {
-	id       string
+	id string
+	// procsMu guards procs, since the follow-up processes of a process
+	// are created by its triggers, which run in the goroutine of the
+	// process. It must not be held while calling methods on processes.
+	procsMu  sync.RWMutex
 	procs    map[string]Process
 	tracker  ProcessTracker
 	loggers  LoggingCache
 	remote   *options.Remote
 	executor func(context.Context, *options.Create) options.ResolveExecutor
 	env      *dt.List[irt.KV[string, string]]
+	events   *managerEvents
+	hooksMu  sync.RWMutex
+	hooks    []processHook
 }
 
 func (m *basicProcessManager) ID() string { return m.id }
@@ -77,7 +86,9 @@ func (m *basicProcessManager) CreateProcess(ctx context.Context, opts *options.C
 		opts.Environment.Extend(m.env.IteratorFront())
 	}
 
-	proc, err := NewProcess(ctx, opts)
+	created := m.runCreateHooks(ctx, opts)
+	proc, err := NewProcess(withManagerEvents(WithProcessManager(ctx, m), m.events), opts)
+	created(proc)
 	if err != nil {
 		return nil, fmt.Errorf("problem constructing process: %w", err)
 	}
@@ -96,7 +107,11 @@ func (m *basicProcessManager) CreateProcess(ctx context.Context, opts *options.C
 	// This trigger is not guaranteed to be registered since the process may
 	// have already completed. One way to guarantee it runs could be to add this
 	// as a closer to CreateOptions.
-	_ = proc.RegisterTrigger(ctx, MakeDefaultTrigger(ctx, m, opts, proc.ID()))
+	var recordFollowUp func(FollowUpInfo)
+	if recorder, ok := proc.(followUpRecorder); ok {
+		recordFollowUp = recorder.recordFollowUp
+	}
+	_ = proc.RegisterTrigger(ctx, makeDefaultTrigger(ctx, m, opts, proc.ID(), recordFollowUp))
 
 	if m.tracker != nil {
 		// The process may have terminated already, so don't return on error.
@@ -105,7 +120,17 @@ func (m *basicProcessManager) CreateProcess(ctx context.Context, opts *options.C
 		}
 	}
 
+	m.procsMu.Lock()
 	m.procs[proc.ID()] = proc
+	m.procsMu.Unlock()
+
+	if m.events != nil {
+		id := proc.ID()
+		m.events.emit(ManagerEvent{Type: ManagerEventProcessCreated, ProcessID: id})
+		_ = proc.RegisterTrigger(ctx, func(info ProcessInfo) {
+			m.events.emit(ManagerEvent{Type: ManagerEventProcessExited, ProcessID: id, ExitCode: info.ExitCode})
+		})
+	}
 
 	return proc, nil
 }
@@ -130,15 +155,19 @@ func (m *basicProcessManager) Register(ctx context.Context, proc Process) error
 		return errors.New("process is malformed")
 	}
 
-	if m.tracker != nil {
+	// Completed processes are not tracked because their PIDs may have been
+	// reused by unrelated processes.
+	if m.tracker != nil && !proc.Complete(ctx) {
 		// The process may have terminated already, so don't return on error.
 		if err := m.tracker.Add(proc.Info(ctx)); err != nil {
 			grip.Warning(message.WrapError(err, "problem adding process to tracker during process registration"))
 		}
 	}
 
-	_, ok := m.procs[id]
-	if ok {
+	m.procsMu.Lock()
+	defer m.procsMu.Unlock()
+
+	if _, ok := m.procs[id]; ok {
 		return errors.New("cannot register process that exists")
 	}
 
@@ -146,6 +175,18 @@ func (m *basicProcessManager) Register(ctx context.Context, proc Process) error
 	return nil
 }
 
+// listProcs returns the processes in the manager.
+func (m *basicProcessManager) listProcs() []Process {
+	m.procsMu.RLock()
+	defer m.procsMu.RUnlock()
+
+	procs := make([]Process, 0, len(m.procs))
+	for _, proc := range m.procs {
+		procs = append(procs, proc)
+	}
+	return procs
+}
+
 func (m *basicProcessManager) List(ctx context.Context, f options.Filter) ([]Process, error) {
 	out := []Process{}
 
@@ -153,7 +194,7 @@ func (m *basicProcessManager) List(ctx context.Context, f options.Filter) ([]Pro
 		return out, fmt.Errorf("invalid filter: %w", err)
 	}
 
-	for _, proc := range m.procs {
+	for _, proc := range m.listProcs() {
 		if ctx.Err() != nil {
 			return nil, ctx.Err()
 		}
@@ -187,7 +228,9 @@ func (m *basicProcessManager) List(ctx context.Context, f options.Filter) ([]Pro
 }
 
 func (m *basicProcessManager) Get(_ context.Context, id string) (Process, error) {
+	m.procsMu.RLock()
 	proc, ok := m.procs[id]
+	m.procsMu.RUnlock()
 	if !ok {
 		return nil, fmt.Errorf("process '%s' does not exist", id)
 	}
@@ -196,16 +239,61 @@ func (m *basicProcessManager) Get(_ context.Context, id string) (Process, error)
 }
 
 func (m *basicProcessManager) Clear(ctx context.Context) {
-	for procID, proc := range m.procs {
+	for _, proc := range m.listProcs() {
 		if proc.Complete(ctx) {
-			delete(m.procs, procID)
-			m.loggers.Remove(procID)
+			m.remove(proc.ID())
 		}
 	}
 }
 
+// remove removes the process and its cached logger from the manager.
+func (m *basicProcessManager) remove(id string) {
+	m.procsMu.Lock()
+	delete(m.procs, id)
+	m.procsMu.Unlock()
+	m.loggers.Remove(id)
+
+	m.hooksMu.RLock()
+	defer m.hooksMu.RUnlock()
+	for _, hook := range m.hooks {
+		hook.removed(id)
+	}
+}
+
+func (m *basicProcessManager) addProcessHook(hook processHook) {
+	m.hooksMu.Lock()
+	defer m.hooksMu.Unlock()
+	m.hooks = append(m.hooks, hook)
+}
+
+// runCreateHooks calls the create hooks of the manager with the options of a
+// process, and returns a function that passes the process, or nil if it could
+// not be created, to each of them.
+func (m *basicProcessManager) runCreateHooks(ctx context.Context, opts *options.Create) func(Process) {
+	m.hooksMu.RLock()
+	defer m.hooksMu.RUnlock()
+
+	created := make([]func(Process), 0, len(m.hooks))
+	for _, hook := range m.hooks {
+		created = append(created, hook.create(ctx, opts))
+	}
+
+	return func(proc Process) {
+		for _, fn := range created {
+			fn(proc)
+		}
+	}
+}
+
+// numProcs returns the number of processes in the manager.
+func (m *basicProcessManager) numProcs() int {
+	m.procsMu.RLock()
+	defer m.procsMu.RUnlock()
+	return len(m.procs)
+}
+
 func (m *basicProcessManager) Close(ctx context.Context) error {
-	if len(m.procs) == 0 {
+	if m.numProcs() == 0 {
 		return nil
 	}
 	procs, err := m.List(ctx, options.Running)
@@ -235,7 +323,7 @@ func (m *basicProcessManager) Close(ctx context.Context) error {
 
 func (m *basicProcessManager) Group(ctx context.Context, name string) ([]Process, error) {
 	out := []Process{}
-	for _, proc := range m.procs {
+	for _, proc := range m.listProcs() {
 		if ctx.Err() != nil {
 			return nil, ctx.Err()
 		}
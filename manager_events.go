@@ -0,0 +1,97 @@
+package jasper
+
+import (
+	"context"
+	"time"
+)
+
+// ManagerEventType describes a change in the lifecycle of a process
+// created by a manager.
+type ManagerEventType string
+
+const (
+	// ManagerEventProcessCreated is emitted when the manager creates a
+	// process.
+	ManagerEventProcessCreated ManagerEventType = "process-created"
+	// ManagerEventProcessExited is emitted when a process created by the
+	// manager exits.
+	ManagerEventProcessExited ManagerEventType = "process-exited"
+	// ManagerEventLivenessCheckFailed is emitted each time a liveness
+	// check of a process fails.
+	ManagerEventLivenessCheckFailed ManagerEventType = "liveness-check-failed"
+	// ManagerEventLivenessRestarted is emitted when a process that failed
+	// its liveness checks is terminated and respawned.
+	ManagerEventLivenessRestarted ManagerEventType = "liveness-restarted"
+	// ManagerEventLivenessRestartFailed is emitted when a process that
+	// failed its liveness checks is terminated but could not be respawned,
+	// either because of an error or because it reached its maximum number
+	// of restarts.
+	ManagerEventLivenessRestartFailed ManagerEventType = "liveness-restart-failed"
+)
+
+// ManagerEvent records a change in the lifecycle of a process created by a
+// manager.
+type ManagerEvent struct {
+	Type      ManagerEventType `json:"type" bson:"type"`
+	ManagerID string           `json:"manager_id" bson:"manager_id"`
+	ProcessID string           `json:"process_id" bson:"process_id"`
+	Time      time.Time        `json:"time" bson:"time"`
+	// NewProcessID is the ID of the process that replaced the process when
+	// it was restarted.
+	NewProcessID string `json:"new_process_id,omitempty" bson:"new_process_id,omitempty"`
+	// ExitCode is the exit code of the process when it exited.
+	ExitCode int `json:"exit_code,omitempty" bson:"exit_code,omitempty"`
+	// Error describes why a liveness check or restart failed.
+	Error string `json:"error,omitempty" bson:"error,omitempty"`
+}
+
+// ManagerEventHandler is called with the events of a manager's processes.
+// It is called synchronously, sometimes while the process or the manager
+// holds a lock, so it must not block or call methods on the process or
+// the manager.
+type ManagerEventHandler func(ManagerEvent)
+
+const managerEventsKey ctxKey = "__JASPER_MANAGER_EVENTS"
+
+// managerEvents emits the events of a manager to its handler.
+type managerEvents struct {
+	managerID string
+	handler   ManagerEventHandler
+}
+
+// newManagerEvents returns an emitter for the manager's events, or nil if
+// there is no handler.
+func newManagerEvents(managerID string, handler ManagerEventHandler) *managerEvents {
+	if handler == nil {
+		return nil
+	}
+	return &managerEvents{managerID: managerID, handler: handler}
+}
+
+// emit sends the event to the handler. The emitter may be nil.
+func (e *managerEvents) emit(event ManagerEvent) {
+	if e == nil {
+		return
+	}
+	event.ManagerID = e.managerID
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	e.handler(event)
+}
+
+// withManagerEvents attaches the manager's event emitter to the context of
+// the processes it creates, so that the processes can emit events.
+func withManagerEvents(ctx context.Context, e *managerEvents) context.Context {
+	if e == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, managerEventsKey, e)
+}
+
+// contextManagerEvents returns the event emitter attached to the context,
+// which may be nil.
+func contextManagerEvents(ctx context.Context) *managerEvents {
+	e, _ := ctx.Value(managerEventsKey).(*managerEvents)
+	return e
+}
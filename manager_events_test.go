@@ -0,0 +1,44 @@
+package jasper
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper/testutil"
+)
+
+func TestManagerEvents(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+	defer cancel()
+
+	var (
+		mu     sync.Mutex
+		events []ManagerEvent
+	)
+	manager := NewManager(ManagerOptionSetSynchronized(), ManagerOptionEventHandler(func(event ManagerEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event)
+	}))
+	defer func() { check.NotError(t, manager.Close(ctx)) }()
+
+	proc, err := manager.CreateProcess(ctx, testutil.FalseCreateOpts())
+	assert.NotError(t, err)
+	_, err = proc.Wait(ctx)
+	assert.Error(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, len(events), 2)
+	for _, event := range events {
+		check.Equal(t, event.ManagerID, manager.ID())
+		check.Equal(t, event.ProcessID, proc.ID())
+		check.True(t, !event.Time.IsZero())
+	}
+	check.Equal(t, events[0].Type, ManagerEventProcessCreated)
+	check.Equal(t, events[1].Type, ManagerEventProcessExited)
+	check.Equal(t, events[1].ExitCode, 1)
+}
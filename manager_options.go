@@ -21,6 +21,9 @@ type ManagerOptions struct {
 	Remote           *options.Remote
 	EnvVars          *dt.List[irt.KV[string, string]]
 	ExecutorResolver func(context.Context, *options.Create) options.ResolveExecutor
+	// EventHandler, if set, is called with the lifecycle events of the
+	// manager's processes.
+	EventHandler ManagerEventHandler
 }
 
 func (conf *ManagerOptions) Validate() error {
@@ -86,3 +89,7 @@ func ManagerOptionWithRemote(opt *options.Remote) ManagerOptionProvider {
 func ManagerOptionExecutorResolver(er func(context.Context, *options.Create) options.ResolveExecutor) ManagerOptionProvider {
 	return func(conf *ManagerOptions) error { conf.ExecutorResolver = er; return nil }
 }
+
+func ManagerOptionEventHandler(handler ManagerEventHandler) ManagerOptionProvider {
+	return func(conf *ManagerOptions) error { conf.EventHandler = handler; return nil }
+}
@@ -11,6 +11,9 @@ type remoteOverrideMgr struct {
 	remote *options.Remote
 }
 
+// Unwrap returns the wrapped manager.
+func (m *remoteOverrideMgr) Unwrap() Manager { return m.Manager }
+
 func (m *remoteOverrideMgr) CreateProcess(ctx context.Context, opts *options.Create) (Process, error) {
 	opts.Remote = m.remote
 	return m.Manager.CreateProcess(ctx, opts)
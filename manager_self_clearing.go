@@ -13,10 +13,10 @@ type selfClearingProcessManager struct {
 }
 
 func (m *selfClearingProcessManager) checkProcCapacity(ctx context.Context) error {
-	if len(m.basicProcessManager.procs) == m.maxProcs {
+	if m.basicProcessManager.numProcs() == m.maxProcs {
 		// We are at capacity, we can try to perform a clear.
 		m.Clear(ctx)
-		if len(m.basicProcessManager.procs) == m.maxProcs {
+		if m.basicProcessManager.numProcs() == m.maxProcs {
 			return errors.New("cannot create any more processes")
 		}
 	}
@@ -29,7 +29,7 @@ func (m *selfClearingProcessManager) CreateProcess(ctx context.Context, opts *op
 		return nil, err
 	}
 
-	proc, err := m.basicProcessManager.CreateProcess(ctx, opts)
+	proc, err := m.basicProcessManager.CreateProcess(WithProcessManager(ctx, m), opts)
 	if err != nil {
 		return nil, err
 	}
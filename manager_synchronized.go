@@ -20,7 +20,7 @@ func (m *synchronizedProcessManager) CreateProcess(ctx context.Context, opts *op
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	proc, err := m.manager.CreateProcess(ctx, opts)
+	proc, err := m.manager.CreateProcess(WithProcessManager(ctx, m), opts)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +72,24 @@ func (m *synchronizedProcessManager) Clear(ctx context.Context) {
 	m.manager.Clear(ctx)
 }
 
+// remove removes the process from the wrapped manager, if it supports
+// removing processes.
+func (m *synchronizedProcessManager) remove(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if remover, ok := m.manager.(processRemover); ok {
+		remover.remove(id)
+	}
+}
+
+// addProcessHook adds the hook to the wrapped manager, if it supports hooks.
+func (m *synchronizedProcessManager) addProcessHook(hook processHook) {
+	if hooker, ok := m.manager.(processHooker); ok {
+		hooker.addProcessHook(hook)
+	}
+}
+
 func (m *synchronizedProcessManager) Close(ctx context.Context) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
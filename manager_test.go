@@ -75,6 +75,19 @@ func TestTrackedManager(t *testing.T) {
 					assert.Equal(t, len(mockTracker.Infos), 1)
 					assert.True(t, len(mockTracker.Infos[0].Options.Args) != 0)
 				},
+				"RegisterDoesNotTrackCompletedProcess": func(ctx context.Context, t *testing.T, manager *basicProcessManager, opts *options.Create) {
+					proc, err := NewProcess(ctx, opts)
+					assert.NotError(t, err)
+					_, err = proc.Wait(ctx)
+					assert.NotError(t, err)
+
+					assert.NotError(t, manager.Register(ctx, proc))
+					check.Equal(t, len(manager.procs), 1)
+
+					mockTracker, ok := manager.tracker.(*mockProcessTracker)
+					assert.True(t, ok)
+					check.Equal(t, len(mockTracker.Infos), 0)
+				},
 				"DoNotTrackProcessIfCreateProcessDoesNotMakeProcess": func(ctx context.Context, t *testing.T, manager *basicProcessManager, opts *options.Create) {
 					opts.Args = []string{"foo"}
 					_, err := manager.CreateProcess(ctx, opts)
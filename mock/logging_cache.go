@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/tychoish/fun/erc"
@@ -101,3 +102,13 @@ func (c *LoggingCache) Prune(lastAccessed time.Time) {
 
 // Len returns the size of the in-memory logging cache.
 func (c *LoggingCache) Len() int { return len(c.Cache) }
+
+// List returns copies of the cached loggers sorted by ID.
+func (c *LoggingCache) List() []options.CachedLogger {
+	loggers := make([]options.CachedLogger, 0, len(c.Cache))
+	for _, logger := range c.Cache {
+		loggers = append(loggers, *logger)
+	}
+	sort.Slice(loggers, func(i, j int) bool { return loggers[i].ID < loggers[j].ID })
+	return loggers
+}
@@ -12,16 +12,20 @@ import (
 type Process struct {
 	FailRespawn                 bool
 	FailRegisterTrigger         bool
+	FailRegisterTriggerID       bool
 	FailRegisterSignalTrigger   bool
 	FailRegisterSignalTriggerID bool
 	FailSignal                  bool
 	FailWait                    bool
+	FailWaitReady               bool
 	WaitExitCode                int
 
 	ProcInfo         jasper.ProcessInfo
 	Triggers         jasper.ProcessTriggerSequence `json:"-"`
 	SignalTriggers   jasper.SignalTriggerSequence  `json:"-"`
 	SignalTriggerIDs []jasper.SignalTriggerID
+	TriggerIDs       []jasper.ProcessTriggerID
+	TriggerParams    []map[string]string
 	Signals          []syscall.Signal
 	Tags             []string
 }
@@ -83,6 +87,15 @@ func (p *Process) Wait(_ context.Context) (int, error) {
 	return p.ProcInfo.ExitCode, nil
 }
 
+// WaitReady is a no-op. If FailWaitReady is set, it returns an error.
+func (p *Process) WaitReady(_ context.Context) error {
+	if p.FailWaitReady {
+		return mockFail()
+	}
+
+	return nil
+}
+
 // Respawn creates a new Process, which has a copy of all the fields in the
 // current Process. If FailRespawn is set, it returns an error.
 func (p *Process) Respawn(_ context.Context) (jasper.Process, error) {
@@ -105,6 +118,20 @@ func (p *Process) RegisterTrigger(ctx context.Context, t jasper.ProcessTrigger)
 	return nil
 }
 
+// RegisterTriggerID records the ID of the process trigger in TriggerIDs and
+// its parameters in TriggerParams. If FailRegisterTriggerID is set, it
+// returns an error.
+func (p *Process) RegisterTriggerID(ctx context.Context, id jasper.ProcessTriggerID, params map[string]string) error {
+	if p.FailRegisterTriggerID {
+		return mockFail()
+	}
+
+	p.TriggerIDs = append(p.TriggerIDs, id)
+	p.TriggerParams = append(p.TriggerParams, params)
+
+	return nil
+}
+
 // RegisterSignalTrigger records the signal trigger in SignalTriggers. If
 // FailRegisterSignalTrigger is set, it returns an error.
 func (p *Process) RegisterSignalTrigger(ctx context.Context, t jasper.SignalTrigger) error {
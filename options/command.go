@@ -1,6 +1,8 @@
 package options
 
 import (
+	"fmt"
+
 	"github.com/tychoish/fun/erc"
 	"github.com/tychoish/fun/ers"
 	"github.com/tychoish/grip"
@@ -12,19 +14,22 @@ import (
 // Command represents jasper.Command options that are configurable by the
 // user.
 type Command struct {
-	ID              string          `json:"id,omitempty"`
-	Commands        [][]string      `json:"commands"`
-	Process         Create          `json:"proc_opts,omitempty"`
-	Remote          *Remote         `json:"remote_options,omitempty"`
-	ContinueOnError bool            `json:"continue_on_error,omitempty"`
-	IgnoreError     bool            `json:"ignore_error,omitempty"`
-	Priority        level.Priority  `json:"priority,omitempty"`
-	RunBackground   bool            `json:"run_background,omitempty"`
-	Sudo            bool            `json:"sudo,omitempty"`
-	SudoUser        string          `json:"sudo_user,omitempty"`
-	Prerequisite    func() bool     `json:"-"`
-	PostHook        CommandPostHook `json:"-"`
-	PreHook         CommandPreHook  `json:"-"`
+	ID              string             `json:"id,omitempty"`
+	Commands        [][]string         `json:"commands"`
+	Process         Create             `json:"proc_opts,omitempty"`
+	Remote          *Remote            `json:"remote_options,omitempty"`
+	ContinueOnError bool               `json:"continue_on_error,omitempty"`
+	IgnoreError     bool               `json:"ignore_error,omitempty"`
+	Priority        level.Priority     `json:"priority,omitempty"`
+	RunBackground   bool               `json:"run_background,omitempty"`
+	Sudo            bool               `json:"sudo,omitempty"`
+	SudoUser        string             `json:"sudo_user,omitempty"`
+	Retry           *Retry             `json:"retry,omitempty"`
+	StepRetry       map[int]*Retry     `json:"step_retry,omitempty"`
+	Pipelines       map[int][][]string `json:"pipelines,omitempty"`
+	Prerequisite    func() bool        `json:"-"`
+	PostHook        CommandPostHook    `json:"-"`
+	PreHook         CommandPreHook     `json:"-"`
 }
 
 // Validate ensures that the options passed to the command are valid.
@@ -37,9 +42,40 @@ func (opts *Command) Validate() error {
 	}
 	catcher.Push(opts.Process.Validate())
 	catcher.If(len(opts.Commands) == 0, ers.Error("must specify at least one command"))
+	if opts.Retry != nil {
+		if err := opts.Retry.Validate(); err != nil {
+			catcher.Push(fmt.Errorf("invalid retry options: %w", err))
+		}
+	}
+	for idx, retry := range opts.StepRetry {
+		catcher.If(idx < 0 || idx >= len(opts.Commands), fmt.Errorf("retry options for nonexistent command %d", idx))
+		if retry == nil {
+			continue
+		}
+		if err := retry.Validate(); err != nil {
+			catcher.Push(fmt.Errorf("invalid retry options for command %d: %w", idx, err))
+		}
+	}
+	for idx, stages := range opts.Pipelines {
+		catcher.If(idx < 0 || idx >= len(opts.Commands), fmt.Errorf("pipeline for nonexistent command %d", idx))
+		for stageIdx, stage := range stages {
+			catcher.If(len(stage) == 0, fmt.Errorf("pipeline stage %d of command %d is empty", stageIdx+1, idx))
+		}
+	}
 	return catcher.Resolve()
 }
 
+// RetryFor returns the retry options for the command at the index, which
+// are the command's entry in StepRetry if it has one, and Retry otherwise. A
+// nil entry in StepRetry disables retries for that command. It returns nil
+// if the command is not retried.
+func (opts *Command) RetryFor(idx int) *Retry {
+	if retry, ok := opts.StepRetry[idx]; ok {
+		return retry
+	}
+	return opts.Retry
+}
+
 // CommandPreHook describes a common function type to run before
 // sub-commands in a command object and can modify the state of the
 // command.
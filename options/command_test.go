@@ -50,6 +50,42 @@ func TestCommand(t *testing.T) {
 			}
 			check.NotError(t, opts.Validate())
 		})
+		t.Run("ValidatesRetryOptions", func(t *testing.T) {
+			opts := &Command{
+				Commands:  [][]string{{"true"}, {"false"}},
+				Retry:     &Retry{Attempts: 2},
+				StepRetry: map[int]*Retry{1: nil},
+			}
+			check.NotError(t, opts.Validate())
+
+			opts.StepRetry[2] = &Retry{Attempts: 2}
+			check.Error(t, opts.Validate())
+			delete(opts.StepRetry, 2)
+
+			opts.Retry.Attempts = 0
+			check.Error(t, opts.Validate())
+		})
+	})
+	t.Run("ValidatesPipelines", func(t *testing.T) {
+		opts := &Command{
+			Commands:  [][]string{{"ls"}, {"true"}},
+			Pipelines: map[int][][]string{0: {{"wc", "-l"}}},
+		}
+		check.NotError(t, opts.Validate())
+
+		opts.Pipelines[0] = append(opts.Pipelines[0], []string{})
+		check.Error(t, opts.Validate())
+
+		opts.Pipelines = map[int][][]string{2: {{"wc", "-l"}}}
+		check.Error(t, opts.Validate())
+	})
+	t.Run("RetryFor", func(t *testing.T) {
+		retry := &Retry{Attempts: 2}
+		stepRetry := &Retry{Attempts: 5}
+		opts := &Command{Retry: retry, StepRetry: map[int]*Retry{1: stepRetry, 2: nil}}
+		check.Equal(t, opts.RetryFor(0), retry)
+		check.Equal(t, opts.RetryFor(1), stepRetry)
+		check.True(t, opts.RetryFor(2) == nil)
 	})
 	t.Run("LoggingPreHook", func(t *testing.T) {
 		sender := send.NewInternal(10)
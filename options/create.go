@@ -63,9 +63,35 @@ type Create struct {
 	TimeoutSecs int           `bson:"timeout_secs,omitempty" json:"timeout_secs,omitempty" yaml:"timeout_secs,omitempty"`
 	Timeout     time.Duration `bson:"timeout,omitempty" json:"-" yaml:"-"`
 	Tags        []string      `bson:"tags,omitempty" json:"tags,omitempty" yaml:"tags,omitempty"`
-	OnSuccess   []*Create     `bson:"on_success,omitempty" json:"on_success,omitempty" yaml:"on_success"`
-	OnFailure   []*Create     `bson:"on_failure,omitempty" json:"on_failure,omitempty" yaml:"on_failure"`
-	OnTimeout   []*Create     `bson:"on_timeout,omitempty" json:"on_timeout,omitempty" yaml:"on_timeout"`
+	// OnSuccess, OnFailure and OnTimeout are the follow-up processes that
+	// are created once the process exits. They run with the context passed
+	// to CreateProcess, so canceling it also stops them, unless the context
+	// is marked with jasper.WithDetachedFollowUps.
+	OnSuccess []*Create `bson:"on_success,omitempty" json:"on_success,omitempty" yaml:"on_success"`
+	OnFailure []*Create `bson:"on_failure,omitempty" json:"on_failure,omitempty" yaml:"on_failure"`
+	OnTimeout []*Create `bson:"on_timeout,omitempty" json:"on_timeout,omitempty" yaml:"on_timeout"`
+	// IdleTimeout specifies options to terminate the process if it stops
+	// producing output. Processes that reach the idle timeout run the
+	// OnTimeout triggers.
+	IdleTimeout *IdleTimeout `bson:"idle_timeout,omitempty" json:"idle_timeout,omitempty" yaml:"idle_timeout,omitempty"`
+	// OutputTriggers take actions when lines of the process's output match
+	// their patterns.
+	OutputTriggers []OutputTrigger `bson:"output_triggers,omitempty" json:"output_triggers,omitempty" yaml:"output_triggers,omitempty"`
+	// Readiness specifies probes that determine when the process is ready
+	// to be used.
+	Readiness *Readiness `bson:"readiness,omitempty" json:"readiness,omitempty" yaml:"readiness,omitempty"`
+	// Liveness specifies checks that the process is still working, which
+	// restart the process if they fail.
+	Liveness *Liveness `bson:"liveness,omitempty" json:"liveness,omitempty" yaml:"liveness,omitempty"`
+	// Pipeline specifies the stages that the standard output of the process
+	// is piped into, in order, without a shell: the standard output of each
+	// stage is the standard input of the next one. The process and its
+	// stages are managed as one process, which only succeeds if all of the
+	// stages succeed. The standard output of the process and of every stage
+	// but the last is only written to the pipe, and stages that do not
+	// specify any output of their own write to the Output and Error writers
+	// of the process.
+	Pipeline []*Create `bson:"pipeline,omitempty" json:"pipeline,omitempty" yaml:"pipeline,omitempty"`
 	// StandardInputBytes takes precedence over StandardInput. On remote
 	// interfaces, StandardInputBytes should be set instead of StandardInput.
 	StandardInput      io.Reader `bson:"-" json:"-" yaml:"-"`
@@ -113,6 +139,43 @@ func (opts *Create) Validate() error {
 			opts.Timeout, opts.TimeoutSecs))
 	}
 
+	if opts.IdleTimeout != nil {
+		if err := opts.IdleTimeout.Validate(); err != nil {
+			catcher.Push(fmt.Errorf("invalid idle timeout options: %w", err))
+		}
+	}
+
+	if opts.Readiness != nil {
+		if err := opts.Readiness.Validate(); err != nil {
+			catcher.Push(fmt.Errorf("invalid readiness options: %w", err))
+		}
+	}
+
+	if opts.Liveness != nil {
+		if err := opts.Liveness.Validate(); err != nil {
+			catcher.Push(fmt.Errorf("invalid liveness options: %w", err))
+		}
+	}
+
+	for idx := range opts.OutputTriggers {
+		if err := opts.OutputTriggers[idx].Validate(); err != nil {
+			catcher.Push(fmt.Errorf("invalid output trigger at index %d: %w", idx, err))
+		}
+	}
+
+	for idx, stage := range opts.Pipeline {
+		if stage == nil {
+			catcher.Push(fmt.Errorf("pipeline stage %d is not specified", idx+1))
+			continue
+		}
+		catcher.If(len(stage.Pipeline) != 0, fmt.Errorf("pipeline stage %d cannot have its own pipeline", idx+1))
+		catcher.If(stage.StandardInput != nil || len(stage.StandardInputBytes) != 0,
+			fmt.Errorf("pipeline stage %d cannot specify standard input", idx+1))
+		if err := stage.Validate(); err != nil {
+			catcher.Push(fmt.Errorf("invalid pipeline stage %d: %w", idx+1, err))
+		}
+	}
+
 	if err := opts.Output.Validate(); err != nil {
 		catcher.Push(fmt.Errorf("invalid output options: %w", err))
 	}
@@ -176,6 +239,15 @@ func (opts *Create) Hash() hash.Hash {
 		_, _ = io.WriteString(hash, a)
 	}
 
+	for _, stage := range opts.Pipeline {
+		if stage == nil {
+			continue
+		}
+		for _, a := range stage.Args {
+			_, _ = io.WriteString(hash, a)
+		}
+	}
+
 	for _, t := range opts.Tags {
 		_, _ = io.WriteString(hash, t)
 	}
@@ -291,6 +363,7 @@ func (opts *Create) ResolveEnvironment() []string {
 		for evar := range opts.Environment.IteratorFront() {
 			env = append(env, fmt.Sprintf("%s=%s", evar.Key, evar.Value))
 		}
+		return env
 	}
 
 	return []string{}
@@ -381,6 +454,35 @@ func (opts *Create) Copy() *Create {
 		optsCopy.Docker = opts.Docker.Copy()
 	}
 
+	if opts.IdleTimeout != nil {
+		optsCopy.IdleTimeout = opts.IdleTimeout.Copy()
+	}
+
+	if opts.Readiness != nil {
+		optsCopy.Readiness = opts.Readiness.Copy()
+	}
+
+	if opts.Liveness != nil {
+		optsCopy.Liveness = opts.Liveness.Copy()
+	}
+
+	if opts.Pipeline != nil {
+		optsCopy.Pipeline = make([]*Create, 0, len(opts.Pipeline))
+		for _, stage := range opts.Pipeline {
+			if stage != nil {
+				stage = stage.Copy()
+			}
+			optsCopy.Pipeline = append(optsCopy.Pipeline, stage)
+		}
+	}
+
+	if opts.OutputTriggers != nil {
+		optsCopy.OutputTriggers = make([]OutputTrigger, 0, len(opts.OutputTriggers))
+		for _, trigger := range opts.OutputTriggers {
+			optsCopy.OutputTriggers = append(optsCopy.OutputTriggers, *trigger.Copy())
+		}
+	}
+
 	optsCopy.Output = *opts.Output.Copy()
 
 	optsCopy.closers = nil
@@ -170,6 +170,13 @@ func TestCreate(t *testing.T) {
 			check.Contains(t, cmd.Env(), "foo=bar")
 			check.NotContains(t, cmd.Env(), "bar=foo")
 		},
+		"ResolveEnvironmentReturnsVariablesInOrder": func(t *testing.T, opts *Create) {
+			check.Equal(t, len(opts.ResolveEnvironment()), 0)
+
+			opts.AddEnvVar("foo", "bar")
+			opts.AddEnvVar("baz", "qux")
+			check.EqualItems(t, opts.ResolveEnvironment(), []string{"foo=bar", "baz=qux"})
+		},
 		"MultipleArgsArePropagated": func(t *testing.T, opts *Create) {
 			opts.Args = append(opts.Args, "-lha")
 			cmd, _, err := opts.Resolve(ctx)
@@ -240,6 +247,103 @@ func TestCreate(t *testing.T) {
 
 			check.Error(t, opts.Validate())
 		},
+		"IdleTimeoutSetsDefaultGracePeriod": func(t *testing.T, opts *Create) {
+			opts.IdleTimeout = &IdleTimeout{Duration: time.Minute}
+			assert.NotError(t, opts.Validate())
+			check.Equal(t, opts.IdleTimeout.GracePeriod, DefaultIdleTimeoutGracePeriod)
+		},
+		"InvalidIdleTimeoutShouldNotValidate": func(t *testing.T, opts *Create) {
+			opts.IdleTimeout = &IdleTimeout{}
+			check.Error(t, opts.Validate())
+			opts.IdleTimeout = &IdleTimeout{Duration: time.Minute, GracePeriod: -time.Second}
+			check.Error(t, opts.Validate())
+		},
+		"ValidOutputTriggerMatchesLines": func(t *testing.T, opts *Create) {
+			opts.OutputTriggers = []OutputTrigger{{Pattern: "^ERROR", Stream: "stderr", Fail: true}}
+			assert.NotError(t, opts.Validate())
+			check.True(t, opts.OutputTriggers[0].Matches("stderr", "ERROR: foo"))
+			check.True(t, !opts.OutputTriggers[0].Matches("stdout", "ERROR: foo"))
+			check.True(t, !opts.OutputTriggers[0].Matches("stderr", "no ERROR"))
+			check.True(t, opts.Copy().OutputTriggers[0].Matches("stderr", "ERROR: foo"))
+		},
+		"InvalidOutputTriggersShouldNotValidate": func(t *testing.T, opts *Create) {
+			for _, trigger := range []OutputTrigger{
+				{Fail: true},
+				{Pattern: "(", Fail: true},
+				{Pattern: "foo"},
+				{Pattern: "foo", Stream: "stdin", Fail: true},
+				{Pattern: "foo", Create: &Create{}},
+			} {
+				opts.OutputTriggers = []OutputTrigger{trigger}
+				check.Error(t, opts.Validate())
+			}
+		},
+		"ReadinessSetsDefaultIntervalAndTimeout": func(t *testing.T, opts *Create) {
+			opts.Readiness = &Readiness{TCP: "localhost:8080", OutputPattern: "^listening"}
+			assert.NotError(t, opts.Validate())
+			check.Equal(t, opts.Readiness.Interval, DefaultReadinessInterval)
+			check.Equal(t, opts.Readiness.Timeout, DefaultReadinessProbeTimeout)
+			check.True(t, opts.Readiness.MatchesOutput("listening on :8080"))
+			check.True(t, !opts.Readiness.MatchesOutput("starting"))
+			check.True(t, opts.Copy().Readiness.MatchesOutput("listening on :8080"))
+		},
+		"InvalidReadinessShouldNotValidate": func(t *testing.T, opts *Create) {
+			for _, readiness := range []Readiness{
+				{},
+				{TCP: "localhost"},
+				{HTTP: "ftp://localhost/health"},
+				{OutputPattern: "("},
+				{Command: &Create{}},
+				{File: "ready", Interval: -time.Second},
+				{File: "ready", Timeout: -time.Second},
+			} {
+				opts.Readiness = &readiness
+				check.Error(t, opts.Validate())
+			}
+		},
+		"LivenessSetsDefaults": func(t *testing.T, opts *Create) {
+			opts.Liveness = &Liveness{HTTP: "http://localhost:8080/health"}
+			assert.NotError(t, opts.Validate())
+			check.Equal(t, opts.Liveness.Interval, DefaultLivenessInterval)
+			check.Equal(t, opts.Liveness.Timeout, DefaultLivenessCheckTimeout)
+			check.Equal(t, opts.Liveness.FailureThreshold, DefaultLivenessFailureThreshold)
+			check.Equal(t, opts.Liveness.GracePeriod, DefaultLivenessGracePeriod)
+			check.Zero(t, opts.Liveness.MaxRestarts)
+		},
+		"InvalidLivenessShouldNotValidate": func(t *testing.T, opts *Create) {
+			for _, liveness := range []Liveness{
+				{},
+				{TCP: "localhost"},
+				{HTTP: "localhost:8080"},
+				{Command: &Create{}},
+				{TCP: "localhost:8080", FailureThreshold: -1},
+				{TCP: "localhost:8080", MaxRestarts: -1},
+				{TCP: "localhost:8080", InitialDelay: -time.Second},
+			} {
+				opts.Liveness = &liveness
+				check.Error(t, opts.Validate())
+			}
+		},
+		"PipelineShouldValidate": func(t *testing.T, opts *Create) {
+			opts.Pipeline = []*Create{{Args: []string{"sort"}}, {Args: []string{"wc", "-l"}}}
+			assert.NotError(t, opts.Validate())
+
+			optsCopy := opts.Copy()
+			optsCopy.Pipeline[0].Args[0] = "uniq"
+			check.Equal(t, opts.Pipeline[0].Args[0], "sort")
+			check.True(t, string(opts.Hash().Sum(nil)) != string(optsCopy.Hash().Sum(nil)))
+		},
+		"InvalidPipelineShouldNotValidate": func(t *testing.T, opts *Create) {
+			for _, stage := range []*Create{
+				nil,
+				{},
+				{Args: []string{"cat"}, StandardInputBytes: []byte("hi")},
+				{Args: []string{"cat"}, Pipeline: []*Create{{Args: []string{"cat"}}}},
+			} {
+				opts.Pipeline = []*Create{stage}
+				check.Error(t, opts.Validate())
+			}
+		},
 		"ValidationOverrideDefaultsForSecond": func(t *testing.T, opts *Create) {
 			opts.TimeoutSecs = 100
 			opts.Timeout = 0
@@ -0,0 +1,216 @@
+package options
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchYears bounds how far into the future Next looks for a matching
+// time, so that expressions that can never match (e.g. February 31st) do not
+// loop forever.
+const cronSearchYears = 5
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	cronMinute = cronField{name: "minute", min: 0, max: 59}
+	cronHour   = cronField{name: "hour", min: 0, max: 23}
+	cronDay    = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Both 0 and 7 are Sunday.
+	cronWeekday = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// CronExpression is a parsed cron expression in the standard five field
+// format: minute, hour, day of month, month and day of week. Each field is
+// either "*" or a comma separated list of values, ranges ("1-5") and steps
+// ("*/15" or "0-30/10"), and months and days of the week may be given by
+// their three letter names. The macros @yearly, @annually, @monthly, @weekly,
+// @daily, @midnight and @hourly are also supported.
+//
+// As with cron, if both the day of month and the day of week are restricted,
+// a day matches if it matches either of them.
+type CronExpression struct {
+	expr     string
+	minutes  uint64
+	hours    uint64
+	days     uint64
+	months   uint64
+	weekdays uint64
+	anyDay   bool
+	anyWeek  bool
+}
+
+// ParseCronExpression parses a cron expression.
+func ParseCronExpression(expr string) (*CronExpression, error) {
+	spec := strings.TrimSpace(expr)
+	if macro, ok := cronMacros[strings.ToLower(spec)]; ok {
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression '%s' must have 5 fields, found %d", expr, len(fields))
+	}
+
+	c := &CronExpression{
+		expr:    expr,
+		anyDay:  strings.HasPrefix(fields[2], "*"),
+		anyWeek: strings.HasPrefix(fields[4], "*"),
+	}
+
+	var err error
+	for idx, target := range []struct {
+		field cronField
+		bits  *uint64
+	}{
+		{field: cronMinute, bits: &c.minutes},
+		{field: cronHour, bits: &c.hours},
+		{field: cronDay, bits: &c.days},
+		{field: cronMonth, bits: &c.months},
+		{field: cronWeekday, bits: &c.weekdays},
+	} {
+		if *target.bits, err = target.field.parse(fields[idx]); err != nil {
+			return nil, fmt.Errorf("invalid cron expression '%s': %w", expr, err)
+		}
+	}
+
+	if c.weekdays&(1<<7) != 0 {
+		c.weekdays |= 1
+	}
+
+	return c, nil
+}
+
+func (f cronField) parse(spec string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(spec, ",") {
+		rng, step := part, 1
+		if before, after, ok := strings.Cut(part, "/"); ok {
+			rng = before
+			var err error
+			if step, err = strconv.Atoi(after); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step '%s' in %s field", after, f.name)
+			}
+		}
+
+		low, high := f.min, f.max
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			before, after, _ := strings.Cut(rng, "-")
+			var err error
+			if low, err = f.value(before); err != nil {
+				return 0, err
+			}
+			if high, err = f.value(after); err != nil {
+				return 0, err
+			}
+			if low > high {
+				return 0, fmt.Errorf("invalid range '%s' in %s field", rng, f.name)
+			}
+		default:
+			var err error
+			if low, err = f.value(rng); err != nil {
+				return 0, err
+			}
+			if strings.Contains(part, "/") {
+				high = f.max
+			} else {
+				high = low
+			}
+		}
+
+		for i := low; i <= high; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
+
+func (f cronField) value(spec string) (int, error) {
+	if val, ok := f.names[strings.ToLower(spec)]; ok {
+		return val, nil
+	}
+
+	val, err := strconv.Atoi(spec)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s' in %s field", spec, f.name)
+	}
+	if val < f.min || val > f.max {
+		return 0, fmt.Errorf("value %d in %s field must be between %d and %d", val, f.name, f.min, f.max)
+	}
+	return val, nil
+}
+
+// String returns the original expression.
+func (c *CronExpression) String() string { return c.expr }
+
+// Next returns the first time after t that matches the expression, in t's
+// location. It returns the zero time if there is no matching time within the
+// next five years.
+func (c *CronExpression) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(cronSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if c.months&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if c.hours&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if c.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Truncate(time.Minute).Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (c *CronExpression) matchesDay(t time.Time) bool {
+	day := c.days&(1<<uint(t.Day())) != 0
+	weekday := c.weekdays&(1<<uint(t.Weekday())) != 0
+
+	switch {
+	case c.anyDay && c.anyWeek:
+		return true
+	case c.anyDay:
+		return weekday
+	case c.anyWeek:
+		return day
+	default:
+		return day || weekday
+	}
+}
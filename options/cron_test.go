@@ -0,0 +1,101 @@
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+)
+
+func TestCronExpression(t *testing.T) {
+	start := time.Date(2024, time.January, 15, 10, 30, 45, 0, time.UTC) // Monday
+	t.Run("Next", func(t *testing.T) {
+		for expr, expected := range map[string]time.Time{
+			"* * * * *":          time.Date(2024, time.January, 15, 10, 31, 0, 0, time.UTC),
+			"*/15 * * * *":       time.Date(2024, time.January, 15, 10, 45, 0, 0, time.UTC),
+			"0 * * * *":          time.Date(2024, time.January, 15, 11, 0, 0, 0, time.UTC),
+			"@hourly":            time.Date(2024, time.January, 15, 11, 0, 0, 0, time.UTC),
+			"@daily":             time.Date(2024, time.January, 16, 0, 0, 0, 0, time.UTC),
+			"30 9 * * *":         time.Date(2024, time.January, 16, 9, 30, 0, 0, time.UTC),
+			"0 0 1 * *":          time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			"0 0 * * sun":        time.Date(2024, time.January, 21, 0, 0, 0, 0, time.UTC),
+			"0 0 * * 7":          time.Date(2024, time.January, 21, 0, 0, 0, 0, time.UTC),
+			"0 12 * * mon-fri":   time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC),
+			"0 0 29 feb *":       time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+			"0,20-25/5 11 * * *": time.Date(2024, time.January, 15, 11, 0, 0, 0, time.UTC),
+			// If both the day of month and day of week are restricted,
+			// either can match.
+			"0 0 20 * tue": time.Date(2024, time.January, 16, 0, 0, 0, 0, time.UTC),
+		} {
+			t.Run(expr, func(t *testing.T) {
+				cron, err := ParseCronExpression(expr)
+				assert.NotError(t, err)
+				check.Equal(t, cron.Next(start), expected)
+				check.Equal(t, cron.String(), expr)
+			})
+		}
+	})
+	t.Run("NeverMatches", func(t *testing.T) {
+		cron, err := ParseCronExpression("0 0 31 feb *")
+		assert.NotError(t, err)
+		check.True(t, cron.Next(start).IsZero())
+	})
+	t.Run("InvalidExpressions", func(t *testing.T) {
+		for _, expr := range []string{
+			"",
+			"* * * *",
+			"* * * * * *",
+			"60 * * * *",
+			"* 24 * * *",
+			"* * 0 * *",
+			"* * * 13 *",
+			"* * * * 8",
+			"*/0 * * * *",
+			"5-1 * * * *",
+			"foo * * * *",
+			"@sometimes",
+		} {
+			_, err := ParseCronExpression(expr)
+			check.Error(t, err)
+		}
+	})
+}
+
+func TestSchedule(t *testing.T) {
+	t.Run("ValidateSetsDefaults", func(t *testing.T) {
+		s := Schedule{ID: "foo", Create: &Create{Args: []string{"true"}}, Interval: time.Minute}
+		assert.NotError(t, s.Validate())
+		check.Equal(t, s.Overlap, ScheduleOverlapSkip)
+		check.Equal(t, s.HistorySize, DefaultScheduleHistorySize)
+	})
+	t.Run("NextUsesInterval", func(t *testing.T) {
+		s := Schedule{ID: "foo", Create: &Create{Args: []string{"true"}}, Interval: time.Minute}
+		assert.NotError(t, s.Validate())
+		now := time.Now()
+		check.Equal(t, s.Next(now), now.Add(time.Minute))
+	})
+	t.Run("NextUsesCron", func(t *testing.T) {
+		s := Schedule{ID: "foo", Create: &Create{Args: []string{"true"}}, Cron: "@hourly"}
+		assert.NotError(t, s.Validate())
+		now := time.Date(2024, time.January, 15, 10, 30, 0, 0, time.UTC)
+		check.Equal(t, s.Next(now), time.Date(2024, time.January, 15, 11, 0, 0, 0, time.UTC))
+	})
+	t.Run("InvalidSchedules", func(t *testing.T) {
+		for name, s := range map[string]Schedule{
+			"MissingID":        {Create: &Create{Args: []string{"true"}}, Interval: time.Minute},
+			"MissingCreate":    {ID: "foo", Interval: time.Minute},
+			"InvalidCreate":    {ID: "foo", Create: &Create{}, Interval: time.Minute},
+			"MissingTiming":    {ID: "foo", Create: &Create{Args: []string{"true"}}},
+			"CronAndInterval":  {ID: "foo", Create: &Create{Args: []string{"true"}}, Interval: time.Minute, Cron: "@daily"},
+			"InvalidCron":      {ID: "foo", Create: &Create{Args: []string{"true"}}, Cron: "@sometimes"},
+			"NegativeInterval": {ID: "foo", Create: &Create{Args: []string{"true"}}, Interval: -time.Minute},
+			"NegativeJitter":   {ID: "foo", Create: &Create{Args: []string{"true"}}, Interval: time.Minute, Jitter: -time.Second},
+			"InvalidOverlap":   {ID: "foo", Create: &Create{Args: []string{"true"}}, Interval: time.Minute, Overlap: "sometimes"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				check.Error(t, s.Validate())
+			})
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package options
+
+import (
+	"time"
+
+	"github.com/tychoish/fun/erc"
+	"github.com/tychoish/fun/ers"
+)
+
+// DefaultIdleTimeoutGracePeriod is the grace period used by IdleTimeout if
+// none is specified.
+const DefaultIdleTimeoutGracePeriod = 5 * time.Second
+
+// IdleTimeout configures an inactivity timeout for a process, which is
+// useful to detect processes that hang. If the process does not write to
+// its standard output or standard error for the duration, it is terminated
+// and marked as having timed out. Output that is suppressed is still
+// observed, so a process whose output is suppressed is not considered idle
+// while it writes.
+type IdleTimeout struct {
+	// Duration is how long the process may go without producing output.
+	Duration time.Duration `bson:"duration" json:"duration" yaml:"duration"`
+	// StackDump, if set, sends SIGQUIT to the idle process and waits for
+	// the grace period before it is terminated, so that runtimes that dump
+	// their stacks on SIGQUIT, like Go and Java, record where the process
+	// hung. It is ignored on Windows.
+	StackDump bool `bson:"stack_dump,omitempty" json:"stack_dump,omitempty" yaml:"stack_dump,omitempty"`
+	// GracePeriod is how long the process has to exit after each signal
+	// before the next one is sent. After the optional SIGQUIT, the process
+	// is sent SIGTERM and then SIGKILL.
+	GracePeriod time.Duration `bson:"grace_period,omitempty" json:"grace_period,omitempty" yaml:"grace_period,omitempty"`
+}
+
+// Validate checks that the timeout is valid and sets the default grace
+// period if none is specified.
+func (opts *IdleTimeout) Validate() error {
+	catcher := &erc.Collector{}
+	catcher.If(opts.Duration <= 0, ers.Error("idle timeout duration must be positive"))
+	catcher.If(opts.GracePeriod < 0, ers.Error("idle timeout grace period must be non-negative"))
+	if !catcher.Ok() {
+		return catcher.Resolve()
+	}
+
+	if opts.GracePeriod == 0 {
+		opts.GracePeriod = DefaultIdleTimeoutGracePeriod
+	}
+
+	return nil
+}
+
+// Copy returns a copy of the options.
+func (opts *IdleTimeout) Copy() *IdleTimeout {
+	optsCopy := *opts
+	return &optsCopy
+}
@@ -0,0 +1,124 @@
+package options
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/tychoish/fun/erc"
+	"github.com/tychoish/fun/ers"
+)
+
+const (
+	// DefaultLivenessInterval is how often liveness checks are run if not
+	// otherwise specified.
+	DefaultLivenessInterval = 10 * time.Second
+	// DefaultLivenessCheckTimeout is how long each liveness check may take
+	// if not otherwise specified.
+	DefaultLivenessCheckTimeout = 5 * time.Second
+	// DefaultLivenessFailureThreshold is how many consecutive liveness
+	// checks must fail before the process is restarted if not otherwise
+	// specified.
+	DefaultLivenessFailureThreshold = 3
+	// DefaultLivenessGracePeriod is how long a process that failed its
+	// liveness checks has to exit after SIGTERM before it is killed if not
+	// otherwise specified.
+	DefaultLivenessGracePeriod = 5 * time.Second
+)
+
+// Liveness configures periodic checks that a long-running process is still
+// working. Once the checks fail more times in a row than the failure
+// threshold, the process is terminated and respawned. The checks start once
+// the process is ready, as determined by its readiness probes, and each
+// check passes only if all of the checks that are set succeed. As with
+// readiness probes, the checks run on the host that created the process.
+type Liveness struct {
+	// TCP is an address, in the form "host:port", that accepts
+	// connections while the process is live.
+	TCP string `bson:"tcp,omitempty" json:"tcp,omitempty" yaml:"tcp,omitempty"`
+	// HTTP is a URL that responds to a GET request with a 2xx status while
+	// the process is live.
+	HTTP string `bson:"http,omitempty" json:"http,omitempty" yaml:"http,omitempty"`
+	// Command is a process that exits successfully while the process is
+	// live.
+	Command *Create `bson:"command,omitempty" json:"command,omitempty" yaml:"command,omitempty"`
+
+	// InitialDelay is how long to wait after the process is ready before
+	// the first check.
+	InitialDelay time.Duration `bson:"initial_delay,omitempty" json:"initial_delay,omitempty" yaml:"initial_delay,omitempty"`
+	// Interval is how often the checks are run.
+	Interval time.Duration `bson:"interval,omitempty" json:"interval,omitempty" yaml:"interval,omitempty"`
+	// Timeout is how long each check may take.
+	Timeout time.Duration `bson:"timeout,omitempty" json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	// FailureThreshold is how many consecutive checks must fail before the
+	// process is restarted.
+	FailureThreshold int `bson:"failure_threshold,omitempty" json:"failure_threshold,omitempty" yaml:"failure_threshold,omitempty"`
+	// GracePeriod is how long the process has to exit after it is sent
+	// SIGTERM before it is sent SIGKILL.
+	GracePeriod time.Duration `bson:"grace_period,omitempty" json:"grace_period,omitempty" yaml:"grace_period,omitempty"`
+	// MaxRestarts limits how many times the process and the processes
+	// that replace it are restarted. Once the limit is reached, a process
+	// that fails its checks is terminated but not respawned. If it is
+	// zero, there is no limit.
+	MaxRestarts int `bson:"max_restarts,omitempty" json:"max_restarts,omitempty" yaml:"max_restarts,omitempty"`
+}
+
+// Validate checks that at least one check is set and that the checks are
+// valid, and sets the defaults for the fields that are not specified.
+func (opts *Liveness) Validate() error {
+	catcher := &erc.Collector{}
+
+	catcher.If(opts.TCP == "" && opts.HTTP == "" && opts.Command == nil, ers.Error("must specify at least one liveness check"))
+	if opts.TCP != "" {
+		if _, _, err := net.SplitHostPort(opts.TCP); err != nil {
+			catcher.Push(fmt.Errorf("invalid TCP address '%s': %w", opts.TCP, err))
+		}
+	}
+	if opts.HTTP != "" {
+		if u, err := url.Parse(opts.HTTP); err != nil {
+			catcher.Push(fmt.Errorf("invalid HTTP URL '%s': %w", opts.HTTP, err))
+		} else {
+			catcher.If(u.Scheme != "http" && u.Scheme != "https", ers.Error("HTTP URL scheme must be http or https"))
+		}
+	}
+	if opts.Command != nil {
+		if err := opts.Command.Validate(); err != nil {
+			catcher.Push(fmt.Errorf("invalid command: %w", err))
+		}
+	}
+	catcher.If(opts.InitialDelay < 0, ers.Error("initial delay must be non-negative"))
+	catcher.If(opts.Interval < 0, ers.Error("interval must be non-negative"))
+	catcher.If(opts.Timeout < 0, ers.Error("timeout must be non-negative"))
+	catcher.If(opts.FailureThreshold < 0, ers.Error("failure threshold must be non-negative"))
+	catcher.If(opts.GracePeriod < 0, ers.Error("grace period must be non-negative"))
+	catcher.If(opts.MaxRestarts < 0, ers.Error("max restarts must be non-negative"))
+
+	if !catcher.Ok() {
+		return catcher.Resolve()
+	}
+
+	if opts.Interval == 0 {
+		opts.Interval = DefaultLivenessInterval
+	}
+	if opts.Timeout == 0 {
+		opts.Timeout = DefaultLivenessCheckTimeout
+	}
+	if opts.FailureThreshold == 0 {
+		opts.FailureThreshold = DefaultLivenessFailureThreshold
+	}
+	if opts.GracePeriod == 0 {
+		opts.GracePeriod = DefaultLivenessGracePeriod
+	}
+
+	return nil
+}
+
+// Copy returns a copy of the options.
+func (opts *Liveness) Copy() *Liveness {
+	optsCopy := *opts
+	if opts.Command != nil {
+		optsCopy.Command = opts.Command.Copy()
+	}
+	return &optsCopy
+}
@@ -0,0 +1,92 @@
+package options
+
+import (
+	"fmt"
+	"regexp"
+	"syscall"
+
+	"github.com/tychoish/fun/erc"
+	"github.com/tychoish/fun/ers"
+)
+
+// OutputTrigger describes actions to take when a line of a process's output
+// matches a regular expression. Lines are matched as they are written, and
+// the actions are taken in the order of the fields below.
+type OutputTrigger struct {
+	// Pattern is the regular expression matched against each line of
+	// output, without its trailing newline.
+	Pattern string `bson:"pattern" json:"pattern" yaml:"pattern"`
+	// Stream limits matching to the lines written to either "stdout" or
+	// "stderr". If it is empty, lines on both streams are matched.
+	Stream string `bson:"stream,omitempty" json:"stream,omitempty" yaml:"stream,omitempty"`
+	// Once, if set, takes the actions only on the first matching line.
+	Once bool `bson:"once,omitempty" json:"once,omitempty" yaml:"once,omitempty"`
+
+	// Fail marks the process as failed, even if it exits with a zero exit
+	// code.
+	Fail bool `bson:"fail,omitempty" json:"fail,omitempty" yaml:"fail,omitempty"`
+	// Tag is added to the process.
+	Tag string `bson:"tag,omitempty" json:"tag,omitempty" yaml:"tag,omitempty"`
+	// TriggerID is the ID of an output trigger registered with Jasper,
+	// which is called with the matching line.
+	TriggerID string `bson:"trigger_id,omitempty" json:"trigger_id,omitempty" yaml:"trigger_id,omitempty"`
+	// Create is a process to create, which is tagged with the ID of the
+	// process whose output matched.
+	Create *Create `bson:"create,omitempty" json:"create,omitempty" yaml:"create,omitempty"`
+	// Signal is sent to the process. For example, SIGTERM terminates the
+	// process.
+	Signal syscall.Signal `bson:"signal,omitempty" json:"signal,omitempty" yaml:"signal,omitempty"`
+
+	regexp *regexp.Regexp
+}
+
+// Validate checks that the trigger has a valid pattern and at least one
+// action.
+func (opts *OutputTrigger) Validate() error {
+	catcher := &erc.Collector{}
+
+	catcher.If(opts.Pattern == "", ers.Error("must specify a pattern"))
+	if opts.Pattern != "" {
+		re, err := regexp.Compile(opts.Pattern)
+		if err != nil {
+			catcher.Push(fmt.Errorf("invalid pattern '%s': %w", opts.Pattern, err))
+		}
+		opts.regexp = re
+	}
+
+	switch opts.Stream {
+	case "", "stdout", "stderr":
+	default:
+		catcher.Push(fmt.Errorf("unrecognized output stream '%s'", opts.Stream))
+	}
+
+	catcher.If(opts.Signal < 0, ers.Error("signal must be non-negative"))
+	catcher.If(!opts.Fail && opts.Tag == "" && opts.TriggerID == "" && opts.Create == nil && opts.Signal == 0,
+		ers.Error("must specify at least one action"))
+
+	if opts.Create != nil {
+		if err := opts.Create.Validate(); err != nil {
+			catcher.Push(fmt.Errorf("invalid create options: %w", err))
+		}
+	}
+
+	return catcher.Resolve()
+}
+
+// Matches returns whether the line written to the stream matches the
+// trigger. The trigger must be validated first.
+func (opts *OutputTrigger) Matches(stream, line string) bool {
+	if opts.Stream != "" && opts.Stream != stream {
+		return false
+	}
+	return opts.regexp != nil && opts.regexp.MatchString(line)
+}
+
+// Copy returns a copy of the options.
+func (opts *OutputTrigger) Copy() *OutputTrigger {
+	optsCopy := *opts
+	if opts.Create != nil {
+		optsCopy.Create = opts.Create.Copy()
+	}
+	return &optsCopy
+}
@@ -0,0 +1,115 @@
+package options
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/tychoish/fun/erc"
+	"github.com/tychoish/fun/ers"
+)
+
+const (
+	// DefaultReadinessInterval is how often readiness probes are run if
+	// not otherwise specified.
+	DefaultReadinessInterval = 250 * time.Millisecond
+	// DefaultReadinessProbeTimeout is how long each attempt of a readiness
+	// probe may take if not otherwise specified.
+	DefaultReadinessProbeTimeout = 5 * time.Second
+)
+
+// Readiness configures probes that determine when a long-running process,
+// such as a server, is ready to be used. The process is ready once all of
+// the probes that are set have succeeded. The probes run on the host that
+// created the process, so the TCP, HTTP, file and command probes of
+// processes created over SSH or in Docker containers are checked from that
+// host rather than from where the process runs.
+type Readiness struct {
+	// TCP is an address, in the form "host:port", that accepts
+	// connections once the process is ready.
+	TCP string `bson:"tcp,omitempty" json:"tcp,omitempty" yaml:"tcp,omitempty"`
+	// HTTP is a URL that responds to a GET request with a 2xx status once
+	// the process is ready.
+	HTTP string `bson:"http,omitempty" json:"http,omitempty" yaml:"http,omitempty"`
+	// OutputPattern is a regular expression that matches a line of the
+	// process's standard output or standard error once it is ready.
+	OutputPattern string `bson:"output_pattern,omitempty" json:"output_pattern,omitempty" yaml:"output_pattern,omitempty"`
+	// File is a path that exists once the process is ready.
+	File string `bson:"file,omitempty" json:"file,omitempty" yaml:"file,omitempty"`
+	// Command is a process that exits successfully once the process is
+	// ready.
+	Command *Create `bson:"command,omitempty" json:"command,omitempty" yaml:"command,omitempty"`
+
+	// Interval is how often the probes are run until they succeed.
+	Interval time.Duration `bson:"interval,omitempty" json:"interval,omitempty" yaml:"interval,omitempty"`
+	// Timeout is how long each attempt of a probe may take.
+	Timeout time.Duration `bson:"timeout,omitempty" json:"timeout,omitempty" yaml:"timeout,omitempty"`
+
+	outputRegexp *regexp.Regexp
+}
+
+// Validate checks that at least one probe is set and that the probes are
+// valid, and sets the default interval and timeout if they are not
+// specified.
+func (opts *Readiness) Validate() error {
+	catcher := &erc.Collector{}
+
+	catcher.If(opts.TCP == "" && opts.HTTP == "" && opts.OutputPattern == "" && opts.File == "" && opts.Command == nil,
+		ers.Error("must specify at least one readiness probe"))
+	if opts.TCP != "" {
+		if _, _, err := net.SplitHostPort(opts.TCP); err != nil {
+			catcher.Push(fmt.Errorf("invalid TCP address '%s': %w", opts.TCP, err))
+		}
+	}
+	if opts.HTTP != "" {
+		if u, err := url.Parse(opts.HTTP); err != nil {
+			catcher.Push(fmt.Errorf("invalid HTTP URL '%s': %w", opts.HTTP, err))
+		} else {
+			catcher.If(u.Scheme != "http" && u.Scheme != "https", ers.Error("HTTP URL scheme must be http or https"))
+		}
+	}
+	if opts.OutputPattern != "" {
+		re, err := regexp.Compile(opts.OutputPattern)
+		if err != nil {
+			catcher.Push(fmt.Errorf("invalid output pattern '%s': %w", opts.OutputPattern, err))
+		}
+		opts.outputRegexp = re
+	}
+	if opts.Command != nil {
+		if err := opts.Command.Validate(); err != nil {
+			catcher.Push(fmt.Errorf("invalid command: %w", err))
+		}
+	}
+	catcher.If(opts.Interval < 0, ers.Error("interval must be non-negative"))
+	catcher.If(opts.Timeout < 0, ers.Error("timeout must be non-negative"))
+
+	if !catcher.Ok() {
+		return catcher.Resolve()
+	}
+
+	if opts.Interval == 0 {
+		opts.Interval = DefaultReadinessInterval
+	}
+	if opts.Timeout == 0 {
+		opts.Timeout = DefaultReadinessProbeTimeout
+	}
+
+	return nil
+}
+
+// MatchesOutput returns whether the line of output matches the output
+// pattern. The options must be validated first.
+func (opts *Readiness) MatchesOutput(line string) bool {
+	return opts.outputRegexp != nil && opts.outputRegexp.MatchString(line)
+}
+
+// Copy returns a copy of the options.
+func (opts *Readiness) Copy() *Readiness {
+	optsCopy := *opts
+	if opts.Command != nil {
+		optsCopy.Command = opts.Command.Copy()
+	}
+	return &optsCopy
+}
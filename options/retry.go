@@ -0,0 +1,101 @@
+package options
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/tychoish/fun/erc"
+	"github.com/tychoish/fun/ers"
+)
+
+// Retry configures how a command that fails is run again. By default, every
+// failed attempt is retried until the attempts are exhausted. If ExitCodes or
+// OutputPatterns are set, only attempts that exit with one of the exit codes
+// or that write a line of output matching one of the patterns are retried.
+// Attempts that time out are always retried.
+type Retry struct {
+	// Attempts is the maximum number of times the command is run,
+	// including the first attempt.
+	Attempts int `bson:"attempts" json:"attempts" yaml:"attempts"`
+	// Backoff is how long to wait after the first failed attempt before the
+	// next one.
+	Backoff time.Duration `bson:"backoff,omitempty" json:"backoff,omitempty" yaml:"backoff,omitempty"`
+	// BackoffMultiplier, if greater than one, multiplies the backoff after
+	// each failed attempt.
+	BackoffMultiplier float64 `bson:"backoff_multiplier,omitempty" json:"backoff_multiplier,omitempty" yaml:"backoff_multiplier,omitempty"`
+	// MaxBackoff, if set, limits how long to wait between attempts.
+	MaxBackoff time.Duration `bson:"max_backoff,omitempty" json:"max_backoff,omitempty" yaml:"max_backoff,omitempty"`
+	// ExitCodes limits retries to attempts that exit with one of the exit
+	// codes.
+	ExitCodes []int `bson:"exit_codes,omitempty" json:"exit_codes,omitempty" yaml:"exit_codes,omitempty"`
+	// OutputPatterns limits retries to attempts that write a line of
+	// output that matches one of the regular expressions. The output is
+	// only matched for processes created locally, not for processes
+	// created by a remote service.
+	OutputPatterns []string `bson:"output_patterns,omitempty" json:"output_patterns,omitempty" yaml:"output_patterns,omitempty"`
+	// AttemptTimeout limits how long each attempt may run. It overrides the
+	// timeout of the process if it is shorter.
+	AttemptTimeout time.Duration `bson:"attempt_timeout,omitempty" json:"attempt_timeout,omitempty" yaml:"attempt_timeout,omitempty"`
+}
+
+// Validate checks that the retry options are valid.
+func (opts *Retry) Validate() error {
+	catcher := &erc.Collector{}
+
+	catcher.If(opts.Attempts < 1, ers.Error("must allow at least one attempt"))
+	catcher.If(opts.Backoff < 0, ers.Error("backoff must be non-negative"))
+	catcher.If(opts.BackoffMultiplier < 0, ers.Error("backoff multiplier must be non-negative"))
+	catcher.If(opts.MaxBackoff < 0, ers.Error("max backoff must be non-negative"))
+	catcher.If(opts.AttemptTimeout < 0, ers.Error("attempt timeout must be non-negative"))
+	catcher.If(opts.AttemptTimeout > 0 && opts.AttemptTimeout < time.Second, ers.Error("attempt timeout must be at least one second"))
+
+	for _, pattern := range opts.OutputPatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			catcher.Push(fmt.Errorf("invalid output pattern '%s': %w", pattern, err))
+		}
+	}
+
+	return catcher.Resolve()
+}
+
+// ShouldRetry returns whether a failed attempt, which exited with the exit
+// code, should be retried, given whether it timed out and whether its output
+// matched one of the output patterns. It does not account for the number of
+// attempts.
+func (opts *Retry) ShouldRetry(exitCode int, timedOut, outputMatched bool) bool {
+	if timedOut {
+		return true
+	}
+	if len(opts.ExitCodes) == 0 && len(opts.OutputPatterns) == 0 {
+		return true
+	}
+	return slices.Contains(opts.ExitCodes, exitCode) || outputMatched
+}
+
+// BackoffAfter returns how long to wait after the given attempt fails, where
+// the first attempt is one.
+func (opts *Retry) BackoffAfter(attempt int) time.Duration {
+	backoff := opts.Backoff
+	if opts.BackoffMultiplier > 1 {
+		for i := 1; i < attempt; i++ {
+			backoff = time.Duration(float64(backoff) * opts.BackoffMultiplier)
+			if opts.MaxBackoff > 0 && backoff >= opts.MaxBackoff {
+				break
+			}
+		}
+	}
+	if opts.MaxBackoff > 0 && backoff > opts.MaxBackoff {
+		backoff = opts.MaxBackoff
+	}
+	return backoff
+}
+
+// Copy returns a copy of the options.
+func (opts *Retry) Copy() *Retry {
+	optsCopy := *opts
+	optsCopy.ExitCodes = slices.Clone(opts.ExitCodes)
+	optsCopy.OutputPatterns = slices.Clone(opts.OutputPatterns)
+	return &optsCopy
+}
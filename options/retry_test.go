@@ -0,0 +1,44 @@
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tychoish/fun/assert/check"
+)
+
+func TestRetry(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		check.NotError(t, (&Retry{Attempts: 1}).Validate())
+		check.NotError(t, (&Retry{Attempts: 3, Backoff: time.Second, OutputPatterns: []string{"^error"}, AttemptTimeout: time.Minute}).Validate())
+		check.Error(t, (&Retry{}).Validate())
+		check.Error(t, (&Retry{Attempts: 2, Backoff: -time.Second}).Validate())
+		check.Error(t, (&Retry{Attempts: 2, OutputPatterns: []string{"("}}).Validate())
+		check.Error(t, (&Retry{Attempts: 2, AttemptTimeout: time.Millisecond}).Validate())
+	})
+	t.Run("ShouldRetry", func(t *testing.T) {
+		check.True(t, (&Retry{Attempts: 2}).ShouldRetry(1, false, false))
+
+		opts := &Retry{Attempts: 2, ExitCodes: []int{128}, OutputPatterns: []string{"reset"}}
+		check.True(t, opts.ShouldRetry(128, false, false))
+		check.True(t, opts.ShouldRetry(1, false, true))
+		check.True(t, opts.ShouldRetry(1, true, false))
+		check.True(t, !opts.ShouldRetry(1, false, false))
+	})
+	t.Run("BackoffAfter", func(t *testing.T) {
+		check.Equal(t, (&Retry{Backoff: time.Second}).BackoffAfter(3), time.Second)
+
+		opts := &Retry{Backoff: time.Second, BackoffMultiplier: 2, MaxBackoff: 5 * time.Second}
+		check.Equal(t, opts.BackoffAfter(1), time.Second)
+		check.Equal(t, opts.BackoffAfter(2), 2*time.Second)
+		check.Equal(t, opts.BackoffAfter(3), 4*time.Second)
+		check.Equal(t, opts.BackoffAfter(4), 5*time.Second)
+		check.Equal(t, opts.BackoffAfter(100), 5*time.Second)
+	})
+	t.Run("Copy", func(t *testing.T) {
+		opts := &Retry{Attempts: 2, ExitCodes: []int{1}}
+		optsCopy := opts.Copy()
+		optsCopy.ExitCodes[0] = 2
+		check.Equal(t, opts.ExitCodes[0], 1)
+	})
+}
@@ -0,0 +1,118 @@
+package options
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tychoish/fun/erc"
+)
+
+// ScheduleOverlap determines what a schedule does when it is time to create
+// a process but the process from the previous run is still running.
+type ScheduleOverlap string
+
+const (
+	// ScheduleOverlapSkip skips the run.
+	ScheduleOverlapSkip ScheduleOverlap = "skip"
+	// ScheduleOverlapQueue starts the run once the previous run's process
+	// exits.
+	ScheduleOverlapQueue ScheduleOverlap = "queue"
+	// ScheduleOverlapReplace terminates the previous run's process and
+	// starts the run.
+	ScheduleOverlapReplace ScheduleOverlap = "replace"
+)
+
+// Validate ensures that the overlap policy is recognized.
+func (o ScheduleOverlap) Validate() error {
+	switch o {
+	case ScheduleOverlapSkip, ScheduleOverlapQueue, ScheduleOverlapReplace:
+		return nil
+	default:
+		return fmt.Errorf("%s is not a valid schedule overlap policy", o)
+	}
+}
+
+// DefaultScheduleHistorySize is the number of runs that a schedule records
+// if no history size is specified.
+const DefaultScheduleHistorySize = 20
+
+// Schedule describes a process that is created repeatedly, either on a cron
+// schedule or at a fixed interval.
+type Schedule struct {
+	// ID uniquely identifies the schedule.
+	ID string `json:"id" bson:"id" yaml:"id"`
+	// Create are the options for the process created by each run.
+	Create *Create `json:"create" bson:"create" yaml:"create"`
+	// Cron is a cron expression for when runs start, as parsed by
+	// ParseCronExpression. Times are in the local time zone of the
+	// scheduler. Exactly one of Cron and Interval must be set.
+	Cron string `json:"cron,omitempty" bson:"cron,omitempty" yaml:"cron,omitempty"`
+	// Interval is the time between the start of each run.
+	Interval time.Duration `json:"interval,omitempty" bson:"interval,omitempty" yaml:"interval,omitempty"`
+	// Jitter is the maximum random delay added to the start of each run,
+	// which spreads out schedules that would otherwise start at the same
+	// time.
+	Jitter time.Duration `json:"jitter,omitempty" bson:"jitter,omitempty" yaml:"jitter,omitempty"`
+	// Overlap is what to do when a run starts while the previous run's
+	// process is still running. It defaults to ScheduleOverlapSkip.
+	Overlap ScheduleOverlap `json:"overlap,omitempty" bson:"overlap,omitempty" yaml:"overlap,omitempty"`
+	// HistorySize is the number of most recent runs to record. If zero,
+	// DefaultScheduleHistorySize is used.
+	HistorySize int `json:"history_size,omitempty" bson:"history_size,omitempty" yaml:"history_size,omitempty"`
+
+	cron *CronExpression
+}
+
+// Validate checks the schedule and sets the defaults.
+func (s *Schedule) Validate() error {
+	catcher := &erc.Collector{}
+	catcher.If(s.ID == "", errors.New("schedule must have an ID"))
+	catcher.If(s.Cron == "" && s.Interval == 0, errors.New("schedule must specify a cron expression or an interval"))
+	catcher.If(s.Cron != "" && s.Interval != 0, errors.New("schedule cannot specify both a cron expression and an interval"))
+	catcher.If(s.Interval < 0, errors.New("schedule interval cannot be negative"))
+	catcher.If(s.Jitter < 0, errors.New("schedule jitter cannot be negative"))
+	catcher.If(s.HistorySize < 0, errors.New("schedule history size cannot be negative"))
+	if s.Overlap != "" {
+		catcher.Push(s.Overlap.Validate())
+	}
+	if s.Create == nil {
+		catcher.Push(errors.New("schedule must specify process creation options"))
+	} else if err := s.Create.Validate(); err != nil {
+		catcher.Push(fmt.Errorf("invalid process creation options: %w", err))
+	}
+	if s.Cron != "" {
+		cron, err := ParseCronExpression(s.Cron)
+		catcher.Push(err)
+		s.cron = cron
+	}
+	if !catcher.Ok() {
+		return catcher.Resolve()
+	}
+
+	if s.Overlap == "" {
+		s.Overlap = ScheduleOverlapSkip
+	}
+	if s.HistorySize == 0 {
+		s.HistorySize = DefaultScheduleHistorySize
+	}
+	return nil
+}
+
+// Next returns the time of the first run after t, not including jitter. The
+// schedule must be valid. It returns the zero time if there are no more runs.
+func (s *Schedule) Next(t time.Time) time.Time {
+	if s.cron != nil {
+		return s.cron.Next(t)
+	}
+	return t.Add(s.Interval)
+}
+
+// Copy returns a copy of the schedule.
+func (s *Schedule) Copy() *Schedule {
+	sCopy := *s
+	if s.Create != nil {
+		sCopy.Create = s.Create.Copy()
+	}
+	return &sCopy
+}
@@ -0,0 +1,259 @@
+package options
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/shlex"
+
+	"github.com/tychoish/fun/erc"
+)
+
+// TaskFile describes a workflow of commands declaratively, so that it can be
+// written as a JSON or YAML file and loaded into commands. The settings of the
+// task file apply to all of its steps, unless a step overrides them.
+type TaskFile struct {
+	// ID identifies the workflow. It is the prefix of the ID of the
+	// command of each step.
+	ID string `bson:"id,omitempty" json:"id,omitempty" yaml:"id,omitempty"`
+	// Directory is the working directory of the steps.
+	Directory string `bson:"dir,omitempty" json:"dir,omitempty" yaml:"dir,omitempty"`
+	// Environment is the environment of the steps, which is merged with the
+	// environment of each step.
+	Environment map[string]string `bson:"env,omitempty" json:"env,omitempty" yaml:"env,omitempty"`
+	// Tags are added to the processes of the steps.
+	Tags []string `bson:"tags,omitempty" json:"tags,omitempty" yaml:"tags,omitempty"`
+	// Timeout is the maximum duration of the whole workflow, as parsed by
+	// time.ParseDuration.
+	Timeout string `bson:"timeout,omitempty" json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	// ContinueOnError runs the remaining steps after a step fails. The
+	// workflow still fails if any of its steps fail.
+	ContinueOnError bool `bson:"continue_on_error,omitempty" json:"continue_on_error,omitempty" yaml:"continue_on_error,omitempty"`
+	// Loggers are the loggers of the processes of the steps that do not
+	// specify loggers of their own.
+	Loggers []*LoggerConfig `bson:"loggers,omitempty" json:"loggers,omitempty" yaml:"loggers,omitempty"`
+	// Remote runs the steps on a remote host over SSH.
+	Remote *Remote `bson:"remote,omitempty" json:"remote,omitempty" yaml:"remote,omitempty"`
+	// Docker runs the steps in a Docker container.
+	Docker *Docker `bson:"docker,omitempty" json:"docker,omitempty" yaml:"docker,omitempty"`
+	// Steps are run in order.
+	Steps []TaskStep `bson:"steps" json:"steps" yaml:"steps"`
+
+	timeout time.Duration
+}
+
+// TaskStep is one step of a task file. A step either runs a command, which is
+// specified by exactly one of Command and Args, or runs the steps in
+// Parallel at the same time.
+type TaskStep struct {
+	// Name identifies the step in errors and in the ID of its command.
+	Name string `bson:"name,omitempty" json:"name,omitempty" yaml:"name,omitempty"`
+	// Command is the command to run, which is split into arguments like a
+	// shell would, but is not run with a shell.
+	Command string `bson:"command,omitempty" json:"command,omitempty" yaml:"command,omitempty"`
+	// Args are the arguments of the command to run.
+	Args []string `bson:"args,omitempty" json:"args,omitempty" yaml:"args,omitempty"`
+	// Parallel are the steps to run at the same time. They cannot have
+	// parallel steps of their own.
+	Parallel []TaskStep `bson:"parallel,omitempty" json:"parallel,omitempty" yaml:"parallel,omitempty"`
+	// Directory overrides the working directory of the task file.
+	Directory string `bson:"dir,omitempty" json:"dir,omitempty" yaml:"dir,omitempty"`
+	// Environment is merged into the environment of the task file,
+	// overriding the variables that they both set.
+	Environment map[string]string `bson:"env,omitempty" json:"env,omitempty" yaml:"env,omitempty"`
+	// Tags are added to the tags of the task file.
+	Tags []string `bson:"tags,omitempty" json:"tags,omitempty" yaml:"tags,omitempty"`
+	// Timeout is the maximum duration of the process of the step, as parsed
+	// by time.ParseDuration.
+	Timeout string `bson:"timeout,omitempty" json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	// IgnoreError does not fail the workflow if the step fails.
+	IgnoreError bool `bson:"ignore_error,omitempty" json:"ignore_error,omitempty" yaml:"ignore_error,omitempty"`
+	// Loggers override the loggers of the task file.
+	Loggers []*LoggerConfig `bson:"loggers,omitempty" json:"loggers,omitempty" yaml:"loggers,omitempty"`
+	// Remote overrides the target of the task file, running the step on a
+	// remote host over SSH.
+	Remote *Remote `bson:"remote,omitempty" json:"remote,omitempty" yaml:"remote,omitempty"`
+	// Docker overrides the target of the task file, running the step in a
+	// Docker container.
+	Docker *Docker `bson:"docker,omitempty" json:"docker,omitempty" yaml:"docker,omitempty"`
+
+	args    []string
+	timeout time.Duration
+}
+
+// ReadTaskFile reads a JSON task file and validates it. Fields that are not
+// part of the task file schema are rejected.
+func ReadTaskFile(r io.Reader) (*TaskFile, error) {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+
+	tf := &TaskFile{}
+	if err := decoder.Decode(tf); err != nil {
+		return nil, fmt.Errorf("problem parsing task file: %w", err)
+	}
+	if err := tf.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid task file: %w", err)
+	}
+	return tf, nil
+}
+
+// Validate checks the task file and all of its steps. Each error describes
+// where in the task file it is, such as "steps[1].parallel[0]".
+func (tf *TaskFile) Validate() error {
+	catcher := &erc.Collector{}
+	catcher.If(len(tf.Steps) == 0, errors.New("must specify at least one step"))
+
+	timeout, err := parseTaskTimeout(tf.Timeout)
+	catcher.Push(err)
+	tf.timeout = timeout
+
+	catcher.Push(validateTaskTarget("", tf.Remote, tf.Docker, tf.Loggers))
+	catcher.Push(validateTaskEnvironment("", tf.Environment))
+
+	names := map[string]string{}
+	for idx := range tf.Steps {
+		path := fmt.Sprintf("steps[%d]", idx)
+		catcher.Push(tf.Steps[idx].validate(path, true))
+		catcher.Push(checkTaskStepName(names, path, tf.Steps[idx].Name))
+		for pidx := range tf.Steps[idx].Parallel {
+			catcher.Push(checkTaskStepName(names, fmt.Sprintf("%s.parallel[%d]", path, pidx), tf.Steps[idx].Parallel[pidx].Name))
+		}
+	}
+
+	return catcher.Resolve()
+}
+
+// GetTimeout returns the maximum duration of the workflow, or zero if it
+// does not have one. The task file must be valid.
+func (tf *TaskFile) GetTimeout() time.Duration { return tf.timeout }
+
+func (s *TaskStep) validate(path string, allowParallel bool) error {
+	catcher := &erc.Collector{}
+
+	numSpecified := 0
+	for _, specified := range []bool{s.Command != "", len(s.Args) != 0, len(s.Parallel) != 0} {
+		if specified {
+			numSpecified++
+		}
+	}
+	if numSpecified != 1 {
+		catcher.Push(fmt.Errorf("%s: must specify exactly one of 'command', 'args', or 'parallel'", path))
+	}
+
+	switch {
+	case s.Command != "":
+		args, err := shlex.Split(s.Command)
+		if err != nil {
+			catcher.Push(fmt.Errorf("%s: problem splitting command '%s': %w", path, s.Command, err))
+		}
+		catcher.If(err == nil && len(args) == 0, fmt.Errorf("%s: command cannot be blank", path))
+		s.args = args
+	case len(s.Args) != 0:
+		catcher.If(s.Args[0] == "", fmt.Errorf("%s: the first argument cannot be empty", path))
+		s.args = s.Args
+	case len(s.Parallel) != 0:
+		if !allowParallel {
+			catcher.Push(fmt.Errorf("%s: parallel steps cannot have parallel steps of their own", path))
+			break
+		}
+		for idx := range s.Parallel {
+			catcher.Push(s.Parallel[idx].validate(fmt.Sprintf("%s.parallel[%d]", path, idx), false))
+		}
+	}
+
+	if len(s.Parallel) != 0 {
+		catcher.If(s.Directory != "" || len(s.Environment) != 0 || len(s.Tags) != 0 || s.Timeout != "" ||
+			len(s.Loggers) != 0 || s.Remote != nil || s.Docker != nil,
+			fmt.Errorf("%s: parallel steps can only specify a name and whether to ignore errors; specify the other settings on each of the parallel steps", path))
+	}
+
+	timeout, err := parseTaskTimeout(s.Timeout)
+	if err != nil {
+		catcher.Push(fmt.Errorf("%s: %w", path, err))
+	}
+	s.timeout = timeout
+
+	catcher.Push(validateTaskTarget(path, s.Remote, s.Docker, s.Loggers))
+	catcher.Push(validateTaskEnvironment(path, s.Environment))
+
+	return catcher.Resolve()
+}
+
+// GetArgs returns the arguments of the command of the step, which are nil for
+// parallel steps. The step must be valid.
+func (s *TaskStep) GetArgs() []string { return s.args }
+
+// GetTimeout returns the maximum duration of the process of the step, or zero
+// if it does not have one. The step must be valid.
+func (s *TaskStep) GetTimeout() time.Duration { return s.timeout }
+
+func parseTaskTimeout(timeout string) (time.Duration, error) {
+	if timeout == "" {
+		return 0, nil
+	}
+	dur, err := time.ParseDuration(timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout '%s': %w", timeout, err)
+	}
+	if dur <= 0 {
+		return 0, fmt.Errorf("timeout '%s' must be positive", timeout)
+	}
+	return dur, nil
+}
+
+func validateTaskTarget(path string, remote *Remote, docker *Docker, loggers []*LoggerConfig) error {
+	catcher := &erc.Collector{}
+	prefix := ""
+	if path != "" {
+		prefix = path + ": "
+	}
+
+	catcher.If(remote != nil && docker != nil, fmt.Errorf("%scannot specify both a remote and a docker target", prefix))
+	if remote != nil {
+		if err := remote.Validate(); err != nil {
+			catcher.Push(fmt.Errorf("%sinvalid remote target: %w", prefix, err))
+		}
+	}
+	if docker != nil {
+		if err := docker.Validate(); err != nil {
+			catcher.Push(fmt.Errorf("%sinvalid docker target: %w", prefix, err))
+		}
+	}
+	for idx, logger := range loggers {
+		if logger == nil {
+			catcher.Push(fmt.Errorf("%sloggers[%d]: logger is not specified", prefix, idx))
+			continue
+		}
+		if err := logger.resolveProducer(); err != nil {
+			catcher.Push(fmt.Errorf("%sloggers[%d]: %w", prefix, idx, err))
+		}
+	}
+
+	return catcher.Resolve()
+}
+
+func validateTaskEnvironment(path string, env map[string]string) error {
+	for key := range env {
+		if key == "" {
+			if path == "" {
+				return errors.New("environment variable names cannot be empty")
+			}
+			return fmt.Errorf("%s: environment variable names cannot be empty", path)
+		}
+	}
+	return nil
+}
+
+func checkTaskStepName(names map[string]string, path, name string) error {
+	if name == "" {
+		return nil
+	}
+	if other, ok := names[name]; ok {
+		return fmt.Errorf("%s: step name '%s' is already used by %s", path, name, other)
+	}
+	names[name] = path
+	return nil
+}
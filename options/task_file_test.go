@@ -0,0 +1,116 @@
+package options
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+)
+
+func TestTaskFile(t *testing.T) {
+	t.Run("ReadTaskFile", func(t *testing.T) {
+		tf, err := ReadTaskFile(strings.NewReader(`{
+			"id": "build",
+			"timeout": "10m",
+			"env": {"GOFLAGS": "-mod=mod"},
+			"steps": [
+				{"name": "compile", "command": "go build ./...", "timeout": "5m"},
+				{"parallel": [{"args": ["go", "vet", "./..."]}, {"command": "go test ./..."}]}
+			]
+		}`))
+		assert.NotError(t, err)
+		check.Equal(t, tf.GetTimeout(), 10*time.Minute)
+		assert.Equal(t, len(tf.Steps), 2)
+		check.EqualItems(t, tf.Steps[0].GetArgs(), []string{"go", "build", "./..."})
+		check.Equal(t, tf.Steps[0].GetTimeout(), 5*time.Minute)
+		assert.Equal(t, len(tf.Steps[1].Parallel), 2)
+		check.EqualItems(t, tf.Steps[1].Parallel[0].GetArgs(), []string{"go", "vet", "./..."})
+		check.EqualItems(t, tf.Steps[1].Parallel[1].GetArgs(), []string{"go", "test", "./..."})
+	})
+	t.Run("ReadTaskFileRejectsUnknownFields", func(t *testing.T) {
+		_, err := ReadTaskFile(strings.NewReader(`{"stpes": [{"command": "ls"}]}`))
+		assert.Error(t, err)
+		check.Substring(t, err.Error(), "stpes")
+	})
+	t.Run("ReadTaskFileRejectsMalformedInput", func(t *testing.T) {
+		_, err := ReadTaskFile(strings.NewReader(`{"steps": [{"args": "ls"}]}`))
+		check.Error(t, err)
+	})
+	t.Run("Validate", func(t *testing.T) {
+		for name, testCase := range map[string]struct {
+			file     TaskFile
+			contains string
+		}{
+			"NoSteps": {
+				file:     TaskFile{},
+				contains: "at least one step",
+			},
+			"NoCommand": {
+				file:     TaskFile{Steps: []TaskStep{{Name: "empty"}}},
+				contains: "steps[0]: must specify exactly one of",
+			},
+			"CommandAndArgs": {
+				file:     TaskFile{Steps: []TaskStep{{Command: "ls", Args: []string{"ls"}}}},
+				contains: "steps[0]: must specify exactly one of",
+			},
+			"UnterminatedQuote": {
+				file:     TaskFile{Steps: []TaskStep{{Command: "echo 'hi"}}},
+				contains: "steps[0]: problem splitting command",
+			},
+			"InvalidTimeout": {
+				file:     TaskFile{Timeout: "soon", Steps: []TaskStep{{Command: "ls"}}},
+				contains: "invalid timeout 'soon'",
+			},
+			"InvalidStepTimeout": {
+				file:     TaskFile{Steps: []TaskStep{{Command: "ls"}, {Command: "ls", Timeout: "-1s"}}},
+				contains: "steps[1]: timeout '-1s' must be positive",
+			},
+			"NestedParallel": {
+				file: TaskFile{Steps: []TaskStep{{Parallel: []TaskStep{
+					{Command: "ls"},
+					{Parallel: []TaskStep{{Command: "ls"}}},
+				}}}},
+				contains: "steps[0].parallel[1]: parallel steps cannot have parallel steps",
+			},
+			"ParallelWithSettings": {
+				file:     TaskFile{Steps: []TaskStep{{Directory: "/tmp", Parallel: []TaskStep{{Command: "ls"}}}}},
+				contains: "steps[0]: parallel steps can only specify",
+			},
+			"DuplicateNames": {
+				file: TaskFile{Steps: []TaskStep{
+					{Name: "list", Command: "ls"},
+					{Parallel: []TaskStep{{Name: "list", Command: "ls"}}},
+				}},
+				contains: "steps[1].parallel[0]: step name 'list' is already used by steps[0]",
+			},
+			"RemoteAndDocker": {
+				file: TaskFile{Steps: []TaskStep{{
+					Command: "ls",
+					Remote:  &Remote{RemoteConfig: RemoteConfig{Host: "example.com"}},
+					Docker:  &Docker{Image: "ubuntu"},
+				}}},
+				contains: "steps[0]: cannot specify both a remote and a docker target",
+			},
+			"InvalidRemote": {
+				file:     TaskFile{Remote: &Remote{}, Steps: []TaskStep{{Command: "ls"}}},
+				contains: "invalid remote target",
+			},
+			"UnregisteredLogger": {
+				file:     TaskFile{Loggers: []*LoggerConfig{NewLoggerConfig("nonexistent", RawLoggerConfigFormatJSON, nil)}, Steps: []TaskStep{{Command: "ls"}}},
+				contains: "loggers[0]: unregistered logger type 'nonexistent'",
+			},
+			"EmptyEnvironmentVariable": {
+				file:     TaskFile{Steps: []TaskStep{{Command: "ls", Environment: map[string]string{"": "value"}}}},
+				contains: "steps[0]: environment variable names cannot be empty",
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				err := testCase.file.Validate()
+				assert.Error(t, err)
+				check.Substring(t, err.Error(), testCase.contains)
+			})
+		}
+	})
+}
@@ -0,0 +1,338 @@
+package jasper
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/tychoish/jasper/options"
+)
+
+// OutputStream identifies the stream that a line of process output was
+// written to.
+type OutputStream string
+
+const (
+	// OutputStreamStdout is the process's standard output.
+	OutputStreamStdout OutputStream = "stdout"
+	// OutputStreamStderr is the process's standard error.
+	OutputStreamStderr OutputStream = "stderr"
+)
+
+// Validate checks that the output stream is recognized.
+func (s OutputStream) Validate() error {
+	switch s {
+	case OutputStreamStdout, OutputStreamStderr:
+		return nil
+	default:
+		return fmt.Errorf("unrecognized output stream '%s'", s)
+	}
+}
+
+// LogLine is a single line of process output.
+type LogLine struct {
+	// Offset is the position of the line in the process's output, counting
+	// lines on both streams. It can be used to resume following output after
+	// the last line that was received.
+	Offset int64        `bson:"offset" json:"offset" yaml:"offset"`
+	Stream OutputStream `bson:"stream" json:"stream" yaml:"stream"`
+	Line   string       `bson:"line" json:"line" yaml:"line"`
+	Time   time.Time    `bson:"time" json:"time" yaml:"time"`
+}
+
+// DefaultOutputBufferSize is the number of lines retained by an OutputBuffer
+// if not otherwise specified.
+const DefaultOutputBufferSize = 10000
+
+// OutputBuffer records the most recent lines of a process's output, in
+// addition to whatever loggers the process has, so that the output can be
+// followed as it is produced. Create one with CaptureOutput before creating
+// the process.
+type OutputBuffer struct {
+	mu      sync.Mutex
+	size    int
+	lines   []LogLine
+	next    int64
+	closed  bool
+	notify  chan struct{}
+	writers []*lineWriter
+}
+
+// NewOutputBuffer returns an OutputBuffer that retains up to size lines. If
+// size is not positive, DefaultOutputBufferSize is used.
+func NewOutputBuffer(size int) *OutputBuffer {
+	if size <= 0 {
+		size = DefaultOutputBufferSize
+	}
+	return &OutputBuffer{size: size, notify: make(chan struct{})}
+}
+
+// CaptureOutput attaches a new OutputBuffer of the given size to the output
+// options so that the standard output and standard error of the process
+// created from opts are recorded in the buffer. Streams that are suppressed
+// are not captured. The caller should Close the buffer once the process
+// exits.
+func CaptureOutput(opts *options.Create, size int) *OutputBuffer {
+	buf := NewOutputBuffer(size)
+	if !opts.Output.SuppressOutput {
+		opts.Output.Output = teeWriter(opts.Output.Output, buf.Writer(OutputStreamStdout))
+	}
+	if !opts.Output.SuppressError {
+		opts.Output.Error = teeWriter(opts.Output.Error, buf.Writer(OutputStreamStderr))
+	}
+	return buf
+}
+
+func teeWriter(existing, w io.Writer) io.Writer {
+	if existing == nil || existing == io.Discard {
+		return w
+	}
+	return io.MultiWriter(existing, w)
+}
+
+// Writer returns an io.Writer that records each line written to it as output
+// on the given stream. Incomplete lines are held until they are terminated
+// by a newline, and lines longer than 64KB are split into several lines.
+func (b *OutputBuffer) Writer(stream OutputStream) io.Writer {
+	w := &lineWriter{onLine: func(line string) { b.add(stream, line) }}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.writers = append(b.writers, w)
+
+	return w
+}
+
+func (b *OutputBuffer) add(stream OutputStream, line string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+
+	b.lines = append(b.lines, LogLine{
+		Offset: b.next,
+		Stream: stream,
+		Line:   line,
+		Time:   time.Now(),
+	})
+	b.next++
+	if len(b.lines) > b.size {
+		b.lines = slices.Delete(b.lines, 0, len(b.lines)-b.size)
+	}
+
+	close(b.notify)
+	b.notify = make(chan struct{})
+}
+
+// Close marks the output as complete, recording any incomplete lines. Lines
+// written afterwards are discarded and followers stop once they have received
+// the remaining lines.
+func (b *OutputBuffer) Close() error {
+	b.mu.Lock()
+	writers := b.writers
+	b.mu.Unlock()
+	for _, w := range writers {
+		w.flush()
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.closed {
+		b.closed = true
+		close(b.notify)
+	}
+	return nil
+}
+
+// read returns the retained lines starting at the given offset, the offset
+// after the last of them, a channel that is closed when more lines are
+// available, and whether the output is complete.
+func (b *OutputBuffer) read(offset int64) ([]LogLine, int64, <-chan struct{}, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var out []LogLine
+	if len(b.lines) > 0 {
+		start := offset - b.lines[0].Offset
+		if start < 0 {
+			start = 0
+		}
+		if start < int64(len(b.lines)) {
+			out = slices.Clone(b.lines[start:])
+		}
+	}
+
+	return out, max(offset, b.next), b.notify, b.closed
+}
+
+// Follow calls fn with each line of output starting at the given offset, or
+// at the oldest retained line if it has already been discarded, and then with
+// each new line as it is written. If any streams are given, only lines on
+// those streams are passed to fn. Follow returns once the buffer is closed
+// and all of its lines have been passed to fn, if the context is canceled, or
+// if fn returns an error.
+func (b *OutputBuffer) Follow(ctx context.Context, offset int64, streams []OutputStream, fn func(LogLine) error) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		lines, next, more, done := b.read(offset)
+		for _, line := range lines {
+			if len(streams) != 0 && !slices.Contains(streams, line.Stream) {
+				continue
+			}
+			if err := fn(line); err != nil {
+				return err
+			}
+		}
+		offset = next
+
+		if len(lines) != 0 {
+			continue
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-more:
+		}
+	}
+}
+
+// OutputBufferRegistry tracks the output buffers of processes created by a
+// manager so that their output can be followed by process ID.
+type OutputBufferRegistry struct {
+	manager Manager
+	size    int
+	// hooked is true when the manager captures the output of every
+	// process that it creates for the registry, including commands,
+	// follow-ups and triggered processes.
+	hooked  bool
+	mu      sync.Mutex
+	buffers map[string]*OutputBuffer
+}
+
+// NewOutputBufferRegistry returns a registry for the output of processes
+// created by the manager, retaining up to size lines for each process. If the
+// manager is, or wraps, a manager created by NewManager, the output of every
+// process that it creates is captured; otherwise only the output of processes
+// created with the registry's CreateProcess is captured.
+func NewOutputBufferRegistry(m Manager, size int) *OutputBufferRegistry {
+	r := &OutputBufferRegistry{
+		manager: m,
+		size:    size,
+		buffers: map[string]*OutputBuffer{},
+	}
+
+	if hooker, ok := findProcessHooker(m); ok {
+		hooker.addProcessHook(r)
+		r.hooked = true
+	}
+
+	return r
+}
+
+// create captures the output of the process created from opts. The buffer is
+// closed when the process exits and is kept until the process is removed from
+// the manager.
+func (r *OutputBufferRegistry) create(ctx context.Context, opts *options.Create) func(Process) {
+	buf := CaptureOutput(opts, r.size)
+	return func(proc Process) {
+		if proc == nil {
+			_ = buf.Close()
+			return
+		}
+
+		r.mu.Lock()
+		r.buffers[proc.ID()] = buf
+		r.mu.Unlock()
+
+		if err := proc.RegisterTrigger(ctx, func(ProcessInfo) { _ = buf.Close() }); err != nil {
+			_ = buf.Close()
+		}
+	}
+}
+
+// removed discards the buffer of a process that was removed from the manager.
+func (r *OutputBufferRegistry) removed(id string) {
+	r.mu.Lock()
+	buf, ok := r.buffers[id]
+	delete(r.buffers, id)
+	r.mu.Unlock()
+
+	if ok {
+		_ = buf.Close()
+	}
+}
+
+// CreateProcess creates a process with the manager. Unless the manager
+// captures the output of all of its processes, the registry captures the
+// output of this process, which is kept until Clear or Close.
+func (r *OutputBufferRegistry) CreateProcess(ctx context.Context, opts *options.Create) (Process, error) {
+	if r.hooked {
+		return r.manager.CreateProcess(ctx, opts)
+	}
+
+	done := r.create(ctx, opts)
+	proc, err := r.manager.CreateProcess(ctx, opts)
+	done(proc)
+	if err != nil {
+		return nil, err
+	}
+	return proc, nil
+}
+
+// Clear clears the completed processes from the manager and discards their
+// buffers.
+func (r *OutputBufferRegistry) Clear(ctx context.Context) {
+	r.manager.Clear(ctx)
+	r.discardClosed()
+}
+
+// Close closes the manager and discards the buffers of the processes that
+// have exited.
+func (r *OutputBufferRegistry) Close(ctx context.Context) error {
+	err := r.manager.Close(ctx)
+	r.discardClosed()
+	return err
+}
+
+// Get returns the output buffer for the process with the given ID.
+func (r *OutputBufferRegistry) Get(id string) (*OutputBuffer, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	buf, ok := r.buffers[id]
+	if !ok {
+		return nil, fmt.Errorf("output of process '%s' is not being captured", id)
+	}
+	return buf, nil
+}
+
+// discardClosed discards the buffers of processes that have exited when the
+// manager does not report the processes that it removes.
+func (r *OutputBufferRegistry) discardClosed() {
+	if r.hooked {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, buf := range r.buffers {
+		buf.mu.Lock()
+		closed := buf.closed
+		buf.mu.Unlock()
+		if closed {
+			delete(r.buffers, id)
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package jasper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/testutil"
+)
+
+func collectLines(ctx context.Context, buf *OutputBuffer, offset int64, streams ...OutputStream) ([]LogLine, error) {
+	out := []LogLine{}
+	err := buf.Follow(ctx, offset, streams, func(line LogLine) error {
+		out = append(out, line)
+		return nil
+	})
+	return out, err
+}
+
+func TestOutputBuffer(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+	defer cancel()
+
+	t.Run("SplitsLines", func(t *testing.T) {
+		buf := NewOutputBuffer(0)
+		stdout := buf.Writer(OutputStreamStdout)
+		stderr := buf.Writer(OutputStreamStderr)
+
+		_, err := io.WriteString(stdout, "foo\nba")
+		assert.NotError(t, err)
+		_, err = io.WriteString(stderr, "err\r\n")
+		assert.NotError(t, err)
+		_, err = io.WriteString(stdout, "r\nbaz")
+		assert.NotError(t, err)
+		assert.NotError(t, buf.Close())
+
+		lines, err := collectLines(ctx, buf, 0)
+		assert.NotError(t, err)
+		assert.Equal(t, len(lines), 4)
+		for idx, expected := range []LogLine{
+			{Offset: 0, Stream: OutputStreamStdout, Line: "foo"},
+			{Offset: 1, Stream: OutputStreamStderr, Line: "err"},
+			{Offset: 2, Stream: OutputStreamStdout, Line: "bar"},
+			{Offset: 3, Stream: OutputStreamStdout, Line: "baz"},
+		} {
+			check.Equal(t, lines[idx].Offset, expected.Offset)
+			check.Equal(t, lines[idx].Stream, expected.Stream)
+			check.Equal(t, lines[idx].Line, expected.Line)
+		}
+	})
+	t.Run("SplitsLongLines", func(t *testing.T) {
+		buf := NewOutputBuffer(0)
+		_, err := io.WriteString(buf.Writer(OutputStreamStdout), strings.Repeat("x", maxOutputLineSize+1))
+		assert.NotError(t, err)
+		assert.NotError(t, buf.Close())
+
+		lines, err := collectLines(ctx, buf, 0)
+		assert.NotError(t, err)
+		assert.Equal(t, len(lines), 2)
+		check.Equal(t, len(lines[0].Line), maxOutputLineSize)
+		check.Equal(t, lines[1].Line, "x")
+	})
+	t.Run("FiltersStreams", func(t *testing.T) {
+		buf := NewOutputBuffer(0)
+		_, err := io.WriteString(buf.Writer(OutputStreamStdout), "out\n")
+		assert.NotError(t, err)
+		_, err = io.WriteString(buf.Writer(OutputStreamStderr), "err\n")
+		assert.NotError(t, err)
+		assert.NotError(t, buf.Close())
+
+		lines, err := collectLines(ctx, buf, 0, OutputStreamStderr)
+		assert.NotError(t, err)
+		assert.Equal(t, len(lines), 1)
+		check.Equal(t, lines[0].Line, "err")
+		check.Equal(t, lines[0].Offset, int64(1))
+	})
+	t.Run("ResumesFromOffset", func(t *testing.T) {
+		buf := NewOutputBuffer(3)
+		w := buf.Writer(OutputStreamStdout)
+		for i := 0; i < 5; i++ {
+			_, err := fmt.Fprintln(w, i)
+			assert.NotError(t, err)
+		}
+		assert.NotError(t, buf.Close())
+
+		lines, err := collectLines(ctx, buf, 3)
+		assert.NotError(t, err)
+		assert.Equal(t, len(lines), 2)
+		check.Equal(t, lines[0].Line, "3")
+
+		lines, err = collectLines(ctx, buf, 0)
+		assert.NotError(t, err)
+		assert.Equal(t, len(lines), 3)
+		check.Equal(t, lines[0].Offset, int64(2))
+
+		lines, err = collectLines(ctx, buf, 10)
+		assert.NotError(t, err)
+		check.Equal(t, len(lines), 0)
+	})
+	t.Run("FollowsNewLines", func(t *testing.T) {
+		buf := NewOutputBuffer(0)
+		w := buf.Writer(OutputStreamStdout)
+
+		received := make(chan LogLine)
+		errs := make(chan error, 1)
+		go func() {
+			errs <- buf.Follow(ctx, 0, nil, func(line LogLine) error {
+				received <- line
+				return nil
+			})
+		}()
+
+		for _, expected := range []string{"first", "second"} {
+			_, err := fmt.Fprintln(w, expected)
+			assert.NotError(t, err)
+			select {
+			case line := <-received:
+				check.Equal(t, line.Line, expected)
+			case <-ctx.Done():
+				t.Fatal("timed out waiting for line")
+			}
+		}
+
+		assert.NotError(t, buf.Close())
+		check.NotError(t, <-errs)
+	})
+	t.Run("StopsOnError", func(t *testing.T) {
+		buf := NewOutputBuffer(0)
+		_, err := io.WriteString(buf.Writer(OutputStreamStdout), "foo\nbar\n")
+		assert.NotError(t, err)
+
+		expected := errors.New("stop")
+		count := 0
+		err = buf.Follow(ctx, 0, nil, func(LogLine) error {
+			count++
+			return expected
+		})
+		check.ErrorIs(t, err, expected)
+		check.Equal(t, count, 1)
+	})
+	t.Run("StopsOnContextCancellation", func(t *testing.T) {
+		tctx, tcancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer tcancel()
+
+		_, err := collectLines(tctx, NewOutputBuffer(0), 0)
+		check.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestOutputBufferRegistry(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+	defer cancel()
+
+	for name, withLogger := range map[string]bool{
+		"NoLoggers":      false,
+		"InMemoryLogger": true,
+	} {
+		t.Run(name, func(t *testing.T) {
+			opts := &options.Create{Args: []string{"sh", "-c", "echo out; echo err >&2"}}
+			if withLogger {
+				logger, err := NewInMemoryLogger(10)
+				assert.NotError(t, err)
+				opts.Output.Loggers = []*options.LoggerConfig{logger}
+			}
+
+			registry := NewOutputBufferRegistry(NewManager(ManagerOptionSet(ManagerOptions{Synchronized: true})), 0)
+			proc, err := registry.CreateProcess(ctx, opts)
+			assert.NotError(t, err)
+			_, err = proc.Wait(ctx)
+			assert.NotError(t, err)
+
+			buf, err := registry.Get(proc.ID())
+			assert.NotError(t, err)
+			lines, err := collectLines(ctx, buf, 0)
+			assert.NotError(t, err)
+			assert.Equal(t, len(lines), 2)
+
+			streams := map[OutputStream]string{}
+			for _, line := range lines {
+				streams[line.Stream] = line.Line
+			}
+			check.Equal(t, streams[OutputStreamStdout], "out")
+			check.Equal(t, streams[OutputStreamStderr], "err")
+
+			_, err = registry.Get("nonexistent")
+			check.Error(t, err)
+
+			registry.Clear(ctx)
+			_, err = registry.Get(proc.ID())
+			check.Error(t, err)
+		})
+	}
+}
+
+func TestOutputBufferRegistryCapturesManagerProcesses(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+	defer cancel()
+
+	for name, test := range map[string]func(*testing.T, Manager, *OutputBufferRegistry){
+		"Commands": func(t *testing.T, mgr Manager, registry *OutputBufferRegistry) {
+			cmd := mgr.CreateCommand(ctx).Append("echo cmd")
+			assert.NotError(t, cmd.Run(ctx))
+
+			ids := cmd.GetProcIDs()
+			assert.Equal(t, len(ids), 1)
+			buf, err := registry.Get(ids[0])
+			assert.NotError(t, err)
+			lines, err := collectLines(ctx, buf, 0)
+			assert.NotError(t, err)
+			assert.Equal(t, len(lines), 1)
+			check.Equal(t, lines[0].Line, "cmd")
+		},
+		"FollowUps": func(t *testing.T, mgr Manager, registry *OutputBufferRegistry) {
+			proc, err := registry.CreateProcess(ctx, &options.Create{
+				Args:      []string{"true"},
+				OnSuccess: []*options.Create{{Args: []string{"echo", "follow-up"}}},
+			})
+			assert.NotError(t, err)
+			_, err = WaitWithFollowUps(ctx, mgr, proc)
+			assert.NotError(t, err)
+
+			followUps := proc.Info(ctx).FollowUps
+			assert.Equal(t, len(followUps), 1)
+			buf, err := registry.Get(followUps[0].ID)
+			assert.NotError(t, err)
+			lines, err := collectLines(ctx, buf, 0)
+			assert.NotError(t, err)
+			assert.Equal(t, len(lines), 1)
+			check.Equal(t, lines[0].Line, "follow-up")
+		},
+		"DiscardsRemovedProcesses": func(t *testing.T, mgr Manager, registry *OutputBufferRegistry) {
+			proc, err := mgr.CreateProcess(ctx, &options.Create{Args: []string{"echo", "out"}})
+			assert.NotError(t, err)
+			_, err = proc.Wait(ctx)
+			assert.NotError(t, err)
+
+			_, err = registry.Get(proc.ID())
+			assert.NotError(t, err)
+
+			mgr.Clear(ctx)
+			_, err = registry.Get(proc.ID())
+			check.Error(t, err)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mgr := NewManager(ManagerOptionSet(ManagerOptions{Synchronized: true}))
+			test(t, mgr, NewOutputBufferRegistry(mgr, 0))
+		})
+	}
+}
@@ -0,0 +1,298 @@
+package jasper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/tychoish/grip"
+	"github.com/tychoish/grip/message"
+	"github.com/tychoish/jasper/executor"
+	"github.com/tychoish/jasper/options"
+)
+
+// outputTriggerMonitor matches the lines of a process's output against the
+// output triggers in its options and takes their actions.
+type outputTriggerMonitor struct {
+	ctx    context.Context
+	proc   Process
+	rules  []*outputTriggerRule
+	probe  *outputProbe
+	offset atomic.Int64
+
+	mu            sync.Mutex
+	failedPattern string
+	failed        bool
+	writers       []*lineWriter
+}
+
+type outputTriggerRule struct {
+	opts     options.OutputTrigger
+	callback OutputTrigger
+	matched  atomic.Bool
+}
+
+// outputProbe records whether any line of the output of a process matches
+// one of its patterns, without taking any action on the process. It is
+// attached to the context passed to CreateProcess with withOutputProbe and
+// only applies to the first local process created with the context.
+type outputProbe struct {
+	patterns []*regexp.Regexp
+	claimed  atomic.Bool
+	matched  atomic.Bool
+}
+
+// newOutputProbe returns a probe for the patterns, which must be valid, or
+// nil if there are no patterns.
+func newOutputProbe(patterns []string) *outputProbe {
+	if len(patterns) == 0 {
+		return nil
+	}
+
+	probe := &outputProbe{}
+	for _, pattern := range patterns {
+		probe.patterns = append(probe.patterns, regexp.MustCompile(pattern))
+	}
+	return probe
+}
+
+func withOutputProbe(ctx context.Context, probe *outputProbe) context.Context {
+	if probe == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, outputMatchKey, probe)
+}
+
+// claimOutputProbe returns the probe attached to the context, unless another
+// process already claimed it.
+func claimOutputProbe(ctx context.Context) *outputProbe {
+	probe, ok := ctx.Value(outputMatchKey).(*outputProbe)
+	if !ok || !probe.claimed.CompareAndSwap(false, true) {
+		return nil
+	}
+	return probe
+}
+
+func (p *outputProbe) match(line string) {
+	if p.matched.Load() {
+		return
+	}
+	for _, re := range p.patterns {
+		if re.MatchString(line) {
+			p.matched.Store(true)
+			return
+		}
+	}
+}
+
+// hasMatched returns whether any line matched. The probe may be nil.
+func (p *outputProbe) hasMatched() bool {
+	return p != nil && p.matched.Load()
+}
+
+// newOutputTriggerMonitor returns a monitor for the output triggers in the
+// options and the output probe attached to the context, or nil if there are
+// none. The executor's output writers are wrapped to match output, so it
+// must be called before the executor is started. Processes created by the
+// triggers are created with the manager that created the process, if any.
+func newOutputTriggerMonitor(ctx context.Context, proc Process, opts *options.Create, exec executor.Executor) (*outputTriggerMonitor, error) {
+	probe := claimOutputProbe(ctx)
+	if len(opts.OutputTriggers) == 0 && probe == nil {
+		return nil, nil
+	}
+
+	m := &outputTriggerMonitor{ctx: ctx, proc: proc, probe: probe}
+	for _, trigger := range opts.OutputTriggers {
+		rule := &outputTriggerRule{opts: *trigger.Copy()}
+		if trigger.TriggerID != "" {
+			factory, ok := GetOutputTriggerFactory(OutputTriggerID(trigger.TriggerID))
+			if !ok {
+				return nil, fmt.Errorf("output trigger '%s' is not registered", trigger.TriggerID)
+			}
+			rule.callback = factory()
+		}
+		m.rules = append(m.rules, rule)
+	}
+
+	exec.SetStdout(m.wrap(OutputStreamStdout, exec.Stdout()))
+	exec.SetStderr(m.wrap(OutputStreamStderr, exec.Stderr()))
+
+	return m, nil
+}
+
+func (m *outputTriggerMonitor) wrap(stream OutputStream, w io.Writer) io.Writer {
+	tw := &lineWriter{writer: w, onLine: func(line string) { m.match(stream, line) }}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.writers = append(m.writers, tw)
+	return tw
+}
+
+// flush matches any incomplete lines of output. It must be called once the
+// process has exited, before its completion is recorded.
+func (m *outputTriggerMonitor) flush() {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	writers := m.writers
+	m.mu.Unlock()
+	for _, w := range writers {
+		w.flush()
+	}
+}
+
+// failure returns the pattern of the first output trigger that marked the
+// process as failed, if any. The monitor may be nil.
+func (m *outputTriggerMonitor) failure() (string, bool) {
+	if m == nil {
+		return "", false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.failedPattern, m.failed
+}
+
+func (m *outputTriggerMonitor) match(stream OutputStream, text string) {
+	line := LogLine{
+		Offset: m.offset.Add(1) - 1,
+		Stream: stream,
+		Line:   text,
+		Time:   time.Now(),
+	}
+
+	if m.probe != nil {
+		m.probe.match(text)
+	}
+	for _, rule := range m.rules {
+		if !rule.opts.Matches(string(stream), text) {
+			continue
+		}
+		if rule.opts.Once && !rule.matched.CompareAndSwap(false, true) {
+			continue
+		}
+		m.run(rule, line)
+	}
+}
+
+func (m *outputTriggerMonitor) run(rule *outputTriggerRule, line LogLine) {
+	if rule.opts.Fail {
+		m.mu.Lock()
+		if !m.failed {
+			m.failed = true
+			m.failedPattern = rule.opts.Pattern
+		}
+		m.mu.Unlock()
+	}
+
+	if rule.opts.Tag != "" {
+		m.proc.Tag(rule.opts.Tag)
+	}
+
+	if rule.callback != nil {
+		rule.callback(m.proc, line)
+	}
+
+	if rule.opts.Create != nil {
+		// The process is created asynchronously so that the output is
+		// not blocked by a synchronized manager.
+		go m.create(rule.opts.Pattern, rule.opts.Create.Copy())
+	}
+
+	if rule.opts.Signal != 0 {
+		if err := m.proc.Signal(m.ctx, rule.opts.Signal); err != nil {
+			grip.Debug(message.WrapError(err, message.Fields{
+				"message": "problem signaling process from output trigger",
+				"pattern": rule.opts.Pattern,
+				"id":      m.proc.ID(),
+			}))
+		}
+	}
+}
+
+func (m *outputTriggerMonitor) create(pattern string, opts *options.Create) {
+	var (
+		proc Process
+		err  error
+	)
+	if manager, ok := processManager(m.ctx); ok {
+		proc, err = manager.CreateProcess(m.ctx, opts)
+	} else {
+		proc, err = NewProcess(m.ctx, opts)
+	}
+	if err != nil {
+		grip.Warning(message.WrapError(err, message.Fields{
+			"trigger": "on-output",
+			"pattern": pattern,
+			"parent":  m.proc.ID(),
+		}))
+		return
+	}
+	proc.Tag(m.proc.ID())
+}
+
+// maxOutputLineSize is the longest line that is matched against output
+// triggers. Longer lines are split, so that output without line breaks does
+// not have to be buffered indefinitely.
+const maxOutputLineSize = 64 * 1024
+
+// lineWriter splits the output written to it into lines, which are passed
+// to onLine after the output is written to the underlying writer. Lines
+// longer than maxOutputLineSize are split into several lines.
+type lineWriter struct {
+	writer  io.Writer
+	onLine  func(string)
+	mu      sync.Mutex
+	partial []byte
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	w.partial = append(w.partial, p...)
+	var lines []string
+	for {
+		idx := bytes.IndexByte(w.partial, '\n')
+		if idx < 0 || idx > maxOutputLineSize {
+			if len(w.partial) < maxOutputLineSize {
+				break
+			}
+			lines = append(lines, string(w.partial[:maxOutputLineSize]))
+			w.partial = w.partial[maxOutputLineSize:]
+			continue
+		}
+		lines = append(lines, string(bytes.TrimSuffix(w.partial[:idx], []byte("\r"))))
+		w.partial = w.partial[idx+1:]
+	}
+	w.mu.Unlock()
+
+	// The output is written before the lines are handled so that it is
+	// recorded even if handling a line signals the process.
+	n, err := len(p), error(nil)
+	if w.writer != nil {
+		n, err = w.writer.Write(p)
+	}
+	for _, line := range lines {
+		w.onLine(line)
+	}
+
+	return n, err
+}
+
+// flush handles the incomplete line of output, if any.
+func (w *lineWriter) flush() {
+	w.mu.Lock()
+	partial := w.partial
+	w.partial = nil
+	w.mu.Unlock()
+
+	if len(partial) != 0 {
+		w.onLine(string(partial))
+	}
+}
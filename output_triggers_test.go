@@ -0,0 +1,34 @@
+package jasper
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+)
+
+func TestLineWriter(t *testing.T) {
+	var buf bytes.Buffer
+	var lines []string
+	w := &lineWriter{writer: &buf, onLine: func(line string) { lines = append(lines, line) }}
+
+	written := 0
+	for _, chunk := range []string{"one\r\ntw", "o\n", strings.Repeat("x", maxOutputLineSize+10), "\nlast"} {
+		n, err := w.Write([]byte(chunk))
+		assert.NotError(t, err)
+		check.Equal(t, n, len(chunk))
+		written += n
+	}
+	check.Equal(t, len(w.partial), len("last"))
+	w.flush()
+
+	assert.Equal(t, len(lines), 5)
+	check.Equal(t, lines[0], "one")
+	check.Equal(t, lines[1], "two")
+	check.Equal(t, len(lines[2]), maxOutputLineSize)
+	check.Equal(t, lines[3], strings.Repeat("x", 10))
+	check.Equal(t, lines[4], "last")
+	check.Equal(t, buf.Len(), written)
+}
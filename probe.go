@@ -0,0 +1,58 @@
+package jasper
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/tychoish/jasper/options"
+)
+
+// probeTCP checks that the address accepts TCP connections.
+func probeTCP(ctx context.Context, addr string, timeout time.Duration) error {
+	dialer := &net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("problem connecting to '%s': %w", addr, err)
+	}
+	return conn.Close()
+}
+
+// probeHTTP checks that a GET request to the URL returns a 2xx status.
+func probeHTTP(ctx context.Context, url string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("problem building request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("problem requesting '%s': %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("request to '%s' returned status %d", url, resp.StatusCode)
+	}
+	return nil
+}
+
+// probeCommand checks that the command exits successfully within the
+// timeout.
+func probeCommand(ctx context.Context, opts *options.Create, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	proc, err := NewProcess(ctx, opts.Copy())
+	if err != nil {
+		return fmt.Errorf("problem creating probe command: %w", err)
+	}
+	if _, err = proc.Wait(ctx); err != nil {
+		return fmt.Errorf("probe command failed: %w", err)
+	}
+	return nil
+}
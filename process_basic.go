@@ -26,10 +26,22 @@ type basicProcess struct {
 	triggers       ProcessTriggerSequence
 	signalTriggers SignalTriggerSequence
 	waitProcessed  chan struct{}
+	idle           *idleMonitor
+	outputTriggers *outputTriggerMonitor
+	readiness      *readinessMonitor
+	liveness       *livenessMonitor
+	// manager is the manager that created the process, if any. It is passed
+	// to the factories of the triggers registered by ID.
+	manager   Manager
+	followUps followUpLog
 	sync.RWMutex
 }
 
 func NewBasicProcess(ctx context.Context, opts *options.Create) (Process, error) {
+	if len(opts.Pipeline) != 0 {
+		return newPipelineProcess(ctx, opts, NewBasicProcess)
+	}
+
 	id := uuid.New().String()
 	opts.AddEnvVar(EnvironID, id)
 
@@ -58,6 +70,20 @@ func NewBasicProcess(ctx context.Context, opts *options.Create) (Process, error)
 		return nil, catcher.Resolve()
 	}
 
+	p.idle = newIdleMonitor(opts, exec)
+	p.readiness = newReadinessMonitor(opts, exec)
+	p.liveness = newLivenessMonitor(ctx, p, opts)
+	p.manager, _ = processManager(ctx)
+	p.outputTriggers, err = newOutputTriggerMonitor(ctx, p, opts, exec)
+	if err != nil {
+		catcher := &erc.Collector{}
+		catcher.Push(errors.New("problem setting up output triggers"))
+		catcher.Push(err)
+		catcher.Push(opts.Close())
+		catcher.Push(exec.Close())
+		return nil, catcher.Resolve()
+	}
+
 	if err = exec.Start(); err != nil {
 		catcher := &erc.Collector{}
 		catcher.Push(errors.New("problem starting process execution"))
@@ -77,8 +103,13 @@ func NewBasicProcess(ctx context.Context, opts *options.Create) (Process, error)
 	}
 	p.info.IsRunning = true
 	p.info.PID = exec.PID()
+	p.info.Ready = p.readiness == nil
+	p.info.Liveness = p.liveness.initialInfo()
 
 	go p.transition(ctx, deadline)
+	go p.idle.watch(p, p.waitProcessed)
+	go p.readiness.run(p.waitProcessed, p.setReady)
+	go p.liveness.run(p.waitProcessed, p.readiness, p.setLiveness)
 
 	return p, nil
 }
@@ -90,7 +121,9 @@ func (p *basicProcess) transition(_ context.Context, deadline time.Time) {
 
 	go func() {
 		defer close(waitFinished)
-		waitFinished <- p.exec.Wait()
+		err := p.exec.Wait()
+		p.outputTriggers.flush()
+		waitFinished <- err
 	}()
 
 	finish := func(err error) {
@@ -114,7 +147,17 @@ func (p *basicProcess) transition(_ context.Context, deadline time.Time) {
 				p.info.Timeout = exitCode == 1 && finishTime.After(deadline)
 			}
 		}
+		if reason := p.idle.timeoutReason(p.info.Timeout); reason != "" {
+			p.info.Timeout = true
+			p.info.TimeoutReason = reason
+		}
 		p.info.Successful = p.exec.Success()
+		if pattern, failed := p.outputTriggers.failure(); failed {
+			p.info.Successful = false
+			if p.err == nil {
+				p.err = fmt.Errorf("output matched failure pattern '%s'", pattern)
+			}
+		}
 		p.triggers.Run(p.info)
 	}
 	finish(<-waitFinished)
@@ -128,7 +171,9 @@ func (p *basicProcess) Info(_ context.Context) ProcessInfo {
 	p.RLock()
 	defer p.RUnlock()
 
-	return p.info
+	info := p.info
+	info.FollowUps = p.followUps.list()
+	return info
 }
 
 func (p *basicProcess) Complete(ctx context.Context) bool {
@@ -183,6 +228,26 @@ func (p *basicProcess) Wait(ctx context.Context) (int, error) {
 	return p.info.ExitCode, p.err
 }
 
+func (p *basicProcess) WaitReady(ctx context.Context) error {
+	return p.readiness.wait(ctx, p.waitProcessed)
+}
+
+func (p *basicProcess) setReady() {
+	p.Lock()
+	defer p.Unlock()
+	p.info.Ready = true
+}
+
+func (p *basicProcess) recordFollowUp(followUp FollowUpInfo) {
+	p.followUps.record(followUp)
+}
+
+func (p *basicProcess) setLiveness(info *LivenessInfo) {
+	p.Lock()
+	defer p.Unlock()
+	p.info.Liveness = info
+}
+
 func (p *basicProcess) RegisterTrigger(_ context.Context, trigger ProcessTrigger) error {
 	if trigger == nil {
 		return errors.New("cannot register nil trigger")
@@ -217,6 +282,14 @@ func (p *basicProcess) RegisterSignalTrigger(_ context.Context, trigger SignalTr
 	return nil
 }
 
+func (p *basicProcess) RegisterTriggerID(ctx context.Context, id ProcessTriggerID, params map[string]string) error {
+	trigger, err := makeProcessTrigger(p.manager, id, params)
+	if err != nil {
+		return err
+	}
+	return p.RegisterTrigger(ctx, trigger)
+}
+
 func (p *basicProcess) RegisterSignalTriggerID(ctx context.Context, id SignalTriggerID) error {
 	makeTrigger, ok := GetSignalTriggerFactory(id)
 	if !ok {
@@ -226,25 +299,30 @@ func (p *basicProcess) RegisterSignalTriggerID(ctx context.Context, id SignalTri
 }
 
 func (p *basicProcess) Tag(t string) {
+	p.Lock()
+	defer p.Unlock()
+
 	_, ok := p.tags[t]
 	if ok {
 		return
 	}
 
 	p.tags[t] = struct{}{}
-	p.Lock()
-	defer p.Unlock()
 	p.info.Options.Tags = append(p.info.Options.Tags, t)
 }
 
 func (p *basicProcess) ResetTags() {
-	p.tags = make(map[string]struct{})
 	p.Lock()
 	defer p.Unlock()
+
+	p.tags = make(map[string]struct{})
 	p.info.Options.Tags = []string{}
 }
 
 func (p *basicProcess) GetTags() []string {
+	p.RLock()
+	defer p.RUnlock()
+
 	out := []string{}
 	for t := range p.tags {
 		out = append(out, t)
@@ -23,6 +23,15 @@ type blockingProcess struct {
 	ops      chan func(executor.Executor)
 	complete chan struct{}
 	err      error
+	idle     *idleMonitor
+	// outputTriggers is set before the process starts.
+	outputTriggers *outputTriggerMonitor
+	readiness      *readinessMonitor
+	liveness       *livenessMonitor
+	// manager is the manager that created the process, if any. It is passed
+	// to the factories of the triggers registered by ID.
+	manager   Manager
+	followUps followUpLog
 
 	mu             sync.RWMutex
 	tags           map[string]struct{}
@@ -33,6 +42,10 @@ type blockingProcess struct {
 
 // NewBlockingProcess creates a new process
 func NewBlockingProcess(ctx context.Context, opts *options.Create) (Process, error) {
+	if len(opts.Pipeline) != 0 {
+		return newPipelineProcess(ctx, opts, NewBlockingProcess)
+	}
+
 	id := uuid.New().String()
 	opts.AddEnvVar(EnvironID, id)
 
@@ -60,6 +73,19 @@ func NewBlockingProcess(ctx context.Context, opts *options.Create) (Process, err
 		return nil, catcher.Resolve()
 	}
 
+	p.idle = newIdleMonitor(opts, exec)
+	p.readiness = newReadinessMonitor(opts, exec)
+	p.liveness = newLivenessMonitor(ctx, p, opts)
+	p.manager, _ = processManager(ctx)
+	p.outputTriggers, err = newOutputTriggerMonitor(ctx, p, opts, exec)
+	if err != nil {
+		catcher := &erc.Collector{}
+		catcher.Push(errors.New("problem setting up output triggers"))
+		catcher.Push(err)
+		catcher.Push(opts.Close())
+		return nil, catcher.Resolve()
+	}
+
 	if err = exec.Start(); err != nil {
 		catcher := &erc.Collector{}
 		catcher.Push(errors.New("problem starting command"))
@@ -74,6 +100,8 @@ func NewBlockingProcess(ctx context.Context, opts *options.Create) (Process, err
 		Options:   *opts,
 		IsRunning: true,
 		StartAt:   time.Now(),
+		Ready:     p.readiness == nil,
+		Liveness:  p.liveness.initialInfo(),
 	}
 	if opts.Remote != nil {
 		p.info.Host = opts.Remote.Host
@@ -82,6 +110,9 @@ func NewBlockingProcess(ctx context.Context, opts *options.Create) (Process, err
 	}
 
 	go p.reactor(ctx, deadline, exec)
+	go p.idle.watch(p, p.complete)
+	go p.readiness.run(p.complete, p.setReady)
+	go p.liveness.run(p.complete, p.readiness, p.setLiveness)
 
 	return p, nil
 }
@@ -99,10 +130,28 @@ func (p *blockingProcess) hasCompleteInfo() bool {
 	return p.info.Complete
 }
 
+func (p *blockingProcess) setReady() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.info.Ready = true
+}
+
+func (p *blockingProcess) setLiveness(info *LivenessInfo) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.info.Liveness = info
+}
+
 func (p *blockingProcess) getInfo() ProcessInfo {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
-	return p.info
+	info := p.info
+	info.FollowUps = p.followUps.list()
+	return info
+}
+
+func (p *blockingProcess) recordFollowUp(followUp FollowUpInfo) {
+	p.followUps.record(followUp)
 }
 
 func (p *blockingProcess) setErr(err error) {
@@ -125,7 +174,9 @@ func (p *blockingProcess) reactor(ctx context.Context, deadline time.Time, exec
 	signal := make(chan error)
 	go func() {
 		defer close(signal)
-		signal <- exec.Wait()
+		err := exec.Wait()
+		p.outputTriggers.flush()
+		signal <- err
 	}()
 	defer close(p.complete)
 
@@ -157,6 +208,19 @@ func (p *blockingProcess) reactor(ctx context.Context, deadline time.Time, exec
 						info.Timeout = exitCode == 1 && finishTime.After(deadline)
 					}
 				}
+				// The probes may succeed after the info is copied,
+				// which would otherwise be overwritten below.
+				info.Ready = info.Ready || p.readiness.succeeded()
+				if reason := p.idle.timeoutReason(info.Timeout); reason != "" {
+					info.Timeout = true
+					info.TimeoutReason = reason
+				}
+				if pattern, failed := p.outputTriggers.failure(); failed {
+					info.Successful = false
+					if err == nil {
+						err = fmt.Errorf("output matched failure pattern '%s'", pattern)
+					}
+				}
 			}()
 
 			p.mu.RLock()
@@ -335,6 +399,14 @@ func (p *blockingProcess) RegisterSignalTrigger(_ context.Context, trigger Signa
 	return nil
 }
 
+func (p *blockingProcess) RegisterTriggerID(ctx context.Context, id ProcessTriggerID, params map[string]string) error {
+	trigger, err := makeProcessTrigger(p.manager, id, params)
+	if err != nil {
+		return err
+	}
+	return p.RegisterTrigger(ctx, trigger)
+}
+
 func (p *blockingProcess) RegisterSignalTriggerID(ctx context.Context, id SignalTriggerID) error {
 	makeTrigger, ok := GetSignalTriggerFactory(id)
 	if !ok {
@@ -375,6 +447,10 @@ func (p *blockingProcess) Wait(ctx context.Context) (int, error) {
 	}
 }
 
+func (p *blockingProcess) WaitReady(ctx context.Context) error {
+	return p.readiness.wait(ctx, p.complete)
+}
+
 func (p *blockingProcess) Respawn(ctx context.Context) (Process, error) {
 	opts := p.Info(ctx).Options
 	optsCopy := opts.Copy()
@@ -0,0 +1,377 @@
+package jasper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tychoish/fun/erc"
+	"github.com/tychoish/jasper/options"
+)
+
+// pipelineProcess is a Process made up of the stages of a pipeline, where the
+// standard output of each stage is piped into the standard input of the next
+// one. It is complete once all of its stages have exited, and it only
+// succeeds if all of them succeed, like a shell pipeline with the pipefail
+// option set.
+type pipelineProcess struct {
+	id       string
+	opts     options.Create
+	makeProc ProcessConstructor
+	stages   []Process
+	// manager is the manager that created the process, if any. It is passed
+	// to the factories of the triggers registered by ID.
+	manager   Manager
+	followUps followUpLog
+
+	// stageInfo holds the info of the stages that have exited, which is
+	// recorded by the triggers of the stages since the stages cannot be
+	// queried while their triggers run.
+	stageInfo     []ProcessInfo
+	stagesExited  []bool
+	numExited     int
+	tags          map[string]struct{}
+	triggers      ProcessTriggerSequence
+	waitProcessed chan struct{}
+	sync.RWMutex
+}
+
+// newPipelineProcess creates a process that runs the pipeline of the
+// options, using the constructor to create each of its stages.
+func newPipelineProcess(ctx context.Context, opts *options.Create, makeProc ProcessConstructor) (Process, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	p := &pipelineProcess{
+		id:            uuid.New().String(),
+		opts:          *opts,
+		makeProc:      makeProc,
+		tags:          make(map[string]struct{}),
+		waitProcessed: make(chan struct{}),
+	}
+	p.manager, _ = processManager(ctx)
+	for _, t := range opts.Tags {
+		p.tags[t] = struct{}{}
+	}
+	p.triggers = append(p.triggers, makeOptionsCloseTrigger())
+
+	stageOpts := makePipelineStageOptions(opts)
+	p.stageInfo = make([]ProcessInfo, len(stageOpts))
+	p.stagesExited = make([]bool, len(stageOpts))
+
+	for idx, sopts := range stageOpts {
+		stage, err := makeProc(ctx, sopts)
+		if err != nil {
+			catcher := &erc.Collector{}
+			catcher.Push(fmt.Errorf("problem starting pipeline stage %d: %w", idx, err))
+			for _, stageOpts := range stageOpts[idx:] {
+				catcher.Push(stageOpts.Close())
+			}
+			for _, stage := range p.stages {
+				catcher.Push(stage.Signal(ctx, syscall.SIGKILL))
+			}
+			catcher.Push(opts.Close())
+			return nil, catcher.Resolve()
+		}
+		p.stages = append(p.stages, stage)
+	}
+
+	for idx, stage := range p.stages {
+		if err := stage.RegisterTrigger(ctx, func(info ProcessInfo) { p.stageExited(idx, info) }); err != nil {
+			// The stage may have already exited.
+			p.stageExited(idx, stage.Info(ctx))
+		}
+	}
+
+	return p, nil
+}
+
+// makePipelineStageOptions returns the options for each stage of the
+// pipeline in the options, with pipes between them. Only the final stage
+// writes to the loggers of the pipeline.
+func makePipelineStageOptions(opts *options.Create) []*options.Create {
+	first := opts.Copy()
+	first.Pipeline = nil
+	first.OnSuccess = nil
+	first.OnFailure = nil
+	first.OnTimeout = nil
+
+	stageOpts := []*options.Create{first}
+	for _, stage := range opts.Pipeline {
+		stageCopy := stage.Copy()
+		if stageCopy.Output.Output == nil && stageCopy.Output.Error == nil && len(stageCopy.Output.Loggers) == 0 {
+			// Stages without output of their own write to the output of the
+			// pipeline.
+			stageCopy.Output.Output = opts.Output.Output
+			stageCopy.Output.Error = opts.Output.Error
+			stageCopy.Output.SuppressOutput = opts.Output.SuppressOutput
+			stageCopy.Output.SuppressError = opts.Output.SuppressError
+			stageCopy.Output.SendErrorToOutput = opts.Output.SendErrorToOutput
+			stageCopy.Output.Loggers = opts.Output.Loggers
+		}
+		stageOpts = append(stageOpts, stageCopy)
+	}
+
+	for idx := 0; idx < len(stageOpts)-1; idx++ {
+		reader, writer := io.Pipe()
+
+		stageOpts[idx].Output.Output = writer
+		stageOpts[idx].Output.Loggers = nil
+		stageOpts[idx].Output.SuppressOutput = false
+		stageOpts[idx].Output.SendOutputToError = false
+		stageOpts[idx].RegisterCloser(writer.Close)
+
+		stageOpts[idx+1].StandardInput = reader
+		stageOpts[idx+1].StandardInputBytes = nil
+		stageOpts[idx+1].RegisterCloser(reader.Close)
+	}
+
+	return stageOpts
+}
+
+// stageExited records the info of the stage once it exits, and runs the
+// triggers of the pipeline once all of its stages have exited.
+func (p *pipelineProcess) stageExited(idx int, info ProcessInfo) {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.stagesExited[idx] {
+		return
+	}
+	p.stagesExited[idx] = true
+	p.stageInfo[idx] = info
+	p.numExited++
+
+	if p.numExited == len(p.stages) {
+		defer close(p.waitProcessed)
+		p.triggers.Run(p.makeInfo(p.stageInfo))
+	}
+}
+
+// makeInfo returns the info of the pipeline given the info of its stages.
+// The pipeline must be locked.
+func (p *pipelineProcess) makeInfo(stages []ProcessInfo) ProcessInfo {
+	info := ProcessInfo{
+		ID:         p.id,
+		Options:    p.opts,
+		Complete:   true,
+		Successful: true,
+		Ready:      true,
+		Stages:     stages,
+	}
+
+	for _, stage := range stages {
+		if info.Host == "" {
+			info.Host = stage.Host
+		}
+		if info.StartAt.IsZero() || stage.StartAt.Before(info.StartAt) {
+			info.StartAt = stage.StartAt
+		}
+		if stage.EndAt.After(info.EndAt) {
+			info.EndAt = stage.EndAt
+		}
+		info.PID = stage.PID
+		info.IsRunning = info.IsRunning || stage.IsRunning
+		info.Complete = info.Complete && stage.Complete
+		info.Ready = info.Ready && stage.Ready
+		if stage.Timeout {
+			info.Timeout = true
+			info.TimeoutReason = stage.TimeoutReason
+		}
+		if stage.Complete && !stage.Successful {
+			info.ExitCode = stage.ExitCode
+		}
+		info.Successful = info.Successful && stage.Successful
+	}
+	if !info.Complete {
+		info.Successful = false
+		info.EndAt = time.Time{}
+	}
+
+	info.Options.Tags = append([]string(nil), p.opts.Tags...)
+	info.FollowUps = p.followUps.list()
+
+	return info
+}
+
+func (p *pipelineProcess) ID() string { return p.id }
+
+func (p *pipelineProcess) Info(ctx context.Context) ProcessInfo {
+	p.RLock()
+	numExited := p.numExited
+	stages := append([]ProcessInfo(nil), p.stageInfo...)
+	exited := append([]bool(nil), p.stagesExited...)
+	p.RUnlock()
+
+	if numExited != len(p.stages) {
+		for idx, stage := range p.stages {
+			if !exited[idx] {
+				stages[idx] = stage.Info(ctx)
+			}
+		}
+	}
+
+	p.RLock()
+	defer p.RUnlock()
+	return p.makeInfo(stages)
+}
+
+func (p *pipelineProcess) Running(ctx context.Context) bool {
+	return !p.Complete(ctx)
+}
+
+func (p *pipelineProcess) Complete(_ context.Context) bool {
+	p.RLock()
+	defer p.RUnlock()
+	return p.numExited == len(p.stages)
+}
+
+// Signal sends the signal to every stage of the pipeline that has not exited.
+func (p *pipelineProcess) Signal(ctx context.Context, sig syscall.Signal) error {
+	p.RLock()
+	exited := append([]bool(nil), p.stagesExited...)
+	p.RUnlock()
+
+	catcher := &erc.Collector{}
+	signaled := false
+	for idx, stage := range p.stages {
+		if exited[idx] {
+			continue
+		}
+		if err := stage.Signal(ctx, sig); err != nil && stage.Running(ctx) {
+			catcher.Push(fmt.Errorf("problem signaling pipeline stage %d: %w", idx, err))
+		}
+		signaled = true
+	}
+	catcher.If(!signaled, errors.New("cannot signal a process that has terminated"))
+	return catcher.Resolve()
+}
+
+// Wait waits for every stage of the pipeline to exit. It returns the exit
+// code and the error of the last stage that failed, if any.
+func (p *pipelineProcess) Wait(ctx context.Context) (int, error) {
+	if !p.Complete(ctx) {
+		select {
+		case <-ctx.Done():
+			return -1, errors.New("operation canceled")
+		case <-p.waitProcessed:
+		}
+	}
+
+	var (
+		exitCode int
+		err      error
+	)
+	for idx, stage := range p.stages {
+		if stageExitCode, stageErr := stage.Wait(ctx); stageErr != nil {
+			exitCode = stageExitCode
+			err = fmt.Errorf("pipeline stage %d: %w", idx, stageErr)
+		}
+	}
+	return exitCode, err
+}
+
+func (p *pipelineProcess) WaitReady(ctx context.Context) error {
+	for idx, stage := range p.stages {
+		if err := stage.WaitReady(ctx); err != nil {
+			return fmt.Errorf("pipeline stage %d: %w", idx, err)
+		}
+	}
+	return nil
+}
+
+func (p *pipelineProcess) Respawn(ctx context.Context) (Process, error) {
+	return newPipelineProcess(ctx, p.opts.Copy(), p.makeProc)
+}
+
+func (p *pipelineProcess) RegisterTrigger(_ context.Context, trigger ProcessTrigger) error {
+	if trigger == nil {
+		return errors.New("cannot register nil trigger")
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	if p.numExited == len(p.stages) {
+		return errors.New("cannot register trigger after process exits")
+	}
+
+	p.triggers = append(p.triggers, trigger)
+
+	return nil
+}
+
+func (p *pipelineProcess) RegisterTriggerID(ctx context.Context, id ProcessTriggerID, params map[string]string) error {
+	trigger, err := makeProcessTrigger(p.manager, id, params)
+	if err != nil {
+		return err
+	}
+	return p.RegisterTrigger(ctx, trigger)
+}
+
+// RegisterSignalTrigger registers the signal trigger with every stage of the
+// pipeline.
+func (p *pipelineProcess) RegisterSignalTrigger(ctx context.Context, trigger SignalTrigger) error {
+	if trigger == nil {
+		return errors.New("cannot register nil trigger")
+	}
+
+	catcher := &erc.Collector{}
+	for idx, stage := range p.stages {
+		if err := stage.RegisterSignalTrigger(ctx, trigger); err != nil {
+			catcher.Push(fmt.Errorf("problem registering signal trigger with pipeline stage %d: %w", idx, err))
+		}
+	}
+	return catcher.Resolve()
+}
+
+func (p *pipelineProcess) RegisterSignalTriggerID(ctx context.Context, id SignalTriggerID) error {
+	makeTrigger, ok := GetSignalTriggerFactory(id)
+	if !ok {
+		return fmt.Errorf("could not find signal trigger with id '%s'", id)
+	}
+	return p.RegisterSignalTrigger(ctx, makeTrigger())
+}
+
+func (p *pipelineProcess) recordFollowUp(followUp FollowUpInfo) {
+	p.followUps.record(followUp)
+}
+
+func (p *pipelineProcess) Tag(t string) {
+	p.Lock()
+	defer p.Unlock()
+
+	_, ok := p.tags[t]
+	if ok {
+		return
+	}
+
+	p.tags[t] = struct{}{}
+	p.opts.Tags = append(p.opts.Tags, t)
+}
+
+func (p *pipelineProcess) ResetTags() {
+	p.Lock()
+	defer p.Unlock()
+
+	p.tags = make(map[string]struct{})
+	p.opts.Tags = []string{}
+}
+
+func (p *pipelineProcess) GetTags() []string {
+	p.RLock()
+	defer p.RUnlock()
+
+	out := []string{}
+	for t := range p.tags {
+		out = append(out, t)
+	}
+	return out
+}
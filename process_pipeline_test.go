@@ -0,0 +1,269 @@
+package jasper
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"slices"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/testutil"
+)
+
+func TestPipelineProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires POSIX utilities")
+	}
+
+	tests := map[string]func(ctx context.Context, t *testing.T, manager Manager, impl string){
+		"PipesOutputBetweenStages": func(ctx context.Context, t *testing.T, manager Manager, impl string) {
+			var buf bytes.Buffer
+			opts := &options.Create{
+				Args:           []string{"printf", `b\na\nc\n`},
+				Implementation: impl,
+				Output:         options.Output{Output: &buf},
+				Pipeline: []*options.Create{
+					{Args: []string{"sort"}},
+					{Args: []string{"head", "-n", "1"}},
+				},
+			}
+			proc, err := manager.CreateProcess(ctx, opts)
+			assert.NotError(t, err)
+
+			exitCode, err := proc.Wait(ctx)
+			assert.NotError(t, err)
+			check.Zero(t, exitCode)
+			check.Equal(t, strings.TrimSpace(buf.String()), "a")
+		},
+		"LoggersReceiveOnlyFinalOutput": func(ctx context.Context, t *testing.T, manager Manager, impl string) {
+			logger, err := NewInMemoryLogger(100)
+			assert.NotError(t, err)
+			opts := &options.Create{
+				Args:           []string{"printf", `b\na\nc\n`},
+				Implementation: impl,
+				Output:         options.Output{Loggers: []*options.LoggerConfig{logger}},
+				Pipeline: []*options.Create{
+					{Args: []string{"sort"}},
+					{Args: []string{"head", "-n", "1"}},
+				},
+			}
+			proc, err := manager.CreateProcess(ctx, opts)
+			assert.NotError(t, err)
+
+			exitCode, err := proc.Wait(ctx)
+			assert.NotError(t, err)
+			check.Zero(t, exitCode)
+
+			logs, err := GetInMemoryLogStream(ctx, proc, 100)
+			assert.NotError(t, err)
+			assert.Equal(t, len(logs), 1)
+			check.Equal(t, strings.TrimSpace(logs[0]), "a")
+		},
+		"ReportsInfoForEachStage": func(ctx context.Context, t *testing.T, manager Manager, impl string) {
+			opts := testutil.TrueCreateOpts()
+			opts.Implementation = impl
+			opts.Pipeline = []*options.Create{{Args: []string{"cat"}}, {Args: []string{"cat"}}}
+			proc, err := manager.CreateProcess(ctx, opts)
+			assert.NotError(t, err)
+
+			_, err = proc.Wait(ctx)
+			assert.NotError(t, err)
+
+			info := proc.Info(ctx)
+			check.True(t, info.Complete)
+			check.True(t, info.Successful)
+			assert.Equal(t, len(info.Stages), 3)
+			check.EqualItems(t, info.Stages[0].Options.Args, []string{"true"})
+			for _, stage := range info.Stages {
+				check.True(t, stage.Complete)
+				check.True(t, stage.Successful)
+				check.NotZero(t, stage.PID)
+				check.True(t, stage.ID != info.ID)
+			}
+			check.Equal(t, info.PID, info.Stages[2].PID)
+		},
+		"FailsIfAnyStageFails": func(ctx context.Context, t *testing.T, manager Manager, impl string) {
+			opts := &options.Create{
+				Args:           []string{"sh", "-c", "echo hi; exit 3"},
+				Implementation: impl,
+				Pipeline:       []*options.Create{{Args: []string{"cat"}}},
+			}
+			proc, err := manager.CreateProcess(ctx, opts)
+			assert.NotError(t, err)
+
+			exitCode, err := proc.Wait(ctx)
+			assert.Error(t, err)
+			check.Equal(t, exitCode, 3)
+
+			info := proc.Info(ctx)
+			check.True(t, info.Complete)
+			check.True(t, !info.Successful)
+			check.Equal(t, info.ExitCode, 3)
+			assert.Equal(t, len(info.Stages), 2)
+			check.True(t, !info.Stages[0].Successful)
+			check.True(t, info.Stages[1].Successful)
+		},
+		"RunsTriggersOnceAllStagesExit": func(ctx context.Context, t *testing.T, manager Manager, impl string) {
+			opts := testutil.FalseCreateOpts()
+			opts.Implementation = impl
+			opts.Pipeline = []*options.Create{{Args: []string{"cat"}}}
+			proc, err := manager.CreateProcess(ctx, opts)
+			assert.NotError(t, err)
+
+			infos := make(chan ProcessInfo, 2)
+			if err := proc.RegisterTrigger(ctx, func(info ProcessInfo) { infos <- info }); err != nil {
+				check.True(t, proc.Complete(ctx))
+				t.Skip("pipeline exited before the trigger was registered")
+			}
+
+			_, err = proc.Wait(ctx)
+			assert.Error(t, err)
+
+			info := <-infos
+			check.True(t, info.Complete)
+			check.True(t, !info.Successful)
+			check.Equal(t, len(info.Stages), 2)
+			check.Equal(t, len(infos), 0)
+		},
+		"SignalsEveryStage": func(ctx context.Context, t *testing.T, manager Manager, impl string) {
+			opts := testutil.SleepCreateOpts(10)
+			opts.Implementation = impl
+			opts.Pipeline = []*options.Create{{Args: []string{"sleep", "10"}}}
+			proc, err := manager.CreateProcess(ctx, opts)
+			assert.NotError(t, err)
+			check.True(t, proc.Running(ctx))
+
+			assert.NotError(t, proc.Signal(ctx, syscall.SIGKILL))
+			_, err = proc.Wait(ctx)
+			assert.Error(t, err)
+
+			info := proc.Info(ctx)
+			check.True(t, info.Complete)
+			for _, stage := range info.Stages {
+				check.True(t, !stage.Successful)
+			}
+			check.Error(t, proc.Signal(ctx, syscall.SIGKILL))
+		},
+		"TagsArePreserved": func(ctx context.Context, t *testing.T, manager Manager, impl string) {
+			opts := testutil.TrueCreateOpts()
+			opts.Implementation = impl
+			opts.Tags = []string{"pipeline"}
+			opts.Pipeline = []*options.Create{{Args: []string{"cat"}}}
+			proc, err := manager.CreateProcess(ctx, opts)
+			assert.NotError(t, err)
+
+			proc.Tag("extra")
+			check.EqualItems(t, slices.Sorted(slices.Values(proc.GetTags())), []string{"extra", "pipeline"})
+			check.EqualItems(t, proc.Info(ctx).Options.Tags, []string{"pipeline", "extra"})
+
+			procs, err := manager.Group(ctx, "pipeline")
+			assert.NotError(t, err)
+			assert.Equal(t, len(procs), 1)
+			check.Equal(t, procs[0].ID(), proc.ID())
+		},
+		"RejectsInvalidStages": func(ctx context.Context, t *testing.T, manager Manager, impl string) {
+			for name, stage := range map[string]*options.Create{
+				"Missing":       nil,
+				"Empty":         {},
+				"StandardInput": {Args: []string{"cat"}, StandardInputBytes: []byte("hi")},
+				"Nested":        {Args: []string{"cat"}, Pipeline: []*options.Create{{Args: []string{"cat"}}}},
+			} {
+				t.Run(name, func(t *testing.T) {
+					opts := testutil.TrueCreateOpts()
+					opts.Implementation = impl
+					opts.Pipeline = []*options.Create{stage}
+					_, err := manager.CreateProcess(ctx, opts)
+					check.Error(t, err)
+				})
+			}
+		},
+	}
+
+	for implName, impl := range map[string]string{
+		"Basic":    options.ProcessImplementationBasic,
+		"Blocking": options.ProcessImplementationBlocking,
+	} {
+		t.Run(implName, func(t *testing.T) {
+			for name, test := range tests {
+				t.Run(name, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), testutil.ManagerTestTimeout)
+					defer cancel()
+
+					manager := NewManager(ManagerOptionSetSynchronized())
+					defer func() { check.NotError(t, manager.Close(ctx)) }()
+
+					test(ctx, t, manager, impl)
+				})
+			}
+		})
+	}
+}
+
+func TestCommandPipeline(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires POSIX utilities")
+	}
+
+	run := func(cmd *Command, ctx context.Context) error { return cmd.Run(ctx) }
+	runParallel := func(cmd *Command, ctx context.Context) error { return cmd.RunParallel(ctx) }
+
+	for name, testCase := range map[string]func(context.Context, *testing.T, *Command){
+		"PipesOutputBetweenStages": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.AppendPipeline(`printf 'b\na\nc\n'`, "sort", "head -n 1")
+			output := verifyCommandAndGetOutput(ctx, t, cmd, run, true)
+			check.Equal(t, strings.TrimSpace(output), "a")
+
+			results := cmd.Results()
+			assert.Equal(t, len(results), 1)
+			check.True(t, results[0].Successful())
+			check.Equal(t, len(cmd.GetProcIDs()), 1)
+		},
+		"FailsIfAnyStageFails": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Pipeline([]string{"false"}, []string{"cat"})
+			assert.Error(t, cmd.Run(ctx))
+			check.Equal(t, cmd.Results()[0].Attempts[0].ExitCode, 1)
+		},
+		"MixesWithOtherCommands": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Append("echo first").
+				Pipeline([]string{"echo", "second"}, []string{"tr", "a-z", "A-Z"}).
+				Append("echo third")
+			output := verifyCommandAndGetOutput(ctx, t, cmd, run, true)
+			checkOutput(t, true, output, "first", "SECOND", "third")
+			checkOutput(t, false, output, "second")
+		},
+		"RunParallelKeepsPipelinesWithTheirCommands": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Append("echo first").
+				Pipeline([]string{"echo", "second"}, []string{"tr", "a-z", "A-Z"})
+			output := verifyCommandAndGetOutput(ctx, t, cmd, runParallel, true)
+			checkOutput(t, true, output, "first", "SECOND")
+			checkOutput(t, false, output, "FIRST", "second")
+		},
+		"ExportsPipelineStages": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Sudo(true).Pipeline([]string{"ls"}, []string{"wc", "-l"}).Add([]string{"true"})
+			opts, err := cmd.ExportCreateOptions()
+			assert.NotError(t, err)
+			assert.Equal(t, len(opts), 2)
+			assert.Equal(t, len(opts[0].Pipeline), 1)
+			check.EqualItems(t, opts[0].Pipeline[0].Args, []string{"sudo", "wc", "-l"})
+			check.Equal(t, len(opts[1].Pipeline), 0)
+			check.True(t, strings.Contains(cmd.String(), "sudo ls | sudo wc -l"))
+		},
+		"InvalidPipelineErrors": func(ctx context.Context, t *testing.T, cmd *Command) {
+			cmd.Pipeline([]string{"true"}, []string{})
+			assert.Error(t, cmd.Run(ctx))
+			check.Equal(t, len(cmd.GetProcIDs()), 0)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+			defer cancel()
+
+			testCase(ctx, t, NewCommand())
+		})
+	}
+}
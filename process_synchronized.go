@@ -90,6 +90,13 @@ func (p *synchronizedProcess) RegisterSignalTriggerID(ctx context.Context, trigg
 	return p.proc.RegisterSignalTriggerID(ctx, trigger)
 }
 
+func (p *synchronizedProcess) RegisterTriggerID(ctx context.Context, id ProcessTriggerID, params map[string]string) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return p.proc.RegisterTriggerID(ctx, id, params)
+}
+
 func (p *synchronizedProcess) Wait(ctx context.Context) (int, error) {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
@@ -98,6 +105,20 @@ func (p *synchronizedProcess) Wait(ctx context.Context) (int, error) {
 	return exitCode, err
 }
 
+// WaitReady does not hold the lock while waiting, so that the process can be
+// used while it starts up.
+func (p *synchronizedProcess) WaitReady(ctx context.Context) error {
+	return p.proc.WaitReady(ctx)
+}
+
+// recordFollowUp does not hold the lock, since it is called by the triggers
+// of the process, which run while Wait holds the lock.
+func (p *synchronizedProcess) recordFollowUp(followUp FollowUpInfo) {
+	if recorder, ok := p.proc.(followUpRecorder); ok {
+		recorder.recordFollowUp(followUp)
+	}
+}
+
 func (p *synchronizedProcess) Respawn(ctx context.Context) (Process, error) {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
@@ -0,0 +1,152 @@
+package jasper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/tychoish/jasper/executor"
+	"github.com/tychoish/jasper/options"
+)
+
+// readinessMonitor runs the readiness probes of a process until they
+// succeed or the process exits.
+type readinessMonitor struct {
+	opts          *options.Readiness
+	ready         chan struct{}
+	isReady       atomic.Bool
+	outputMatched chan struct{}
+	matchOnce     sync.Once
+}
+
+// newReadinessMonitor returns a monitor for the readiness probes in the
+// options, or nil if there are none. If the output of the process is probed,
+// the executor's output writers are wrapped, so it must be called before the
+// executor is started.
+func newReadinessMonitor(opts *options.Create, exec executor.Executor) *readinessMonitor {
+	if opts.Readiness == nil {
+		return nil
+	}
+
+	m := &readinessMonitor{
+		opts:          opts.Readiness,
+		ready:         make(chan struct{}),
+		outputMatched: make(chan struct{}),
+	}
+
+	if m.opts.OutputPattern != "" {
+		onLine := func(line string) {
+			if m.opts.MatchesOutput(line) {
+				m.matchOnce.Do(func() { close(m.outputMatched) })
+			}
+		}
+		exec.SetStdout(&lineWriter{writer: exec.Stdout(), onLine: onLine})
+		exec.SetStderr(&lineWriter{writer: exec.Stderr(), onLine: onLine})
+	}
+
+	return m
+}
+
+// run probes the process at the configured interval until all of the probes
+// succeed, at which point setReady is called, or until done is closed. The
+// monitor may be nil.
+func (m *readinessMonitor) run(done <-chan struct{}, setReady func()) {
+	if m == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// The output is probed as soon as it matches rather than at the next
+	// interval, but only once, since the channel stays closed.
+	outputMatched := m.outputMatched
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-outputMatched:
+			outputMatched = nil
+		case <-timer.C:
+		}
+
+		if m.probe(ctx) {
+			setReady()
+			m.isReady.Store(true)
+			close(m.ready)
+			return
+		}
+		timer.Reset(m.opts.Interval)
+	}
+}
+
+// probe returns whether all of the probes succeed.
+func (m *readinessMonitor) probe(ctx context.Context) bool {
+	if m.opts.OutputPattern != "" {
+		select {
+		case <-m.outputMatched:
+		default:
+			return false
+		}
+	}
+
+	if m.opts.File != "" {
+		if _, err := os.Stat(m.opts.File); err != nil {
+			return false
+		}
+	}
+
+	if m.opts.TCP != "" && probeTCP(ctx, m.opts.TCP, m.opts.Timeout) != nil {
+		return false
+	}
+
+	if m.opts.HTTP != "" && probeHTTP(ctx, m.opts.HTTP, m.opts.Timeout) != nil {
+		return false
+	}
+
+	if m.opts.Command != nil && probeCommand(ctx, m.opts.Command, m.opts.Timeout) != nil {
+		return false
+	}
+
+	return true
+}
+
+// succeeded returns whether the probes have succeeded. The monitor may be nil.
+func (m *readinessMonitor) succeeded() bool {
+	return m != nil && m.isReady.Load()
+}
+
+// wait blocks until the probes succeed, the process exits or the context is
+// canceled. The monitor may be nil, in which case the process is already
+// ready.
+func (m *readinessMonitor) wait(ctx context.Context, exited <-chan struct{}) error {
+	if m == nil {
+		return nil
+	}
+
+	select {
+	case <-m.ready:
+		return nil
+	case <-exited:
+		// The probes may have succeeded just before the process exited.
+		if m.succeeded() {
+			return nil
+		}
+		return errors.New("process exited before it was ready")
+	case <-ctx.Done():
+		return fmt.Errorf("operation canceled: %w", ctx.Err())
+	}
+}
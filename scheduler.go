@@ -0,0 +1,360 @@
+package jasper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tychoish/jasper/options"
+)
+
+// scheduleReplaceGracePeriod is how long the process from the previous run
+// of a schedule with the replace overlap policy has to exit after it is
+// terminated before it is killed.
+const scheduleReplaceGracePeriod = 10 * time.Second
+
+// ScheduleRun records a single run of a schedule.
+type ScheduleRun struct {
+	// ScheduledAt is when the run was due, not including jitter.
+	ScheduledAt time.Time `json:"scheduled_at" bson:"scheduled_at"`
+	// StartedAt is when the run's process was created.
+	StartedAt time.Time `json:"started_at,omitempty" bson:"started_at,omitempty"`
+	ProcessID string    `json:"process_id,omitempty" bson:"process_id,omitempty"`
+	// Skipped is true if the run did not start because the previous run's
+	// process was still running.
+	Skipped    bool `json:"skipped,omitempty" bson:"skipped,omitempty"`
+	Complete   bool `json:"complete,omitempty" bson:"complete,omitempty"`
+	Successful bool `json:"successful,omitempty" bson:"successful,omitempty"`
+	ExitCode   int  `json:"exit_code,omitempty" bson:"exit_code,omitempty"`
+	// Error is set if the process could not be created.
+	Error string `json:"error,omitempty" bson:"error,omitempty"`
+}
+
+// ScheduleInfo describes the state of a schedule.
+type ScheduleInfo struct {
+	Schedule options.Schedule `json:"schedule" bson:"schedule"`
+	// NextRun is when the next run will start, including jitter.
+	NextRun time.Time `json:"next_run,omitempty" bson:"next_run,omitempty"`
+	// RunningProcessID is the ID of the process from the most recent run
+	// if it is still running.
+	RunningProcessID string `json:"running_process_id,omitempty" bson:"running_process_id,omitempty"`
+	// Queued is the number of runs waiting for the running process to exit.
+	Queued int `json:"queued,omitempty" bson:"queued,omitempty"`
+	// History are the most recent runs, oldest first.
+	History []ScheduleRun `json:"history" bson:"history"`
+}
+
+// Scheduler creates processes with a manager on cron schedules or at fixed
+// intervals. Each schedule has at most one running process at a time, and
+// its overlap policy determines what happens when a run is due while the
+// previous run's process is still running. Processes created by a schedule
+// are tagged with the schedule's ID.
+//
+// Schedules are only kept in memory, so callers that want them to persist
+// across restarts must record them and add them again.
+type Scheduler struct {
+	manager Manager
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+
+	mu        sync.Mutex
+	schedules map[string]*scheduleState
+}
+
+type scheduleState struct {
+	opts    options.Schedule
+	ctx     context.Context
+	cancel  context.CancelFunc
+	next    time.Time
+	running Process
+	// runningID is the ID of the running process, which is cached because
+	// synchronized processes block calls to ID while they are being waited
+	// on.
+	runningID string
+	queued    []time.Time
+	history   []ScheduleRun
+}
+
+// NewScheduler returns a scheduler that creates processes with the manager.
+func NewScheduler(m Manager) *Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Scheduler{
+		manager:   m,
+		ctx:       ctx,
+		cancel:    cancel,
+		schedules: map[string]*scheduleState{},
+	}
+}
+
+// Add validates the schedule and starts it. It returns an error if a
+// schedule with the same ID already exists or the scheduler is closed.
+func (s *Scheduler) Add(opts options.Schedule) error {
+	opts = *opts.Copy()
+	if err := opts.Validate(); err != nil {
+		return fmt.Errorf("invalid schedule: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ctx.Err() != nil {
+		return errors.New("scheduler is closed")
+	}
+	if _, ok := s.schedules[opts.ID]; ok {
+		return fmt.Errorf("schedule '%s' already exists", opts.ID)
+	}
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	st := &scheduleState{
+		opts:    opts,
+		ctx:     ctx,
+		cancel:  cancel,
+		history: []ScheduleRun{},
+	}
+	s.schedules[opts.ID] = st
+
+	s.wg.Add(1)
+	go s.loop(st)
+
+	return nil
+}
+
+// Remove stops the schedule with the given ID and discards its queued runs.
+// The process from its most recent run, if any, is left running.
+func (s *Scheduler) Remove(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.schedules[id]
+	if !ok {
+		return fmt.Errorf("schedule '%s' does not exist", id)
+	}
+	st.cancel()
+	delete(s.schedules, id)
+
+	return nil
+}
+
+// Get returns the state of the schedule with the given ID.
+func (s *Scheduler) Get(id string) (ScheduleInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.schedules[id]
+	if !ok {
+		return ScheduleInfo{}, fmt.Errorf("schedule '%s' does not exist", id)
+	}
+	return st.info(), nil
+}
+
+// List returns the state of all schedules, sorted by ID.
+func (s *Scheduler) List() []ScheduleInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	infos := make([]ScheduleInfo, 0, len(s.schedules))
+	for _, st := range s.schedules {
+		infos = append(infos, st.info())
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Schedule.ID < infos[j].Schedule.ID })
+
+	return infos
+}
+
+// Close stops all schedules and waits for them to return. Processes that
+// are still running are left running.
+func (s *Scheduler) Close() error {
+	s.mu.Lock()
+	s.cancel()
+	s.schedules = map[string]*scheduleState{}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	return nil
+}
+
+func (s *Scheduler) loop(st *scheduleState) {
+	defer s.wg.Done()
+
+	last := time.Now()
+	for {
+		due := st.opts.Next(last)
+		if due.IsZero() {
+			return
+		}
+		start := due
+		if st.opts.Jitter > 0 {
+			start = start.Add(time.Duration(rand.Int63n(int64(st.opts.Jitter))))
+		}
+
+		s.mu.Lock()
+		st.next = start
+		s.mu.Unlock()
+
+		timer := time.NewTimer(time.Until(start))
+		select {
+		case <-st.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.trigger(st, due)
+
+		// Runs that were missed while this run was starting are
+		// not made up.
+		last = due
+		if now := time.Now(); st.opts.Next(last).Before(now) {
+			last = now
+		}
+	}
+}
+
+// trigger starts a run of the schedule, applying its overlap policy if the
+// previous run's process is still running.
+func (s *Scheduler) trigger(st *scheduleState, due time.Time) {
+	s.mu.Lock()
+	prev := st.running
+	if prev != nil {
+		switch st.opts.Overlap {
+		case options.ScheduleOverlapSkip:
+			st.record(ScheduleRun{ScheduledAt: due, Skipped: true})
+			s.mu.Unlock()
+			return
+		case options.ScheduleOverlapQueue:
+			st.queued = append(st.queued, due)
+			s.mu.Unlock()
+			return
+		}
+	}
+	s.mu.Unlock()
+
+	if prev != nil {
+		if err := s.replace(st.ctx, prev); err != nil {
+			s.mu.Lock()
+			st.record(ScheduleRun{ScheduledAt: due, Error: err.Error()})
+			s.mu.Unlock()
+			return
+		}
+	}
+
+	s.start(st, due)
+}
+
+// replace terminates the process, killing it if it does not exit within the
+// grace period.
+func (s *Scheduler) replace(ctx context.Context, proc Process) error {
+	if err := Terminate(ctx, proc); err != nil && !proc.Complete(ctx) {
+		return fmt.Errorf("problem terminating process '%s': %w", proc.ID(), err)
+	}
+
+	graceCtx, cancel := context.WithTimeout(ctx, scheduleReplaceGracePeriod)
+	defer cancel()
+	_, _ = proc.Wait(graceCtx)
+	if proc.Complete(ctx) {
+		return nil
+	}
+
+	if err := Kill(ctx, proc); err != nil {
+		return fmt.Errorf("problem killing process '%s': %w", proc.ID(), err)
+	}
+	_, _ = proc.Wait(ctx)
+	return nil
+}
+
+// start creates the run's process. The process is not bound to the
+// scheduler's context so that removing the schedule or closing the scheduler
+// does not kill it.
+func (s *Scheduler) start(st *scheduleState, due time.Time) {
+	if st.ctx.Err() != nil {
+		return
+	}
+
+	opts := st.opts.Create.Copy()
+	opts.Tags = append(opts.Tags, st.opts.ID)
+	run := ScheduleRun{ScheduledAt: due, StartedAt: time.Now()}
+
+	proc, err := s.manager.CreateProcess(context.Background(), opts)
+	if err == nil {
+		run.ProcessID = proc.ID()
+	}
+
+	s.mu.Lock()
+	if err != nil {
+		run.Error = err.Error()
+		st.record(run)
+		s.mu.Unlock()
+		return
+	}
+	st.running = proc
+	st.runningID = run.ProcessID
+	st.record(run)
+	s.mu.Unlock()
+
+	// The completion is recorded in a trigger rather than by waiting on the
+	// process, since waiting on a synchronized process blocks all other
+	// calls to it.
+	trigger := func(info ProcessInfo) { go s.complete(st, info) }
+	if err := proc.RegisterTrigger(s.ctx, trigger); err != nil {
+		s.complete(st, proc.Info(s.ctx))
+	}
+}
+
+// complete records the result of the process and starts the next queued run,
+// if any.
+func (s *Scheduler) complete(st *scheduleState, info ProcessInfo) {
+	if s.ctx.Err() != nil {
+		return
+	}
+
+	s.mu.Lock()
+	for idx := range st.history {
+		if st.history[idx].ProcessID == info.ID {
+			st.history[idx].Complete = true
+			st.history[idx].Successful = info.Successful
+			st.history[idx].ExitCode = info.ExitCode
+		}
+	}
+
+	var due time.Time
+	if st.runningID == info.ID {
+		st.running = nil
+		st.runningID = ""
+		if len(st.queued) != 0 && st.ctx.Err() == nil {
+			due = st.queued[0]
+			st.queued = st.queued[1:]
+		}
+	}
+	s.mu.Unlock()
+
+	if !due.IsZero() {
+		s.start(st, due)
+	}
+}
+
+// record adds the run to the schedule's history, discarding the oldest runs
+// beyond the history size. The caller must hold the scheduler's lock.
+func (st *scheduleState) record(run ScheduleRun) {
+	st.history = append(st.history, run)
+	if extra := len(st.history) - st.opts.HistorySize; extra > 0 {
+		st.history = append([]ScheduleRun{}, st.history[extra:]...)
+	}
+}
+
+// info returns the state of the schedule. The caller must hold the
+// scheduler's lock.
+func (st *scheduleState) info() ScheduleInfo {
+	info := ScheduleInfo{
+		Schedule:         *st.opts.Copy(),
+		NextRun:          st.next,
+		Queued:           len(st.queued),
+		RunningProcessID: st.runningID,
+		History:          append([]ScheduleRun{}, st.history...),
+	}
+	return info
+}
@@ -0,0 +1,182 @@
+package jasper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/testutil"
+)
+
+func waitForSchedule(ctx context.Context, t *testing.T, s *Scheduler, id string, fn func(ScheduleInfo) bool) ScheduleInfo {
+	t.Helper()
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		info, err := s.Get(id)
+		assert.NotError(t, err)
+		if fn(info) {
+			return info
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatalf("schedule '%s' did not reach the expected state: %+v", id, info)
+		case <-ticker.C:
+		}
+	}
+}
+
+func TestScheduler(t *testing.T) {
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, mngr Manager, s *Scheduler){
+		"RunsAtInterval": func(ctx context.Context, t *testing.T, mngr Manager, s *Scheduler) {
+			assert.NotError(t, s.Add(options.Schedule{ID: "foo", Create: testutil.TrueCreateOpts(), Interval: 50 * time.Millisecond}))
+
+			info := waitForSchedule(ctx, t, s, "foo", func(info ScheduleInfo) bool {
+				return len(info.History) >= 2 && info.History[0].Complete
+			})
+			check.True(t, info.History[0].Successful)
+			check.True(t, !info.NextRun.IsZero())
+
+			procs, err := mngr.Group(ctx, "foo")
+			assert.NotError(t, err)
+			check.True(t, len(procs) >= 2)
+		},
+		"RecordsCreationFailures": func(ctx context.Context, t *testing.T, mngr Manager, s *Scheduler) {
+			opts := testutil.TrueCreateOpts()
+			opts.Args = []string{"jasper-scheduler-test-command-does-not-exist"}
+			assert.NotError(t, s.Add(options.Schedule{ID: "foo", Create: opts, Interval: 50 * time.Millisecond}))
+
+			info := waitForSchedule(ctx, t, s, "foo", func(info ScheduleInfo) bool { return len(info.History) >= 1 })
+			check.True(t, info.History[0].Error != "")
+			check.Equal(t, info.History[0].ProcessID, "")
+		},
+		"LimitsHistory": func(ctx context.Context, t *testing.T, mngr Manager, s *Scheduler) {
+			assert.NotError(t, s.Add(options.Schedule{ID: "foo", Create: testutil.TrueCreateOpts(), Interval: 10 * time.Millisecond, HistorySize: 2}))
+
+			waitForSchedule(ctx, t, s, "foo", func(info ScheduleInfo) bool { return len(info.History) == 2 })
+			time.Sleep(100 * time.Millisecond)
+			info, err := s.Get("foo")
+			assert.NotError(t, err)
+			check.Equal(t, len(info.History), 2)
+		},
+		"SkipsOverlappingRuns": func(ctx context.Context, t *testing.T, mngr Manager, s *Scheduler) {
+			assert.NotError(t, s.Add(options.Schedule{
+				ID:       "foo",
+				Create:   testutil.SleepCreateOpts(10),
+				Interval: 50 * time.Millisecond,
+				Overlap:  options.ScheduleOverlapSkip,
+			}))
+
+			info := waitForSchedule(ctx, t, s, "foo", func(info ScheduleInfo) bool { return len(info.History) >= 3 })
+			check.True(t, info.RunningProcessID != "")
+			check.Equal(t, info.History[0].ProcessID, info.RunningProcessID)
+			for _, run := range info.History[1:] {
+				check.True(t, run.Skipped)
+			}
+
+			assert.NotError(t, s.Remove("foo"))
+			proc, err := mngr.Get(ctx, info.RunningProcessID)
+			assert.NotError(t, err)
+			check.True(t, proc.Running(ctx))
+			check.NotError(t, Kill(ctx, proc))
+		},
+		"QueuesOverlappingRuns": func(ctx context.Context, t *testing.T, mngr Manager, s *Scheduler) {
+			assert.NotError(t, s.Add(options.Schedule{
+				ID:       "foo",
+				Create:   testutil.SleepCreateOpts(1),
+				Interval: 200 * time.Millisecond,
+				Overlap:  options.ScheduleOverlapQueue,
+			}))
+
+			info := waitForSchedule(ctx, t, s, "foo", func(info ScheduleInfo) bool { return info.Queued >= 2 })
+			check.Equal(t, len(info.History), 1)
+
+			info = waitForSchedule(ctx, t, s, "foo", func(info ScheduleInfo) bool { return len(info.History) >= 2 })
+			check.True(t, info.History[0].Complete)
+			check.True(t, info.History[1].ProcessID != "")
+			check.True(t, !info.History[1].Skipped)
+		},
+		"ReplacesOverlappingRuns": func(ctx context.Context, t *testing.T, mngr Manager, s *Scheduler) {
+			assert.NotError(t, s.Add(options.Schedule{
+				ID:       "foo",
+				Create:   testutil.SleepCreateOpts(10),
+				Interval: 100 * time.Millisecond,
+				Overlap:  options.ScheduleOverlapReplace,
+			}))
+
+			info := waitForSchedule(ctx, t, s, "foo", func(info ScheduleInfo) bool {
+				return len(info.History) >= 2 && info.History[0].Complete
+			})
+			check.True(t, !info.History[0].Successful)
+			check.True(t, info.History[1].ProcessID != "")
+
+			procs, err := mngr.List(ctx, options.Running)
+			assert.NotError(t, err)
+			check.True(t, len(procs) <= 1)
+			assert.NotError(t, s.Remove("foo"))
+			check.NotError(t, KillAll(ctx, procs))
+		},
+		"UsesCronExpression": func(ctx context.Context, t *testing.T, mngr Manager, s *Scheduler) {
+			assert.NotError(t, s.Add(options.Schedule{ID: "foo", Create: testutil.TrueCreateOpts(), Cron: "@yearly"}))
+
+			info := waitForSchedule(ctx, t, s, "foo", func(info ScheduleInfo) bool { return !info.NextRun.IsZero() })
+			check.Equal(t, info.NextRun.Minute(), 0)
+			check.Equal(t, info.NextRun.Hour(), 0)
+			check.Equal(t, info.NextRun.Day(), 1)
+			check.Equal(t, info.NextRun.Month(), time.January)
+			check.Equal(t, len(info.History), 0)
+		},
+		"AddsJitter": func(ctx context.Context, t *testing.T, mngr Manager, s *Scheduler) {
+			start := time.Now()
+			assert.NotError(t, s.Add(options.Schedule{ID: "foo", Create: testutil.TrueCreateOpts(), Interval: time.Hour, Jitter: time.Hour}))
+
+			info := waitForSchedule(ctx, t, s, "foo", func(info ScheduleInfo) bool { return !info.NextRun.IsZero() })
+			check.True(t, !info.NextRun.Before(start.Add(time.Hour)))
+			check.True(t, info.NextRun.Before(time.Now().Add(2*time.Hour)))
+		},
+		"AddFailsWithDuplicateID": func(ctx context.Context, t *testing.T, mngr Manager, s *Scheduler) {
+			assert.NotError(t, s.Add(options.Schedule{ID: "foo", Create: testutil.TrueCreateOpts(), Interval: time.Hour}))
+			check.Error(t, s.Add(options.Schedule{ID: "foo", Create: testutil.TrueCreateOpts(), Interval: time.Hour}))
+		},
+		"AddFailsWithInvalidSchedule": func(ctx context.Context, t *testing.T, mngr Manager, s *Scheduler) {
+			check.Error(t, s.Add(options.Schedule{ID: "foo", Create: testutil.TrueCreateOpts()}))
+			check.Equal(t, len(s.List()), 0)
+		},
+		"AddFailsAfterClose": func(ctx context.Context, t *testing.T, mngr Manager, s *Scheduler) {
+			assert.NotError(t, s.Close())
+			check.Error(t, s.Add(options.Schedule{ID: "foo", Create: testutil.TrueCreateOpts(), Interval: time.Hour}))
+		},
+		"ListIsSorted": func(ctx context.Context, t *testing.T, mngr Manager, s *Scheduler) {
+			for _, id := range []string{"c", "a", "b"} {
+				assert.NotError(t, s.Add(options.Schedule{ID: id, Create: testutil.TrueCreateOpts(), Interval: time.Hour}))
+			}
+			infos := s.List()
+			assert.Equal(t, len(infos), 3)
+			for idx, id := range []string{"a", "b", "c"} {
+				check.Equal(t, infos[idx].Schedule.ID, id)
+			}
+		},
+		"RemoveStopsSchedule": func(ctx context.Context, t *testing.T, mngr Manager, s *Scheduler) {
+			assert.NotError(t, s.Add(options.Schedule{ID: "foo", Create: testutil.TrueCreateOpts(), Interval: time.Hour}))
+			assert.NotError(t, s.Remove("foo"))
+			_, err := s.Get("foo")
+			check.Error(t, err)
+			check.Error(t, s.Remove("foo"))
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.ManagerTestTimeout)
+			defer cancel()
+
+			mngr := NewManager(ManagerOptionSet(ManagerOptions{Synchronized: true}))
+			defer func() { check.NotError(t, mngr.Close(ctx)) }()
+			s := NewScheduler(mngr)
+			defer func() { check.NotError(t, s.Close()) }()
+
+			testCase(ctx, t, mngr, s)
+		})
+	}
+}
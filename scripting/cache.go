@@ -3,6 +3,7 @@ package scripting
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/tychoish/jasper"
@@ -69,3 +70,16 @@ func (c *cacheImpl) Check(id string) bool {
 
 	return false
 }
+
+func (c *cacheImpl) IDs() []string {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	ids := make([]string, 0, len(c.cache))
+	for id := range c.cache {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
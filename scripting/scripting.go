@@ -76,6 +76,8 @@ type HarnessCache interface {
 	Add(id string, h Harness) error
 	// Check returns whether a Harness with the given ID exists in the cache.
 	Check(id string) bool
+	// IDs returns the IDs of all harnesses in the cache in sorted order.
+	IDs() []string
 }
 
 ////////////////////////////////////////////////////////////////////////
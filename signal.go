@@ -3,8 +3,11 @@ package jasper
 import (
 	"context"
 	"syscall"
+	"time"
 
 	"github.com/tychoish/fun/erc"
+	"github.com/tychoish/grip"
+	"github.com/tychoish/grip/message"
 )
 
 // Terminate sends a SIGTERM signal to the given process under the given
@@ -61,3 +64,26 @@ func KillAll(ctx context.Context, procs []Process) error {
 
 	return catcher.Resolve()
 }
+
+// signalUntilExit sends each of the signals to the process in turn, waiting
+// for the grace period after each one, until the done channel is closed
+// because the process has exited.
+func signalUntilExit(proc Process, done <-chan struct{}, gracePeriod time.Duration, sigs ...syscall.Signal) {
+	for _, sig := range sigs {
+		if err := proc.Signal(context.Background(), sig); err != nil {
+			grip.Debug(message.WrapError(err, message.Fields{
+				"message": "problem signaling process",
+				"id":      proc.ID(),
+				"signal":  sig.String(),
+			}))
+		}
+
+		timer := time.NewTimer(gracePeriod)
+		select {
+		case <-done:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
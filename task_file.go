@@ -0,0 +1,210 @@
+package jasper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/tychoish/fun/erc"
+	"github.com/tychoish/grip/recovery"
+	"github.com/tychoish/jasper/options"
+)
+
+// Tasks are the commands loaded from a task file, which run in the order of
+// the steps of the task file.
+type Tasks struct {
+	ID              string
+	ContinueOnError bool
+	Steps           []*TaskStep
+	file            *options.TaskFile
+}
+
+// TaskStep is a step of Tasks. It either runs its Command, or runs all of its
+// Parallel steps at the same time.
+type TaskStep struct {
+	Name        string
+	Command     *Command
+	Parallel    []*TaskStep
+	IgnoreError bool
+}
+
+// LoadTasks validates the task file and creates the command of each of its
+// steps using makeCommand, which allows the commands to run on any manager,
+// such as with Manager.CreateCommand. If makeCommand is nil, the commands are
+// created with NewCommand.
+func LoadTasks(file *options.TaskFile, makeCommand func() *Command) (*Tasks, error) {
+	if err := file.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid task file: %w", err)
+	}
+	if makeCommand == nil {
+		makeCommand = NewCommand
+	}
+
+	tasks := &Tasks{
+		ID:              file.ID,
+		ContinueOnError: file.ContinueOnError,
+		file:            file,
+	}
+	for idx, step := range file.Steps {
+		tasks.Steps = append(tasks.Steps, loadTaskStep(file, step, taskStepName(step, fmt.Sprintf("step %d", idx+1)), makeCommand))
+	}
+
+	return tasks, nil
+}
+
+func taskStepName(step options.TaskStep, defaultName string) string {
+	if step.Name != "" {
+		return step.Name
+	}
+	return defaultName
+}
+
+func loadTaskStep(file *options.TaskFile, step options.TaskStep, name string, makeCommand func() *Command) *TaskStep {
+	out := &TaskStep{Name: name, IgnoreError: step.IgnoreError}
+	if len(step.Parallel) != 0 {
+		for idx, parallelStep := range step.Parallel {
+			out.Parallel = append(out.Parallel, loadTaskStep(file, parallelStep, taskStepName(parallelStep, fmt.Sprintf("%s.%d", name, idx+1)), makeCommand))
+		}
+		return out
+	}
+
+	id := name
+	if file.ID != "" {
+		id = fmt.Sprintf("%s.%s", file.ID, name)
+	}
+
+	cmd := makeCommand().ID(id).Add(step.GetArgs())
+
+	dir := file.Directory
+	if step.Directory != "" {
+		dir = step.Directory
+	}
+	if dir != "" {
+		cmd.Directory(dir)
+	}
+
+	env := map[string]string{}
+	for k, v := range file.Environment {
+		env[k] = v
+	}
+	for k, v := range step.Environment {
+		env[k] = v
+	}
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		cmd.AddEnv(k, env[k])
+	}
+
+	cmd.ExtendTags(file.Tags).ExtendTags(step.Tags)
+
+	loggers := file.Loggers
+	if len(step.Loggers) != 0 {
+		loggers = step.Loggers
+	}
+	cmd.ExtendLoggers(loggers)
+
+	remote, docker := file.Remote, file.Docker
+	if step.Remote != nil || step.Docker != nil {
+		remote, docker = step.Remote, step.Docker
+	}
+	if remote != nil {
+		cmd.SetRemoteOptions(remote.Copy())
+	}
+	if docker != nil {
+		docker = docker.Copy()
+		cmd.WithOptions(func(opts *options.Command) { opts.Process.Docker = docker })
+	}
+
+	if timeout := step.GetTimeout(); timeout != 0 {
+		cmd.WithOptions(func(opts *options.Command) { opts.Process.Timeout = timeout })
+	}
+
+	out.Command = cmd
+	return out
+}
+
+// Run runs the steps in order. It stops at the first step that fails, unless
+// ContinueOnError is set, and returns the errors of the steps that failed
+// without IgnoreError set. If the task file has a timeout, the steps are
+// canceled once it elapses.
+func (t *Tasks) Run(ctx context.Context) error {
+	if timeout := t.file.GetTimeout(); timeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	catcher := &erc.Collector{}
+	for _, step := range t.Steps {
+		if err := ctx.Err(); err != nil {
+			catcher.Push(fmt.Errorf("operation canceled: %w", err))
+			break
+		}
+
+		err := step.run(ctx)
+		catcher.Push(err)
+		if err != nil && !t.ContinueOnError {
+			break
+		}
+	}
+
+	return catcher.Resolve()
+}
+
+func (s *TaskStep) run(ctx context.Context) error {
+	var err error
+	if s.Command != nil {
+		err = s.Command.Run(ctx)
+	} else {
+		err = s.runParallel(ctx)
+	}
+
+	if err == nil || s.IgnoreError {
+		return nil
+	}
+	return fmt.Errorf("step '%s': %w", s.Name, err)
+}
+
+func (s *TaskStep) runParallel(ctx context.Context) error {
+	catcher := &erc.Collector{}
+	wg := &sync.WaitGroup{}
+	for _, step := range s.Parallel {
+		wg.Add(1)
+		go func(step *TaskStep) {
+			defer wg.Done()
+			defer func() {
+				catcher.Push(recovery.HandlePanicWithError(recover(), nil, "parallel step encountered error"))
+			}()
+
+			catcher.Push(step.run(ctx))
+		}(step)
+	}
+	wg.Wait()
+
+	return catcher.Resolve()
+}
+
+// Commands returns the commands of all of the steps, in order.
+func (t *Tasks) Commands() []*Command {
+	out := []*Command{}
+	for _, step := range t.Steps {
+		out = append(out, step.commands()...)
+	}
+	return out
+}
+
+func (s *TaskStep) commands() []*Command {
+	if s.Command != nil {
+		return []*Command{s.Command}
+	}
+	out := []*Command{}
+	for _, step := range s.Parallel {
+		out = append(out, step.commands()...)
+	}
+	return out
+}
@@ -0,0 +1,160 @@
+package jasper
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/testutil"
+)
+
+func TestTasks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires POSIX utilities")
+	}
+
+	for name, testCase := range map[string]func(ctx context.Context, t *testing.T, manager Manager){
+		"RunsStepsInOrder": func(ctx context.Context, t *testing.T, manager Manager) {
+			dir := t.TempDir()
+			tasks, err := LoadTasks(&options.TaskFile{
+				ID:          "workflow",
+				Directory:   dir,
+				Environment: map[string]string{"GREETING": "hello", "NAME": "world"},
+				Tags:        []string{"tasks"},
+				Steps: []options.TaskStep{
+					{Name: "first", Args: []string{"sh", "-c", "echo $GREETING $NAME > out"}},
+					{Name: "second", Command: "sh -c 'echo $NAME >> out'", Environment: map[string]string{"NAME": "again"}, Tags: []string{"second"}},
+				},
+			}, func() *Command { return manager.CreateCommand(ctx) })
+			assert.NotError(t, err)
+			assert.NotError(t, tasks.Run(ctx))
+
+			out, err := os.ReadFile(filepath.Join(dir, "out"))
+			assert.NotError(t, err)
+			check.Equal(t, string(out), "hello world\nagain\n")
+
+			procs, err := manager.Group(ctx, "tasks")
+			assert.NotError(t, err)
+			check.Equal(t, len(procs), 2)
+			procs, err = manager.Group(ctx, "second")
+			assert.NotError(t, err)
+			check.Equal(t, len(procs), 1)
+
+			cmds := tasks.Commands()
+			assert.Equal(t, len(cmds), 2)
+			check.Substring(t, cmds[0].String(), "id='workflow.first'")
+		},
+		"StopsAtFailedStep": func(ctx context.Context, t *testing.T, manager Manager) {
+			tasks, err := LoadTasks(&options.TaskFile{
+				Steps: []options.TaskStep{{Name: "fails", Command: "false"}, {Command: "true"}},
+			}, nil)
+			assert.NotError(t, err)
+
+			err = tasks.Run(ctx)
+			assert.Error(t, err)
+			check.Substring(t, err.Error(), "step 'fails'")
+			check.Equal(t, len(tasks.Steps[1].Command.GetProcIDs()), 0)
+		},
+		"ContinuesOnError": func(ctx context.Context, t *testing.T, manager Manager) {
+			tasks, err := LoadTasks(&options.TaskFile{
+				ContinueOnError: true,
+				Steps:           []options.TaskStep{{Command: "false"}, {Command: "true"}},
+			}, nil)
+			assert.NotError(t, err)
+
+			err = tasks.Run(ctx)
+			assert.Error(t, err)
+			check.Substring(t, err.Error(), "step 'step 1'")
+			check.Equal(t, len(tasks.Steps[1].Command.GetProcIDs()), 1)
+		},
+		"IgnoresErrors": func(ctx context.Context, t *testing.T, manager Manager) {
+			tasks, err := LoadTasks(&options.TaskFile{
+				Steps: []options.TaskStep{{Command: "false", IgnoreError: true}, {Command: "true"}},
+			}, nil)
+			assert.NotError(t, err)
+			assert.NotError(t, tasks.Run(ctx))
+			check.Equal(t, len(tasks.Steps[1].Command.GetProcIDs()), 1)
+		},
+		"RunsParallelSteps": func(ctx context.Context, t *testing.T, manager Manager) {
+			dir := t.TempDir()
+			tasks, err := LoadTasks(&options.TaskFile{
+				Directory: dir,
+				Steps: []options.TaskStep{
+					{Parallel: []options.TaskStep{
+						{Command: "sh -c 'sleep 1; touch a'"},
+						{Command: "sh -c 'sleep 1; touch b'"},
+					}},
+					{Command: "ls a b"},
+				},
+			}, func() *Command { return manager.CreateCommand(ctx) })
+			assert.NotError(t, err)
+
+			start := time.Now()
+			assert.NotError(t, tasks.Run(ctx))
+			check.True(t, time.Since(start) < 2*time.Second)
+			assert.Equal(t, len(tasks.Steps[0].Parallel), 2)
+			check.Equal(t, tasks.Steps[0].Parallel[1].Name, "step 1.2")
+			check.Equal(t, len(tasks.Commands()), 3)
+		},
+		"ParallelFailuresAreCollected": func(ctx context.Context, t *testing.T, manager Manager) {
+			tasks, err := LoadTasks(&options.TaskFile{
+				Steps: []options.TaskStep{{Name: "group", Parallel: []options.TaskStep{
+					{Name: "a", Command: "false"},
+					{Name: "b", Command: "true"},
+					{Name: "c", Command: "false"},
+				}}},
+			}, nil)
+			assert.NotError(t, err)
+
+			err = tasks.Run(ctx)
+			assert.Error(t, err)
+			check.Substring(t, err.Error(), "step 'a'")
+			check.Substring(t, err.Error(), "step 'c'")
+			check.True(t, !strings.Contains(err.Error(), "step 'b'"))
+		},
+		"StepTimeout": func(ctx context.Context, t *testing.T, manager Manager) {
+			tasks, err := LoadTasks(&options.TaskFile{
+				Steps: []options.TaskStep{{Command: "sleep 10", Timeout: "1s"}},
+			}, nil)
+			assert.NotError(t, err)
+
+			start := time.Now()
+			assert.Error(t, tasks.Run(ctx))
+			check.True(t, time.Since(start) < 5*time.Second)
+		},
+		"TaskFileTimeout": func(ctx context.Context, t *testing.T, manager Manager) {
+			tasks, err := LoadTasks(&options.TaskFile{
+				Timeout: "1s",
+				Steps:   []options.TaskStep{{Command: "sleep 10"}, {Command: "true"}},
+			}, nil)
+			assert.NotError(t, err)
+
+			start := time.Now()
+			assert.Error(t, tasks.Run(ctx))
+			check.True(t, time.Since(start) < 5*time.Second)
+			check.Equal(t, len(tasks.Steps[1].Command.GetProcIDs()), 0)
+		},
+		"InvalidTaskFileErrors": func(ctx context.Context, t *testing.T, manager Manager) {
+			_, err := LoadTasks(&options.TaskFile{Steps: []options.TaskStep{{}}}, nil)
+			assert.Error(t, err)
+			check.Substring(t, err.Error(), "steps[0]")
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.ManagerTestTimeout)
+			defer cancel()
+
+			manager := NewManager(ManagerOptionSetSynchronized())
+			defer func() { check.NotError(t, manager.Close(ctx)) }()
+
+			testCase(ctx, t, manager)
+		})
+	}
+}
@@ -3,13 +3,19 @@ package testcases
 import (
 	"bytes"
 	"context"
+	"fmt"
+	"net"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 	"syscall"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/tychoish/fun/assert"
 	"github.com/tychoish/fun/assert/check"
 	"github.com/tychoish/jasper"
@@ -115,6 +121,26 @@ func ProcessCases() map[string]ProcessCase {
 			proc.Tag("bar")
 			check.Equal(t, len(proc.GetTags()), 2)
 		},
+		"TagsAreSafeForConcurrentUse": func(ctx context.Context, t *testing.T, opts *options.Create, makep jasper.ProcessConstructor) {
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+
+			wg := &sync.WaitGroup{}
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					proc.Tag(fmt.Sprint(i % 3))
+					_ = proc.GetTags()
+					if i == 5 {
+						proc.ResetTags()
+					}
+				}(i)
+			}
+			wg.Wait()
+
+			check.True(t, len(proc.GetTags()) <= 3)
+		},
 		"CompleteIsTrueAfterWait": func(ctx context.Context, t *testing.T, opts *options.Create, makep jasper.ProcessConstructor) {
 			proc, err := makep(ctx, opts)
 			assert.NotError(t, err)
@@ -170,6 +196,40 @@ func ProcessCases() map[string]ProcessCase {
 			assert.NotError(t, err)
 			check.NotError(t, proc.RegisterSignalTriggerID(ctx, jasper.CleanTerminationSignalTrigger))
 		},
+		"RegisterTriggerIDErrorsForExitedProcess": func(ctx context.Context, t *testing.T, opts *options.Create, makep jasper.ProcessConstructor) {
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+			_, err = proc.Wait(ctx)
+			check.NotError(t, err)
+			check.Error(t, proc.RegisterTriggerID(ctx, jasper.WriteInfoToFileProcessTrigger, map[string]string{"path": filepath.Join(t.TempDir(), "info.json")}))
+		},
+		"RegisterTriggerIDFailsWithInvalidTriggerID": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
+			opts := testutil.SleepCreateOpts(3)
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+			check.Error(t, proc.RegisterTriggerID(ctx, jasper.ProcessTriggerID("foo"), nil))
+		},
+		"RegisterTriggerIDFailsWithInvalidParams": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
+			opts := testutil.SleepCreateOpts(3)
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+			check.Error(t, proc.RegisterTriggerID(ctx, jasper.WriteInfoToFileProcessTrigger, nil))
+		},
+		"RegisterTriggerIDWritesInfoToFileOnExit": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
+			if runtime.GOOS == "windows" {
+				t.Skip("test requires a POSIX shell")
+			}
+			path := filepath.Join(t.TempDir(), "info.json")
+			proc, err := makep(ctx, &options.Create{Args: []string{"sleep", "0.2"}})
+			assert.NotError(t, err)
+			assert.NotError(t, proc.RegisterTriggerID(ctx, jasper.WriteInfoToFileProcessTrigger, map[string]string{"path": path}))
+			_, err = proc.Wait(ctx)
+			assert.NotError(t, err)
+
+			data, err := os.ReadFile(path)
+			assert.NotError(t, err)
+			check.Substring(t, string(data), proc.ID())
+		},
 		"DefaultTriggerSucceeds": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
 			opts := testutil.SleepCreateOpts(3)
 			proc, err := makep(ctx, opts)
@@ -512,6 +572,276 @@ func ProcessCases() map[string]ProcessCase {
 				check.Equal(t, int(syscall.SIGKILL), exitCode)
 			}
 			check.True(t, proc.Info(ctx).Timeout)
+			check.Equal(t, proc.Info(ctx).TimeoutReason, jasper.TimeoutReasonDeadline)
+		},
+		"IdleTimeoutTerminatesIdleProcess": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
+			opts := testutil.SleepCreateOpts(100)
+			opts.IdleTimeout = &options.IdleTimeout{Duration: 100 * time.Millisecond, GracePeriod: 100 * time.Millisecond}
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+
+			_, err = proc.Wait(ctx)
+			check.Error(t, err)
+			info := proc.Info(ctx)
+			check.True(t, info.Timeout)
+			check.Equal(t, info.TimeoutReason, jasper.TimeoutReasonIdle)
+		},
+		"IdleTimeoutDoesNotTerminateActiveProcess": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
+			if runtime.GOOS == "windows" {
+				t.Skip("test requires a POSIX shell")
+			}
+			opts := &options.Create{Args: []string{"sh", "-c", "for i in 1 2 3 4 5; do echo $i; sleep 0.1; done"}}
+			opts.IdleTimeout = &options.IdleTimeout{Duration: 500 * time.Millisecond}
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+
+			_, err = proc.Wait(ctx)
+			check.NotError(t, err)
+			info := proc.Info(ctx)
+			check.True(t, !info.Timeout)
+			check.Equal(t, info.TimeoutReason, "")
+		},
+		"IdleTimeoutSendsStackDumpSignal": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
+			if runtime.GOOS == "windows" {
+				t.Skip("SIGQUIT is not supported on windows")
+			}
+			output := &bytes.Buffer{}
+			opts := &options.Create{Args: []string{"sh", "-c", `trap 'echo dumped; kill $!; exit 3' QUIT; sleep 100 & wait`}}
+			opts.Output.Output = output
+			opts.IdleTimeout = &options.IdleTimeout{Duration: 100 * time.Millisecond, StackDump: true, GracePeriod: 5 * time.Second}
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+
+			exitCode, err := proc.Wait(ctx)
+			check.Error(t, err)
+			check.Equal(t, exitCode, 3)
+			check.Equal(t, strings.TrimSpace(output.String()), "dumped")
+			check.Equal(t, proc.Info(ctx).TimeoutReason, jasper.TimeoutReasonIdle)
+		},
+		"OutputTriggerMarksProcessFailed": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
+			if runtime.GOOS == "windows" {
+				t.Skip("test requires a POSIX shell")
+			}
+			opts := &options.Create{
+				Args:           []string{"sh", "-c", "echo starting; echo 'ERROR: something broke'"},
+				OutputTriggers: []options.OutputTrigger{{Pattern: "^ERROR:", Fail: true}},
+			}
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+
+			exitCode, err := proc.Wait(ctx)
+			check.Error(t, err)
+			check.Equal(t, exitCode, 0)
+			check.True(t, !proc.Info(ctx).Successful)
+		},
+		"OutputTriggerTagsAndSignalsProcess": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
+			if runtime.GOOS == "windows" {
+				t.Skip("test requires a POSIX shell")
+			}
+			opts := &options.Create{
+				Args: []string{"sh", "-c", "echo ready; exec sleep 100"},
+				OutputTriggers: []options.OutputTrigger{
+					{Pattern: "ready", Tag: "is-ready"},
+					{Pattern: "ready", Stream: "stderr", Tag: "wrong-stream"},
+					{Pattern: "ready", Signal: syscall.SIGTERM},
+				},
+			}
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+
+			exitCode, err := proc.Wait(ctx)
+			check.Error(t, err)
+			check.Equal(t, exitCode, int(syscall.SIGTERM))
+			check.EqualItems(t, proc.GetTags(), []string{"is-ready"})
+		},
+		"OutputTriggerCallsRegisteredTrigger": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
+			if runtime.GOOS == "windows" {
+				t.Skip("test requires a POSIX shell")
+			}
+			var (
+				mu    sync.Mutex
+				lines []jasper.LogLine
+			)
+			id := jasper.OutputTriggerID(uuid.New().String())
+			assert.NotError(t, jasper.RegisterOutputTriggerFactory(id, func() jasper.OutputTrigger {
+				return func(_ jasper.Process, line jasper.LogLine) {
+					mu.Lock()
+					defer mu.Unlock()
+					lines = append(lines, line)
+				}
+			}))
+			opts := &options.Create{
+				Args: []string{"sh", "-c", "echo 'match 1'; echo other; echo 'match 2' >&2; printf 'match 3'"},
+				OutputTriggers: []options.OutputTrigger{
+					{Pattern: "^match", TriggerID: string(id)},
+					{Pattern: "^match", TriggerID: string(id), Once: true},
+				},
+			}
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+
+			_, err = proc.Wait(ctx)
+			assert.NotError(t, err)
+
+			mu.Lock()
+			defer mu.Unlock()
+			// The first trigger matches all three lines, including the
+			// unterminated last line, and the second only the first.
+			assert.Equal(t, len(lines), 4)
+			streams := map[string]jasper.OutputStream{}
+			for _, line := range lines {
+				streams[line.Line] = line.Stream
+			}
+			check.Equal(t, len(streams), 3)
+			check.Equal(t, streams["match 1"], jasper.OutputStreamStdout)
+			check.Equal(t, streams["match 2"], jasper.OutputStreamStderr)
+		},
+		"OutputTriggerWithUnregisteredIDFails": func(ctx context.Context, t *testing.T, opts *options.Create, makep jasper.ProcessConstructor) {
+			opts.OutputTriggers = []options.OutputTrigger{{Pattern: ".", TriggerID: "jasper-test-output-trigger-does-not-exist"}}
+			_, err := makep(ctx, opts)
+			check.Error(t, err)
+		},
+		"WaitReadyWithoutReadinessProbesDoesNotBlock": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
+			proc, err := makep(ctx, testutil.SleepCreateOpts(5))
+			assert.NotError(t, err)
+			defer func() { check.NotError(t, proc.Signal(ctx, syscall.SIGKILL)) }()
+
+			check.NotError(t, proc.WaitReady(ctx))
+			check.True(t, proc.Info(ctx).Ready)
+		},
+		"WaitReadyBlocksUntilOutputMatches": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
+			if runtime.GOOS == "windows" {
+				t.Skip("test requires a POSIX shell")
+			}
+			opts := &options.Create{Args: []string{"sh", "-c", "echo starting; sleep 0.5; echo listening; exec sleep 5"}}
+			opts.Readiness = &options.Readiness{OutputPattern: "^listening$", Interval: 10 * time.Millisecond}
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+			defer func() { check.NotError(t, proc.Signal(ctx, syscall.SIGKILL)) }()
+
+			check.True(t, !proc.Info(ctx).Ready)
+			assert.NotError(t, proc.WaitReady(ctx))
+			info := proc.Info(ctx)
+			check.True(t, info.Ready)
+			check.True(t, info.IsRunning)
+		},
+		"WaitReadyBlocksUntilFileExists": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
+			if runtime.GOOS == "windows" {
+				t.Skip("test requires a POSIX shell")
+			}
+			file := filepath.Join(t.TempDir(), "ready")
+			opts := &options.Create{Args: []string{"sh", "-c", "sleep 0.2; touch " + file + "; exec sleep 5"}}
+			opts.Readiness = &options.Readiness{File: file, Interval: 10 * time.Millisecond}
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+			defer func() { check.NotError(t, proc.Signal(ctx, syscall.SIGKILL)) }()
+
+			assert.NotError(t, proc.WaitReady(ctx))
+			check.True(t, proc.Info(ctx).Ready)
+			_, err = os.Stat(file)
+			check.NotError(t, err)
+		},
+		"WaitReadyBlocksUntilPortAcceptsConnections": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			assert.NotError(t, err)
+			defer listener.Close()
+
+			opts := testutil.SleepCreateOpts(5)
+			opts.Readiness = &options.Readiness{TCP: listener.Addr().String(), Interval: 10 * time.Millisecond}
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+			defer func() { check.NotError(t, proc.Signal(ctx, syscall.SIGKILL)) }()
+
+			assert.NotError(t, proc.WaitReady(ctx))
+			check.True(t, proc.Info(ctx).Ready)
+		},
+		"WaitReadyRunsProbeCommand": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
+			opts := testutil.SleepCreateOpts(5)
+			opts.Readiness = &options.Readiness{Command: testutil.TrueCreateOpts(), Interval: 10 * time.Millisecond}
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+			defer func() { check.NotError(t, proc.Signal(ctx, syscall.SIGKILL)) }()
+
+			assert.NotError(t, proc.WaitReady(ctx))
+			check.True(t, proc.Info(ctx).Ready)
+		},
+		"WaitReadyErrorsIfProcessExitsBeforeReady": func(ctx context.Context, t *testing.T, opts *options.Create, makep jasper.ProcessConstructor) {
+			opts.Readiness = &options.Readiness{File: filepath.Join(t.TempDir(), "does-not-exist"), Interval: 10 * time.Millisecond}
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+
+			check.Error(t, proc.WaitReady(ctx))
+			_, err = proc.Wait(ctx)
+			check.NotError(t, err)
+			check.True(t, !proc.Info(ctx).Ready)
+		},
+		"WaitReadyRespectsCanceledContext": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
+			opts := testutil.SleepCreateOpts(5)
+			opts.Readiness = &options.Readiness{File: filepath.Join(t.TempDir(), "does-not-exist")}
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+			defer func() { check.NotError(t, proc.Signal(ctx, syscall.SIGKILL)) }()
+
+			waitCtx, waitCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer waitCancel()
+			check.Error(t, proc.WaitReady(waitCtx))
+			check.True(t, !proc.Info(ctx).Ready)
+		},
+		"LivenessRecordsPassingChecks": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
+			opts := testutil.SleepCreateOpts(5)
+			opts.Liveness = &options.Liveness{Command: testutil.TrueCreateOpts(), Interval: 10 * time.Millisecond}
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+			defer func() { check.NotError(t, proc.Signal(ctx, syscall.SIGKILL)) }()
+
+			for {
+				info := proc.Info(ctx)
+				assert.True(t, info.Liveness != nil)
+				if len(info.Liveness.Checks) >= 2 {
+					for _, c := range info.Liveness.Checks {
+						check.True(t, c.Passed)
+					}
+					check.Zero(t, info.Liveness.ConsecutiveFailures)
+					check.True(t, info.IsRunning)
+					return
+				}
+				select {
+				case <-ctx.Done():
+					t.Fatal("timed out waiting for liveness checks")
+				case <-time.After(10 * time.Millisecond):
+				}
+			}
+		},
+		"LivenessRestartsProcessAfterFailureThreshold": func(ctx context.Context, t *testing.T, _ *options.Create, makep jasper.ProcessConstructor) {
+			opts := testutil.SleepCreateOpts(5)
+			opts.Liveness = &options.Liveness{
+				Command:          testutil.FalseCreateOpts(),
+				Interval:         10 * time.Millisecond,
+				FailureThreshold: 2,
+				GracePeriod:      time.Second,
+				MaxRestarts:      1,
+			}
+			proc, err := makep(ctx, opts)
+			assert.NotError(t, err)
+
+			_, err = proc.Wait(ctx)
+			check.Error(t, err)
+
+			for {
+				info := proc.Info(ctx)
+				assert.True(t, info.Liveness != nil)
+				if info.Liveness.RestartedAs != "" {
+					check.True(t, info.Liveness.RestartedAs != proc.ID())
+					check.True(t, info.Liveness.ConsecutiveFailures >= 2)
+					check.True(t, !info.Liveness.Checks[len(info.Liveness.Checks)-1].Passed)
+					return
+				}
+				select {
+				case <-ctx.Done():
+					t.Fatal("timed out waiting for process to restart")
+				case <-time.After(10 * time.Millisecond):
+				}
+			}
 		},
 		"CallingSignalOnDeadProcessDoesError": func(ctx context.Context, t *testing.T, opts *options.Create, makep jasper.ProcessConstructor) {
 			proc, err := makep(ctx, opts)
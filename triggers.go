@@ -1,11 +1,18 @@
 package jasper
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
 	"syscall"
 	"time"
 
 	"github.com/tychoish/grip"
+	"github.com/tychoish/grip/level"
 	"github.com/tychoish/grip/message"
 	"github.com/tychoish/jasper/options"
 )
@@ -58,6 +65,40 @@ const (
 	CleanTerminationSignalTrigger SignalTriggerID = "clean_terminate"
 )
 
+// ProcessTriggerID is the unique representation of a process trigger.
+type ProcessTriggerID string
+
+const (
+	// WebhookOnExitProcessTrigger is the ID for the process trigger that
+	// sends the process's info as JSON in a POST request to the URL in the
+	// "url" parameter when the process exits.
+	WebhookOnExitProcessTrigger ProcessTriggerID = "webhook_on_exit"
+	// WriteInfoToFileProcessTrigger is the ID for the process trigger that
+	// writes the process's info as JSON to the file in the "path" parameter
+	// when the process exits.
+	WriteInfoToFileProcessTrigger ProcessTriggerID = "write_info_to_file"
+	// SendToLoggingCacheProcessTrigger is the ID for the process trigger
+	// that sends a message describing the process's exit to a logger in the
+	// logging cache of the manager that created the process. The logger is
+	// the one in the "logger_id" parameter, or the logger with the same ID
+	// as the process if it is not set.
+	SendToLoggingCacheProcessTrigger ProcessTriggerID = "send_to_logging_cache"
+	// RemoveFromManagerProcessTrigger is the ID for the process trigger that
+	// removes the process and its cached logger from the manager that
+	// created it when the process exits. Since the process is removed in the
+	// background, the manager should be synchronized.
+	RemoveFromManagerProcessTrigger ProcessTriggerID = "remove_from_manager"
+)
+
+// OutputTrigger describes hooks that run when a line of a process's output
+// matches an options.OutputTrigger that refers to the trigger by its ID. It
+// runs in the goroutine that copies the process's output, so it should
+// return quickly and must not wait for the process to exit.
+type OutputTrigger func(Process, LogLine)
+
+// OutputTriggerID is the unique representation of an output trigger.
+type OutputTriggerID string
+
 func makeOptionsCloseTrigger() ProcessTrigger {
 	return func(info ProcessInfo) {
 		grip.Warning(message.WrapError(info.Options.Close(),
@@ -65,11 +106,260 @@ func makeOptionsCloseTrigger() ProcessTrigger {
 	}
 }
 
+// webhookTriggerTimeout is how long the webhook-on-exit trigger waits for a
+// response.
+const webhookTriggerTimeout = 10 * time.Second
+
+// makeWebhookOnExitTrigger creates a process trigger that posts the
+// process's info to the "url" parameter. The request is sent in the
+// background so that it does not delay the completion of the process.
+func makeWebhookOnExitTrigger(_ Manager, params map[string]string) (ProcessTrigger, error) {
+	url := params["url"]
+	if url == "" {
+		return nil, errors.New("must specify a url")
+	}
+
+	return func(info ProcessInfo) {
+		go func() {
+			if err := postProcessInfo(url, info); err != nil {
+				grip.Warning(message.WrapError(err, message.Fields{
+					"message": "problem sending process info to webhook",
+					"id":      info.ID,
+					"url":     url,
+				}))
+			}
+		}()
+	}, nil
+}
+
+func postProcessInfo(url string, info ProcessInfo) error {
+	payload, err := json.Marshal(info)
+	if err != nil {
+		return fmt.Errorf("problem marshalling process info: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), webhookTriggerTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("problem building request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("problem making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// makeWriteInfoToFileTrigger creates a process trigger that writes the
+// process's info to the "path" parameter. The file is written before the
+// process is marked as complete, so it exists once Wait returns.
+func makeWriteInfoToFileTrigger(_ Manager, params map[string]string) (ProcessTrigger, error) {
+	path := params["path"]
+	if path == "" {
+		return nil, errors.New("must specify a path")
+	}
+
+	return func(info ProcessInfo) {
+		payload, err := json.Marshal(info)
+		if err != nil {
+			grip.Warning(message.WrapError(err, message.Fields{
+				"message": "problem marshalling process info",
+				"id":      info.ID,
+			}))
+			return
+		}
+		grip.Warning(message.WrapError(os.WriteFile(path, payload, 0644), message.Fields{
+			"message": "problem writing process info to file",
+			"id":      info.ID,
+			"path":    path,
+		}))
+	}, nil
+}
+
+// makeSendToLoggingCacheTrigger creates a process trigger that sends a
+// message describing the process's exit to a logger in the manager's
+// logging cache. The message is sent in the background, since the trigger
+// runs while the process is locked and the manager may be waiting on the
+// process.
+func makeSendToLoggingCacheTrigger(m Manager, params map[string]string) (ProcessTrigger, error) {
+	if m == nil {
+		return nil, errors.New("process was not created by a manager")
+	}
+	loggerID := params["logger_id"]
+
+	return func(info ProcessInfo) {
+		go func() {
+			id := loggerID
+			if id == "" {
+				id = info.ID
+			}
+
+			logger := m.LoggingCache(context.Background()).Get(id)
+			if logger == nil {
+				grip.Warning(message.Fields{
+					"message": "could not find logger to send process info to",
+					"id":      info.ID,
+					"logger":  id,
+				})
+				return
+			}
+
+			priority := level.Info
+			if !info.Successful {
+				priority = level.Error
+			}
+			grip.Warning(message.WrapError(logger.Send(&options.LoggingPayload{
+				Data: message.Fields{
+					"message":    "process exited",
+					"id":         info.ID,
+					"args":       info.Options.Args,
+					"exit_code":  info.ExitCode,
+					"successful": info.Successful,
+					"timeout":    info.Timeout,
+					"duration":   info.EndAt.Sub(info.StartAt).String(),
+				},
+				Priority:          priority,
+				PreferSendToError: !info.Successful,
+			}), message.Fields{
+				"message": "problem sending process info to logger",
+				"id":      info.ID,
+				"logger":  id,
+			}))
+		}()
+	}, nil
+}
+
+// processRemover is implemented by managers that can remove a single
+// process.
+type processRemover interface {
+	remove(id string)
+}
+
+// findProcessRemover returns the manager, or the first manager that it wraps,
+// that can remove a single process. Managers in other packages that wrap a
+// manager expose it with an Unwrap method.
+func findProcessRemover(m Manager) (processRemover, bool) {
+	for m != nil {
+		if remover, ok := m.(processRemover); ok {
+			return remover, true
+		}
+		wrapper, ok := m.(interface{ Unwrap() Manager })
+		if !ok {
+			break
+		}
+		m = wrapper.Unwrap()
+	}
+	return nil, false
+}
+
+// processHook observes the processes that a manager creates and removes.
+type processHook interface {
+	// create is called with the options of each process before the
+	// manager creates it, and returns a function that is called with the
+	// process once it is created, or with nil if it could not be created.
+	create(ctx context.Context, opts *options.Create) func(Process)
+	// removed is called with the ID of each process that the manager
+	// removes.
+	removed(id string)
+}
+
+// processHooker is implemented by managers that call hooks for the
+// processes that they create and remove.
+type processHooker interface {
+	addProcessHook(processHook)
+}
+
+// findProcessHooker returns the manager, or the first manager that it wraps,
+// that calls hooks for its processes.
+func findProcessHooker(m Manager) (processHooker, bool) {
+	for m != nil {
+		if hooker, ok := m.(processHooker); ok {
+			return hooker, true
+		}
+		wrapper, ok := m.(interface{ Unwrap() Manager })
+		if !ok {
+			break
+		}
+		m = wrapper.Unwrap()
+	}
+	return nil, false
+}
+
+// makeRemoveFromManagerTrigger creates a process trigger that removes the
+// process from the manager that created it. The process is removed in the
+// background, since the trigger runs while the process is locked and the
+// manager may be waiting on the process.
+func makeRemoveFromManagerTrigger(m Manager, _ map[string]string) (ProcessTrigger, error) {
+	if m == nil {
+		return nil, errors.New("process was not created by a manager")
+	}
+	remover, ok := findProcessRemover(m)
+	if !ok {
+		return nil, fmt.Errorf("manager of type %T cannot remove processes", m)
+	}
+
+	return func(info ProcessInfo) {
+		go remover.remove(info.ID)
+	}, nil
+}
+
+// MakeDefaultTrigger creates the trigger that managers register on the
+// processes they create, which creates the follow-up processes in the
+// OnSuccess, OnFailure or OnTimeout options of the process with the manager
+// once it exits, tagged with the parent ID.
 func MakeDefaultTrigger(ctx context.Context, m Manager, opts *options.Create, parentID string) ProcessTrigger {
+	return makeDefaultTrigger(ctx, m, opts, parentID, nil)
+}
+
+// makeDefaultTrigger creates the trigger described by MakeDefaultTrigger,
+// which also calls record with each follow-up process once it is created or
+// could not be created, and again once it exits. record may be nil.
+func makeDefaultTrigger(ctx context.Context, m Manager, opts *options.Create, parentID string, record func(FollowUpInfo)) ProcessTrigger {
 	deadline, hasDeadline := ctx.Deadline()
 	timeout := time.Until(deadline)
+	if record == nil {
+		record = func(FollowUpInfo) {}
+	}
+
+	createFollowUp := func(ctx context.Context, trigger FollowUpTrigger, opt *options.Create) (Process, error) {
+		p, err := m.CreateProcess(ctx, opt.Copy())
+		if err != nil {
+			grip.Warning(message.WrapError(err, message.Fields{
+				"trigger": string(trigger),
+				"parent":  parentID,
+			}))
+			record(FollowUpInfo{Trigger: trigger, Error: err.Error()})
+			return nil, err
+		}
+		p.Tag(parentID)
+
+		record(FollowUpInfo{Trigger: trigger, ID: p.ID()})
+		if err := p.RegisterTrigger(ctx, func(info ProcessInfo) { record(makeFollowUpInfo(trigger, info)) }); err != nil {
+			// The follow-up process may have already exited.
+			record(makeFollowUpInfo(trigger, p.Info(ctx)))
+		}
+		return p, nil
+	}
 
 	return func(info ProcessInfo) {
+		// Follow-up processes run with the context of the process unless
+		// the caller detached them, in which case they keep its values but
+		// not its cancellation, unless it was canceled before the process
+		// exited.
+		followUpCtx := ctx
+		if hasDetachedFollowUps(ctx) && ctx.Err() == nil {
+			followUpCtx = context.WithoutCancel(ctx)
+		}
+
 		switch {
 		case info.Timeout:
 			var (
@@ -81,46 +371,36 @@ func MakeDefaultTrigger(ctx context.Context, m Manager, opts *options.Create, pa
 				if hasDeadline {
 					newctx, cancel = context.WithTimeout(context.Background(), timeout)
 				} else {
-					newctx, cancel = context.WithCancel(ctx)
+					newctx, cancel = context.WithCancel(followUpCtx)
 				}
 
-				p, err := m.CreateProcess(newctx, opt.Copy())
+				p, err := createFollowUp(newctx, FollowUpOnTimeout, opt)
 				if err != nil {
-					grip.Warning(message.WrapError(err, message.Fields{
-						"trigger": "on-timeout",
-						"parent":  parentID,
-					}))
 					cancel()
 					continue
 				}
-				p.Tag(parentID)
 				_ = p.RegisterTrigger(ctx, func(_ ProcessInfo) { cancel() })
 			}
 		case info.Successful:
 			for _, opt := range opts.OnSuccess {
-				p, err := m.CreateProcess(ctx, opt.Copy())
-				if err != nil {
-					grip.Warning(message.WrapError(err, message.Fields{
-						"trigger": "on-success",
-						"parent":  parentID,
-					}))
-					continue
-				}
-				p.Tag(parentID)
+				_, _ = createFollowUp(followUpCtx, FollowUpOnSuccess, opt)
 			}
 		case !info.Successful:
 			for _, opt := range opts.OnFailure {
-				p, err := m.CreateProcess(ctx, opt.Copy())
-				if err != nil {
-
-					grip.Warning(message.WrapError(err, message.Fields{
-						"trigger": "on-failure",
-						"parent":  parentID,
-					}))
-					continue
-				}
-				p.Tag(parentID)
+				_, _ = createFollowUp(followUpCtx, FollowUpOnFailure, opt)
 			}
 		}
 	}
 }
+
+// makeFollowUpInfo describes the outcome of the follow-up process with the
+// info.
+func makeFollowUpInfo(trigger FollowUpTrigger, info ProcessInfo) FollowUpInfo {
+	return FollowUpInfo{
+		Trigger:    trigger,
+		ID:         info.ID,
+		Complete:   info.Complete,
+		Successful: info.Successful,
+		ExitCode:   info.ExitCode,
+	}
+}
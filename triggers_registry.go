@@ -3,6 +3,7 @@ package jasper
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/tychoish/grip"
@@ -20,6 +21,8 @@ var jasperSignalTriggerRegistry *signalTriggerRegistry
 
 func init() {
 	jasperSignalTriggerRegistry = newSignalTriggerRegistry()
+	jasperOutputTriggerRegistry = newOutputTriggerRegistry()
+	jasperProcessTriggerRegistry = newProcessTriggerRegistry()
 
 	signalTriggers := map[SignalTriggerID]SignalTriggerFactory{
 		CleanTerminationSignalTrigger: makeCleanTerminationSignalTrigger,
@@ -28,6 +31,17 @@ func init() {
 	for id, factory := range signalTriggers {
 		grip.EmergencyPanic(RegisterSignalTriggerFactory(id, factory))
 	}
+
+	processTriggers := map[ProcessTriggerID]ProcessTriggerFactory{
+		WebhookOnExitProcessTrigger:      makeWebhookOnExitTrigger,
+		WriteInfoToFileProcessTrigger:    makeWriteInfoToFileTrigger,
+		SendToLoggingCacheProcessTrigger: makeSendToLoggingCacheTrigger,
+		RemoveFromManagerProcessTrigger:  makeRemoveFromManagerTrigger,
+	}
+
+	for id, factory := range processTriggers {
+		grip.EmergencyPanic(RegisterProcessTriggerFactory(id, factory))
+	}
 }
 
 func newSignalTriggerRegistry() *signalTriggerRegistry {
@@ -75,3 +89,187 @@ func (r *signalTriggerRegistry) getSignalTriggerFactory(id SignalTriggerID) (Sig
 	factory, ok := r.signalTriggers[id]
 	return factory, ok
 }
+
+// SignalTriggerIDs returns the IDs of all registered signal triggers in sorted
+// order.
+func SignalTriggerIDs() []SignalTriggerID {
+	return jasperSignalTriggerRegistry.signalTriggerIDs()
+}
+
+func (r *signalTriggerRegistry) signalTriggerIDs() []SignalTriggerID {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]SignalTriggerID, 0, len(r.signalTriggers))
+	for id := range r.signalTriggers {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// OutputTriggerFactory is a function that creates an OutputTrigger.
+type OutputTriggerFactory func() OutputTrigger
+
+type outputTriggerRegistry struct {
+	mu             sync.RWMutex
+	outputTriggers map[OutputTriggerID]OutputTriggerFactory
+}
+
+var jasperOutputTriggerRegistry *outputTriggerRegistry
+
+func newOutputTriggerRegistry() *outputTriggerRegistry {
+	return &outputTriggerRegistry{outputTriggers: map[OutputTriggerID]OutputTriggerFactory{}}
+}
+
+// RegisterOutputTriggerFactory registers a factory to create the output
+// trigger represented by the id, so that it can be referred to by the
+// TriggerID of an options.OutputTrigger.
+func RegisterOutputTriggerFactory(id OutputTriggerID, factory OutputTriggerFactory) error {
+	if err := jasperOutputTriggerRegistry.registerOutputTriggerFactory(id, factory); err != nil {
+		return fmt.Errorf("problem registering output trigger factory: %w", err)
+	}
+	return nil
+}
+
+// GetOutputTriggerFactory retrieves a factory to create the output trigger
+// represented by the id.
+func GetOutputTriggerFactory(id OutputTriggerID) (OutputTriggerFactory, bool) {
+	return jasperOutputTriggerRegistry.getOutputTriggerFactory(id)
+}
+
+func (r *outputTriggerRegistry) registerOutputTriggerFactory(id OutputTriggerID, factory OutputTriggerFactory) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if string(id) == "" {
+		return errors.New("cannot register an empty output trigger id")
+	}
+
+	if _, ok := r.outputTriggers[id]; ok {
+		return fmt.Errorf("output trigger '%s' is already registered", string(id))
+	}
+
+	if factory == nil {
+		return fmt.Errorf("cannot register a nil factory for output trigger id '%s'", string(id))
+	}
+
+	r.outputTriggers[id] = factory
+	return nil
+}
+
+func (r *outputTriggerRegistry) getOutputTriggerFactory(id OutputTriggerID) (OutputTriggerFactory, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	factory, ok := r.outputTriggers[id]
+	return factory, ok
+}
+
+// OutputTriggerIDs returns the IDs of all registered output triggers in sorted
+// order.
+func OutputTriggerIDs() []OutputTriggerID {
+	return jasperOutputTriggerRegistry.outputTriggerIDs()
+}
+
+func (r *outputTriggerRegistry) outputTriggerIDs() []OutputTriggerID {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]OutputTriggerID, 0, len(r.outputTriggers))
+	for id := range r.outputTriggers {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// ProcessTriggerFactory is a function that creates a ProcessTrigger for a
+// process created by the manager, configured by the parameters. The manager
+// is nil if the process was not created by a manager. It returns an error
+// if the parameters are invalid.
+type ProcessTriggerFactory func(m Manager, params map[string]string) (ProcessTrigger, error)
+
+type processTriggerRegistry struct {
+	mu              sync.RWMutex
+	processTriggers map[ProcessTriggerID]ProcessTriggerFactory
+}
+
+var jasperProcessTriggerRegistry *processTriggerRegistry
+
+func newProcessTriggerRegistry() *processTriggerRegistry {
+	return &processTriggerRegistry{processTriggers: map[ProcessTriggerID]ProcessTriggerFactory{}}
+}
+
+// RegisterProcessTriggerFactory registers a factory to create the process
+// trigger represented by the id.
+func RegisterProcessTriggerFactory(id ProcessTriggerID, factory ProcessTriggerFactory) error {
+	if err := jasperProcessTriggerRegistry.registerProcessTriggerFactory(id, factory); err != nil {
+		return fmt.Errorf("problem registering process trigger factory: %w", err)
+	}
+	return nil
+}
+
+// GetProcessTriggerFactory retrieves a factory to create the process trigger
+// represented by the id.
+func GetProcessTriggerFactory(id ProcessTriggerID) (ProcessTriggerFactory, bool) {
+	return jasperProcessTriggerRegistry.getProcessTriggerFactory(id)
+}
+
+func (r *processTriggerRegistry) registerProcessTriggerFactory(id ProcessTriggerID, factory ProcessTriggerFactory) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if string(id) == "" {
+		return errors.New("cannot register an empty process trigger id")
+	}
+
+	if _, ok := r.processTriggers[id]; ok {
+		return fmt.Errorf("process trigger '%s' is already registered", string(id))
+	}
+
+	if factory == nil {
+		return fmt.Errorf("cannot register a nil factory for process trigger id '%s'", string(id))
+	}
+
+	r.processTriggers[id] = factory
+	return nil
+}
+
+func (r *processTriggerRegistry) getProcessTriggerFactory(id ProcessTriggerID) (ProcessTriggerFactory, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	factory, ok := r.processTriggers[id]
+	return factory, ok
+}
+
+// ProcessTriggerIDs returns the IDs of all registered process triggers in
+// sorted order.
+func ProcessTriggerIDs() []ProcessTriggerID {
+	return jasperProcessTriggerRegistry.processTriggerIDs()
+}
+
+func (r *processTriggerRegistry) processTriggerIDs() []ProcessTriggerID {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]ProcessTriggerID, 0, len(r.processTriggers))
+	for id := range r.processTriggers {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// makeProcessTrigger creates the process trigger represented by the id for
+// a process created by the manager, which may be nil.
+func makeProcessTrigger(m Manager, id ProcessTriggerID, params map[string]string) (ProcessTrigger, error) {
+	makeTrigger, ok := GetProcessTriggerFactory(id)
+	if !ok {
+		return nil, fmt.Errorf("could not find process trigger with id '%s'", id)
+	}
+	trigger, err := makeTrigger(m, params)
+	if err != nil {
+		return nil, fmt.Errorf("problem creating process trigger '%s': %w", id, err)
+	}
+	return trigger, nil
+}
@@ -2,11 +2,19 @@ package jasper
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
 	"time"
 
 	"github.com/tychoish/fun/assert"
 	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/grip/level"
+	"github.com/tychoish/grip/send"
 	"github.com/tychoish/jasper/options"
 	"github.com/tychoish/jasper/testutil"
 )
@@ -163,3 +171,199 @@ func TestDefaultTrigger(t *testing.T) {
 		})
 	}
 }
+
+func TestOutputTriggers(t *testing.T) {
+	t.Run("Registry", func(t *testing.T) {
+		r := newOutputTriggerRegistry()
+		factory := func() OutputTrigger { return func(Process, LogLine) {} }
+		check.Error(t, r.registerOutputTriggerFactory("", factory))
+		check.Error(t, r.registerOutputTriggerFactory("foo", nil))
+		assert.NotError(t, r.registerOutputTriggerFactory("foo", factory))
+		check.Error(t, r.registerOutputTriggerFactory("foo", factory))
+		assert.NotError(t, r.registerOutputTriggerFactory("bar", factory))
+
+		_, ok := r.getOutputTriggerFactory("foo")
+		check.True(t, ok)
+		_, ok = r.getOutputTriggerFactory("baz")
+		check.True(t, !ok)
+		check.EqualItems(t, r.outputTriggerIDs(), []OutputTriggerID{"bar", "foo"})
+	})
+	t.Run("CreatesProcessWithManager", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), testutil.ManagerTestTimeout)
+		defer cancel()
+
+		manager := NewManager(ManagerOptionSet(ManagerOptions{Synchronized: true}))
+		defer func() { check.NotError(t, manager.Close(ctx)) }()
+
+		opts := testutil.TrueCreateOpts()
+		opts.Args = []string{"echo", "spawn"}
+		opts.OutputTriggers = []options.OutputTrigger{{Pattern: "spawn", Create: testutil.TrueCreateOpts()}}
+		proc, err := manager.CreateProcess(ctx, opts)
+		assert.NotError(t, err)
+		_, err = proc.Wait(ctx)
+		assert.NotError(t, err)
+
+		for {
+			children, err := manager.Group(ctx, proc.ID())
+			assert.NotError(t, err)
+			if len(children) == 1 {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				t.Fatal("output trigger did not create process")
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	})
+}
+
+func TestProcessTriggers(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires a POSIX shell")
+	}
+
+	// sleepOpts returns options for a process that runs long enough for
+	// triggers to be registered before it exits.
+	sleepOpts := func() *options.Create {
+		return &options.Create{Args: []string{"sleep", "0.2"}}
+	}
+
+	t.Run("Registry", func(t *testing.T) {
+		r := newProcessTriggerRegistry()
+		factory := func(Manager, map[string]string) (ProcessTrigger, error) { return func(ProcessInfo) {}, nil }
+		check.Error(t, r.registerProcessTriggerFactory("", factory))
+		check.Error(t, r.registerProcessTriggerFactory("foo", nil))
+		assert.NotError(t, r.registerProcessTriggerFactory("foo", factory))
+		check.Error(t, r.registerProcessTriggerFactory("foo", factory))
+		assert.NotError(t, r.registerProcessTriggerFactory("bar", factory))
+
+		_, ok := r.getProcessTriggerFactory("foo")
+		check.True(t, ok)
+		_, ok = r.getProcessTriggerFactory("baz")
+		check.True(t, !ok)
+		check.EqualItems(t, r.processTriggerIDs(), []ProcessTriggerID{"bar", "foo"})
+	})
+	t.Run("BuiltinsAreRegistered", func(t *testing.T) {
+		check.EqualItems(t, ProcessTriggerIDs(), []ProcessTriggerID{
+			RemoveFromManagerProcessTrigger,
+			SendToLoggingCacheProcessTrigger,
+			WebhookOnExitProcessTrigger,
+			WriteInfoToFileProcessTrigger,
+		})
+	})
+	t.Run("BuiltinsValidateParameters", func(t *testing.T) {
+		manager := NewManager()
+		for _, id := range []ProcessTriggerID{WebhookOnExitProcessTrigger, WriteInfoToFileProcessTrigger} {
+			_, err := makeProcessTrigger(manager, id, nil)
+			check.Error(t, err)
+		}
+		for _, id := range []ProcessTriggerID{SendToLoggingCacheProcessTrigger, RemoveFromManagerProcessTrigger} {
+			_, err := makeProcessTrigger(nil, id, nil)
+			check.Error(t, err)
+		}
+		_, err := makeProcessTrigger(manager, "foo", nil)
+		check.Error(t, err)
+	})
+	t.Run("WebhookOnExit", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), testutil.ManagerTestTimeout)
+		defer cancel()
+
+		received := make(chan ProcessInfo, 1)
+		srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			var info struct {
+				ID         string `json:"id"`
+				Successful bool   `json:"successful"`
+			}
+			check.Equal(t, r.Method, http.MethodPost)
+			check.NotError(t, json.NewDecoder(r.Body).Decode(&info))
+			received <- ProcessInfo{ID: info.ID, Successful: info.Successful}
+		}))
+		defer srv.Close()
+
+		manager := NewManager(ManagerOptionSet(ManagerOptions{Synchronized: true}))
+		proc, err := manager.CreateProcess(ctx, sleepOpts())
+		assert.NotError(t, err)
+		assert.NotError(t, proc.RegisterTriggerID(ctx, WebhookOnExitProcessTrigger, map[string]string{"url": srv.URL}))
+		_, err = proc.Wait(ctx)
+		assert.NotError(t, err)
+
+		select {
+		case info := <-received:
+			check.Equal(t, info.ID, proc.ID())
+			check.True(t, info.Successful)
+		case <-ctx.Done():
+			t.Fatal("webhook was not called")
+		}
+	})
+	t.Run("WriteInfoToFile", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), testutil.ManagerTestTimeout)
+		defer cancel()
+
+		path := filepath.Join(t.TempDir(), "info.json")
+		manager := NewManager(ManagerOptionSet(ManagerOptions{Synchronized: true}))
+		proc, err := manager.CreateProcess(ctx, sleepOpts())
+		assert.NotError(t, err)
+		assert.NotError(t, proc.RegisterTriggerID(ctx, WriteInfoToFileProcessTrigger, map[string]string{"path": path}))
+		_, err = proc.Wait(ctx)
+		assert.NotError(t, err)
+
+		data, err := os.ReadFile(path)
+		assert.NotError(t, err)
+		var info struct {
+			ID       string `json:"id"`
+			Complete bool   `json:"complete"`
+		}
+		assert.NotError(t, json.Unmarshal(data, &info))
+		check.Equal(t, info.ID, proc.ID())
+		check.True(t, info.Complete)
+	})
+	t.Run("SendToLoggingCache", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), testutil.ManagerTestTimeout)
+		defer cancel()
+
+		manager := NewManager(ManagerOptionSet(ManagerOptions{Synchronized: true}))
+		sender := send.MakeInternal()
+		assert.NotError(t, manager.LoggingCache(ctx).Put("logger", &options.CachedLogger{ID: "logger", Output: sender, Error: sender}))
+
+		opts := &options.Create{Args: []string{"sh", "-c", "sleep 0.2; exit 1"}}
+		proc, err := manager.CreateProcess(ctx, opts)
+		assert.NotError(t, err)
+		assert.NotError(t, proc.RegisterTriggerID(ctx, SendToLoggingCacheProcessTrigger, map[string]string{"logger_id": "logger"}))
+		_, err = proc.Wait(ctx)
+		assert.Error(t, err)
+
+		for !sender.HasMessage() {
+			select {
+			case <-ctx.Done():
+				t.Fatal("message was not sent to logger")
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+		msg := sender.GetMessage()
+		check.Equal(t, msg.Priority, level.Error)
+		check.Substring(t, msg.Rendered, proc.ID())
+	})
+	t.Run("RemoveFromManager", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), testutil.ManagerTestTimeout)
+		defer cancel()
+
+		manager := NewManager(ManagerOptionSet(ManagerOptions{Synchronized: true}))
+		proc, err := manager.CreateProcess(ctx, sleepOpts())
+		assert.NotError(t, err)
+		assert.NotError(t, proc.RegisterTriggerID(ctx, RemoveFromManagerProcessTrigger, nil))
+		_, err = proc.Wait(ctx)
+		assert.NotError(t, err)
+
+		for {
+			if _, err := manager.Get(ctx, proc.ID()); err != nil {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				t.Fatal("process was not removed from manager")
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	})
+}
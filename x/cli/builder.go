@@ -135,6 +135,12 @@ func BuildManagerCloseCommand(basePrefix ...string) []string {
 	return append(BuildManagerCommand(basePrefix...), CloseCommand)
 }
 
+// BuildManagerSnapshotCommand is a convenience function to generate the
+// slice of strings to invoke the Jasper.Client.Manager.Snapshot subcommand.
+func BuildManagerSnapshotCommand(basePrefix ...string) []string {
+	return append(BuildManagerCommand(basePrefix...), SnapshotCommand)
+}
+
 // Jasper.Client.Process builders
 
 // BuildProcessCommand is a convenience function to generate the slice of
@@ -191,6 +197,13 @@ func BuildProcessRegisterSignalTriggerIDCommand(basePrefix ...string) []string {
 	return append(BuildProcessCommand(basePrefix...), RegisterSignalTriggerIDCommand)
 }
 
+// BuildProcessRegisterTriggerIDCommand is a convenience function to generate
+// the slice of strings to invoke the Jasper.Client.Process.RegisterTriggerID
+// subcommand.
+func BuildProcessRegisterTriggerIDCommand(basePrefix ...string) []string {
+	return append(BuildProcessCommand(basePrefix...), RegisterTriggerIDCommand)
+}
+
 // BuildProcessSignalCommand is a convenience function to generate the slice of
 // strings to invoke the Jasper.Client.Process.Signal subcommand.
 func BuildProcessSignalCommand(basePrefix ...string) []string {
@@ -203,6 +216,12 @@ func BuildProcessWaitCommand(basePrefix ...string) []string {
 	return append(BuildProcessCommand(basePrefix...), WaitCommand)
 }
 
+// BuildProcessWaitReadyCommand is a convenience function to generate the slice
+// of strings to invoke the Jasper.Client.Process.WaitReady subcommand.
+func BuildProcessWaitReadyCommand(basePrefix ...string) []string {
+	return append(BuildProcessCommand(basePrefix...), WaitReadyCommand)
+}
+
 // Jasper.Client.Remote builders
 
 // BuildRemoteCommand is a convenience function to generate the slice of strings
@@ -218,6 +237,25 @@ func BuildRemoteDownloadFileCommand(basePrefix ...string) []string {
 	return append(BuildRemoteCommand(basePrefix...), DownloadFileCommand)
 }
 
+// BuildRemoteDownloadCacheStatsCommand is a convenience function to generate
+// the slice of strings to invoke the
+// Jasper.Client.Remote.GetDownloadCacheStats subcommand.
+func BuildRemoteDownloadCacheStatsCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), DownloadCacheStatsCommand)
+}
+
+// BuildRemoteHealthCommand is a convenience function to generate the slice
+// of strings to invoke the Jasper.Client.Remote.CheckHealth subcommand.
+func BuildRemoteHealthCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), HealthCommand)
+}
+
+// BuildRemoteDrainCommand is a convenience function to generate the slice of
+// strings to invoke the Jasper.Client.Remote.Drain subcommand.
+func BuildRemoteDrainCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), DrainCommand)
+}
+
 // BuildRemoteGetLogStreamCommand is a convenience function to generate the
 // slice of strings to invoke the Jasper.Client.Remote.GetLogStream
 // subcommand.
@@ -225,6 +263,26 @@ func BuildRemoteGetLogStreamCommand(basePrefix ...string) []string {
 	return append(BuildRemoteCommand(basePrefix...), GetLogStreamCommand)
 }
 
+// BuildRemoteAddScheduleCommand is a convenience function to generate the
+// slice of strings to invoke the Jasper.Client.Remote.AddSchedule subcommand.
+func BuildRemoteAddScheduleCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), AddScheduleCommand)
+}
+
+// BuildRemoteRemoveScheduleCommand is a convenience function to generate the
+// slice of strings to invoke the Jasper.Client.Remote.RemoveSchedule
+// subcommand.
+func BuildRemoteRemoveScheduleCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), RemoveScheduleCommand)
+}
+
+// BuildRemoteListSchedulesCommand is a convenience function to generate the
+// slice of strings to invoke the Jasper.Client.Remote.ListSchedules
+// subcommand.
+func BuildRemoteListSchedulesCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), ListSchedulesCommand)
+}
+
 // BuildRemoteSignalEventCommand is a convenience function to generate the
 // slice of strings to invoke the Jasper.Client.Remote.SignalEvent
 // subcommand.
@@ -237,3 +295,58 @@ func BuildRemoteSignalEventCommand(basePrefix ...string) []string {
 func BuildRemoteWriteFileCommand(basePrefix ...string) []string {
 	return append(BuildRemoteCommand(basePrefix...), WriteFileCommand)
 }
+
+// BuildRemoteReadFileCommand is a convenience function to generate the slice
+// of strings to invoke the Jasper.Client.Remote.ReadFile subcommand.
+func BuildRemoteReadFileCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), ReadFileCommand)
+}
+
+// BuildRemoteCreateArchiveCommand is a convenience function to generate the
+// slice of strings to invoke the Jasper.Client.Remote.CreateArchive
+// subcommand.
+func BuildRemoteCreateArchiveCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), CreateArchiveCommand)
+}
+
+// BuildRemoteStatFileCommand is a convenience function to generate the slice
+// of strings to invoke the Jasper.Client.Remote.StatFile subcommand.
+func BuildRemoteStatFileCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), StatFileCommand)
+}
+
+// BuildRemoteListDirectoryCommand is a convenience function to generate the slice
+// of strings to invoke the Jasper.Client.Remote.ListDirectory subcommand.
+func BuildRemoteListDirectoryCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), ListDirectoryCommand)
+}
+
+// BuildRemoteGlobFilesCommand is a convenience function to generate the slice
+// of strings to invoke the Jasper.Client.Remote.GlobFiles subcommand.
+func BuildRemoteGlobFilesCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), GlobFilesCommand)
+}
+
+// BuildRemoteRemoveFileCommand is a convenience function to generate the slice
+// of strings to invoke the Jasper.Client.Remote.RemoveFile subcommand.
+func BuildRemoteRemoveFileCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), RemoveFileCommand)
+}
+
+// BuildRemoteRenameFileCommand is a convenience function to generate the slice
+// of strings to invoke the Jasper.Client.Remote.RenameFile subcommand.
+func BuildRemoteRenameFileCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), RenameFileCommand)
+}
+
+// BuildRemoteMakeDirectoryCommand is a convenience function to generate the slice
+// of strings to invoke the Jasper.Client.Remote.MakeDirectory subcommand.
+func BuildRemoteMakeDirectoryCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), MakeDirectoryCommand)
+}
+
+// BuildRemoteChecksumFileCommand is a convenience function to generate the slice
+// of strings to invoke the Jasper.Client.Remote.ChecksumFile subcommand.
+func BuildRemoteChecksumFileCommand(basePrefix ...string) []string {
+	return append(BuildRemoteCommand(basePrefix...), ChecksumFileCommand)
+}
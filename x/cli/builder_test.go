@@ -46,8 +46,10 @@ func TestBuildCommands(t *testing.T) {
 		{subcommand: []string{binary, JasperCommand, ClientCommand, ProcessCommand, ResetTagsCommand}, buildSubcommand: BuildProcessResetTagsCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, ProcessCommand, RespawnCommand}, buildSubcommand: BuildProcessRespawnCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, ProcessCommand, RegisterSignalTriggerIDCommand}, buildSubcommand: BuildProcessRegisterSignalTriggerIDCommand},
+		{subcommand: []string{binary, JasperCommand, ClientCommand, ProcessCommand, RegisterTriggerIDCommand}, buildSubcommand: BuildProcessRegisterTriggerIDCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, ProcessCommand, SignalCommand}, buildSubcommand: BuildProcessSignalCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, ProcessCommand, WaitCommand}, buildSubcommand: BuildProcessWaitCommand},
+		{subcommand: []string{binary, JasperCommand, ClientCommand, ProcessCommand, WaitReadyCommand}, buildSubcommand: BuildProcessWaitReadyCommand},
 
 		{subcommand: []string{binary, JasperCommand, ClientCommand, RemoteCommand}, buildSubcommand: BuildRemoteCommand},
 		{subcommand: []string{binary, JasperCommand, ClientCommand, RemoteCommand, DownloadFileCommand}, buildSubcommand: BuildRemoteDownloadFileCommand},
@@ -10,8 +10,14 @@ const (
 
 	hostFlagName          = "host"
 	portFlagName          = "port"
+	socketFlagName        = "socket"
 	credsFilePathFlagName = "creds_path"
 
+	requireClientCertFlagName = "require_client_cert"
+
+	authConfigFilePathFlagName = "auth_config_path"
+	authCredsFilePathFlagName  = "auth_creds_path"
+
 	defaultLocalHostName = "localhost"
 )
 
@@ -27,6 +33,7 @@ func Jasper() *cli.Command {
 			Client(),
 			Service(),
 			RunCMD(),
+			ExecCMD(),
 			ListCMD(),
 			ClearCMD(),
 			KillCMD(),
@@ -47,13 +47,21 @@ func clientFlags() []cli.Flag {
 			Name:  portFlagName,
 			Usage: fmt.Sprintf("the port running the Jasper service (if service is '%s', default port is %d; if service is '%s', default port is %d)", RESTService, defaultRESTPort, RPCService, defaultRPCPort),
 		},
+		&cli.StringFlag{
+			Name:  socketFlagName,
+			Usage: "the path to the unix domain socket of the Jasper service, which is used instead of the host and port",
+		},
 		&cli.StringFlag{
 			Name:  joinFlagNames(serviceFlagName, "s"),
 			Usage: fmt.Sprintf("the type of Jasper service ('%s' or '%s')", RESTService, RPCService),
 		},
 		&cli.StringFlag{
 			Name:  credsFilePathFlagName,
-			Usage: "the path to the file containing the credentials",
+			Usage: "the path to the file containing the TLS credentials",
+		},
+		&cli.StringFlag{
+			Name:  authCredsFilePathFlagName,
+			Usage: "the path to the file containing the token or signing key used to authenticate requests",
 		},
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"os/signal"
 	"strings"
 	"time"
 
@@ -215,6 +216,158 @@ func printLogs(ctx context.Context, client remote.Manager, cmd *jasper.Command,
 	return cmd.Wait(ctx)
 }
 
+// ExecCMD provides an interactive session with a command on a remote
+// instance, attaching the terminal to its standard input and output.
+// Alternatively, it runs the workflow described by a task file, either with a
+// Jasper service or locally.
+func ExecCMD() *cli.Command {
+	const (
+		envFlagName   = "env"
+		dirFlagName   = "dir"
+		ttyFlagName   = "tty"
+		fileFlagName  = "file"
+		localFlagName = "local"
+	)
+
+	return &cli.Command{
+		Name:      "exec",
+		Usage:     "run a command interactively with Jasper service, propagating the exit code from the process, or run the steps of a task file",
+		ArgsUsage: "<command> [args...]",
+		Flags: append(clientFlags(),
+			&cli.StringFlag{
+				Name:    fileFlagName,
+				Aliases: []string{"f"},
+				Usage:   "run the steps of the task file at this path, which is YAML if it has a '.yaml' or '.yml' extension and JSON otherwise",
+			},
+			&cli.BoolFlag{
+				Name:  localFlagName,
+				Usage: "run the task file with a local manager instead of a Jasper service",
+			},
+			&cli.StringSliceFlag{
+				Name:  envFlagName,
+				Usage: "specify environment variables, in '<key>=<val>' forms. may specify more than once",
+			},
+			&cli.StringFlag{
+				Name:  dirFlagName,
+				Usage: "specify the working directory of the command",
+			},
+			&cli.BoolFlag{
+				Name:  joinFlagNames(ttyFlagName, "t"),
+				Usage: "run the command in a pseudo-terminal, putting the local terminal in raw mode",
+			},
+		),
+		Before: func(ctx context.Context, c *cli.Command) (context.Context, error) {
+			if c.String(fileFlagName) != "" {
+				if c.NArg() != 0 {
+					return ctx, errors.New("cannot specify both a task file and a command")
+				}
+				if c.Bool(localFlagName) {
+					return ctx, nil
+				}
+				return clientBefore()(ctx, c)
+			}
+			if c.Bool(localFlagName) {
+				return ctx, errors.New("can only run a task file locally")
+			}
+			return mergeBeforeFuncs(
+				clientBefore(),
+				func(ctx context.Context, c *cli.Command) (context.Context, error) {
+					if c.NArg() == 0 {
+						return ctx, errors.New("must specify a command")
+					}
+					return ctx, nil
+				})(ctx, c)
+		},
+		Action: func(ctx context.Context, c *cli.Command) error {
+			if path := c.String(fileFlagName); path != "" {
+				tf, err := readTaskFile(path)
+				if err != nil {
+					return err
+				}
+				if c.Bool(localFlagName) {
+					return runTaskFileLocally(ctx, tf)
+				}
+				return withConnection(ctx, c, func(client remote.Manager) error {
+					return runTaskFileRemotely(ctx, client, tf)
+				})
+			}
+
+			opts := &roptions.Exec{
+				Create: &options.Create{
+					Args:             c.Args().Slice(),
+					WorkingDirectory: c.String(dirFlagName),
+				},
+				TTY: c.Bool(ttyFlagName),
+			}
+			for _, e := range c.StringSlice(envFlagName) {
+				parts := strings.SplitN(e, "=", 2)
+				if len(parts) != 2 {
+					return fmt.Errorf("environment variable '%s' is not in the form '<key>=<val>'", e)
+				}
+				opts.Create.AddEnvVar(parts[0], parts[1])
+			}
+
+			return withConnection(ctx, c, func(client remote.Manager) error {
+				exitCode, err := runExecSession(ctx, client, opts)
+				if exitCode < 0 {
+					return err
+				}
+				if exitCode != 0 {
+					os.Exit(exitCode)
+				}
+				return nil
+			})
+		},
+	}
+}
+
+// runExecSession runs the interactive session, attaching it to the standard
+// input and output of the current process, and returns the exit code of the
+// remote process.
+func runExecSession(ctx context.Context, client remote.Manager, opts *roptions.Exec) (int, error) {
+	stdin := int(os.Stdin.Fd())
+	tty := opts.TTY && isTerminal(stdin)
+	if tty {
+		if size, err := getWindowSize(stdin); err == nil {
+			opts.WindowSize = size
+		}
+		restore, err := makeRawTerminal(stdin)
+		if err != nil {
+			return -1, fmt.Errorf("problem setting up terminal: %w", err)
+		}
+		defer func() { grip.Warning(message.WrapError(restore(), "problem restoring terminal")) }()
+	}
+
+	session, err := client.Exec(ctx, opts)
+	if err != nil {
+		return -1, fmt.Errorf("problem starting session: %w", err)
+	}
+	defer func() { grip.Debug(message.WrapError(session.Close(), "problem closing session")) }()
+
+	if tty {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		resized := make(chan os.Signal, 1)
+		notifyWindowResize(resized)
+		defer signal.Stop(resized)
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-resized:
+					if size, err := getWindowSize(stdin); err == nil {
+						grip.Debug(message.WrapError(session.Resize(size), "problem resizing terminal"))
+					}
+				}
+			}
+		}()
+	}
+
+	return remote.AttachExecSession(ctx, session, os.Stdin, os.Stdout, os.Stderr)
+}
+
 // ListCMD provides a user interface to inspect processes managed by a
 // jasper instance and their state. The output of the command is a
 // human-readable table.
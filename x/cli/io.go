@@ -10,6 +10,7 @@ import (
 	"github.com/tychoish/fun/ers"
 	"github.com/tychoish/jasper"
 	"github.com/tychoish/jasper/options"
+	roptions "github.com/tychoish/jasper/x/remote/options"
 )
 
 const (
@@ -181,7 +182,8 @@ func ExtractWaitResponse(input []byte) (WaitResponse, error) {
 // outcome and the service status.
 type ServiceStatusResponse struct {
 	OutcomeResponse `json:"outcome"`
-	Status          ServiceStatus `json:"status,omitempty"`
+	Status          ServiceStatus          `json:"status,omitempty"`
+	Health          *roptions.HealthReport `json:"health,omitempty"`
 }
 
 // ExtractServiceStatusResponse unmarshals the input bytes into a
@@ -245,6 +247,158 @@ func ExtractIDResponse(input []byte) (IDResponse, error) {
 	return resp, resp.successOrError()
 }
 
+// SnapshotResponse represents CLI-specific output containing a snapshot of
+// the state of a manager.
+type SnapshotResponse struct {
+	OutcomeResponse `json:"outcome"`
+	Snapshot        roptions.Snapshot `json:"snapshot"`
+}
+
+// ExtractSnapshotResponse unmarshals the input bytes into a SnapshotResponse
+// and checks if the request was successful.
+func ExtractSnapshotResponse(input []byte) (SnapshotResponse, error) {
+	resp := SnapshotResponse{}
+	if err := json.Unmarshal(input, &resp); err != nil {
+		return resp, fmt.Errorf("%s: %w", unmarshalFailed, err)
+	}
+	return resp, resp.successOrError()
+}
+
+// FileInfoResponse represents CLI-specific output containing information
+// about a file.
+type FileInfoResponse struct {
+	OutcomeResponse `json:"outcome"`
+	Info            roptions.FileInfo `json:"info"`
+}
+
+// ExtractFileInfoResponse unmarshals the input bytes into a FileInfoResponse
+// and checks if the request was successful.
+func ExtractFileInfoResponse(input []byte) (FileInfoResponse, error) {
+	resp := FileInfoResponse{}
+	if err := json.Unmarshal(input, &resp); err != nil {
+		return resp, fmt.Errorf("%s: %w", unmarshalFailed, err)
+	}
+	return resp, resp.successOrError()
+}
+
+// FileInfosResponse represents CLI-specific output containing information
+// about multiple files.
+type FileInfosResponse struct {
+	OutcomeResponse `json:"outcome"`
+	Infos           []roptions.FileInfo `json:"infos,omitempty"`
+}
+
+// ExtractFileInfosResponse unmarshals the input bytes into a
+// FileInfosResponse and checks if the request was successful.
+func ExtractFileInfosResponse(input []byte) (FileInfosResponse, error) {
+	resp := FileInfosResponse{}
+	if err := json.Unmarshal(input, &resp); err != nil {
+		return resp, fmt.Errorf("%s: %w", unmarshalFailed, err)
+	}
+	return resp, resp.successOrError()
+}
+
+// PathsResponse represents CLI-specific output containing file paths.
+type PathsResponse struct {
+	OutcomeResponse `json:"outcome"`
+	Paths           []string `json:"paths,omitempty"`
+}
+
+// ExtractPathsResponse unmarshals the input bytes into a PathsResponse and
+// checks if the request was successful.
+func ExtractPathsResponse(input []byte) (PathsResponse, error) {
+	resp := PathsResponse{}
+	if err := json.Unmarshal(input, &resp); err != nil {
+		return resp, fmt.Errorf("%s: %w", unmarshalFailed, err)
+	}
+	return resp, resp.successOrError()
+}
+
+// ChecksumResponse represents CLI-specific output containing the checksum of
+// a file.
+type ChecksumResponse struct {
+	OutcomeResponse `json:"outcome"`
+	Checksum        string `json:"checksum,omitempty"`
+}
+
+// ExtractChecksumResponse unmarshals the input bytes into a ChecksumResponse
+// and checks if the request was successful.
+func ExtractChecksumResponse(input []byte) (ChecksumResponse, error) {
+	resp := ChecksumResponse{}
+	if err := json.Unmarshal(input, &resp); err != nil {
+		return resp, fmt.Errorf("%s: %w", unmarshalFailed, err)
+	}
+	return resp, resp.successOrError()
+}
+
+// DownloadCacheStatsResponse represents CLI-specific output containing the
+// state of the download cache.
+type DownloadCacheStatsResponse struct {
+	OutcomeResponse `json:"outcome"`
+	Stats           roptions.DownloadCacheStats `json:"stats"`
+}
+
+// ExtractDownloadCacheStatsResponse unmarshals the input bytes into a
+// DownloadCacheStatsResponse and checks if the request was successful.
+func ExtractDownloadCacheStatsResponse(input []byte) (DownloadCacheStatsResponse, error) {
+	resp := DownloadCacheStatsResponse{}
+	if err := json.Unmarshal(input, &resp); err != nil {
+		return resp, fmt.Errorf("%s: %w", unmarshalFailed, err)
+	}
+	return resp, resp.successOrError()
+}
+
+// SchedulesResponse represents CLI-specific output containing the state of
+// the schedules on a service.
+type SchedulesResponse struct {
+	OutcomeResponse `json:"outcome"`
+	Schedules       []jasper.ScheduleInfo `json:"schedules"`
+}
+
+// ExtractSchedulesResponse unmarshals the input bytes into a
+// SchedulesResponse and checks if the request was successful.
+func ExtractSchedulesResponse(input []byte) (SchedulesResponse, error) {
+	resp := SchedulesResponse{}
+	if err := json.Unmarshal(input, &resp); err != nil {
+		return resp, fmt.Errorf("%s: %w", unmarshalFailed, err)
+	}
+	return resp, resp.successOrError()
+}
+
+// HealthResponse represents CLI-specific output containing the health and
+// readiness of a service.
+type HealthResponse struct {
+	OutcomeResponse `json:"outcome"`
+	Report          roptions.HealthReport `json:"report"`
+}
+
+// ExtractHealthResponse unmarshals the input bytes into a HealthResponse and
+// checks if the request was successful.
+func ExtractHealthResponse(input []byte) (HealthResponse, error) {
+	resp := HealthResponse{}
+	if err := json.Unmarshal(input, &resp); err != nil {
+		return resp, fmt.Errorf("%s: %w", unmarshalFailed, err)
+	}
+	return resp, resp.successOrError()
+}
+
+// DrainResponse represents CLI-specific output containing the outcome of
+// draining a service.
+type DrainResponse struct {
+	OutcomeResponse `json:"outcome"`
+	Report          roptions.DrainReport `json:"report"`
+}
+
+// ExtractDrainResponse unmarshals the input bytes into a DrainResponse and
+// checks if the request was successful.
+func ExtractDrainResponse(input []byte) (DrainResponse, error) {
+	resp := DrainResponse{}
+	if err := json.Unmarshal(input, &resp); err != nil {
+		return resp, fmt.Errorf("%s: %w", unmarshalFailed, err)
+	}
+	return resp, resp.successOrError()
+}
+
 // IDInput represents CLI-specific input representing a Jasper process ID.
 type IDInput struct {
 	ID string `json:"id"`
@@ -359,6 +513,24 @@ func (in *SignalTriggerIDInput) Validate() error {
 	return nil
 }
 
+// TriggerIDInput represents CLI-specific input to attach a process trigger,
+// configured by the parameters, to a Jasper process.
+type TriggerIDInput struct {
+	ID        string                  `json:"id"`
+	TriggerID jasper.ProcessTriggerID `json:"trigger_id"`
+	Params    map[string]string       `json:"params,omitempty"`
+}
+
+// Validate checks that the TriggerIDInput has a non-empty Jasper process ID
+// and a non-empty trigger ID. The trigger ID is resolved by the service, which
+// may have registered triggers that are not known to the CLI.
+func (in *TriggerIDInput) Validate() error {
+	catcher := &erc.Collector{}
+	catcher.If(len(in.ID) == 0, ers.Error("Jasper process ID must not be empty"))
+	catcher.If(len(in.TriggerID) == 0, ers.Error("trigger ID must not be empty"))
+	return catcher.Resolve()
+}
+
 // TagIDInput represents the CLI-specific input for a process with a given tag.
 type TagIDInput struct {
 	ID  string `json:"id"`
@@ -414,6 +586,21 @@ func (in *LogStreamInput) Validate() error {
 	return nil
 }
 
+// LogFollowInput represents the CLI-specific input to follow the output of a
+// process as it is produced.
+type LogFollowInput struct {
+	ID string `json:"id"`
+	roptions.LogFollow
+}
+
+// Validate checks that the process ID is set and that the options are valid.
+func (in *LogFollowInput) Validate() error {
+	catcher := &erc.Collector{}
+	catcher.If(in.ID == "", errors.New("process ID must be specified"))
+	catcher.Push(in.LogFollow.Validate())
+	return catcher.Resolve()
+}
+
 // EventInput represents the CLI-specific input to signal a named event.
 type EventInput struct {
 	Name string `json:"name"`
@@ -427,6 +614,20 @@ func (e *EventInput) Validate() error {
 	return nil
 }
 
+// FilePathInput represents CLI-specific input representing a path, or a
+// pattern of paths, on the remote host.
+type FilePathInput struct {
+	Path string `json:"path"`
+}
+
+// Validate checks that the path is set.
+func (in *FilePathInput) Validate() error {
+	if in.Path == "" {
+		return errors.New("path cannot be empty")
+	}
+	return nil
+}
+
 // LoggingCacheCreateInput represents CLI-specific input to create a cached
 // logger.
 type LoggingCacheCreateInput struct {
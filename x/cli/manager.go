@@ -22,6 +22,7 @@ const (
 	ListCommand            = "list"
 	ClearCommand           = "clear"
 	CloseCommand           = "close"
+	SnapshotCommand        = "snapshot"
 )
 
 // Manager creates a cli.Command that interfaces with a Jasper manager. Each
@@ -41,6 +42,7 @@ func Manager() *cli.Command {
 			managerClear(),
 			managerClose(),
 			managerCreateScripting(),
+			managerSnapshot(),
 		},
 	}
 }
@@ -108,16 +110,23 @@ func managerCreateCommand() *cli.Command {
 		Action: func(ctx context.Context, c *cli.Command) error {
 			opts := &options.Command{}
 			return doPassthroughInputOutput(c, opts, func(ctx context.Context, client remote.Manager) interface{} {
-				cmd := client.CreateCommand(ctx).Extend(opts.Commands).
-					Background(opts.RunBackground).
+				cmd := client.CreateCommand(ctx)
+				for idx, args := range opts.Commands {
+					cmd = cmd.Pipeline(append([][]string{args}, opts.Pipelines[idx]...)...)
+				}
+				cmd = cmd.Background(opts.RunBackground).
 					ContinueOnError(opts.ContinueOnError).
 					IgnoreError(opts.IgnoreError).
 					Sudo(opts.Sudo).
 					ApplyFromOpts(&opts.Process).
-					Priority(opts.Priority)
+					Priority(opts.Priority).
+					Retry(opts.Retry)
 				if opts.SudoUser != "" {
 					cmd = cmd.SudoAs(opts.SudoUser)
 				}
+				for idx, retry := range opts.StepRetry {
+					cmd = cmd.RetryStep(idx, retry)
+				}
 				return makeOutcomeResponse(cmd.Run(ctx))
 			})
 		},
@@ -200,6 +209,25 @@ func managerClear() *cli.Command {
 	}
 }
 
+// managerSnapshot writes the state of the manager as a snapshot that can be
+// imported into another manager.
+func managerSnapshot() *cli.Command {
+	return &cli.Command{
+		Name:   SnapshotCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			return doPassthroughOutput(c, func(ctx context.Context, client remote.Manager) interface{} {
+				snapshot, err := client.Snapshot(ctx)
+				if err != nil {
+					return &SnapshotResponse{OutcomeResponse: *makeOutcomeResponse(err)}
+				}
+				return &SnapshotResponse{Snapshot: snapshot, OutcomeResponse: *makeOutcomeResponse(nil)}
+			})
+		},
+	}
+}
+
 func managerClose() *cli.Command {
 	return &cli.Command{
 		Name:   CloseCommand,
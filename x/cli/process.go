@@ -15,6 +15,7 @@ const (
 	InfoCommand                    = "info"
 	CompleteCommand                = "complete"
 	RegisterSignalTriggerIDCommand = "register-signal-trigger-id"
+	RegisterTriggerIDCommand       = "register-trigger-id"
 	RespawnCommand                 = "respawn"
 	RunningCommand                 = "running"
 	SignalCommand                  = "signal"
@@ -22,6 +23,7 @@ const (
 	GetTagsCommand                 = "get-tags"
 	ResetTagsCommand               = "reset-tags"
 	WaitCommand                    = "wait"
+	WaitReadyCommand               = "wait-ready"
 )
 
 // Process creates a cli.Command that interfaces with a Jasper process. Due to
@@ -39,8 +41,10 @@ func Process() *cli.Command {
 			processResetTags(),
 			processRespawn(),
 			processRegisterSignalTriggerID(),
+			processRegisterTriggerID(),
 			processSignal(),
 			processWait(),
+			processWaitReady(),
 		},
 	}
 }
@@ -139,6 +143,24 @@ func processWait() *cli.Command {
 	}
 }
 
+func processWaitReady() *cli.Command {
+	return &cli.Command{
+		Name:   WaitReadyCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			input := &IDInput{}
+			return doPassthroughInputOutput(c, input, func(ctx context.Context, client remote.Manager) interface{} {
+				proc, err := client.Get(ctx, input.ID)
+				if err != nil {
+					return makeOutcomeResponse(fmt.Errorf("error finding process with id '%s': %w", input.ID, err))
+				}
+				return makeOutcomeResponse(proc.WaitReady(ctx))
+			})
+		},
+	}
+}
+
 func processRespawn() *cli.Command {
 	return &cli.Command{
 		Name:   RespawnCommand,
@@ -182,6 +204,27 @@ func processRegisterSignalTriggerID() *cli.Command {
 	}
 }
 
+func processRegisterTriggerID() *cli.Command {
+	return &cli.Command{
+		Name:   RegisterTriggerIDCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			input := &TriggerIDInput{}
+			return doPassthroughInputOutput(c, input, func(ctx context.Context, client remote.Manager) interface{} {
+				proc, err := client.Get(ctx, input.ID)
+				if err != nil {
+					return makeOutcomeResponse(fmt.Errorf("error finding process with id '%s': %w", input.ID, err))
+				}
+				if err := proc.RegisterTriggerID(ctx, input.TriggerID, input.Params); err != nil {
+					return makeOutcomeResponse(fmt.Errorf("couldn't register trigger with id '%s' on process with id '%s': %w", input.TriggerID, input.ID, err))
+				}
+				return makeOutcomeResponse(nil)
+			})
+		},
+	}
+}
+
 func processTag() *cli.Command {
 	return &cli.Command{
 		Name:   TagCommand,
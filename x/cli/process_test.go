@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"encoding/json"
+	"path/filepath"
 	"testing"
 
 	"github.com/tychoish/fun/assert"
@@ -112,6 +113,20 @@ func TestCLIProcess(t *testing.T) {
 					assert.NotError(t, err)
 					assert.Error(t, execCLICommandInputOutput(t, processWait(), []string{string(input)}, &WaitResponse{}))
 				},
+				"WaitReadyWithExistingIDSucceeds": func(ctx context.Context, t *testing.T, c *cli.Command, jasperProcID string) {
+					input, err := json.Marshal(IDInput{jasperProcID})
+					assert.NotError(t, err)
+					resp := &OutcomeResponse{}
+					assert.NotError(t, execCLICommandInputOutput(t, processWaitReady(), []string{string(input)}, resp))
+					assert.True(t, resp.Successful())
+				},
+				"WaitReadyWithNonexistentIDFails": func(ctx context.Context, t *testing.T, c *cli.Command, jasperProcID string) {
+					input, err := json.Marshal(IDInput{nonexistentID})
+					assert.NotError(t, err)
+					resp := &OutcomeResponse{}
+					assert.NotError(t, execCLICommandInputOutput(t, processWaitReady(), []string{string(input)}, resp))
+					assert.True(t, !resp.Successful())
+				},
 				"Respawn": func(ctx context.Context, t *testing.T, c *cli.Command, jasperProcID string) {
 					input, err := json.Marshal(IDInput{jasperProcID})
 					assert.NotError(t, err)
@@ -128,6 +143,24 @@ func TestCLIProcess(t *testing.T) {
 					assert.NotError(t, execCLICommandInputOutput(t, processRegisterSignalTriggerID(), []string{string(input)}, resp))
 					assert.True(t, resp.Successful())
 				},
+				"RegisterTriggerID": func(ctx context.Context, t *testing.T, c *cli.Command, jasperProcID string) {
+					input, err := json.Marshal(TriggerIDInput{
+						ID:        jasperProcID,
+						TriggerID: jasper.WriteInfoToFileProcessTrigger,
+						Params:    map[string]string{"path": filepath.Join(t.TempDir(), "info.json")},
+					})
+					assert.NotError(t, err)
+					resp := &OutcomeResponse{}
+					assert.NotError(t, execCLICommandInputOutput(t, processRegisterTriggerID(), []string{string(input)}, resp))
+					assert.True(t, resp.Successful())
+				},
+				"RegisterTriggerIDWithInvalidParamsFails": func(ctx context.Context, t *testing.T, c *cli.Command, jasperProcID string) {
+					input, err := json.Marshal(TriggerIDInput{ID: jasperProcID, TriggerID: jasper.WriteInfoToFileProcessTrigger})
+					assert.NotError(t, err)
+					resp := &OutcomeResponse{}
+					assert.NotError(t, execCLICommandInputOutput(t, processRegisterTriggerID(), []string{string(input)}, resp))
+					assert.True(t, !resp.Successful())
+				},
 				"Tag": func(ctx context.Context, t *testing.T, c *cli.Command, jasperProcID string) {
 					assert.True(t, tagProcess(t, jasperProcID, "foo").Successful())
 				},
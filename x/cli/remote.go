@@ -2,7 +2,12 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
 
+	"github.com/tychoish/jasper"
 	"github.com/tychoish/jasper/options"
 	"github.com/tychoish/jasper/x/remote"
 	roptions "github.com/tychoish/jasper/x/remote/options"
@@ -11,14 +16,31 @@ import (
 
 // Constants representing the Jasper RemoteClient interface as CLI commands.
 const (
-	RemoteCommand       = "remote"
-	DownloadFileCommand = "download-file"
-	GetLogStreamCommand = "get-log-stream"
-	SignalEventCommand  = "signal-event"
-	WriteFileCommand    = "write-file"
-	SendMessagesCommand = "send-messages"
+	RemoteCommand             = "remote"
+	DownloadFileCommand       = "download-file"
+	DownloadCacheStatsCommand = "download-cache-stats"
+	HealthCommand             = "health"
+	DrainCommand              = "drain"
+	AddScheduleCommand        = "add-schedule"
+	RemoveScheduleCommand     = "remove-schedule"
+	ListSchedulesCommand      = "list-schedules"
+	GetLogStreamCommand       = "get-log-stream"
+	SignalEventCommand        = "signal-event"
+	WriteFileCommand          = "write-file"
+	SendMessagesCommand       = "send-messages"
+	ReadFileCommand           = "read-file"
+	StatFileCommand           = "stat-file"
+	ListDirectoryCommand      = "list-directory"
+	GlobFilesCommand          = "glob-files"
+	RemoveFileCommand         = "remove-file"
+	RenameFileCommand         = "rename-file"
+	MakeDirectoryCommand      = "make-directory"
+	ChecksumFileCommand       = "checksum-file"
+	CreateArchiveCommand      = "create-archive"
 )
 
+const followFlagName = "follow"
+
 // Remote creates a cli.Command that allows the remote-specific methods in the
 // RemoteClient interface except for CloseClient, for which there is no CLI
 // equivalent.
@@ -27,10 +49,25 @@ func Remote() *cli.Command {
 		Name: RemoteCommand,
 		Commands: []*cli.Command{
 			remoteDownloadFile(),
+			remoteDownloadCacheStats(),
+			remoteHealth(),
+			remoteDrain(),
+			remoteAddSchedule(),
+			remoteRemoveSchedule(),
+			remoteListSchedules(),
 			remoteGetLogStream(),
 			remoteSignalEvent(),
 			remoteWriteFile(),
 			remoteSendMessages(),
+			remoteReadFile(),
+			remoteStatFile(),
+			remoteListDirectory(),
+			remoteGlobFiles(),
+			remoteRemoveFile(),
+			remoteRenameFile(),
+			remoteMakeDirectory(),
+			remoteChecksumFile(),
+			remoteCreateArchive(),
 		},
 	}
 }
@@ -63,12 +100,86 @@ func remoteDownloadFile() *cli.Command {
 	}
 }
 
-func remoteGetLogStream() *cli.Command {
+func remoteDownloadCacheStats() *cli.Command {
 	return &cli.Command{
-		Name:   GetLogStreamCommand,
+		Name:   DownloadCacheStatsCommand,
 		Flags:  clientFlags(),
 		Before: clientBefore(),
 		Action: func(ctx context.Context, c *cli.Command) error {
+			return doPassthroughOutput(c, func(ctx context.Context, client remote.Manager) interface{} {
+				stats, err := client.GetDownloadCacheStats(ctx)
+				if err != nil {
+					return &DownloadCacheStatsResponse{OutcomeResponse: *makeOutcomeResponse(err)}
+				}
+				return &DownloadCacheStatsResponse{Stats: stats, OutcomeResponse: *makeOutcomeResponse(nil)}
+			})
+		},
+	}
+}
+
+func remoteHealth() *cli.Command {
+	return &cli.Command{
+		Name:   HealthCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			return doPassthroughOutput(c, func(ctx context.Context, client remote.Manager) interface{} {
+				report, err := client.CheckHealth(ctx)
+				if err != nil {
+					return &HealthResponse{OutcomeResponse: *makeOutcomeResponse(err)}
+				}
+				return &HealthResponse{Report: report, OutcomeResponse: *makeOutcomeResponse(nil)}
+			})
+		},
+	}
+}
+
+// remoteDrain drains the service. Since draining waits for the running
+// processes to finish, the request is not limited by the usual client
+// connection timeout.
+func remoteDrain() *cli.Command {
+	return &cli.Command{
+		Name:   DrainCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			input := roptions.Drain{}
+			if err := readInput(os.Stdin, &input); err != nil {
+				return fmt.Errorf("error reading from standard input: %w", err)
+			}
+			if err := input.Validate(); err != nil {
+				return fmt.Errorf("input is invalid: %w", err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), drainTimeout(input))
+			defer cancel()
+
+			return withConnection(ctx, c, func(client remote.Manager) error {
+				report, err := client.Drain(ctx, input)
+				if err != nil {
+					return writeOutput(os.Stdout, &DrainResponse{OutcomeResponse: *makeOutcomeResponse(err)})
+				}
+				return writeOutput(os.Stdout, &DrainResponse{Report: report, OutcomeResponse: *makeOutcomeResponse(nil)})
+			})
+		},
+	}
+}
+
+func remoteGetLogStream() *cli.Command {
+	return &cli.Command{
+		Name: GetLogStreamCommand,
+		Flags: append(clientFlags(),
+			&cli.BoolFlag{
+				Name:  followFlagName,
+				Usage: "stream each line of output as a JSON object as it is produced until the process exits",
+			},
+		),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			if c.Bool(followFlagName) {
+				return followLogStream(ctx, c)
+			}
+
 			input := LogStreamInput{}
 			return doPassthroughInputOutput(c, &input, func(ctx context.Context, client remote.Manager) interface{} {
 				logs, err := client.GetLogStream(ctx, input.ID, input.Count)
@@ -81,6 +192,25 @@ func remoteGetLogStream() *cli.Command {
 	}
 }
 
+// followLogStream follows the output of the process given by the input,
+// writing each line to standard output as a JSON object.
+func followLogStream(ctx context.Context, c *cli.Command) error {
+	input := LogFollowInput{}
+	if err := readInput(os.Stdin, &input); err != nil {
+		return fmt.Errorf("error reading from standard input: %w", err)
+	}
+	if err := input.Validate(); err != nil {
+		return fmt.Errorf("input is invalid: %w", err)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	return withConnection(ctx, c, func(client remote.Manager) error {
+		return client.FollowLogStream(ctx, input.ID, input.LogFollow, func(line jasper.LogLine) error {
+			return enc.Encode(line)
+		})
+	})
+}
+
 func remoteSignalEvent() *cli.Command {
 	return &cli.Command{
 		Name:   SignalEventCommand,
@@ -98,6 +228,51 @@ func remoteSignalEvent() *cli.Command {
 	}
 }
 
+func remoteAddSchedule() *cli.Command {
+	return &cli.Command{
+		Name:   AddScheduleCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			input := options.Schedule{}
+			return doPassthroughInputOutput(c, &input, func(ctx context.Context, client remote.Manager) interface{} {
+				return makeOutcomeResponse(client.AddSchedule(ctx, input))
+			})
+		},
+	}
+}
+
+func remoteRemoveSchedule() *cli.Command {
+	return &cli.Command{
+		Name:   RemoveScheduleCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			input := IDInput{}
+			return doPassthroughInputOutput(c, &input, func(ctx context.Context, client remote.Manager) interface{} {
+				return makeOutcomeResponse(client.RemoveSchedule(ctx, input.ID))
+			})
+		},
+	}
+}
+
+func remoteListSchedules() *cli.Command {
+	return &cli.Command{
+		Name:   ListSchedulesCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			return doPassthroughOutput(c, func(ctx context.Context, client remote.Manager) interface{} {
+				schedules, err := client.ListSchedules(ctx)
+				if err != nil {
+					return &SchedulesResponse{OutcomeResponse: *makeOutcomeResponse(err)}
+				}
+				return &SchedulesResponse{Schedules: schedules, OutcomeResponse: *makeOutcomeResponse(nil)}
+			})
+		},
+	}
+}
+
 func remoteSendMessages() *cli.Command {
 	return &cli.Command{
 		Name:   SendMessagesCommand,
@@ -114,3 +289,181 @@ func remoteSendMessages() *cli.Command {
 		},
 	}
 }
+
+// remoteReadFile writes the contents of the file to standard output as they
+// are read, rather than as a JSON response.
+func remoteReadFile() *cli.Command {
+	return &cli.Command{
+		Name:   ReadFileCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			input := roptions.ReadFile{}
+			if err := readInput(os.Stdin, &input); err != nil {
+				return fmt.Errorf("error reading from standard input: %w", err)
+			}
+			if err := input.Validate(); err != nil {
+				return fmt.Errorf("input is invalid: %w", err)
+			}
+
+			return withConnection(ctx, c, func(client remote.Manager) error {
+				file, err := client.ReadFile(ctx, input)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+
+				if _, err = io.Copy(os.Stdout, file); err != nil {
+					return fmt.Errorf("problem writing file contents: %w", err)
+				}
+				return nil
+			})
+		},
+	}
+}
+
+// remoteCreateArchive writes the archive to standard output as it is
+// created, rather than as a JSON response.
+func remoteCreateArchive() *cli.Command {
+	return &cli.Command{
+		Name:   CreateArchiveCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			input := roptions.CreateArchive{}
+			if err := readInput(os.Stdin, &input); err != nil {
+				return fmt.Errorf("error reading from standard input: %w", err)
+			}
+			if err := input.Validate(); err != nil {
+				return fmt.Errorf("input is invalid: %w", err)
+			}
+
+			return withConnection(ctx, c, func(client remote.Manager) error {
+				archive, err := client.CreateArchive(ctx, input)
+				if err != nil {
+					return err
+				}
+				defer archive.Close()
+
+				if _, err = io.Copy(os.Stdout, archive); err != nil {
+					return fmt.Errorf("problem writing archive: %w", err)
+				}
+				return nil
+			})
+		},
+	}
+}
+
+func remoteStatFile() *cli.Command {
+	return &cli.Command{
+		Name:   StatFileCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			input := FilePathInput{}
+			return doPassthroughInputOutput(c, &input, func(ctx context.Context, client remote.Manager) interface{} {
+				info, err := client.StatFile(ctx, input.Path)
+				if err != nil {
+					return &FileInfoResponse{OutcomeResponse: *makeOutcomeResponse(err)}
+				}
+				return &FileInfoResponse{Info: info, OutcomeResponse: *makeOutcomeResponse(nil)}
+			})
+		},
+	}
+}
+
+func remoteListDirectory() *cli.Command {
+	return &cli.Command{
+		Name:   ListDirectoryCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			input := roptions.ListDirectory{}
+			return doPassthroughInputOutput(c, &input, func(ctx context.Context, client remote.Manager) interface{} {
+				infos, err := client.ListDirectory(ctx, input)
+				if err != nil {
+					return &FileInfosResponse{OutcomeResponse: *makeOutcomeResponse(err)}
+				}
+				return &FileInfosResponse{Infos: infos, OutcomeResponse: *makeOutcomeResponse(nil)}
+			})
+		},
+	}
+}
+
+func remoteGlobFiles() *cli.Command {
+	return &cli.Command{
+		Name:   GlobFilesCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			input := FilePathInput{}
+			return doPassthroughInputOutput(c, &input, func(ctx context.Context, client remote.Manager) interface{} {
+				paths, err := client.GlobFiles(ctx, input.Path)
+				if err != nil {
+					return &PathsResponse{OutcomeResponse: *makeOutcomeResponse(err)}
+				}
+				return &PathsResponse{Paths: paths, OutcomeResponse: *makeOutcomeResponse(nil)}
+			})
+		},
+	}
+}
+
+func remoteRemoveFile() *cli.Command {
+	return &cli.Command{
+		Name:   RemoveFileCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			input := roptions.RemoveFile{}
+			return doPassthroughInputOutput(c, &input, func(ctx context.Context, client remote.Manager) interface{} {
+				return makeOutcomeResponse(client.RemoveFile(ctx, input))
+			})
+		},
+	}
+}
+
+func remoteRenameFile() *cli.Command {
+	return &cli.Command{
+		Name:   RenameFileCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			input := roptions.RenameFile{}
+			return doPassthroughInputOutput(c, &input, func(ctx context.Context, client remote.Manager) interface{} {
+				return makeOutcomeResponse(client.RenameFile(ctx, input))
+			})
+		},
+	}
+}
+
+func remoteMakeDirectory() *cli.Command {
+	return &cli.Command{
+		Name:   MakeDirectoryCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			input := roptions.MakeDirectory{}
+			return doPassthroughInputOutput(c, &input, func(ctx context.Context, client remote.Manager) interface{} {
+				return makeOutcomeResponse(client.MakeDirectory(ctx, input))
+			})
+		},
+	}
+}
+
+func remoteChecksumFile() *cli.Command {
+	return &cli.Command{
+		Name:   ChecksumFileCommand,
+		Flags:  clientFlags(),
+		Before: clientBefore(),
+		Action: func(ctx context.Context, c *cli.Command) error {
+			input := roptions.FileChecksum{}
+			return doPassthroughInputOutput(c, &input, func(ctx context.Context, client remote.Manager) interface{} {
+				checksum, err := client.ChecksumFile(ctx, input)
+				if err != nil {
+					return &ChecksumResponse{OutcomeResponse: *makeOutcomeResponse(err)}
+				}
+				return &ChecksumResponse{Checksum: checksum, OutcomeResponse: *makeOutcomeResponse(nil)}
+			})
+		},
+	}
+}
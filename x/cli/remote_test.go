@@ -60,6 +60,38 @@ func TestCLIRemote(t *testing.T) {
 
 					check.True(t, resp.Successful())
 				},
+				"GetLogStreamFollowSucceeds": func(ctx context.Context, t *testing.T, c *cli.Command) {
+					opts := &options.Create{Args: []string{"sh", "-c", "echo foo; echo bar"}}
+					createInput, err := json.Marshal(opts)
+					assert.NotError(t, err)
+					createResp := &InfoResponse{}
+					assert.NotError(t, execCLICommandInputOutput(t, managerCreateProcess(), []string{string(createInput)}, createResp))
+
+					input, err := json.Marshal(LogFollowInput{ID: createResp.Info.ID})
+					assert.NotError(t, err)
+					assert.NotError(t, withMockStdin(t, string(input), func(*os.File) error {
+						return withMockStdout(t, func(stdout *os.File) error {
+							if err := remoteGetLogStream().Run(ctx, []string{remoteGetLogStream().Name, "--" + followFlagName}); err != nil {
+								return err
+							}
+							if _, err := stdout.Seek(0, 0); err != nil {
+								return err
+							}
+
+							lines := []string{}
+							dec := json.NewDecoder(stdout)
+							for dec.More() {
+								line := jasper.LogLine{}
+								if err := dec.Decode(&line); err != nil {
+									return err
+								}
+								lines = append(lines, line.Line)
+							}
+							check.EqualItems(t, lines, []string{"foo", "bar"})
+							return nil
+						})
+					}))
+				},
 				"WriteFileSucceeds": func(ctx context.Context, t *testing.T, c *cli.Command) {
 					tmpFile, err := os.CreateTemp(testutil.BuildDirectory(), "write_file")
 					assert.NotError(t, err)
@@ -2,12 +2,14 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/evergreen-ci/service"
 
@@ -17,7 +19,11 @@ import (
 	"github.com/tychoish/grip/message"
 	"github.com/tychoish/grip/recovery"
 	"github.com/tychoish/grip/x/splunk"
+	"github.com/tychoish/jasper"
 	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/util"
+	"github.com/tychoish/jasper/x/remote"
+	roptions "github.com/tychoish/jasper/x/remote/options"
 	jsplunk "github.com/tychoish/jasper/x/splunk"
 	"github.com/urfave/cli/v3"
 )
@@ -59,6 +65,17 @@ const (
 	splunkTokenFilePathFlagName = "splunk_token_path"
 	splunkChannelFlagName       = "splunk_channel"
 
+	downloadCachePathFlagName = "download_cache_path"
+	downloadCacheSizeFlagName = "download_cache_size"
+
+	maxProcessesFlagName       = "max_processes"
+	loggingCacheMaxAgeFlagName = "logging_cache_max_age"
+
+	drainFlagName            = "drain"
+	drainTimeoutFlagName     = "drain_timeout"
+	drainTerminateFlagName   = "drain_terminate"
+	drainGracePeriodFlagName = "drain_grace_period"
+
 	// Flags related to resource limits.
 	limitNumFilesFlagName      = "limit_num_files"
 	limitNumProcsFlagName      = "limit_num_procs"
@@ -153,6 +170,44 @@ func serviceFlags() []cli.Flag {
 			Usage:   "the splunk channel",
 			Sources: cli.EnvVars("GRIP_SPLUNK_CHANNEL"),
 		},
+		&cli.StringFlag{
+			Name:  authConfigFilePathFlagName,
+			Usage: "the path to the file containing the configuration used to authenticate and authorize requests",
+		},
+		&cli.StringFlag{
+			Name:  downloadCachePathFlagName,
+			Usage: "the absolute path to the directory used to cache downloaded files. If unset, downloads are not cached",
+		},
+		&cli.IntFlag{
+			Name:  downloadCacheSizeFlagName,
+			Usage: "the maximum total size of the download cache (bytes). Specify 0 for no limit",
+		},
+		&cli.IntFlag{
+			Name:  maxProcessesFlagName,
+			Usage: "the number of running processes at which the service reports that it is not ready. Specify 0 for no limit",
+		},
+		&cli.DurationFlag{
+			Name:  loggingCacheMaxAgeFlagName,
+			Usage: "remove loggers from the logging cache that have not been accessed for this long. If unset, the logging cache is not pruned",
+		},
+		&cli.BoolFlag{
+			Name:  drainFlagName,
+			Usage: "drain the service before it stops: reject new processes and wait for the running processes to finish",
+		},
+		&cli.DurationFlag{
+			Name:  drainTimeoutFlagName,
+			Usage: "the maximum time to wait for running processes to finish when draining",
+			Value: roptions.DefaultDrainTimeout,
+		},
+		&cli.BoolFlag{
+			Name:  drainTerminateFlagName,
+			Usage: "terminate the processes that are still running when the drain timeout expires",
+		},
+		&cli.DurationFlag{
+			Name:  drainGracePeriodFlagName,
+			Usage: "the time that terminated processes have to exit before they are killed when draining",
+			Value: roptions.DefaultDrainGracePeriod,
+		},
 		&cli.IntFlag{
 			Name:  limitNumFilesFlagName,
 			Usage: "the maximum number of open file descriptors. Specify -1 for no limit",
@@ -247,6 +302,133 @@ func setupLogger(opts *options.LoggerConfig) error {
 	return nil
 }
 
+// makeDownloadCache returns the download cache options from the flags. It
+// returns nil if the download cache is not enabled.
+func makeDownloadCache(c *cli.Command) *roptions.DownloadCacheOptions {
+	path := c.String(downloadCachePathFlagName)
+	if path == "" {
+		return nil
+	}
+
+	return &roptions.DownloadCacheOptions{
+		Directory: path,
+		MaxSize:   int64(c.Int(downloadCacheSizeFlagName)),
+	}
+}
+
+// setupDownloadCache creates a download cache and sets it as the cache used by
+// downloads.
+func setupDownloadCache(opts *roptions.DownloadCacheOptions) error {
+	cache, err := roptions.NewDownloadCache(*opts)
+	if err != nil {
+		return fmt.Errorf("could not configure download cache: %w", err)
+	}
+	roptions.SetGlobalDownloadCache(cache)
+	return nil
+}
+
+// makeHealthManager wraps the manager so that the services report their
+// health and readiness according to the flags.
+func makeHealthManager(c *cli.Command, manager jasper.Manager) *remote.HealthManager {
+	return remote.NewHealthManager(manager, remote.HealthOptions{MaxProcesses: c.Int(maxProcessesFlagName)})
+}
+
+// makeDrainOptions returns the drain options from the flags. It returns nil if
+// the service should not be drained.
+func makeDrainOptions(c *cli.Command) *roptions.Drain {
+	if !c.Bool(drainFlagName) {
+		return nil
+	}
+
+	return &roptions.Drain{
+		Timeout:     c.Duration(drainTimeoutFlagName),
+		Terminate:   c.Bool(drainTerminateFlagName),
+		GracePeriod: c.Duration(drainGracePeriodFlagName),
+	}
+}
+
+// drainTimeoutPadding is the additional time allowed for a drain beyond the
+// time that the drain waits for processes.
+const drainTimeoutPadding = 30 * time.Second
+
+// drainTimeout returns the maximum time that a drain with the given options
+// can take.
+func drainTimeout(opts roptions.Drain) time.Duration {
+	timeout := opts.Timeout + drainTimeoutPadding
+	if opts.Terminate {
+		timeout += opts.GracePeriod
+	}
+	return timeout
+}
+
+// drainBeforeClose wraps the function that creates a service so that the
+// manager is drained before the service is closed. If the drain options are
+// nil, the service is closed without draining.
+func drainBeforeClose(makeService func(context.Context) (util.CloseFunc, error), manager jasper.Manager, opts *roptions.Drain) func(context.Context) (util.CloseFunc, error) {
+	if opts == nil {
+		return makeService
+	}
+
+	return func(ctx context.Context) (util.CloseFunc, error) {
+		closeService, err := makeService(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() error {
+			catcher := &erc.Collector{}
+			catcher.Push(drainManager(manager, *opts))
+			catcher.Push(closeService())
+			return catcher.Resolve()
+		}, nil
+	}
+}
+
+// drainManager drains the manager, which must be a HealthManager, logs the
+// outcome, and then closes the loggers in the logging cache, since the service
+// is shutting down.
+func drainManager(manager jasper.Manager, opts roptions.Drain) error {
+	hm, ok := manager.(*remote.HealthManager)
+	if !ok {
+		return errors.New("manager does not support draining")
+	}
+	if err := opts.Validate(); err != nil {
+		return fmt.Errorf("invalid drain options: %w", err)
+	}
+
+	// The service context is already done by the time the service closes,
+	// so the drain needs its own context.
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout(opts))
+	defer cancel()
+
+	grip.Info("draining service")
+	report, err := hm.Drain(ctx, opts)
+	grip.Info(message.Fields{
+		"message": "drained service",
+		"report":  report,
+	})
+	if err != nil {
+		return fmt.Errorf("problem draining service: %w", err)
+	}
+	if lc := hm.LoggingCache(ctx); lc != nil {
+		if err := lc.Clear(ctx); err != nil {
+			return fmt.Errorf("problem closing cached loggers: %w", err)
+		}
+	}
+	return nil
+}
+
+// setupLoggingCachePruner prunes loggers that have not been accessed within
+// maxAge from the manager's logging cache until the context is canceled. If
+// the manager is a HealthManager, the services report that they are unhealthy
+// if the pruner stops.
+func setupLoggingCachePruner(ctx context.Context, manager jasper.Manager, maxAge time.Duration) {
+	check := remote.PruneLoggingCache(ctx, manager, 0, maxAge)
+	if hm, ok := manager.(*remote.HealthManager); ok {
+		hm.AddHealthCheck("logging-cache-pruner", check)
+	}
+}
+
 // buildRunCommand builds the command arguments to run the Jasper service with
 // the flags set in the cli.Command.
 func buildRunCommand(c *cli.Command, serviceType string) []string {
@@ -444,9 +626,17 @@ func start(daemon service.Interface, config *service.Config) error {
 	})
 }
 
-// stop ends the running service.
+// stop ends the running service. If the daemon is configured to drain, the
+// running service is drained before it is stopped.
 func stop(daemon service.Interface, config *service.Config) error {
 	return withService(daemon, config, func(svc service.Service) error {
+		if d, ok := daemon.(drainer); ok && d.drainOptions() != nil {
+			if status, err := svc.Status(); err == nil && status == service.StatusRunning {
+				if err := drainService(d); err != nil {
+					return fmt.Errorf("not stopping service: %w", err)
+				}
+			}
+		}
 		return svc.Stop()
 	})
 }
@@ -465,7 +655,8 @@ func run(daemon service.Interface, config *service.Config) error {
 	})
 }
 
-// status gets the current status of the running service.
+// status gets the current status of the running service. If the service is
+// running, the status includes the health and readiness that it reports.
 func status(daemon service.Interface, config *service.Config) error {
 	return withService(daemon, config, func(svc service.Service) error {
 		status, err := svc.Status()
@@ -474,8 +665,64 @@ func status(daemon service.Interface, config *service.Config) error {
 				OutcomeResponse: *makeOutcomeResponse(fmt.Errorf("error getting status from service: %w", err)),
 			})
 		}
-		return writeOutput(os.Stdout, &ServiceStatusResponse{Status: statusToString(status), OutcomeResponse: *makeOutcomeResponse(nil)})
+
+		resp := &ServiceStatusResponse{Status: statusToString(status), OutcomeResponse: *makeOutcomeResponse(nil)}
+		if hc, ok := daemon.(healthChecker); ok && status == service.StatusRunning {
+			resp.Health = checkServiceHealth(hc)
+		}
+		return writeOutput(os.Stdout, resp)
+	})
+}
+
+// healthCheckTimeout is the maximum time to wait for a running service to
+// report its health.
+const healthCheckTimeout = 10 * time.Second
+
+// healthChecker is implemented by daemons that can check the health of the
+// service that they run.
+type healthChecker interface {
+	checkHealth(ctx context.Context) (roptions.HealthReport, error)
+}
+
+// checkServiceHealth returns the health report of the service. If the service
+// cannot be reached, it is reported as unhealthy.
+func checkServiceHealth(hc healthChecker) *roptions.HealthReport {
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+
+	report, err := hc.checkHealth(ctx)
+	if err != nil {
+		return &roptions.HealthReport{Failures: map[string]string{"service": err.Error()}}
+	}
+	return &report
+}
+
+// drainer is implemented by daemons that can drain the service that they run.
+type drainer interface {
+	drainOptions() *roptions.Drain
+	drainService(ctx context.Context, opts roptions.Drain) (roptions.DrainReport, error)
+}
+
+// drainService drains the running service with the daemon's drain options
+// and logs the outcome.
+func drainService(d drainer) error {
+	opts := *d.drainOptions()
+	if err := opts.Validate(); err != nil {
+		return fmt.Errorf("invalid drain options: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout(opts))
+	defer cancel()
+
+	report, err := d.drainService(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("problem draining service: %w", err)
+	}
+	grip.Info(message.Fields{
+		"message": "drained service",
+		"report":  report,
 	})
+	return nil
 }
 
 // ServiceStatus represents the state of the service.
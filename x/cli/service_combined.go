@@ -8,15 +8,19 @@ import (
 
 	"github.com/tychoish/fun/erc"
 	"github.com/tychoish/jasper"
+	roptions "github.com/tychoish/jasper/x/remote/options"
 	"github.com/urfave/cli/v3"
 )
 
 const (
-	restHostFlagName = "rest_host"
-	restPortFlagName = "rest_port"
+	restHostFlagName          = "rest_host"
+	restPortFlagName          = "rest_port"
+	restSocketFlagName        = "rest_socket"
+	restCredsFilePathFlagName = "rest_creds_path"
 
 	rpcHostFlagName          = "rpc_host"
 	rpcPortFlagName          = "rpc_port"
+	rpcSocketFlagName        = "rpc_socket"
 	rpcCredsFilePathFlagName = "rpc_creds_path"
 )
 
@@ -37,6 +41,18 @@ func serviceCommandCombined(cmd string, operation serviceOperation) *cli.Command
 				Usage:   "the port running the REST service ",
 				Value:   defaultRESTPort,
 			},
+			&cli.StringFlag{
+				Name:  restSocketFlagName,
+				Usage: "the path to a unix domain socket for the REST service to listen on instead of the host and port",
+			},
+			&cli.StringFlag{
+				Name:  restCredsFilePathFlagName,
+				Usage: "the path to the REST service credentials file, which enables HTTPS",
+			},
+			&cli.BoolFlag{
+				Name:  requireClientCertFlagName,
+				Usage: "require HTTPS clients of the REST service to present a certificate signed by the CA in the credentials",
+			},
 			&cli.StringFlag{
 				Name:    rpcHostFlagName,
 				Sources: cli.EnvVars(rpcHostEnvVar),
@@ -49,6 +65,10 @@ func serviceCommandCombined(cmd string, operation serviceOperation) *cli.Command
 				Usage:   "the port running the RPC service",
 				Value:   defaultRPCPort,
 			},
+			&cli.StringFlag{
+				Name:  rpcSocketFlagName,
+				Usage: "the path to a unix domain socket for the RPC service to listen on instead of the host and port",
+			},
 			&cli.StringFlag{
 				Name:  rpcCredsFilePathFlagName,
 				Usage: "the path to the RPC service credentials file",
@@ -61,12 +81,25 @@ func serviceCommandCombined(cmd string, operation serviceOperation) *cli.Command
 			validateLimits(limitNumFilesFlagName, limitNumProcsFlagName, limitLockedMemoryFlagName, limitVirtualMemoryFlagName),
 		),
 		Action: func(ctx context.Context, c *cli.Command) error {
-			manager := jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true}))
+			manager := makeHealthManager(c, jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true})))
 
 			daemon := newCombinedDaemon(
-				newRESTDaemon(c.String(restHostFlagName), c.Int(restPortFlagName), manager, makeLogger(c)),
+				newRESTDaemon(c.String(restHostFlagName), c.Int(restPortFlagName), manager, c.String(restCredsFilePathFlagName), makeLogger(c)),
 				newRPCDaemon(c.String(rpcHostFlagName), c.Int(rpcPortFlagName), manager, c.String(rpcCredsFilePathFlagName), makeLogger(c)),
 			)
+			daemon.RESTDaemon.RequireClientCert = c.Bool(requireClientCertFlagName)
+			daemon.RESTDaemon.SocketPath = c.String(restSocketFlagName)
+			daemon.RPCDaemon.SocketPath = c.String(rpcSocketFlagName)
+			daemon.RESTDaemon.AuthConfigFilePath = c.String(authConfigFilePathFlagName)
+			daemon.RPCDaemon.AuthConfigFilePath = c.String(authConfigFilePathFlagName)
+			// The daemons share a process and manager, so the download cache
+			// and logging cache pruner only need to be set up once.
+			daemon.RESTDaemon.DownloadCache = makeDownloadCache(c)
+			daemon.RESTDaemon.LoggingCacheMaxAge = c.Duration(loggingCacheMaxAgeFlagName)
+			// Both daemons drain the shared manager so that neither service
+			// closes before the running processes are done.
+			daemon.RESTDaemon.Drain = makeDrainOptions(c)
+			daemon.RPCDaemon.Drain = makeDrainOptions(c)
 
 			config := serviceConfig(CombinedService, c, buildRunCommand(c, CombinedService))
 
@@ -103,3 +136,19 @@ func (d *combinedDaemon) Stop(s service.Service) error {
 	catcher.Push(d.RESTDaemon.Stop(s))
 	return catcher.Resolve()
 }
+
+// checkHealth checks the health of the REST service. The REST and RPC
+// services share a manager, so they report the same health.
+func (d *combinedDaemon) checkHealth(ctx context.Context) (roptions.HealthReport, error) {
+	return d.RESTDaemon.checkHealth(ctx)
+}
+
+func (d *combinedDaemon) drainOptions() *roptions.Drain {
+	return d.RESTDaemon.drainOptions()
+}
+
+// drainService drains the REST service. The REST and RPC services share a
+// manager, so this drains both of them.
+func (d *combinedDaemon) drainService(ctx context.Context, opts roptions.Drain) (roptions.DrainReport, error) {
+	return d.RESTDaemon.drainService(ctx, opts)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/evergreen-ci/service"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/tychoish/jasper/options"
 	"github.com/tychoish/jasper/util"
 	"github.com/tychoish/jasper/x/remote"
+	roptions "github.com/tychoish/jasper/x/remote/options"
 	"github.com/urfave/cli/v3"
 )
 
@@ -40,6 +42,18 @@ func serviceCommandREST(cmd string, operation serviceOperation) *cli.Command {
 				Usage:   "the port running the REST service",
 				Value:   defaultRESTPort,
 			},
+			&cli.StringFlag{
+				Name:  socketFlagName,
+				Usage: "the path to a unix domain socket for the REST service to listen on instead of the host and port",
+			},
+			&cli.StringFlag{
+				Name:  credsFilePathFlagName,
+				Usage: "the path to the file containing the REST service credentials, which enables HTTPS",
+			},
+			&cli.BoolFlag{
+				Name:  requireClientCertFlagName,
+				Usage: "require HTTPS clients to present a certificate signed by the CA in the credentials",
+			},
 		),
 		Before: mergeBeforeFuncs(
 			validatePort(portFlagName),
@@ -47,9 +61,15 @@ func serviceCommandREST(cmd string, operation serviceOperation) *cli.Command {
 			validateLimits(limitNumFilesFlagName, limitNumProcsFlagName, limitLockedMemoryFlagName, limitVirtualMemoryFlagName),
 		),
 		Action: func(ctx context.Context, c *cli.Command) error {
-			manager := jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true}))
+			manager := makeHealthManager(c, jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true})))
 
-			daemon := newRESTDaemon(c.String(hostFlagName), c.Int(portFlagName), manager, makeLogger(c))
+			daemon := newRESTDaemon(c.String(hostFlagName), c.Int(portFlagName), manager, c.String(credsFilePathFlagName), makeLogger(c))
+			daemon.RequireClientCert = c.Bool(requireClientCertFlagName)
+			daemon.SocketPath = c.String(socketFlagName)
+			daemon.AuthConfigFilePath = c.String(authConfigFilePathFlagName)
+			daemon.DownloadCache = makeDownloadCache(c)
+			daemon.LoggingCacheMaxAge = c.Duration(loggingCacheMaxAgeFlagName)
+			daemon.Drain = makeDrainOptions(c)
 
 			config := serviceConfig(RESTService, c, buildRunCommand(c, RESTService))
 
@@ -62,20 +82,28 @@ func serviceCommandREST(cmd string, operation serviceOperation) *cli.Command {
 }
 
 type restDaemon struct {
-	Host    string
-	Port    int
-	Manager jasper.Manager
-	Logger  *options.LoggerConfig
+	Host               string
+	Port               int
+	SocketPath         string
+	CredsFilePath      string
+	RequireClientCert  bool
+	AuthConfigFilePath string
+	Manager            jasper.Manager
+	Logger             *options.LoggerConfig
+	DownloadCache      *roptions.DownloadCacheOptions
+	LoggingCacheMaxAge time.Duration
+	Drain              *roptions.Drain
 
 	exit chan struct{}
 }
 
-func newRESTDaemon(host string, port int, manager jasper.Manager, logger *options.LoggerConfig) *restDaemon {
+func newRESTDaemon(host string, port int, manager jasper.Manager, credsFilePath string, logger *options.LoggerConfig) *restDaemon {
 	return &restDaemon{
-		Host:    host,
-		Port:    port,
-		Manager: manager,
-		Logger:  logger,
+		Host:          host,
+		Port:          port,
+		CredsFilePath: credsFilePath,
+		Manager:       manager,
+		Logger:        logger,
 	}
 }
 
@@ -85,6 +113,11 @@ func (d *restDaemon) Start(s service.Service) error {
 			return fmt.Errorf("failed to set up logging: %w", err)
 		}
 	}
+	if d.DownloadCache != nil {
+		if err := setupDownloadCache(d.DownloadCache); err != nil {
+			return fmt.Errorf("failed to set up download cache: %w", err)
+		}
+	}
 
 	d.exit = make(chan struct{})
 	if d.Manager == nil {
@@ -93,6 +126,9 @@ func (d *restDaemon) Start(s service.Service) error {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	go handleDaemonSignals(ctx, cancel, d.exit)
+	if d.LoggingCacheMaxAge > 0 {
+		setupLoggingCachePruner(ctx, d.Manager, d.LoggingCacheMaxAge)
+	}
 
 	go func(ctx context.Context, d *restDaemon) {
 		defer recovery.LogStackTraceAndContinue("rest service")
@@ -108,7 +144,7 @@ func (d *restDaemon) Stop(s service.Service) error {
 }
 
 func (d *restDaemon) run(ctx context.Context) error {
-	if err := runServices(ctx, d.newService); err != nil {
+	if err := runServices(ctx, drainBeforeClose(d.newService, d.Manager, d.Drain)); err != nil {
 		return fmt.Errorf("error running REST service: %w", err)
 	}
 	return nil
@@ -118,27 +154,58 @@ func (d *restDaemon) newService(ctx context.Context) (util.CloseFunc, error) {
 	if d.Manager == nil {
 		return nil, errors.New("manager is not set on REST service")
 	}
-	grip.Info(grip.MPrintf("starting REST service at '%s:%d'", d.Host, d.Port))
-	return newRESTService(ctx, d.Host, d.Port, d.Manager)
+	if d.SocketPath != "" {
+		grip.Info(grip.MPrintf("starting REST service at '%s'", d.SocketPath))
+	} else {
+		grip.Info(grip.MPrintf("starting REST service at '%s:%d'", d.Host, d.Port))
+	}
+	return newRESTService(ctx, d.Host, d.Port, d.SocketPath, d.Manager, d.CredsFilePath, d.RequireClientCert, d.AuthConfigFilePath)
+}
+
+func (d *restDaemon) checkHealth(ctx context.Context) (roptions.HealthReport, error) {
+	client, err := newRemoteClient(ctx, RESTService, d.Host, d.Port, d.SocketPath, d.CredsFilePath, "")
+	if err != nil {
+		return roptions.HealthReport{}, err
+	}
+	defer client.CloseConnection()
+
+	return client.CheckHealth(ctx)
+}
+
+func (d *restDaemon) drainOptions() *roptions.Drain {
+	return d.Drain
+}
+
+func (d *restDaemon) drainService(ctx context.Context, opts roptions.Drain) (roptions.DrainReport, error) {
+	client, err := newRemoteClient(ctx, RESTService, d.Host, d.Port, d.SocketPath, d.CredsFilePath, "")
+	if err != nil {
+		return roptions.DrainReport{}, err
+	}
+	defer client.CloseConnection()
+
+	return client.Drain(ctx, opts)
 }
 
 // newRESTService creates a REST service around the manager serving requests on
-// the host and port.
-func newRESTService(ctx context.Context, host string, port int, manager jasper.Manager) (util.CloseFunc, error) {
-	service := remote.NewRestService(manager)
-	app := service.App(ctx)
-	app.SetPrefix("jasper")
-	if err := app.SetHost(host); err != nil {
-		return nil, fmt.Errorf("error setting REST host: %w", err)
+// the host and port, or on the unix domain socket if the socket path is
+// non-empty. If the credentials file path is non-empty, the service serves
+// HTTPS. If the auth config file path is non-empty, requests must be
+// authorized according to the configuration in that file.
+func newRESTService(ctx context.Context, host string, port int, socketPath string, manager jasper.Manager, credsFilePath string, requireClientCert bool, authConfigFilePath string) (util.CloseFunc, error) {
+	addr, err := serviceAddr(host, port, socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve REST address: %w", err)
 	}
-	if err := app.SetPort(port); err != nil {
-		return nil, fmt.Errorf("error setting REST port: %w", err)
+
+	authorizer, err := newAuthorizer(authConfigFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error setting up REST authorization: %w", err)
 	}
 
-	go func() {
-		defer recovery.LogStackTraceAndContinue("rest service")
-		grip.Warning(message.WrapError(app.Run(ctx), "error running REST app"))
-	}()
+	closeService, err := remote.StartRestServiceWithFile(ctx, remote.NewRestServiceWithAuth(manager, authorizer), addr, credsFilePath, requireClientCert)
+	if err != nil {
+		return nil, fmt.Errorf("error starting REST service: %w", err)
+	}
 
-	return func() error { return nil }, nil
+	return closeService, nil
 }
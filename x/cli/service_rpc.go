@@ -4,7 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net"
+	"time"
 
 	"github.com/evergreen-ci/service"
 	"github.com/tychoish/grip"
@@ -14,6 +14,7 @@ import (
 	"github.com/tychoish/jasper/options"
 	"github.com/tychoish/jasper/util"
 	"github.com/tychoish/jasper/x/remote"
+	roptions "github.com/tychoish/jasper/x/remote/options"
 	"github.com/urfave/cli/v3"
 )
 
@@ -40,6 +41,10 @@ func serviceCommandRPC(cmd string, operation serviceOperation) *cli.Command {
 				Usage:   "the port running the RPC service",
 				Value:   defaultRPCPort,
 			},
+			&cli.StringFlag{
+				Name:  socketFlagName,
+				Usage: "the path to a unix domain socket for the RPC service to listen on instead of the host and port",
+			},
 			&cli.StringFlag{
 				Name:  credsFilePathFlagName,
 				Usage: "the path to the file containing the RPC service credentials",
@@ -51,9 +56,14 @@ func serviceCommandRPC(cmd string, operation serviceOperation) *cli.Command {
 			validateLimits(limitNumFilesFlagName, limitNumProcsFlagName, limitLockedMemoryFlagName, limitVirtualMemoryFlagName),
 		),
 		Action: func(ctx context.Context, c *cli.Command) error {
-			manager := jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true}))
+			manager := makeHealthManager(c, jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true})))
 
 			daemon := newRPCDaemon(c.String(hostFlagName), c.Int(portFlagName), manager, c.String(credsFilePathFlagName), makeLogger(c))
+			daemon.SocketPath = c.String(socketFlagName)
+			daemon.AuthConfigFilePath = c.String(authConfigFilePathFlagName)
+			daemon.DownloadCache = makeDownloadCache(c)
+			daemon.LoggingCacheMaxAge = c.Duration(loggingCacheMaxAgeFlagName)
+			daemon.Drain = makeDrainOptions(c)
 
 			config := serviceConfig(RPCService, c, buildRunCommand(c, RPCService))
 
@@ -66,11 +76,16 @@ func serviceCommandRPC(cmd string, operation serviceOperation) *cli.Command {
 }
 
 type rpcDaemon struct {
-	Host          string
-	Port          int
-	CredsFilePath string
-	Manager       jasper.Manager
-	Logger        *options.LoggerConfig
+	Host               string
+	Port               int
+	SocketPath         string
+	CredsFilePath      string
+	AuthConfigFilePath string
+	Manager            jasper.Manager
+	Logger             *options.LoggerConfig
+	DownloadCache      *roptions.DownloadCacheOptions
+	LoggingCacheMaxAge time.Duration
+	Drain              *roptions.Drain
 
 	exit chan struct{}
 }
@@ -91,6 +106,11 @@ func (d *rpcDaemon) Start(s service.Service) error {
 			return fmt.Errorf(": %w", err)
 		}
 	}
+	if d.DownloadCache != nil {
+		if err := setupDownloadCache(d.DownloadCache); err != nil {
+			return fmt.Errorf("failed to set up download cache: %w", err)
+		}
+	}
 
 	d.exit = make(chan struct{})
 	if d.Manager == nil {
@@ -99,6 +119,9 @@ func (d *rpcDaemon) Start(s service.Service) error {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	go handleDaemonSignals(ctx, cancel, d.exit)
+	if d.LoggingCacheMaxAge > 0 {
+		setupLoggingCachePruner(ctx, d.Manager, d.LoggingCacheMaxAge)
+	}
 
 	go func(ctx context.Context, d *rpcDaemon) {
 		defer recovery.LogStackTraceAndContinue("rpc service")
@@ -114,7 +137,7 @@ func (d *rpcDaemon) Stop(s service.Service) error {
 }
 
 func (d *rpcDaemon) run(ctx context.Context) error {
-	if err := runServices(ctx, d.newService); err != nil {
+	if err := runServices(ctx, drainBeforeClose(d.newService, d.Manager, d.Drain)); err != nil {
 		return fmt.Errorf("error running RPC service: %w", err)
 	}
 	return nil
@@ -125,20 +148,55 @@ func (d *rpcDaemon) newService(ctx context.Context) (util.CloseFunc, error) {
 		return nil, errors.New("manager is not set on RPC service")
 	}
 
-	grip.Info(grip.MPrintf("starting RPC service at '%s:%d'", d.Host, d.Port))
+	if d.SocketPath != "" {
+		grip.Info(grip.MPrintf("starting RPC service at '%s'", d.SocketPath))
+	} else {
+		grip.Info(grip.MPrintf("starting RPC service at '%s:%d'", d.Host, d.Port))
+	}
+
+	return newRPCService(ctx, d.Host, d.Port, d.SocketPath, d.Manager, d.CredsFilePath, d.AuthConfigFilePath)
+}
+
+func (d *rpcDaemon) checkHealth(ctx context.Context) (roptions.HealthReport, error) {
+	client, err := newRemoteClient(ctx, RPCService, d.Host, d.Port, d.SocketPath, d.CredsFilePath, "")
+	if err != nil {
+		return roptions.HealthReport{}, err
+	}
+	defer client.CloseConnection()
 
-	return newRPCService(ctx, d.Host, d.Port, d.Manager, d.CredsFilePath)
+	return client.CheckHealth(ctx)
+}
+
+func (d *rpcDaemon) drainOptions() *roptions.Drain {
+	return d.Drain
+}
+
+func (d *rpcDaemon) drainService(ctx context.Context, opts roptions.Drain) (roptions.DrainReport, error) {
+	client, err := newRemoteClient(ctx, RPCService, d.Host, d.Port, d.SocketPath, d.CredsFilePath, "")
+	if err != nil {
+		return roptions.DrainReport{}, err
+	}
+	defer client.CloseConnection()
+
+	return client.Drain(ctx, opts)
 }
 
 // newRPCService creates an RPC service around the manager serving requests on
-// the host and port.
-func newRPCService(ctx context.Context, host string, port int, manager jasper.Manager, credsFilePath string) (util.CloseFunc, error) {
-	addr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("%s:%d", host, port))
+// the host and port, or on the unix domain socket if the socket path is
+// non-empty. If the auth config file path is non-empty, requests must be
+// authorized according to the configuration in that file.
+func newRPCService(ctx context.Context, host string, port int, socketPath string, manager jasper.Manager, credsFilePath, authConfigFilePath string) (util.CloseFunc, error) {
+	addr, err := serviceAddr(host, port, socketPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve RPC address: %w", err)
 	}
 
-	closeService, err := remote.StartRPCServiceWithFile(ctx, manager, addr, credsFilePath)
+	authorizer, err := newAuthorizer(authConfigFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error setting up RPC authorization: %w", err)
+	}
+
+	closeService, err := remote.StartRPCServiceWithFile(ctx, manager, addr, credsFilePath, remote.AuthServerOptions(authorizer)...)
 	if err != nil {
 		return nil, fmt.Errorf("error starting RPC service: %w", err)
 	}
@@ -26,7 +26,7 @@ func TestDaemon(t *testing.T) {
 			assert.NotError(t, err)
 			assert.NotError(t, daemon.Start(svc))
 
-			client, err := newRemoteClient(ctx, RPCService, "localhost", port, "")
+			client, err := newRemoteClient(ctx, RPCService, "localhost", port, "", "", "")
 			assert.NotError(t, err)
 
 			return func() error { return daemon.Stop(svc) }, client
@@ -35,13 +35,13 @@ func TestDaemon(t *testing.T) {
 			port := testutil.GetPortNumber()
 			manager := jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true}))
 
-			daemon := newRESTDaemon("localhost", port, manager, nil)
+			daemon := newRESTDaemon("localhost", port, manager, "", nil)
 			svc, err := service.New(daemon, &service.Config{Name: "foo"})
 			assert.NotError(t, err)
 			assert.NotError(t, daemon.Start(svc))
 			assert.NotError(t, testutil.WaitForRESTService(ctx, fmt.Sprintf("http://localhost:%d/jasper/v1", port)))
 
-			client, err := newRemoteClient(ctx, RESTService, "localhost", port, "")
+			client, err := newRemoteClient(ctx, RESTService, "localhost", port, "", "", "")
 			assert.NotError(t, err)
 
 			return func() error { return daemon.Stop(svc) }, client
@@ -49,7 +49,7 @@ func TestDaemon(t *testing.T) {
 		"CombinedServiceRESTClient": func(ctx context.Context, t *testing.T, manager jasper.Manager) (util.CloseFunc, remote.Manager) {
 			restPort := testutil.GetPortNumber()
 			daemon := newCombinedDaemon(
-				newRESTDaemon("localhost", restPort, manager, nil),
+				newRESTDaemon("localhost", restPort, manager, "", nil),
 				newRPCDaemon("localhost", testutil.GetPortNumber(), manager, "", nil),
 			)
 			svc, err := service.New(daemon, &service.Config{Name: "foo"})
@@ -57,7 +57,7 @@ func TestDaemon(t *testing.T) {
 			assert.NotError(t, daemon.Start(svc))
 			assert.NotError(t, testutil.WaitForRESTService(ctx, fmt.Sprintf("http://localhost:%d/jasper/v1", restPort)))
 
-			client, err := newRemoteClient(ctx, RESTService, "localhost", restPort, "")
+			client, err := newRemoteClient(ctx, RESTService, "localhost", restPort, "", "", "")
 			assert.NotError(t, err)
 
 			return func() error { return daemon.Stop(svc) }, client
@@ -65,14 +65,14 @@ func TestDaemon(t *testing.T) {
 		"CombinedServiceRPCClient": func(ctx context.Context, t *testing.T, manager jasper.Manager) (util.CloseFunc, remote.Manager) {
 			rpcPort := testutil.GetPortNumber()
 			daemon := newCombinedDaemon(
-				newRESTDaemon("localhost", testutil.GetPortNumber(), manager, nil),
+				newRESTDaemon("localhost", testutil.GetPortNumber(), manager, "", nil),
 				newRPCDaemon("localhost", rpcPort, manager, "", nil),
 			)
 			svc, err := service.New(daemon, &service.Config{Name: "foo"})
 			assert.NotError(t, err)
 			assert.NotError(t, daemon.Start(svc))
 
-			client, err := newRemoteClient(ctx, RPCService, "localhost", rpcPort, "")
+			client, err := newRemoteClient(ctx, RPCService, "localhost", rpcPort, "", "", "")
 			assert.NotError(t, err)
 
 			return func() error { return daemon.Stop(svc) }, client
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/evergreen-ci/service"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/tychoish/jasper/options"
 	"github.com/tychoish/jasper/util"
 	"github.com/tychoish/jasper/x/remote"
+	roptions "github.com/tychoish/jasper/x/remote/options"
 	"github.com/urfave/cli/v3"
 )
 
@@ -46,9 +48,13 @@ func serviceCommandWire(cmd string, operation serviceOperation) *cli.Command {
 			validateLimits(limitNumFilesFlagName, limitNumProcsFlagName, limitLockedMemoryFlagName, limitVirtualMemoryFlagName),
 		),
 		Action: func(ctx context.Context, c *cli.Command) error {
-			manager := jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true}))
+			manager := makeHealthManager(c, jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true})))
 
 			daemon := newWireDaemon(c.String(hostFlagName), c.Int(portFlagName), manager, makeLogger(c))
+			daemon.AuthConfigFilePath = c.String(authConfigFilePathFlagName)
+			daemon.DownloadCache = makeDownloadCache(c)
+			daemon.LoggingCacheMaxAge = c.Duration(loggingCacheMaxAgeFlagName)
+			daemon.Drain = makeDrainOptions(c)
 
 			config := serviceConfig(WireService, c, buildRunCommand(c, WireService))
 
@@ -61,10 +67,14 @@ func serviceCommandWire(cmd string, operation serviceOperation) *cli.Command {
 }
 
 type wireDaemon struct {
-	Host    string
-	Port    int
-	Manager jasper.Manager
-	Logger  *options.LoggerConfig
+	Host               string
+	Port               int
+	AuthConfigFilePath string
+	Manager            jasper.Manager
+	Logger             *options.LoggerConfig
+	DownloadCache      *roptions.DownloadCacheOptions
+	LoggingCacheMaxAge time.Duration
+	Drain              *roptions.Drain
 
 	exit chan struct{}
 }
@@ -84,6 +94,11 @@ func (d *wireDaemon) Start(s service.Service) error {
 			return fmt.Errorf("failed to set up logging: %w", err)
 		}
 	}
+	if d.DownloadCache != nil {
+		if err := setupDownloadCache(d.DownloadCache); err != nil {
+			return fmt.Errorf("failed to set up download cache: %w", err)
+		}
+	}
 
 	d.exit = make(chan struct{})
 	if d.Manager == nil {
@@ -92,6 +107,9 @@ func (d *wireDaemon) Start(s service.Service) error {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	go handleDaemonSignals(ctx, cancel, d.exit)
+	if d.LoggingCacheMaxAge > 0 {
+		setupLoggingCachePruner(ctx, d.Manager, d.LoggingCacheMaxAge)
+	}
 
 	go func(ctx context.Context, d *wireDaemon) {
 		grip.Error(message.WrapError(d.run(ctx), "error running wire service"))
@@ -106,7 +124,7 @@ func (d *wireDaemon) Stop(s service.Service) error {
 }
 
 func (d *wireDaemon) run(ctx context.Context) error {
-	if err := runServices(ctx, d.newService); err != nil {
+	if err := runServices(ctx, drainBeforeClose(d.newService, d.Manager, d.Drain)); err != nil {
 		return fmt.Errorf("error running wire service: %w", err)
 	}
 	return nil
@@ -118,9 +136,48 @@ func (d *wireDaemon) newService(ctx context.Context) (util.CloseFunc, error) {
 		return nil, fmt.Errorf("failed to resolve wire address: %w", err)
 	}
 
-	closeService, err := remote.StartMDBService(ctx, d.Manager, addr)
+	authorizer, err := newAuthorizer(d.AuthConfigFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error setting up wire authorization: %w", err)
+	}
+
+	closeService, err := remote.StartMDBServiceWithAuth(ctx, d.Manager, addr, authorizer)
 	if err != nil {
 		return nil, fmt.Errorf("error starting wire service: %w", err)
 	}
 	return closeService, nil
 }
+
+func (d *wireDaemon) checkHealth(ctx context.Context) (roptions.HealthReport, error) {
+	addr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("%s:%d", d.Host, d.Port))
+	if err != nil {
+		return roptions.HealthReport{}, fmt.Errorf("failed to resolve wire address: %w", err)
+	}
+
+	client, err := remote.NewMDBClient(ctx, addr, healthCheckTimeout)
+	if err != nil {
+		return roptions.HealthReport{}, err
+	}
+	defer client.CloseConnection()
+
+	return client.CheckHealth(ctx)
+}
+
+func (d *wireDaemon) drainOptions() *roptions.Drain {
+	return d.Drain
+}
+
+func (d *wireDaemon) drainService(ctx context.Context, opts roptions.Drain) (roptions.DrainReport, error) {
+	addr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("%s:%d", d.Host, d.Port))
+	if err != nil {
+		return roptions.DrainReport{}, fmt.Errorf("failed to resolve wire address: %w", err)
+	}
+
+	client, err := remote.NewMDBClient(ctx, addr, drainTimeout(opts))
+	if err != nil {
+		return roptions.DrainReport{}, err
+	}
+	defer client.CloseConnection()
+
+	return client.Drain(ctx, opts)
+}
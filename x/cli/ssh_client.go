@@ -221,6 +221,102 @@ func (c *sshClient) DownloadFile(ctx context.Context, opts roptions.Download) er
 	return nil
 }
 
+func (c *sshClient) GetDownloadCacheStats(ctx context.Context) (roptions.DownloadCacheStats, error) {
+	output, err := c.runRemoteCommand(ctx, DownloadCacheStatsCommand, nil)
+	if err != nil {
+		return roptions.DownloadCacheStats{}, err
+	}
+
+	resp, err := ExtractDownloadCacheStatsResponse(output)
+	if err != nil {
+		return resp.Stats, err
+	}
+
+	return resp.Stats, nil
+}
+
+func (c *sshClient) AddSchedule(ctx context.Context, opts options.Schedule) error {
+	output, err := c.runRemoteCommand(ctx, AddScheduleCommand, &opts)
+	if err != nil {
+		return err
+	}
+
+	if _, err := ExtractOutcomeResponse(output); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *sshClient) RemoveSchedule(ctx context.Context, id string) error {
+	output, err := c.runRemoteCommand(ctx, RemoveScheduleCommand, &IDInput{ID: id})
+	if err != nil {
+		return err
+	}
+
+	if _, err := ExtractOutcomeResponse(output); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *sshClient) ListSchedules(ctx context.Context) ([]jasper.ScheduleInfo, error) {
+	output, err := c.runRemoteCommand(ctx, ListSchedulesCommand, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ExtractSchedulesResponse(output)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Schedules, nil
+}
+
+func (c *sshClient) CheckHealth(ctx context.Context) (roptions.HealthReport, error) {
+	output, err := c.runRemoteCommand(ctx, HealthCommand, nil)
+	if err != nil {
+		return roptions.HealthReport{}, err
+	}
+
+	resp, err := ExtractHealthResponse(output)
+	if err != nil {
+		return resp.Report, err
+	}
+
+	return resp.Report, nil
+}
+
+func (c *sshClient) Drain(ctx context.Context, opts roptions.Drain) (roptions.DrainReport, error) {
+	output, err := c.runRemoteCommand(ctx, DrainCommand, &opts)
+	if err != nil {
+		return roptions.DrainReport{}, err
+	}
+
+	resp, err := ExtractDrainResponse(output)
+	if err != nil {
+		return resp.Report, err
+	}
+
+	return resp.Report, nil
+}
+
+func (c *sshClient) Snapshot(ctx context.Context) (roptions.Snapshot, error) {
+	output, err := c.runManagerCommand(ctx, SnapshotCommand, nil)
+	if err != nil {
+		return roptions.Snapshot{}, err
+	}
+
+	resp, err := ExtractSnapshotResponse(output)
+	if err != nil {
+		return resp.Snapshot, err
+	}
+
+	return resp.Snapshot, nil
+}
+
 func (c *sshClient) WriteFile(ctx context.Context, opts options.WriteFile) error {
 	return opts.WriteBufferedContent(func(opts options.WriteFile) error {
 		output, err := c.runRemoteCommand(ctx, WriteFileCommand, &opts)
@@ -250,6 +346,206 @@ func (c *sshClient) GetLogStream(ctx context.Context, id string, count int) (jas
 	return resp.LogStream, nil
 }
 
+func (c *sshClient) FollowLogStream(ctx context.Context, id string, opts roptions.LogFollow, fn func(jasper.LogLine) error) error {
+	input, err := clientInput(&LogFollowInput{ID: id, LogFollow: opts})
+	if err != nil {
+		return fmt.Errorf("problem creating client input: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	subcommand := []string{RemoteCommand, GetLogStreamCommand, fmt.Sprintf("--%s", followFlagName)}
+	reader, writer := io.Pipe()
+	cmd := c.newCommand(ctx, subcommand, input, nil).SetOutputWriter(writer)
+
+	cmdErr := make(chan error, 1)
+	go func() {
+		err := cmd.Run(ctx)
+		writer.CloseWithError(err)
+		cmdErr <- err
+	}()
+
+	dec := json.NewDecoder(reader)
+	for {
+		line := jasper.LogLine{}
+		if err := dec.Decode(&line); err == io.EOF {
+			break
+		} else if err != nil {
+			cancel()
+			reader.CloseWithError(err)
+			return fmt.Errorf("problem reading log line: %w", err)
+		}
+
+		if err := fn(line); err != nil {
+			cancel()
+			reader.CloseWithError(err)
+			return err
+		}
+	}
+
+	if err := <-cmdErr; err != nil {
+		return fmt.Errorf("problem running command '%s' over SSH: %w", c.opts.buildCommand(subcommand...), err)
+	}
+
+	return nil
+}
+
+func (c *sshClient) Exec(ctx context.Context, opts *roptions.Exec) (remote.ExecSession, error) {
+	return nil, errors.New("interactive sessions are not supported over SSH")
+}
+
+func (c *sshClient) ReadFile(ctx context.Context, opts roptions.ReadFile) (io.ReadCloser, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid read file options: %w", err)
+	}
+
+	input, err := clientInput(&opts)
+	if err != nil {
+		return nil, fmt.Errorf("problem creating client input: %w", err)
+	}
+
+	return c.streamCommand(ctx, []string{RemoteCommand, ReadFileCommand}, input), nil
+}
+
+func (c *sshClient) CreateArchive(ctx context.Context, opts roptions.CreateArchive) (io.ReadCloser, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid create archive options: %w", err)
+	}
+
+	input, err := clientInput(&opts)
+	if err != nil {
+		return nil, fmt.Errorf("problem creating client input: %w", err)
+	}
+
+	return c.streamCommand(ctx, []string{RemoteCommand, CreateArchiveCommand}, input), nil
+}
+
+// streamCommand runs the subcommand and returns a reader for its raw output.
+// Errors from the command are returned when reading.
+func (c *sshClient) streamCommand(ctx context.Context, subcommand []string, input []byte) io.ReadCloser {
+	ctx, cancel := context.WithCancel(ctx)
+	reader, writer := io.Pipe()
+	// The command closes its output writer once it finishes, so the pipe is
+	// closed here instead, after the error from the command is known.
+	cmd := c.newCommand(ctx, subcommand, input, nil).SetOutputWriter(unclosedPipeWriter{PipeWriter: writer})
+
+	go func() {
+		if err := cmd.Run(ctx); err != nil {
+			writer.CloseWithError(fmt.Errorf("problem running command '%s' over SSH: %w", c.opts.buildCommand(subcommand...), err))
+			return
+		}
+		writer.Close()
+	}()
+
+	return &sshFileReader{PipeReader: reader, cancel: cancel}
+}
+
+// sshFileReader reads the output of a streaming command and stops the command
+// when it is closed.
+type sshFileReader struct {
+	*io.PipeReader
+	cancel context.CancelFunc
+}
+
+func (r *sshFileReader) Close() error {
+	r.cancel()
+	return r.PipeReader.Close()
+}
+
+// unclosedPipeWriter is a pipe writer that is not closed by Close.
+type unclosedPipeWriter struct {
+	*io.PipeWriter
+}
+
+func (unclosedPipeWriter) Close() error { return nil }
+
+func (c *sshClient) StatFile(ctx context.Context, path string) (roptions.FileInfo, error) {
+	output, err := c.runRemoteCommand(ctx, StatFileCommand, &FilePathInput{Path: path})
+	if err != nil {
+		return roptions.FileInfo{}, err
+	}
+
+	resp, err := ExtractFileInfoResponse(output)
+	if err != nil {
+		return roptions.FileInfo{}, err
+	}
+
+	return resp.Info, nil
+}
+
+func (c *sshClient) ListDirectory(ctx context.Context, opts roptions.ListDirectory) ([]roptions.FileInfo, error) {
+	output, err := c.runRemoteCommand(ctx, ListDirectoryCommand, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ExtractFileInfosResponse(output)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Infos, nil
+}
+
+func (c *sshClient) GlobFiles(ctx context.Context, pattern string) ([]string, error) {
+	output, err := c.runRemoteCommand(ctx, GlobFilesCommand, &FilePathInput{Path: pattern})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ExtractPathsResponse(output)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Paths, nil
+}
+
+func (c *sshClient) RemoveFile(ctx context.Context, opts roptions.RemoveFile) error {
+	output, err := c.runRemoteCommand(ctx, RemoveFileCommand, &opts)
+	if err != nil {
+		return err
+	}
+
+	_, err = ExtractOutcomeResponse(output)
+	return err
+}
+
+func (c *sshClient) RenameFile(ctx context.Context, opts roptions.RenameFile) error {
+	output, err := c.runRemoteCommand(ctx, RenameFileCommand, &opts)
+	if err != nil {
+		return err
+	}
+
+	_, err = ExtractOutcomeResponse(output)
+	return err
+}
+
+func (c *sshClient) MakeDirectory(ctx context.Context, opts roptions.MakeDirectory) error {
+	output, err := c.runRemoteCommand(ctx, MakeDirectoryCommand, &opts)
+	if err != nil {
+		return err
+	}
+
+	_, err = ExtractOutcomeResponse(output)
+	return err
+}
+
+func (c *sshClient) ChecksumFile(ctx context.Context, opts roptions.FileChecksum) (string, error) {
+	output, err := c.runRemoteCommand(ctx, ChecksumFileCommand, &opts)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := ExtractChecksumResponse(output)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Checksum, nil
+}
+
 func (c *sshClient) SignalEvent(ctx context.Context, name string) error {
 	output, err := c.runRemoteCommand(ctx, SignalEventCommand, &EventInput{Name: name})
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/tychoish/fun/assert"
 	"github.com/tychoish/fun/assert/check"
@@ -317,7 +318,8 @@ func TestSSHClient(t *testing.T) {
 			opts := roptions.Download{URL: "https://example.com", Path: "/foo"}
 			assert.NotError(t, client.DownloadFile(ctx, opts))
 
-			assert.Equal(t, opts, inputChecker)
+			assert.Equal(t, opts.URL, inputChecker.URL)
+			assert.Equal(t, opts.Path, inputChecker.Path)
 		},
 		"DownloadFileFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
 			baseManager.Create = makeCreateFunc(
@@ -371,6 +373,416 @@ func TestSSHClient(t *testing.T) {
 			_, err := client.GetLogStream(ctx, "foo", 10)
 			assert.Error(t, err)
 		},
+		"FollowLogStreamPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := LogFollowInput{}
+			expected := jasper.LogLine{Offset: 3, Stream: jasper.OutputStreamStderr, Line: "foo"}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, GetLogStreamCommand, "--" + followFlagName},
+				&inputChecker,
+				expected,
+			)
+
+			lines := []jasper.LogLine{}
+			opts := roptions.LogFollow{Offset: 3, Streams: []jasper.OutputStream{jasper.OutputStreamStderr}}
+			assert.NotError(t, client.FollowLogStream(ctx, "foo", opts, func(line jasper.LogLine) error {
+				lines = append(lines, line)
+				return nil
+			}))
+
+			assert.Equal(t, inputChecker.ID, "foo")
+			assert.Equal(t, inputChecker.Offset, opts.Offset)
+			assert.EqualItems(t, inputChecker.Streams, opts.Streams)
+
+			assert.Equal(t, len(lines), 1)
+			assert.Equal(t, lines[0].Offset, expected.Offset)
+			assert.Equal(t, lines[0].Stream, expected.Stream)
+			assert.Equal(t, lines[0].Line, expected.Line)
+		},
+		"FollowLogStreamFailsIfBaseManagerCreateFails": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.FailCreate = true
+			assert.Error(t, client.FollowLogStream(ctx, "foo", roptions.LogFollow{}, func(jasper.LogLine) error { return nil }))
+		},
+		"ReadFilePassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := roptions.ReadFile{}
+			baseManager.Create = func(opts *options.Create) mock.Process {
+				assert.NotError(t, json.Unmarshal(opts.StandardInputBytes, &inputChecker))
+				cliCommand := strings.Join(client.opts.buildCommand(RemoteCommand, ReadFileCommand), " ")
+				assert.Equal(t, cliCommand, strings.Join(opts.Args, " "))
+				_, err := opts.Output.Output.Write([]byte("foobar"))
+				assert.NotError(t, err)
+				return mock.Process{}
+			}
+
+			opts := roptions.ReadFile{Path: "/foo", Offset: 1}
+			file, err := client.ReadFile(ctx, opts)
+			assert.NotError(t, err)
+			content, err := io.ReadAll(file)
+			assert.NotError(t, err)
+			assert.NotError(t, file.Close())
+
+			assert.Equal(t, string(content), "foobar")
+			assert.Equal(t, inputChecker, opts)
+		},
+		"ReadFileFailsIfBaseManagerCreateFails": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.FailCreate = true
+			file, err := client.ReadFile(ctx, roptions.ReadFile{Path: "/foo"})
+			assert.NotError(t, err)
+			_, err = io.ReadAll(file)
+			assert.Error(t, err)
+		},
+		"ReadFileFailsWithInvalidOptions": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			_, err := client.ReadFile(ctx, roptions.ReadFile{Path: "foo"})
+			assert.Error(t, err)
+		},
+		"CreateArchivePassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := roptions.CreateArchive{}
+			baseManager.Create = func(opts *options.Create) mock.Process {
+				assert.NotError(t, json.Unmarshal(opts.StandardInputBytes, &inputChecker))
+				cliCommand := strings.Join(client.opts.buildCommand(RemoteCommand, CreateArchiveCommand), " ")
+				assert.Equal(t, cliCommand, strings.Join(opts.Args, " "))
+				_, err := opts.Output.Output.Write([]byte("archive"))
+				assert.NotError(t, err)
+				return mock.Process{}
+			}
+
+			archive, err := client.CreateArchive(ctx, roptions.CreateArchive{Path: "/foo", Format: roptions.ArchiveZip})
+			assert.NotError(t, err)
+			content, err := io.ReadAll(archive)
+			assert.NotError(t, err)
+			assert.NotError(t, archive.Close())
+
+			assert.Equal(t, string(content), "archive")
+			assert.Equal(t, inputChecker.Path, "/foo")
+			assert.Equal(t, inputChecker.Format, roptions.ArchiveZip)
+		},
+		"CreateArchiveFailsIfBaseManagerCreateFails": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.FailCreate = true
+			archive, err := client.CreateArchive(ctx, roptions.CreateArchive{Path: "/foo"})
+			assert.NotError(t, err)
+			_, err = io.ReadAll(archive)
+			assert.Error(t, err)
+		},
+		"CreateArchiveFailsWithInvalidOptions": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			_, err := client.CreateArchive(ctx, roptions.CreateArchive{Path: "/foo", Format: roptions.ArchiveTarBz2})
+			assert.Error(t, err)
+		},
+		"StatFilePassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := FilePathInput{}
+			resp := &FileInfoResponse{
+				Info:            roptions.FileInfo{Path: "/foo", Name: "foo", Size: 3},
+				OutcomeResponse: *makeOutcomeResponse(nil),
+			}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, StatFileCommand},
+				&inputChecker,
+				resp,
+			)
+			info, err := client.StatFile(ctx, "/foo")
+			assert.NotError(t, err)
+
+			assert.Equal(t, inputChecker.Path, "/foo")
+			assert.Equal(t, info.Name, resp.Info.Name)
+			assert.Equal(t, info.Size, resp.Info.Size)
+		},
+		"StatFileFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, StatFileCommand},
+				nil,
+				invalidResponse(),
+			)
+			_, err := client.StatFile(ctx, "/foo")
+			assert.Error(t, err)
+		},
+		"ListDirectoryPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := roptions.ListDirectory{}
+			resp := &FileInfosResponse{
+				Infos:           []roptions.FileInfo{{Path: "/foo/bar", Name: "bar"}},
+				OutcomeResponse: *makeOutcomeResponse(nil),
+			}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, ListDirectoryCommand},
+				&inputChecker,
+				resp,
+			)
+			opts := roptions.ListDirectory{Path: "/foo", Recursive: true}
+			infos, err := client.ListDirectory(ctx, opts)
+			assert.NotError(t, err)
+
+			assert.Equal(t, inputChecker, opts)
+			assert.Equal(t, len(infos), 1)
+			assert.Equal(t, infos[0].Path, "/foo/bar")
+		},
+		"GlobFilesPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := FilePathInput{}
+			resp := &PathsResponse{
+				Paths:           []string{"/foo/bar.txt"},
+				OutcomeResponse: *makeOutcomeResponse(nil),
+			}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, GlobFilesCommand},
+				&inputChecker,
+				resp,
+			)
+			paths, err := client.GlobFiles(ctx, "/foo/*.txt")
+			assert.NotError(t, err)
+
+			assert.Equal(t, inputChecker.Path, "/foo/*.txt")
+			assert.EqualItems(t, paths, resp.Paths)
+		},
+		"RemoveFilePassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := roptions.RemoveFile{}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, RemoveFileCommand},
+				&inputChecker,
+				makeOutcomeResponse(nil),
+			)
+			opts := roptions.RemoveFile{Path: "/foo", Recursive: true}
+			assert.NotError(t, client.RemoveFile(ctx, opts))
+			assert.Equal(t, inputChecker, opts)
+		},
+		"RemoveFileFailsIfBaseManagerCreateFails": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.FailCreate = true
+			assert.Error(t, client.RemoveFile(ctx, roptions.RemoveFile{Path: "/foo"}))
+		},
+		"RenameFilePassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := roptions.RenameFile{}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, RenameFileCommand},
+				&inputChecker,
+				makeOutcomeResponse(nil),
+			)
+			opts := roptions.RenameFile{OldPath: "/foo", NewPath: "/bar"}
+			assert.NotError(t, client.RenameFile(ctx, opts))
+			assert.Equal(t, inputChecker, opts)
+		},
+		"MakeDirectoryPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := roptions.MakeDirectory{}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, MakeDirectoryCommand},
+				&inputChecker,
+				makeOutcomeResponse(nil),
+			)
+			opts := roptions.MakeDirectory{Path: "/foo", Perm: 0o755, Parents: true}
+			assert.NotError(t, client.MakeDirectory(ctx, opts))
+			assert.Equal(t, inputChecker, opts)
+		},
+		"MakeDirectoryFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, MakeDirectoryCommand},
+				nil,
+				invalidResponse(),
+			)
+			assert.Error(t, client.MakeDirectory(ctx, roptions.MakeDirectory{Path: "/foo"}))
+		},
+		"ChecksumFilePassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := roptions.FileChecksum{}
+			resp := &ChecksumResponse{
+				Checksum:        "abc",
+				OutcomeResponse: *makeOutcomeResponse(nil),
+			}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, ChecksumFileCommand},
+				&inputChecker,
+				resp,
+			)
+			opts := roptions.FileChecksum{Path: "/foo", Algorithm: roptions.ChecksumSHA1}
+			checksum, err := client.ChecksumFile(ctx, opts)
+			assert.NotError(t, err)
+
+			assert.Equal(t, inputChecker, opts)
+			assert.Equal(t, checksum, "abc")
+		},
+		"ChecksumFileFailsIfBaseManagerCreateFails": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.FailCreate = true
+			_, err := client.ChecksumFile(ctx, roptions.FileChecksum{Path: "/foo"})
+			assert.Error(t, err)
+		},
+		"GetDownloadCacheStatsPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			stats := roptions.DownloadCacheStats{Enabled: true, Directory: "/foo", Entries: 1, Size: 3, Hits: 2}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, DownloadCacheStatsCommand},
+				nil,
+				&DownloadCacheStatsResponse{Stats: stats, OutcomeResponse: *makeOutcomeResponse(nil)},
+			)
+			resp, err := client.GetDownloadCacheStats(ctx)
+			assert.NotError(t, err)
+			assert.Equal(t, resp, stats)
+		},
+		"GetDownloadCacheStatsFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, DownloadCacheStatsCommand},
+				nil,
+				invalidResponse(),
+			)
+			_, err := client.GetDownloadCacheStats(ctx)
+			assert.Error(t, err)
+		},
+		"CheckHealthPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			report := roptions.HealthReport{Healthy: true, Failures: map[string]string{"draining": "service is draining"}}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, HealthCommand},
+				nil,
+				&HealthResponse{Report: report, OutcomeResponse: *makeOutcomeResponse(nil)},
+			)
+			resp, err := client.CheckHealth(ctx)
+			assert.NotError(t, err)
+			assert.True(t, resp.Healthy)
+			assert.True(t, !resp.Ready)
+			assert.Equal(t, resp.Failures["draining"], report.Failures["draining"])
+		},
+		"CheckHealthFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, HealthCommand},
+				nil,
+				invalidResponse(),
+			)
+			_, err := client.CheckHealth(ctx)
+			assert.Error(t, err)
+		},
+		"DrainPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := roptions.Drain{}
+			report := roptions.DrainReport{Completed: []string{"foo"}, Killed: []string{"bar"}}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, DrainCommand},
+				&inputChecker,
+				&DrainResponse{Report: report, OutcomeResponse: *makeOutcomeResponse(nil)},
+			)
+			opts := roptions.Drain{Timeout: time.Minute, Terminate: true}
+			resp, err := client.Drain(ctx, opts)
+			assert.NotError(t, err)
+			assert.EqualItems(t, resp.Completed, report.Completed)
+			assert.EqualItems(t, resp.Killed, report.Killed)
+			assert.Equal(t, inputChecker.Timeout, opts.Timeout)
+			assert.True(t, inputChecker.Terminate)
+		},
+		"DrainFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, DrainCommand},
+				nil,
+				invalidResponse(),
+			)
+			_, err := client.Drain(ctx, roptions.Drain{})
+			assert.Error(t, err)
+		},
+		"SnapshotPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			snapshot := roptions.Snapshot{
+				Version:             roptions.SnapshotVersion,
+				ManagerID:           "foo",
+				Processes:           []roptions.ProcessSnapshot{{Info: jasper.ProcessInfo{ID: "bar", Complete: true}, Tags: []string{"baz"}}},
+				ScriptingHarnessIDs: []string{"qux"},
+			}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{ManagerCommand, SnapshotCommand},
+				nil,
+				&SnapshotResponse{Snapshot: snapshot, OutcomeResponse: *makeOutcomeResponse(nil)},
+			)
+			resp, err := client.Snapshot(ctx)
+			assert.NotError(t, err)
+			assert.Equal(t, resp.ManagerID, snapshot.ManagerID)
+			assert.Equal(t, len(resp.Processes), 1)
+			assert.Equal(t, resp.Processes[0].Info.ID, "bar")
+			assert.EqualItems(t, resp.Processes[0].Tags, snapshot.Processes[0].Tags)
+			assert.EqualItems(t, resp.ScriptingHarnessIDs, snapshot.ScriptingHarnessIDs)
+		},
+		"SnapshotFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{ManagerCommand, SnapshotCommand},
+				nil,
+				invalidResponse(),
+			)
+			_, err := client.Snapshot(ctx)
+			assert.Error(t, err)
+		},
+		"AddSchedulePassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := options.Schedule{}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, AddScheduleCommand},
+				&inputChecker,
+				makeOutcomeResponse(nil),
+			)
+			opts := options.Schedule{ID: "foo", Create: &options.Create{Args: []string{"true"}}, Cron: "@hourly"}
+			assert.NotError(t, client.AddSchedule(ctx, opts))
+			assert.Equal(t, inputChecker.ID, opts.ID)
+			assert.Equal(t, inputChecker.Cron, opts.Cron)
+			assert.EqualItems(t, inputChecker.Create.Args, opts.Create.Args)
+		},
+		"AddScheduleFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, AddScheduleCommand},
+				nil,
+				invalidResponse(),
+			)
+			assert.Error(t, client.AddSchedule(ctx, options.Schedule{ID: "foo", Create: &options.Create{Args: []string{"true"}}, Cron: "@hourly"}))
+		},
+		"RemoveSchedulePassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			inputChecker := IDInput{}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, RemoveScheduleCommand},
+				&inputChecker,
+				makeOutcomeResponse(nil),
+			)
+			assert.NotError(t, client.RemoveSchedule(ctx, "foo"))
+			assert.Equal(t, inputChecker.ID, "foo")
+		},
+		"RemoveScheduleFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, RemoveScheduleCommand},
+				nil,
+				invalidResponse(),
+			)
+			assert.Error(t, client.RemoveSchedule(ctx, "foo"))
+		},
+		"ListSchedulesPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			schedules := []jasper.ScheduleInfo{{
+				Schedule:         options.Schedule{ID: "foo", Cron: "@hourly"},
+				RunningProcessID: "bar",
+				History:          []jasper.ScheduleRun{{ProcessID: "bar"}},
+			}}
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, ListSchedulesCommand},
+				nil,
+				&SchedulesResponse{Schedules: schedules, OutcomeResponse: *makeOutcomeResponse(nil)},
+			)
+			resp, err := client.ListSchedules(ctx)
+			assert.NotError(t, err)
+			assert.Equal(t, len(resp), 1)
+			assert.Equal(t, resp[0].Schedule.ID, "foo")
+			assert.Equal(t, resp[0].RunningProcessID, "bar")
+			assert.Equal(t, len(resp[0].History), 1)
+		},
+		"ListSchedulesFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
+			baseManager.Create = makeCreateFunc(
+				t, client,
+				[]string{RemoteCommand, ListSchedulesCommand},
+				nil,
+				invalidResponse(),
+			)
+			_, err := client.ListSchedules(ctx)
+			assert.Error(t, err)
+		},
 		"SignalEventPassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
 			inputChecker := EventInput{}
 			baseManager.Create = makeCreateFunc(
@@ -11,11 +11,17 @@ import (
 // ClientOptions represents the options to connect the CLI client to the Jasper
 // service.
 type ClientOptions struct {
-	BinaryPath          string
-	Type                string
-	Host                string
-	Port                int
+	BinaryPath string
+	Type       string
+	Host       string
+	Port       int
+	// SocketPath is the path to the unix domain socket of the service on the
+	// remote machine, which is used instead of the host and port.
+	SocketPath          string
 	CredentialsFilePath string
+	// AuthCredentialsFilePath is the path to the file containing the
+	// credentials used to authenticate requests to the service.
+	AuthCredentialsFilePath string
 }
 
 // Validate checks that the binary path is set and it is a recognized Jasper
@@ -58,9 +64,17 @@ func (opts *sshClientOptions) buildCommand(clientSubcommand ...string) []string
 		args = append(args, fmt.Sprintf("--%s=%d", portFlagName, opts.Client.Port))
 	}
 
+	if opts.Client.SocketPath != "" {
+		args = append(args, fmt.Sprintf("--%s=%s", socketFlagName, opts.Client.SocketPath))
+	}
+
 	if opts.Client.CredentialsFilePath != "" {
 		args = append(args, fmt.Sprintf("--%s=%s", credsFilePathFlagName, opts.Client.CredentialsFilePath))
 	}
 
+	if opts.Client.AuthCredentialsFilePath != "" {
+		args = append(args, fmt.Sprintf("--%s=%s", authCredsFilePathFlagName, opts.Client.AuthCredentialsFilePath))
+	}
+
 	return args
 }
@@ -106,6 +106,19 @@ func (p *sshProcess) Signal(ctx context.Context, sig syscall.Signal) error {
 	return nil
 }
 
+func (p *sshProcess) WaitReady(ctx context.Context) error {
+	output, err := p.runCommand(ctx, WaitReadyCommand, &IDInput{ID: p.info.ID})
+	if err != nil {
+		return err
+	}
+
+	if _, err = ExtractOutcomeResponse(output); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (p *sshProcess) Wait(ctx context.Context) (int, error) {
 	output, err := p.runCommand(ctx, WaitCommand, &IDInput{ID: p.info.ID})
 	if err != nil {
@@ -162,6 +175,23 @@ func (p *sshProcess) RegisterSignalTriggerID(ctx context.Context, sigID jasper.S
 	return nil
 }
 
+func (p *sshProcess) RegisterTriggerID(ctx context.Context, triggerID jasper.ProcessTriggerID, params map[string]string) error {
+	output, err := p.runCommand(ctx, RegisterTriggerIDCommand, &TriggerIDInput{
+		ID:        p.info.ID,
+		TriggerID: triggerID,
+		Params:    params,
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err = ExtractOutcomeResponse(output); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (p *sshProcess) Tag(tag string) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -246,6 +246,30 @@ func TestSSHProcess(t *testing.T) {
 			assert.Error(t, err)
 			assert.NotZero(t, exitCode)
 		},
+		"WaitReadyPassesWithValidResponse": func(ctx context.Context, t *testing.T, proc *sshProcess, manager *sshClient, baseManager *mock.Manager) {
+			inputChecker := IDInput{}
+			baseManager.Create = makeCreateFunc(
+				t, manager,
+				[]string{ProcessCommand, WaitReadyCommand},
+				&inputChecker,
+				makeOutcomeResponse(nil),
+			)
+
+			assert.NotError(t, proc.WaitReady(ctx))
+			assert.Equal(t, proc.ID(), inputChecker.ID)
+		},
+		"WaitReadyFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, proc *sshProcess, manager *sshClient, baseManager *mock.Manager) {
+			inputChecker := IDInput{}
+			baseManager.Create = makeCreateFunc(
+				t, manager,
+				[]string{ProcessCommand, WaitReadyCommand},
+				&inputChecker,
+				&struct{}{},
+			)
+
+			assert.Error(t, proc.WaitReady(ctx))
+			assert.Equal(t, proc.ID(), inputChecker.ID)
+		},
 		"RegisterTriggerFails": func(ctx context.Context, t *testing.T, proc *sshProcess, manager *sshClient, baseManager *mock.Manager) {
 			assert.Error(t, proc.RegisterTrigger(ctx, func(jasper.ProcessInfo) {}))
 		},
@@ -266,6 +290,32 @@ func TestSSHProcess(t *testing.T) {
 			assert.Equal(t, proc.ID(), inputChecker.ID)
 			assert.Equal(t, sigID, inputChecker.SignalTriggerID)
 		},
+		"RegisterTriggerIDPassesWithValidResponse": func(ctx context.Context, t *testing.T, proc *sshProcess, manager *sshClient, baseManager *mock.Manager) {
+			inputChecker := TriggerIDInput{}
+			baseManager.Create = makeCreateFunc(
+				t, manager,
+				[]string{ProcessCommand, RegisterTriggerIDCommand},
+				&inputChecker,
+				makeOutcomeResponse(nil),
+			)
+
+			params := map[string]string{"path": "info.json"}
+			assert.NotError(t, proc.RegisterTriggerID(ctx, jasper.WriteInfoToFileProcessTrigger, params))
+			assert.Equal(t, proc.ID(), inputChecker.ID)
+			assert.Equal(t, jasper.WriteInfoToFileProcessTrigger, inputChecker.TriggerID)
+			assert.Equal(t, "info.json", inputChecker.Params["path"])
+		},
+		"RegisterTriggerIDFailsWithInvalidResponse": func(ctx context.Context, t *testing.T, proc *sshProcess, manager *sshClient, baseManager *mock.Manager) {
+			inputChecker := TriggerIDInput{}
+			baseManager.Create = makeCreateFunc(
+				t, manager,
+				[]string{ProcessCommand, RegisterTriggerIDCommand},
+				&inputChecker,
+				&struct{}{},
+			)
+
+			assert.Error(t, proc.RegisterTriggerID(ctx, jasper.WriteInfoToFileProcessTrigger, nil))
+		},
 		"TagPasses": func(ctx context.Context, t *testing.T, proc *sshProcess, manager *sshClient, baseManager *mock.Manager) {
 			inputChecker := TagIDInput{}
 			baseManager.Create = makeCreateFunc(
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tychoish/fun/erc"
+	"github.com/tychoish/grip"
+	"github.com/tychoish/jasper"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/x/remote"
+	"gopkg.in/yaml.v3"
+)
+
+// readTaskFile reads and validates the task file at the path. Files with a
+// ".yaml" or ".yml" extension are parsed as YAML, and all other files are
+// parsed as JSON.
+func readTaskFile(path string) (*options.TaskFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("problem reading task file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		// The task file is converted to JSON so that it is parsed the same
+		// way regardless of its format, including its loggers, which only
+		// support JSON.
+		var raw interface{}
+		if err = yaml.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("problem parsing YAML task file '%s': %w", path, err)
+		}
+		if data, err = json.Marshal(raw); err != nil {
+			return nil, fmt.Errorf("problem converting YAML task file '%s': %w", path, err)
+		}
+	}
+
+	tf, err := options.ReadTaskFile(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("task file '%s': %w", path, err)
+	}
+	return tf, nil
+}
+
+// runTaskFileLocally runs the task file with a new local manager, writing the
+// output of the steps to standard output and standard error.
+func runTaskFileLocally(ctx context.Context, tf *options.TaskFile) error {
+	mngr := jasper.NewManager(jasper.ManagerOptionSetSynchronized())
+
+	tasks, err := jasper.LoadTasks(tf, func() *jasper.Command {
+		return mngr.CreateCommand(ctx).WithOptions(func(opts *options.Command) {
+			opts.Process.Output.Output = os.Stdout
+			opts.Process.Output.Error = os.Stderr
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	catcher := &erc.Collector{}
+	catcher.Push(tasks.Run(ctx))
+	catcher.Push(mngr.Close(ctx))
+	return catcher.Resolve()
+}
+
+// runTaskFileRemotely runs the task file with the remote manager, and then
+// prints the output of the steps.
+func runTaskFileRemotely(ctx context.Context, client remote.Manager, tf *options.TaskFile) error {
+	const inMemoryCap = 1000
+
+	catcher := &erc.Collector{}
+	tasks, err := jasper.LoadTasks(tf, func() *jasper.Command {
+		cmd := client.CreateCommand(ctx).RedirectErrorToOutput(true)
+		logger, err := jasper.NewInMemoryLogger(inMemoryCap)
+		if err != nil {
+			catcher.Push(fmt.Errorf("problem creating new in memory logger: %w", err))
+			return cmd
+		}
+		return cmd.AppendLoggers(logger)
+	})
+	if err != nil {
+		return err
+	}
+	if !catcher.Ok() {
+		return catcher.Resolve()
+	}
+
+	catcher.Push(tasks.Run(ctx))
+	for _, cmd := range tasks.Commands() {
+		if len(cmd.GetProcIDs()) == 0 {
+			continue
+		}
+		// The exit codes of the commands are already reflected in the error
+		// of the tasks.
+		_, err := printLogs(ctx, client, cmd, inMemoryCap)
+		grip.Debug(err)
+	}
+
+	return catcher.Resolve()
+}
@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/testutil"
+	"github.com/tychoish/jasper/util"
+	"github.com/tychoish/jasper/x/remote"
+)
+
+const testYAMLTaskFile = `
+id: workflow
+env:
+  NAME: world
+steps:
+  - name: greet
+    command: sh -c 'echo hello $NAME > out'
+  - parallel:
+      - args: [touch, a]
+      - args: [touch, b]
+        timeout: 10s
+`
+
+func TestReadTaskFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(t *testing.T, name, contents string) string {
+		path := filepath.Join(dir, name)
+		assert.NotError(t, os.WriteFile(path, []byte(contents), 0o644))
+		return path
+	}
+
+	t.Run("YAML", func(t *testing.T) {
+		tf, err := readTaskFile(write(t, "tasks.yaml", testYAMLTaskFile))
+		assert.NotError(t, err)
+		check.Equal(t, tf.ID, "workflow")
+		check.Equal(t, tf.Environment["NAME"], "world")
+		assert.Equal(t, len(tf.Steps), 2)
+		check.EqualItems(t, tf.Steps[0].GetArgs(), []string{"sh", "-c", "echo hello $NAME > out"})
+		assert.Equal(t, len(tf.Steps[1].Parallel), 2)
+		check.NotZero(t, tf.Steps[1].Parallel[1].GetTimeout())
+	})
+	t.Run("JSON", func(t *testing.T) {
+		tf, err := readTaskFile(write(t, "tasks.json", `{"steps": [{"command": "ls"}]}`))
+		assert.NotError(t, err)
+		check.EqualItems(t, tf.Steps[0].GetArgs(), []string{"ls"})
+	})
+	t.Run("InvalidYAML", func(t *testing.T) {
+		_, err := readTaskFile(write(t, "invalid.yml", "steps: [\n"))
+		check.Error(t, err)
+	})
+	t.Run("UnknownField", func(t *testing.T) {
+		_, err := readTaskFile(write(t, "unknown.yaml", "steps:\n  - comand: ls\n"))
+		assert.Error(t, err)
+		check.Substring(t, err.Error(), "comand")
+	})
+	t.Run("InvalidStep", func(t *testing.T) {
+		_, err := readTaskFile(write(t, "invalid-step.yaml", "steps:\n  - command: ls\n    args: [ls]\n"))
+		assert.Error(t, err)
+		check.Substring(t, err.Error(), "steps[0]")
+	})
+	t.Run("Nonexistent", func(t *testing.T) {
+		_, err := readTaskFile(filepath.Join(dir, "nonexistent.yaml"))
+		check.Error(t, err)
+	})
+}
+
+func TestExecTaskFileLocally(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires POSIX utilities")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+	defer cancel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "tasks.yaml")
+	assert.NotError(t, os.WriteFile(path, []byte("dir: "+dir+"\n"+testYAMLTaskFile), 0o644))
+
+	assert.NotError(t, ExecCMD().Run(ctx, []string{"exec", "--local", "-f", path}))
+
+	out, err := os.ReadFile(filepath.Join(dir, "out"))
+	assert.NotError(t, err)
+	check.Equal(t, string(out), "hello world\n")
+	for _, name := range []string{"a", "b"} {
+		_, err := os.Stat(filepath.Join(dir, name))
+		check.NotError(t, err)
+	}
+
+	check.Error(t, ExecCMD().Run(ctx, []string{"exec", "--local", "-f", path, "ls"}))
+	check.Error(t, ExecCMD().Run(ctx, []string{"exec", "--local", "ls"}))
+}
+
+func TestRunTaskFileRemotely(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires POSIX utilities")
+	}
+
+	for serviceName, makeServiceAndClient := range map[string]func(context.Context, *testing.T, int, jasper.Manager) (util.CloseFunc, remote.Manager){
+		RESTService: makeTestRESTServiceAndClient,
+		RPCService:  makeTestRPCServiceAndClient,
+	} {
+		t.Run(serviceName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+			defer cancel()
+
+			manager := jasper.NewManager(jasper.ManagerOptionSetSynchronized())
+			closeService, client := makeServiceAndClient(ctx, t, testutil.GetPortNumber(), manager)
+			defer func() {
+				check.NotError(t, client.CloseConnection())
+				check.NotError(t, closeService())
+			}()
+
+			dir := t.TempDir()
+			path := filepath.Join(dir, "tasks.yaml")
+			assert.NotError(t, os.WriteFile(path, []byte("dir: "+dir+"\n"+testYAMLTaskFile), 0o644))
+			tf, err := readTaskFile(path)
+			assert.NotError(t, err)
+
+			assert.NotError(t, runTaskFileRemotely(ctx, client, tf))
+			out, err := os.ReadFile(filepath.Join(dir, "out"))
+			assert.NotError(t, err)
+			check.Equal(t, string(out), "hello world\n")
+
+			procs, err := manager.List(ctx, options.All)
+			assert.NotError(t, err)
+			check.Equal(t, len(procs), 3)
+
+			tf.Steps = append(tf.Steps, options.TaskStep{Command: "false"})
+			check.Error(t, runTaskFileRemotely(ctx, client, tf))
+		})
+	}
+}
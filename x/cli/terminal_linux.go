@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	roptions "github.com/tychoish/jasper/x/remote/options"
+	"golang.org/x/sys/unix"
+)
+
+// isTerminal returns whether the file descriptor refers to a terminal.
+func isTerminal(fd int) bool {
+	_, err := unix.IoctlGetTermios(fd, unix.TCGETS)
+	return err == nil
+}
+
+// makeRawTerminal puts the terminal into raw mode so that input is passed
+// through without being processed, and returns a function that restores the
+// terminal to its previous state.
+func makeRawTerminal(fd int) (func() error, error) {
+	termios, err := unix.IoctlGetTermios(fd, unix.TCGETS)
+	if err != nil {
+		return nil, err
+	}
+	previous := *termios
+
+	termios.Iflag &^= unix.IGNBRK | unix.BRKINT | unix.PARMRK | unix.ISTRIP | unix.INLCR | unix.IGNCR | unix.ICRNL | unix.IXON
+	termios.Oflag &^= unix.OPOST
+	termios.Lflag &^= unix.ECHO | unix.ECHONL | unix.ICANON | unix.ISIG | unix.IEXTEN
+	termios.Cflag &^= unix.CSIZE | unix.PARENB
+	termios.Cflag |= unix.CS8
+	termios.Cc[unix.VMIN] = 1
+	termios.Cc[unix.VTIME] = 0
+	if err := unix.IoctlSetTermios(fd, unix.TCSETS, termios); err != nil {
+		return nil, err
+	}
+
+	return func() error { return unix.IoctlSetTermios(fd, unix.TCSETS, &previous) }, nil
+}
+
+// getWindowSize returns the size of the terminal.
+func getWindowSize(fd int) (roptions.WindowSize, error) {
+	size, err := unix.IoctlGetWinsize(fd, unix.TIOCGWINSZ)
+	if err != nil {
+		return roptions.WindowSize{}, err
+	}
+	return roptions.WindowSize{Rows: size.Row, Cols: size.Col}, nil
+}
+
+// notifyWindowResize relays signals that the terminal was resized to the
+// channel.
+func notifyWindowResize(c chan<- os.Signal) {
+	signal.Notify(c, syscall.SIGWINCH)
+}
@@ -0,0 +1,22 @@
+//go:build !linux
+
+package cli
+
+import (
+	"errors"
+	"os"
+
+	roptions "github.com/tychoish/jasper/x/remote/options"
+)
+
+var errTerminalNotSupported = errors.New("terminal control is not supported on this platform")
+
+func isTerminal(int) bool { return false }
+
+func makeRawTerminal(int) (func() error, error) { return nil, errTerminalNotSupported }
+
+func getWindowSize(int) (roptions.WindowSize, error) {
+	return roptions.WindowSize{}, errTerminalNotSupported
+}
+
+func notifyWindowResize(chan<- os.Signal) {}
@@ -10,14 +10,17 @@ import (
 	"math"
 	"math/rand"
 	"net"
+	"net/http"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/evergreen-ci/service"
 	"github.com/tychoish/fun/erc"
+	"github.com/tychoish/jasper/options"
 	"github.com/tychoish/jasper/util"
 	"github.com/tychoish/jasper/x/remote"
+	roptions "github.com/tychoish/jasper/x/remote/options"
 	"github.com/urfave/cli/v3"
 )
 
@@ -99,23 +102,94 @@ func writeOutput(output io.Writer, input interface{}) error {
 	return nil
 }
 
+// serviceAddr returns the address of the unix domain socket at the path if
+// it is non-empty, or the TCP address of the host and port otherwise.
+func serviceAddr(host string, port int, socketPath string) (net.Addr, error) {
+	if socketPath != "" {
+		return remote.UnixSocketAddr{Path: socketPath}, nil
+	}
+	return net.ResolveTCPAddr("tcp", fmt.Sprintf("%s:%d", host, port))
+}
+
 // newRemoteClient returns a remote client that connects to the service at the
-// given host and port, with the optional TLS credentials file for RPC
-// communication.
-func newRemoteClient(ctx context.Context, service, host string, port int, credsFilePath string) (remote.Manager, error) {
-	addr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("%s:%d", host, port))
+// given host and port, or the unix domain socket if the socket path is
+// non-empty, with the optional TLS credentials file and the optional file
+// containing the credentials used to authenticate requests.
+func newRemoteClient(ctx context.Context, service, host string, port int, socketPath, credsFilePath, authCredsFilePath string) (remote.Manager, error) {
+	addr, err := serviceAddr(host, port, socketPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve address: %w", err)
 	}
 
+	var authCreds *roptions.AuthCredentials
+	if authCredsFilePath != "" {
+		if authCreds, err = roptions.NewAuthCredentialsFromFile(authCredsFilePath); err != nil {
+			return nil, fmt.Errorf("error getting auth credentials from file: %w", err)
+		}
+	}
+
 	if service == RESTService {
-		return remote.NewRestClient(addr), nil
+		return newRESTClient(addr, credsFilePath, authCreds)
 	} else if service == RPCService {
-		return remote.NewRPCClientWithFile(ctx, addr, credsFilePath)
+		if authCreds == nil {
+			return remote.NewRPCClientWithFile(ctx, addr, credsFilePath)
+		}
+		return remote.NewRPCClientWithFile(ctx, addr, credsFilePath, remote.AuthDialOptions(*authCreds)...)
 	}
 	return nil, fmt.Errorf("unrecognized service type '%s'", service)
 }
 
+// newRESTClient returns a REST client that connects to the service at the
+// address over HTTPS if the TLS credentials file path is non-empty, and that
+// authenticates its requests if the auth credentials are non-nil.
+func newRESTClient(addr net.Addr, credsFilePath string, authCreds *roptions.AuthCredentials) (remote.Manager, error) {
+	if credsFilePath == "" && authCreds == nil {
+		return remote.NewRestClient(addr), nil
+	}
+
+	var transport http.RoundTripper
+	if credsFilePath != "" {
+		creds, err := options.NewCredentialsFromFile(credsFilePath)
+		if err != nil {
+			return nil, fmt.Errorf("error getting credentials from file: %w", err)
+		}
+		tlsTransport, err := remote.NewRestTLSTransport(creds)
+		if err != nil {
+			return nil, err
+		}
+		if addr.Network() == "unix" {
+			tlsTransport = remote.NewUnixSocketTransport(addr.String(), tlsTransport)
+		}
+		transport = tlsTransport
+	} else if addr.Network() == "unix" {
+		transport = remote.NewUnixSocketTransport(addr.String(), nil)
+	}
+	if authCreds != nil {
+		transport = remote.NewAuthTransport(*authCreds, transport)
+	}
+
+	client := &http.Client{Transport: transport}
+	if credsFilePath != "" {
+		return remote.MakeSecureRestClient(addr, client), nil
+	}
+	return remote.MakeRestClient(addr, client), nil
+}
+
+// newAuthorizer returns the authorizer for the auth configuration in the file
+// at the given path, or nil if the path is empty.
+func newAuthorizer(authConfigFilePath string) (*remote.Authorizer, error) {
+	if authConfigFilePath == "" {
+		return nil, nil
+	}
+
+	conf, err := roptions.NewAuthConfigFromFile(authConfigFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error getting auth config from file: %w", err)
+	}
+
+	return remote.NewAuthorizerFromConfig(*conf)
+}
+
 // doPassthroughInputOutput passes input from standard input to the input validator,
 // validates the input, runs the request, and writes the response of the request
 // to standard output.
@@ -151,10 +225,12 @@ func doPassthroughOutput(c *cli.Command, request func(context.Context, remote.Ma
 func withConnection(ctx context.Context, c *cli.Command, operation func(remote.Manager) error) error {
 	host := c.String(hostFlagName)
 	port := c.Int(portFlagName)
+	socketPath := c.String(socketFlagName)
 	service := c.String(serviceFlagName)
 	credsFilePath := c.String(credsFilePathFlagName)
+	authCredsFilePath := c.String(authCredsFilePathFlagName)
 
-	client, err := newRemoteClient(ctx, service, host, port, credsFilePath)
+	client, err := newRemoteClient(ctx, service, host, port, socketPath, credsFilePath, authCredsFilePath)
 	if err != nil {
 		return fmt.Errorf("error setting up remote client: %w", err)
 	}
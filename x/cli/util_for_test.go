@@ -127,7 +127,7 @@ func execCLICommandOutput(t *testing.T, cmd *cli.Command, inputArgs []string, ou
 // makeTestRESTService creates a REST service for testing purposes only on
 // localhost.
 func makeTestRESTService(ctx context.Context, t *testing.T, port int, manager jasper.Manager) util.CloseFunc {
-	closeService, err := newRESTService(ctx, "localhost", port, manager)
+	closeService, err := newRESTService(ctx, "localhost", port, "", manager, "", false, "")
 	assert.NotError(t, err)
 	assert.NotError(t, testutil.WaitForRESTService(ctx, fmt.Sprintf("http://localhost:%d/jasper/v1", port)))
 	return closeService
@@ -137,7 +137,7 @@ func makeTestRESTService(ctx context.Context, t *testing.T, port int, manager ja
 // purposes on localhost.
 func makeTestRESTServiceAndClient(ctx context.Context, t *testing.T, port int, manager jasper.Manager) (util.CloseFunc, remote.Manager) {
 	closeService := makeTestRESTService(ctx, t, port, manager)
-	client, err := newRemoteClient(ctx, RESTService, "localhost", port, "")
+	client, err := newRemoteClient(ctx, RESTService, "localhost", port, "", "", "")
 	assert.NotError(t, err)
 	return closeService, client
 }
@@ -145,7 +145,7 @@ func makeTestRESTServiceAndClient(ctx context.Context, t *testing.T, port int, m
 // makeTestRPCService creates an RPC service for testing purposes only on
 // localhost with no credentials.
 func makeTestRPCService(ctx context.Context, t *testing.T, port int, manager jasper.Manager) util.CloseFunc {
-	closeService, err := newRPCService(ctx, "localhost", port, manager, "")
+	closeService, err := newRPCService(ctx, "localhost", port, "", manager, "", "")
 	assert.NotError(t, err)
 	return closeService
 }
@@ -154,7 +154,7 @@ func makeTestRPCService(ctx context.Context, t *testing.T, port int, manager jas
 // purposes on localhost with no credentials.
 func makeTestRPCServiceAndClient(ctx context.Context, t *testing.T, port int, manager jasper.Manager) (util.CloseFunc, remote.Manager) {
 	closeService := makeTestRPCService(ctx, t, port, manager)
-	client, err := newRemoteClient(ctx, RPCService, "localhost", port, "")
+	client, err := newRemoteClient(ctx, RPCService, "localhost", port, "", "", "")
 	assert.NotError(t, err)
 	return closeService, client
 }
@@ -89,7 +89,7 @@ func TestWriteOutputInvalidOutput(t *testing.T) {
 
 func TestMakeRemoteClientInvalidService(t *testing.T) {
 	ctx := context.Background()
-	client, err := newRemoteClient(ctx, "invalid", "localhost", testutil.GetPortNumber(), "")
+	client, err := newRemoteClient(ctx, "invalid", "localhost", testutil.GetPortNumber(), "", "", "")
 	assert.Error(t, err)
 	assert.True(t, client == nil)
 }
@@ -46,7 +46,8 @@ func Enqueue(ctx context.Context, c *jasper.Command, q amboy.Queue) error {
 
 // JobsForeground returns a slice of jobs for every operation
 // captured in the command. The output of the commands are logged,
-// using the default grip sender in the foreground.
+// using the default grip sender in the foreground. The jobs retry
+// their operations as the retry options of the command allow.
 func JobsForeground(c *jasper.Command) ([]amboy.Job, error) {
 	opts, err := c.ExportCreateOptions()
 	if err != nil {
@@ -55,14 +56,15 @@ func JobsForeground(c *jasper.Command) ([]amboy.Job, error) {
 
 	out := make([]amboy.Job, len(opts))
 	for idx := range opts {
-		out[idx] = NewJobForeground(c.GetProcessConstructor(), opts[idx])
+		out[idx] = newJobForeground(c.GetProcessConstructor(), opts[idx], c.GetRetryOptions(idx))
 	}
 	return out, nil
 }
 
 // Jobs returns a slice of jobs for every operation in the
 // command. The output of the commands are captured in the body of the
-// job.
+// job, along with every attempt when the retry options of the command
+// allow the operations to be retried.
 func Jobs(c *jasper.Command) ([]amboy.Job, error) {
 	opts, err := c.ExportCreateOptions()
 	if err != nil {
@@ -71,7 +73,7 @@ func Jobs(c *jasper.Command) ([]amboy.Job, error) {
 
 	out := make([]amboy.Job, len(opts))
 	for idx := range opts {
-		out[idx] = NewJobOptions(c.GetProcessConstructor(), opts[idx])
+		out[idx] = newJobOptions(c.GetProcessConstructor(), opts[idx], c.GetRetryOptions(idx))
 	}
 	return out, nil
 }
@@ -171,8 +171,10 @@ func (j *amboyJob) Run(ctx context.Context) {
 }
 
 type amboySimpleCapturedOutputJob struct {
-	Options *options.Create `bson:"options" json:"options" yaml:"options"`
-	Output  struct {
+	Options  *options.Create         `bson:"options" json:"options" yaml:"options"`
+	Retry    *options.Retry          `bson:"retry,omitempty" json:"retry,omitempty" yaml:"retry,omitempty"`
+	Attempts []jasper.CommandAttempt `bson:"attempts,omitempty" json:"attempts,omitempty" yaml:"attempts,omitempty"`
+	Output   struct {
 		Error  string `bson:"error," json:"error," yaml:"error,"`
 		Output string `bson:"output" json:"output" yaml:"output"`
 	} `bson:"output" json:"output" yaml:"output"`
@@ -205,8 +207,20 @@ func amboySimpleCapturedOutputJobFactory(pc jasper.ProcessConstructor) *amboySim
 // Pass the process constructor to allow the amboy jobs to manipulate
 // processes in an existing Manager.
 func NewJobOptions(pc jasper.ProcessConstructor, opts *options.Create) Job {
+	return newJobOptions(pc, opts, nil)
+}
+
+// NewJobOptionsWithRetry is the same as NewJobOptions, but the process is
+// retried as the retry options allow if it fails. Every attempt is recorded
+// in the body of the job.
+func NewJobOptionsWithRetry(pc jasper.ProcessConstructor, opts *options.Create, retry *options.Retry) Job {
+	return newJobOptions(pc, opts, retry)
+}
+
+func newJobOptions(pc jasper.ProcessConstructor, opts *options.Create, retry *options.Retry) *amboySimpleCapturedOutputJob {
 	j := amboySimpleCapturedOutputJobFactory(pc)
 	j.Options = opts
+	j.Retry = retry
 	j.SetID(fmt.Sprintf("%s.%x", j.Type().Name, opts.Hash()))
 	return j
 }
@@ -224,21 +238,22 @@ func (j *amboySimpleCapturedOutputJob) Run(ctx context.Context) {
 	j.Options.Output.Error = error
 	j.Options.Output.Output = output
 
-	p, err := j.makep(ctx, j.Options)
-	if err != nil {
-		j.AddError(err)
+	attempts, err := jasper.RunProcessWithRetry(ctx, j.makep, j.Options, j.Retry)
+	j.AddError(err)
+	j.Attempts = attempts
+	if len(attempts) == 0 {
 		return
 	}
-	exitCode, err := p.Wait(ctx)
-	j.AddError(err)
-	j.ExitCode = exitCode
+	j.ExitCode = attempts[len(attempts)-1].ExitCode
 	j.Output.Error = error.String()
 	j.Output.Output = output.String()
 }
 
 type amboyForegroundOutputJob struct {
-	Options   *options.Create `bson:"options" json:"options" yaml:"options"`
-	ExitCode  int             `bson:"exit_code" json:"exit_code" yaml:"exit_code"`
+	Options   *options.Create         `bson:"options" json:"options" yaml:"options"`
+	Retry     *options.Retry          `bson:"retry,omitempty" json:"retry,omitempty" yaml:"retry,omitempty"`
+	Attempts  []jasper.CommandAttempt `bson:"attempts,omitempty" json:"attempts,omitempty" yaml:"attempts,omitempty"`
+	ExitCode  int                     `bson:"exit_code" json:"exit_code" yaml:"exit_code"`
 	*job.Base `bson:"metadata" json:"metadata" yaml:"metadata"`
 
 	makep jasper.ProcessConstructor
@@ -266,9 +281,21 @@ func amboyForegroundOutputJobFactory(pc jasper.ProcessConstructor) *amboyForegro
 // Pass the process constructor to allow the amboy jobs to manipulate
 // processes in an existing Manager.
 func NewJobForeground(pc jasper.ProcessConstructor, opts *options.Create) amboy.Job {
+	return newJobForeground(pc, opts, nil)
+}
+
+// NewJobForegroundWithRetry is the same as NewJobForeground, but the process
+// is retried as the retry options allow if it fails. Every attempt is
+// recorded in the body of the job.
+func NewJobForegroundWithRetry(pc jasper.ProcessConstructor, opts *options.Create, retry *options.Retry) amboy.Job {
+	return newJobForeground(pc, opts, retry)
+}
+
+func newJobForeground(pc jasper.ProcessConstructor, opts *options.Create, retry *options.Retry) *amboyForegroundOutputJob {
 	j := amboyForegroundOutputJobFactory(pc)
 	j.SetID(fmt.Sprintf("%s.%x", j.Type().Name, opts.Hash()))
 	j.Options = opts
+	j.Retry = retry
 	return j
 }
 
@@ -294,12 +321,11 @@ func (j *amboyForegroundOutputJob) Run(ctx context.Context) {
 	j.Options.Output.Error = send.MakeWriterSender(grip.Sender())
 	j.Options.Output.Output = send.MakeWriterSender(grip.Sender())
 
-	p, err := j.makep(ctx, j.Options)
-	if err != nil {
-		j.AddError(err)
+	attempts, err := jasper.RunProcessWithRetry(ctx, j.makep, j.Options, j.Retry)
+	j.AddError(err)
+	j.Attempts = attempts
+	if len(attempts) == 0 {
 		return
 	}
-	exitCode, err := p.Wait(ctx)
-	j.AddError(err)
-	j.ExitCode = exitCode
+	j.ExitCode = attempts[len(attempts)-1].ExitCode
 }
@@ -21,12 +21,27 @@ const downloadJobName = "jasper-download-job"
 // downloadFileJob is an amboy.Job implementation that supports
 // downloading a a file to the local file system.
 type downloadFileJob struct {
-	URL       string `bson:"url" json:"url" yaml:"url"`
-	Directory string `bson:"dir" json:"dir" yaml:"dir"`
-	FileName  string `bson:"file" json:"file" yaml:"file"`
+	URL       string          `bson:"url" json:"url" yaml:"url"`
+	Directory string          `bson:"dir" json:"dir" yaml:"dir"`
+	FileName  string          `bson:"file" json:"file" yaml:"file"`
+	Options   DownloadOptions `bson:"options" json:"options" yaml:"options"`
 	*job.Base `bson:"metadata" json:"metadata" yaml:"metadata"`
 }
 
+// DownloadOptions are the optional settings for a download job, which are
+// passed through to the underlying download.
+type DownloadOptions struct {
+	Checksum options.DownloadChecksum `bson:"checksum,omitempty" json:"checksum,omitempty" yaml:"checksum,omitempty"`
+	Headers  map[string]string        `bson:"headers,omitempty" json:"headers,omitempty" yaml:"headers,omitempty"`
+	Username string                   `bson:"username,omitempty" json:"username,omitempty" yaml:"username,omitempty"`
+	Password string                   `bson:"password,omitempty" json:"password,omitempty" yaml:"password,omitempty"`
+	Resume   bool                     `bson:"resume,omitempty" json:"resume,omitempty" yaml:"resume,omitempty"`
+	Retry    options.DownloadRetry    `bson:"retry,omitempty" json:"retry,omitempty" yaml:"retry,omitempty"`
+	// UseCache downloads the file through the global download cache, if
+	// one is set. Only downloads with a checksum are cached.
+	UseCache bool `bson:"use_cache,omitempty" json:"use_cache,omitempty" yaml:"use_cache,omitempty"`
+}
+
 func newDownloadJob() *downloadFileJob {
 	return &downloadFileJob{
 		Base: &job.Base{
@@ -44,7 +59,22 @@ func newDownloadJob() *downloadFileJob {
 // will only execute if that file does not exist, unless the force
 // flag is passed.
 func NewDownloadJob(url, path string, force bool) (amboy.Job, error) {
+	return NewDownloadJobWithOptions(url, path, force, DownloadOptions{})
+}
+
+// NewDownloadJobWithOptions is the same as NewDownloadJob, but the download
+// verifies the checksum, sends the headers and credentials, and resumes or
+// retries as configured in the options.
+func NewDownloadJobWithOptions(url, path string, force bool, opts DownloadOptions) (amboy.Job, error) {
+	if err := opts.Checksum.Validate(); err != nil {
+		return nil, fmt.Errorf("problem constructing Job object (checksum): %w", err)
+	}
+	if err := opts.Retry.Validate(); err != nil {
+		return nil, fmt.Errorf("problem constructing Job object (retry): %w", err)
+	}
+
 	j := newDownloadJob()
+	j.Options = opts
 	if err := j.setURL(url); err != nil {
 		return nil, fmt.Errorf("problem constructing Job object (url): %w", err)
 	}
@@ -99,6 +129,13 @@ func (j *downloadFileJob) Run(ctx context.Context) {
 			ShouldExtract: true,
 			TargetPath:    getTargetDirectory(fn),
 		},
+		Checksum: j.Options.Checksum,
+		Headers:  j.Options.Headers,
+		Username: j.Options.Username,
+		Password: j.Options.Password,
+		Resume:   j.Options.Resume,
+		Retry:    j.Options.Retry,
+		UseCache: j.Options.UseCache,
 	}
 
 	if err := opts.Download(ctx); err != nil {
@@ -101,5 +101,32 @@ func TestAmboyJob(t *testing.T) {
 			assert.Error(t, job.Error())
 		})
 	})
+	t.Run("Retry", func(t *testing.T) {
+		retry := &options.Retry{Attempts: 3}
+		t.Run("Simple", func(t *testing.T) {
+			job := NewJobOptionsWithRetry(jasper.NewBasicProcess, &options.Create{Args: []string{"false"}}, retry).(*amboySimpleCapturedOutputJob)
+			job.Run(ctx)
+			assert.Error(t, job.Error())
+			check.Equal(t, len(job.Attempts), 3)
+			check.Equal(t, job.ExitCode, 1)
+		})
+		t.Run("Foreground", func(t *testing.T) {
+			job := NewJobForegroundWithRetry(jasper.NewBasicProcess, &options.Create{Args: []string{"false"}}, retry).(*amboyForegroundOutputJob)
+			job.Run(ctx)
+			assert.Error(t, job.Error())
+			check.Equal(t, len(job.Attempts), 3)
+		})
+		t.Run("CommandJobs", func(t *testing.T) {
+			cmd := jasper.NewCommand().Extend([][]string{{"false"}, {"true"}}).Retry(retry).RetryStep(1, nil)
+			jobs, err := Jobs(cmd)
+			assert.NotError(t, err)
+			assert.Equal(t, len(jobs), 2)
+			check.Equal(t, jobs[0].(*amboySimpleCapturedOutputJob).Retry, retry)
+			check.True(t, jobs[1].(*amboySimpleCapturedOutputJob).Retry == nil)
 
+			jobs, err = JobsForeground(cmd)
+			assert.NotError(t, err)
+			check.Equal(t, jobs[0].(*amboyForegroundOutputJob).Retry, retry)
+		})
+	})
 }
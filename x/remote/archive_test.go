@@ -0,0 +1,160 @@
+package remote
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	ropts "github.com/tychoish/jasper/x/remote/options"
+)
+
+type testArchiveEntry struct {
+	name string
+	link string
+	body string
+	dir  bool
+}
+
+func writeTestTar(t *testing.T, path string, entries []testArchiveEntry) {
+	file, err := os.Create(path)
+	assert.NotError(t, err)
+	defer func() { assert.NotError(t, file.Close()) }()
+
+	tw := tar.NewWriter(file)
+	for _, entry := range entries {
+		hdr := &tar.Header{Name: entry.name, Mode: 0o644, Typeflag: tar.TypeReg, Size: int64(len(entry.body))}
+		switch {
+		case entry.dir:
+			hdr.Typeflag = tar.TypeDir
+			hdr.Mode = 0o755
+		case entry.link != "":
+			hdr.Typeflag = tar.TypeSymlink
+			hdr.Linkname = entry.link
+		}
+		assert.NotError(t, tw.WriteHeader(hdr))
+		if hdr.Typeflag == tar.TypeReg {
+			_, err = tw.Write([]byte(entry.body))
+			assert.NotError(t, err)
+		}
+	}
+	assert.NotError(t, tw.Close())
+}
+
+func TestArchiveExtract(t *testing.T) {
+	for testName, testCase := range map[string]func(t *testing.T, dir, target string){
+		"ExtractsFiles": func(t *testing.T, dir, target string) {
+			path := filepath.Join(dir, "archive.tar")
+			writeTestTar(t, path, []testArchiveEntry{
+				{name: "a/", dir: true},
+				{name: "a/b.txt", body: "foo"},
+				{name: "a/link", link: "b.txt"},
+			})
+
+			assert.NotError(t, ropts.Archive{Format: ropts.ArchiveAuto, TargetPath: target}.Extract(path))
+			content, err := os.ReadFile(filepath.Join(target, "a", "link"))
+			assert.NotError(t, err)
+			check.Equal(t, string(content), "foo")
+		},
+		"StripsComponents": func(t *testing.T, dir, target string) {
+			path := filepath.Join(dir, "archive.tar")
+			writeTestTar(t, path, []testArchiveEntry{
+				{name: "top/", dir: true},
+				{name: "top/a/b.txt", body: "foo"},
+				{name: "c.txt", body: "bar"},
+			})
+
+			assert.NotError(t, ropts.Archive{Format: ropts.ArchiveTar, TargetPath: target, StripComponents: 1}.Extract(path))
+			content, err := os.ReadFile(filepath.Join(target, "a", "b.txt"))
+			assert.NotError(t, err)
+			check.Equal(t, string(content), "foo")
+
+			entries, err := os.ReadDir(target)
+			assert.NotError(t, err)
+			check.Equal(t, len(entries), 1)
+		},
+		"FailsWithPathTraversal": func(t *testing.T, dir, target string) {
+			path := filepath.Join(dir, "archive.tar")
+			writeTestTar(t, path, []testArchiveEntry{{name: "a/../../evil", body: "foo"}})
+
+			check.Error(t, ropts.Archive{Format: ropts.ArchiveTar, TargetPath: target}.Extract(path))
+			_, err := os.Stat(filepath.Join(dir, "evil"))
+			check.True(t, os.IsNotExist(err))
+		},
+		"FailsWithAbsolutePath": func(t *testing.T, dir, target string) {
+			path := filepath.Join(dir, "archive.tar")
+			writeTestTar(t, path, []testArchiveEntry{{name: filepath.Join(dir, "evil"), body: "foo"}})
+
+			check.Error(t, ropts.Archive{Format: ropts.ArchiveTar, TargetPath: target}.Extract(path))
+			_, err := os.Stat(filepath.Join(dir, "evil"))
+			check.True(t, os.IsNotExist(err))
+		},
+		"FailsWithSymlinkOutsideTarget": func(t *testing.T, dir, target string) {
+			for _, link := range []string{"../", dir} {
+				path := filepath.Join(dir, "archive.tar")
+				writeTestTar(t, path, []testArchiveEntry{{name: "link", link: link}})
+				check.Error(t, ropts.Archive{Format: ropts.ArchiveTar, TargetPath: target}.Extract(path))
+			}
+		},
+		"FailsWritingThroughSymlink": func(t *testing.T, dir, target string) {
+			path := filepath.Join(dir, "archive.tar")
+			writeTestTar(t, path, []testArchiveEntry{
+				{name: "sub/", dir: true},
+				{name: "link", link: "sub"},
+				{name: "link/file", body: "foo"},
+			})
+
+			check.Error(t, ropts.Archive{Format: ropts.ArchiveTar, TargetPath: target}.Extract(path))
+			_, err := os.Stat(filepath.Join(target, "sub", "file"))
+			check.True(t, os.IsNotExist(err))
+		},
+		"FailsWithZipPathTraversal": func(t *testing.T, dir, target string) {
+			path := filepath.Join(dir, "archive.zip")
+			file, err := os.Create(path)
+			assert.NotError(t, err)
+			zw := zip.NewWriter(file)
+			fw, err := zw.Create("../evil")
+			assert.NotError(t, err)
+			_, err = fw.Write([]byte("foo"))
+			assert.NotError(t, err)
+			assert.NotError(t, zw.Close())
+			assert.NotError(t, file.Close())
+
+			check.Error(t, ropts.Archive{Format: ropts.ArchiveAuto, TargetPath: target}.Extract(path))
+			_, err = os.Stat(filepath.Join(dir, "evil"))
+			check.True(t, os.IsNotExist(err))
+		},
+		"FailsWithUnknownExtension": func(t *testing.T, dir, target string) {
+			path := filepath.Join(dir, "archive.foo")
+			assert.NotError(t, os.WriteFile(path, nil, 0o644))
+			check.Error(t, ropts.Archive{Format: ropts.ArchiveAuto, TargetPath: target}.Extract(path))
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			dir := t.TempDir()
+			testCase(t, dir, filepath.Join(dir, "target"))
+		})
+	}
+}
+
+func TestDetectArchiveFormat(t *testing.T) {
+	for name, expected := range map[string]ropts.ArchiveFormat{
+		"foo.tar":     ropts.ArchiveTar,
+		"foo.tar.gz":  ropts.ArchiveTarGz,
+		"foo.tgz":     ropts.ArchiveTarGz,
+		"foo.tar.xz":  ropts.ArchiveTarXz,
+		"foo.tar.zst": ropts.ArchiveTarZst,
+		"foo.tar.bz2": ropts.ArchiveTarBz2,
+		"FOO.ZIP":     ropts.ArchiveZip,
+	} {
+		format, err := ropts.DetectArchiveFormat(name)
+		assert.NotError(t, err)
+		check.Equal(t, format, expected)
+	}
+
+	_, err := ropts.DetectArchiveFormat("foo.txt")
+	check.Error(t, err)
+}
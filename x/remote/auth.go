@@ -0,0 +1,345 @@
+package remote
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tychoish/fun/ers"
+	roptions "github.com/tychoish/jasper/x/remote/options"
+)
+
+const (
+	// ErrNoCredentials is returned by an Authenticator when the request
+	// does not contain any credentials that it can check.
+	ErrNoCredentials = ers.Error("request has no credentials")
+	// ErrNotAuthenticated is returned when the credentials on a request are
+	// missing or invalid.
+	ErrNotAuthenticated = ers.Error("request is not authenticated")
+	// ErrNotAuthorized is returned when an authenticated identity is not
+	// permitted to perform the requested operation.
+	ErrNotAuthorized = ers.Error("identity is not authorized")
+)
+
+// Header and metadata keys used to carry credentials on REST and gRPC
+// requests. gRPC uses the lower case form of each key.
+const (
+	authorizationHeader = "Authorization"
+	authKeyIDHeader     = "X-Jasper-Key-Id"
+	authTimestampHeader = "X-Jasper-Timestamp"
+	authNonceHeader     = "X-Jasper-Nonce"
+	authSignatureHeader = "X-Jasper-Signature"
+
+	bearerPrefix = "Bearer "
+)
+
+// bearerToken returns the token in the value of an Authorization header. A
+// request without the header has no token, and a header that does not use the
+// bearer scheme is rejected rather than treated as a token.
+func bearerToken(header string) (string, error) {
+	if header == "" {
+		return "", nil
+	}
+
+	token, ok := strings.CutPrefix(header, bearerPrefix)
+	if !ok {
+		return "", fmt.Errorf("%w: authorization header does not contain a bearer token", ErrNotAuthenticated)
+	}
+	return token, nil
+}
+
+// AuthRequest holds the authentication material presented with a single
+// request, independent of the protocol that carried it.
+type AuthRequest struct {
+	// Target identifies the operation invoked by the request: the method
+	// and request URI for REST, the full method name for gRPC and the
+	// command name for the MongoDB wire protocol.
+	Target string
+	// Body is the request body covered by the signature, if any: the body
+	// for REST, the encoded request message of unary calls for gRPC and the
+	// command document without the credentials for the MongoDB wire
+	// protocol.
+	Body []byte
+
+	Token     string
+	KeyID     string
+	Timestamp string
+	// Nonce is a random value that makes each signature unique, so that a
+	// signature cannot be replayed within the allowed clock skew.
+	Nonce     string
+	Signature string
+
+	// PeerCertificates are the verified TLS client certificates, if any.
+	PeerCertificates []*x509.Certificate
+	// PeerCredentials identify the process that sent the request over a
+	// unix domain socket, if any.
+	PeerCredentials *PeerCredentials
+}
+
+// Identity is the result of authenticating a request.
+type Identity struct {
+	Name string
+	// Method is the name of the authentication method that produced the
+	// identity.
+	Method string
+}
+
+// Authenticator checks the credentials on a request and determines the
+// identity of the caller. Implementations should return ErrNoCredentials if
+// the request carries no credentials of the kind they check, so that they
+// can be combined with NewMultiAuthenticator.
+type Authenticator interface {
+	Authenticate(AuthRequest) (Identity, error)
+}
+
+type tokenAuthenticator struct {
+	tokens map[string]string
+}
+
+// NewTokenAuthenticator returns an Authenticator that accepts static bearer
+// tokens. The tokens map identity names to the token for that identity.
+func NewTokenAuthenticator(tokens map[string]string) Authenticator {
+	return &tokenAuthenticator{tokens: tokens}
+}
+
+func (a *tokenAuthenticator) Authenticate(req AuthRequest) (Identity, error) {
+	if req.Token == "" {
+		return Identity{}, ErrNoCredentials
+	}
+
+	for name, token := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(req.Token)) == 1 {
+			return Identity{Name: name, Method: "token"}, nil
+		}
+	}
+
+	return Identity{}, errors.New("invalid token")
+}
+
+type certificateAuthenticator struct{}
+
+// NewCertificateAuthenticator returns an Authenticator that uses the common
+// name of the verified TLS client certificate as the identity. The service
+// must be configured to require and verify client certificates.
+func NewCertificateAuthenticator() Authenticator { return certificateAuthenticator{} }
+
+func (certificateAuthenticator) Authenticate(req AuthRequest) (Identity, error) {
+	if len(req.PeerCertificates) == 0 {
+		return Identity{}, ErrNoCredentials
+	}
+
+	name := req.PeerCertificates[0].Subject.CommonName
+	if name == "" {
+		return Identity{}, errors.New("client certificate has no common name")
+	}
+
+	return Identity{Name: name, Method: "certificate"}, nil
+}
+
+type hmacAuthenticator struct {
+	keys    map[string]string
+	maxSkew time.Duration
+	now     func() time.Time
+
+	mu sync.Mutex
+	// nonces records when each nonce was last accepted, keyed by the key ID
+	// and the nonce, so that replayed signatures can be rejected.
+	nonces    map[string]time.Time
+	lastPrune time.Time
+}
+
+// NewHMACAuthenticator returns an Authenticator that accepts requests signed
+// with HMAC-SHA256 using one of the shared secrets in keys, which maps key IDs
+// to secrets. The key ID is used as the identity name. Requests whose
+// timestamp differs from the current time by more than maxSkew are rejected,
+// as are requests that reuse the nonce of a request that was already
+// accepted.
+func NewHMACAuthenticator(keys map[string]string, maxSkew time.Duration) Authenticator {
+	if maxSkew <= 0 {
+		maxSkew = roptions.DefaultAuthClockSkew
+	}
+	return &hmacAuthenticator{keys: keys, maxSkew: maxSkew, now: time.Now, nonces: map[string]time.Time{}}
+}
+
+func (a *hmacAuthenticator) Authenticate(req AuthRequest) (Identity, error) {
+	if req.Signature == "" {
+		return Identity{}, ErrNoCredentials
+	}
+
+	secret, ok := a.keys[req.KeyID]
+	if !ok {
+		return Identity{}, fmt.Errorf("unknown signing key '%s'", req.KeyID)
+	}
+
+	ts, err := strconv.ParseInt(req.Timestamp, 10, 64)
+	if err != nil {
+		return Identity{}, fmt.Errorf("invalid signature timestamp: %w", err)
+	}
+	if skew := a.now().Sub(time.Unix(ts, 0)).Abs(); skew > a.maxSkew {
+		return Identity{}, fmt.Errorf("signature timestamp is outside of the allowed window by %s", skew-a.maxSkew)
+	}
+
+	if req.Nonce == "" {
+		return Identity{}, errors.New("signature has no nonce")
+	}
+
+	expected := signAuthRequest(secret, req.KeyID, req.Timestamp, req.Nonce, req.Target, req.Body)
+	if !hmac.Equal([]byte(expected), []byte(req.Signature)) {
+		return Identity{}, errors.New("invalid signature")
+	}
+
+	if !a.useNonce(req.KeyID, req.Nonce) {
+		return Identity{}, errors.New("signature has already been used")
+	}
+
+	return Identity{Name: req.KeyID, Method: "hmac"}, nil
+}
+
+// useNonce records the nonce for the key and reports whether it had not been
+// used before. Nonces are only kept for as long as a signature that carries
+// them would pass the timestamp check.
+func (a *hmacAuthenticator) useNonce(keyID, nonce string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+	if now.Sub(a.lastPrune) > a.maxSkew {
+		for key, seen := range a.nonces {
+			if now.Sub(seen) > 2*a.maxSkew {
+				delete(a.nonces, key)
+			}
+		}
+		a.lastPrune = now
+	}
+
+	key := keyID + "\n" + nonce
+	if _, ok := a.nonces[key]; ok {
+		return false
+	}
+	a.nonces[key] = now
+	return true
+}
+
+// signAuthRequest computes the hex-encoded HMAC-SHA256 signature of a
+// request.
+func signAuthRequest(secret, keyID, timestamp, nonce, target string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%s\n%x", keyID, timestamp, nonce, target, sha256.Sum256(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// makeSignedAuthRequest populates the credentials that a client with the
+// given credentials should send for a request. Each call uses a new nonce, so
+// the result must only be sent once.
+func makeSignedAuthRequest(creds *roptions.AuthCredentials, target string, body []byte) AuthRequest {
+	req := AuthRequest{Token: creds.Token}
+	if creds.KeyID != "" {
+		req.KeyID = creds.KeyID
+		req.Timestamp = strconv.FormatInt(time.Now().Unix(), 10)
+		req.Nonce = rand.Text()
+		req.Signature = signAuthRequest(creds.Secret, req.KeyID, req.Timestamp, req.Nonce, target, body)
+	}
+	return req
+}
+
+type multiAuthenticator []Authenticator
+
+// NewMultiAuthenticator returns an Authenticator that tries each of the
+// given authenticators in order and uses the first that finds credentials
+// on the request.
+func NewMultiAuthenticator(auths ...Authenticator) Authenticator {
+	return multiAuthenticator(auths)
+}
+
+func (auths multiAuthenticator) Authenticate(req AuthRequest) (Identity, error) {
+	for _, a := range auths {
+		id, err := a.Authenticate(req)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+		return id, err
+	}
+	return Identity{}, ErrNoCredentials
+}
+
+// Authorizer authenticates requests to remote services and checks that the
+// caller is allowed to perform the requested operation.
+type Authorizer struct {
+	authenticator Authenticator
+	policy        roptions.AuthPolicy
+}
+
+// NewAuthorizer returns an Authorizer that uses the given authenticator and
+// policy.
+func NewAuthorizer(authenticator Authenticator, policy roptions.AuthPolicy) (*Authorizer, error) {
+	if authenticator == nil {
+		return nil, errors.New("must specify an authenticator")
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid policy: %w", err)
+	}
+	return &Authorizer{authenticator: authenticator, policy: policy}, nil
+}
+
+// NewAuthorizerFromConfig returns an Authorizer that accepts each of the
+// authentication methods enabled in the configuration.
+func NewAuthorizerFromConfig(conf roptions.AuthConfig) (*Authorizer, error) {
+	if err := conf.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid auth config: %w", err)
+	}
+
+	auths := []Authenticator{}
+	if len(conf.Tokens) > 0 {
+		auths = append(auths, NewTokenAuthenticator(conf.Tokens))
+	}
+	if len(conf.HMACKeys) > 0 {
+		auths = append(auths, NewHMACAuthenticator(conf.HMACKeys, conf.MaxClockSkew))
+	}
+	if conf.ClientCertificates {
+		auths = append(auths, NewCertificateAuthenticator())
+	}
+	if conf.PeerCredentials {
+		auths = append(auths, NewPeerCredentialsAuthenticator())
+	}
+
+	return NewAuthorizer(NewMultiAuthenticator(auths...), conf.Policy)
+}
+
+// Authorize authenticates the request and checks that the resulting identity
+// may perform the operation. The returned error wraps either
+// ErrNotAuthenticated or ErrNotAuthorized.
+func (a *Authorizer) Authorize(req AuthRequest, op roptions.Operation) (Identity, error) {
+	id, err := a.authenticator.Authenticate(req)
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %w", ErrNotAuthenticated, err)
+	}
+
+	if !a.policy.Allowed(id.Name, op) {
+		return id, fmt.Errorf("%w: '%s' cannot perform '%s' operations", ErrNotAuthorized, id.Name, op)
+	}
+
+	return id, nil
+}
+
+type identityContextKey struct{}
+
+// IdentityFromContext returns the identity of the caller that was
+// authenticated by a remote service handling the request, if any.
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(identityContextKey{}).(Identity)
+	return id, ok
+}
+
+func withIdentity(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, identityContextKey{}, id)
+}
@@ -0,0 +1,427 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os/user"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/tychoish/birch"
+	"github.com/tychoish/birch/x/mrpc/mongowire"
+	"github.com/tychoish/birch/x/mrpc/shell"
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/testutil"
+	"github.com/tychoish/jasper/x/remote/internal"
+	roptions "github.com/tychoish/jasper/x/remote/options"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthenticators(t *testing.T) {
+	t.Run("Token", func(t *testing.T) {
+		auth := NewTokenAuthenticator(map[string]string{"alice": "secret-token"})
+
+		id, err := auth.Authenticate(AuthRequest{Token: "secret-token"})
+		assert.NotError(t, err)
+		check.Equal(t, id.Name, "alice")
+
+		_, err = auth.Authenticate(AuthRequest{Token: "wrong"})
+		assert.Error(t, err)
+		check.True(t, !errors.Is(err, ErrNoCredentials))
+
+		_, err = auth.Authenticate(AuthRequest{})
+		check.ErrorIs(t, err, ErrNoCredentials)
+	})
+	t.Run("Certificate", func(t *testing.T) {
+		auth := NewCertificateAuthenticator()
+
+		id, err := auth.Authenticate(AuthRequest{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "bob"}}}})
+		assert.NotError(t, err)
+		check.Equal(t, id.Name, "bob")
+
+		_, err = auth.Authenticate(AuthRequest{PeerCertificates: []*x509.Certificate{{}}})
+		check.Error(t, err)
+
+		_, err = auth.Authenticate(AuthRequest{})
+		check.ErrorIs(t, err, ErrNoCredentials)
+	})
+	t.Run("PeerCredentials", func(t *testing.T) {
+		auth := &peerCredentialsAuthenticator{lookupUser: func(uid string) (*user.User, error) {
+			if uid == "1000" {
+				return &user.User{Uid: uid, Username: "dave"}, nil
+			}
+			return nil, user.UnknownUserIdError(1001)
+		}}
+
+		id, err := auth.Authenticate(AuthRequest{PeerCredentials: &PeerCredentials{UID: 1000}})
+		assert.NotError(t, err)
+		check.Equal(t, id.Name, "dave")
+		check.Equal(t, id.Method, "peer")
+
+		id, err = auth.Authenticate(AuthRequest{PeerCredentials: &PeerCredentials{UID: 1001}})
+		assert.NotError(t, err)
+		check.Equal(t, id.Name, "1001")
+
+		_, err = auth.Authenticate(AuthRequest{})
+		check.ErrorIs(t, err, ErrNoCredentials)
+	})
+	t.Run("HMAC", func(t *testing.T) {
+		auth := NewHMACAuthenticator(map[string]string{"carol": "shared"}, time.Minute)
+		creds := &roptions.AuthCredentials{KeyID: "carol", Secret: "shared"}
+
+		req := makeSignedAuthRequest(creds, "target", []byte("body"))
+		req.Target = "target"
+		req.Body = []byte("body")
+		id, err := auth.Authenticate(req)
+		assert.NotError(t, err)
+		check.Equal(t, id.Name, "carol")
+
+		tampered := req
+		tampered.Body = []byte("other")
+		_, err = auth.Authenticate(tampered)
+		check.Error(t, err)
+
+		retargeted := req
+		retargeted.Target = "other"
+		_, err = auth.Authenticate(retargeted)
+		check.Error(t, err)
+
+		_, err = auth.Authenticate(req)
+		check.Error(t, err)
+
+		resent := makeSignedAuthRequest(creds, "target", []byte("body"))
+		resent.Target = "target"
+		resent.Body = []byte("body")
+		_, err = auth.Authenticate(resent)
+		check.NotError(t, err)
+
+		noNonce := req
+		noNonce.Nonce = ""
+		noNonce.Signature = signAuthRequest("shared", "carol", noNonce.Timestamp, "", "target", []byte("body"))
+		_, err = auth.Authenticate(noNonce)
+		check.Error(t, err)
+
+		stale := req
+		stale.Timestamp = strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+		stale.Nonce = "stale"
+		stale.Signature = signAuthRequest("shared", "carol", stale.Timestamp, stale.Nonce, "target", []byte("body"))
+		_, err = auth.Authenticate(stale)
+		check.Error(t, err)
+
+		unknown := makeSignedAuthRequest(&roptions.AuthCredentials{KeyID: "dave", Secret: "shared"}, "target", nil)
+		_, err = auth.Authenticate(unknown)
+		check.Error(t, err)
+
+		_, err = auth.Authenticate(AuthRequest{Token: "token"})
+		check.ErrorIs(t, err, ErrNoCredentials)
+	})
+	t.Run("Multi", func(t *testing.T) {
+		auth := NewMultiAuthenticator(
+			NewTokenAuthenticator(map[string]string{"alice": "token"}),
+			NewCertificateAuthenticator(),
+		)
+
+		id, err := auth.Authenticate(AuthRequest{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "bob"}}}})
+		assert.NotError(t, err)
+		check.Equal(t, id.Name, "bob")
+
+		_, err = auth.Authenticate(AuthRequest{Token: "wrong", PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "bob"}}}})
+		check.Error(t, err)
+
+		_, err = auth.Authenticate(AuthRequest{})
+		check.ErrorIs(t, err, ErrNoCredentials)
+	})
+}
+
+func TestAuthPolicy(t *testing.T) {
+	policy := roptions.AuthPolicy{
+		Roles: map[string][]roptions.Operation{
+			"writer": {roptions.OperationFileWrite},
+		},
+		Identities: map[string][]string{
+			"alice": {roptions.RoleAdmin},
+			"bob":   {roptions.RoleReadOnly, "writer"},
+		},
+		DefaultRoles: []string{roptions.RoleReadOnly},
+	}
+	assert.NotError(t, policy.Validate())
+
+	check.True(t, policy.Allowed("alice", roptions.OperationAdmin))
+	check.True(t, policy.Allowed("bob", roptions.OperationRead))
+	check.True(t, policy.Allowed("bob", roptions.OperationFileWrite))
+	check.True(t, !policy.Allowed("bob", roptions.OperationCreate))
+	check.True(t, policy.Allowed("carol", roptions.OperationRead))
+	check.True(t, !policy.Allowed("carol", roptions.OperationSignal))
+
+	policy.Identities["dave"] = []string{"nonexistent"}
+	check.Error(t, policy.Validate())
+
+	invalid := roptions.AuthPolicy{Roles: map[string][]roptions.Operation{"bad": {"bogus"}}}
+	check.Error(t, invalid.Validate())
+}
+
+func TestAuthorizer(t *testing.T) {
+	authorizer, err := NewAuthorizerFromConfig(roptions.AuthConfig{
+		Tokens:   map[string]string{"reader": "read-token", "admin": "admin-token"},
+		HMACKeys: map[string]string{"operator": "operator-secret"},
+		Policy: roptions.AuthPolicy{
+			Identities: map[string][]string{
+				"reader":   {roptions.RoleReadOnly},
+				"operator": {roptions.RoleOperator},
+				"admin":    {roptions.RoleAdmin},
+			},
+		},
+	})
+	assert.NotError(t, err)
+
+	_, err = authorizer.Authorize(AuthRequest{}, roptions.OperationRead)
+	check.ErrorIs(t, err, ErrNotAuthenticated)
+
+	_, err = authorizer.Authorize(AuthRequest{Token: "read-token"}, roptions.OperationCreate)
+	check.ErrorIs(t, err, ErrNotAuthorized)
+
+	id, err := authorizer.Authorize(AuthRequest{Token: "admin-token"}, roptions.OperationAdmin)
+	assert.NotError(t, err)
+	check.Equal(t, id.Name, "admin")
+
+	creds := &roptions.AuthCredentials{KeyID: "operator", Secret: "operator-secret"}
+	req := makeSignedAuthRequest(creds, "target", nil)
+	req.Target = "target"
+	_, err = authorizer.Authorize(req, roptions.OperationCreate)
+	check.NotError(t, err)
+	req = makeSignedAuthRequest(creds, "target", nil)
+	req.Target = "target"
+	_, err = authorizer.Authorize(req, roptions.OperationFileWrite)
+	check.ErrorIs(t, err, ErrNotAuthorized)
+
+	_, err = NewAuthorizerFromConfig(roptions.AuthConfig{})
+	check.Error(t, err)
+
+	conf := roptions.AuthConfig{Tokens: map[string]string{"reader": "read-token"}}
+	check.NotError(t, conf.Validate())
+	check.Equal(t, conf.MaxClockSkew, 0)
+}
+
+func TestBearerToken(t *testing.T) {
+	token, err := bearerToken("")
+	check.NotError(t, err)
+	check.Equal(t, token, "")
+
+	token, err = bearerToken("Bearer read-token")
+	check.NotError(t, err)
+	check.Equal(t, token, "read-token")
+
+	for _, header := range []string{"read-token", "Basic cmVhZGVyOnJlYWQtdG9rZW4=", "bearer read-token"} {
+		_, err = bearerToken(header)
+		check.ErrorIs(t, err, ErrNotAuthenticated)
+	}
+}
+
+func TestRestServiceAuth(t *testing.T) {
+	authorizer, err := NewAuthorizerFromConfig(roptions.AuthConfig{
+		Tokens:   map[string]string{"reader": "read-token"},
+		HMACKeys: map[string]string{"writer": "writer-secret"},
+		Policy: roptions.AuthPolicy{
+			Identities: map[string][]string{
+				"reader": {roptions.RoleReadOnly},
+				"writer": {roptions.RoleAdmin},
+			},
+		},
+	})
+	assert.NotError(t, err)
+
+	srv := NewRestServiceWithAuth(jasper.NewManager(), authorizer)
+	var body []byte
+	handler := srv.authorize(roptions.OperationFileWrite, func(rw http.ResponseWriter, r *http.Request) {
+		id, ok := IdentityFromContext(r.Context())
+		check.True(t, ok)
+		check.Equal(t, id.Name, "writer")
+		body, err = io.ReadAll(r.Body)
+		check.NotError(t, err)
+		rw.WriteHeader(http.StatusOK)
+	})
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	for _, test := range []struct {
+		name   string
+		client *http.Client
+		code   int
+	}{
+		{name: "NoCredentials", client: http.DefaultClient, code: http.StatusUnauthorized},
+		{name: "InvalidToken", client: &http.Client{Transport: NewAuthTransport(roptions.AuthCredentials{Token: "wrong"}, nil)}, code: http.StatusUnauthorized},
+		{name: "Unauthorized", client: &http.Client{Transport: NewAuthTransport(roptions.AuthCredentials{Token: "read-token"}, nil)}, code: http.StatusForbidden},
+		{name: "InvalidSignature", client: &http.Client{Transport: NewAuthTransport(roptions.AuthCredentials{KeyID: "writer", Secret: "wrong"}, nil)}, code: http.StatusUnauthorized},
+		{name: "Signed", client: &http.Client{Transport: NewAuthTransport(roptions.AuthCredentials{KeyID: "writer", Secret: "writer-secret"}, nil)}, code: http.StatusOK},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			body = nil
+			resp, err := test.client.Post(ts.URL+"/file/write?force=true", "application/json", bytes.NewBufferString(`{"path":"foo"}`))
+			assert.NotError(t, err)
+			defer resp.Body.Close()
+			check.Equal(t, resp.StatusCode, test.code)
+			if test.code == http.StatusOK {
+				check.Equal(t, string(body), `{"path":"foo"}`)
+			}
+		})
+	}
+	t.Run("Replayed", func(t *testing.T) {
+		transport := &authTransport{creds: roptions.AuthCredentials{KeyID: "writer", Secret: "writer-secret"}, base: http.DefaultTransport}
+		req, err := http.NewRequest(http.MethodPost, ts.URL+"/file/write?force=true", nil)
+		assert.NotError(t, err)
+		transport.setCredentials(req, nil)
+
+		for _, code := range []int{http.StatusOK, http.StatusUnauthorized} {
+			resp, err := http.DefaultClient.Do(req.Clone(req.Context()))
+			assert.NotError(t, err)
+			resp.Body.Close()
+			check.Equal(t, resp.StatusCode, code)
+		}
+	})
+	t.Run("NonBearerToken", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, ts.URL+"/file/write?force=true", nil)
+		assert.NotError(t, err)
+		req.Header.Set(authorizationHeader, "read-token")
+
+		resp, err := http.DefaultClient.Do(req)
+		assert.NotError(t, err)
+		resp.Body.Close()
+		check.Equal(t, resp.StatusCode, http.StatusUnauthorized)
+	})
+	t.Run("BodyTooLarge", func(t *testing.T) {
+		client := &http.Client{Transport: NewAuthTransport(roptions.AuthCredentials{KeyID: "writer", Secret: "writer-secret"}, nil)}
+		resp, err := client.Post(ts.URL+"/file/write?force=true", "application/json", bytes.NewReader(make([]byte, maxSignedRequestBodySize+1)))
+		assert.NotError(t, err)
+		defer resp.Body.Close()
+		check.Equal(t, resp.StatusCode, http.StatusRequestEntityTooLarge)
+	})
+}
+
+func TestRPCServiceAuth(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+	defer cancel()
+
+	authorizer, err := NewAuthorizerFromConfig(roptions.AuthConfig{
+		Tokens:   map[string]string{"reader": "read-token"},
+		HMACKeys: map[string]string{"operator": "operator-secret"},
+		Policy: roptions.AuthPolicy{
+			Identities: map[string][]string{
+				"reader":   {roptions.RoleReadOnly},
+				"operator": {roptions.RoleOperator},
+			},
+		},
+	})
+	assert.NotError(t, err)
+
+	addr, err := tryStartRPCService(ctx, func(ctx context.Context, addr net.Addr) error {
+		closeService, err := StartRPCService(ctx, jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true})), addr, nil, AuthServerOptions(authorizer)...)
+		if err != nil {
+			return err
+		}
+		go func() {
+			<-ctx.Done()
+			check.NotError(t, closeService())
+		}()
+		return nil
+	})
+	assert.NotError(t, err)
+
+	opts := &options.Create{Args: []string{"echo", "hello"}}
+
+	t.Run("NoCredentials", func(t *testing.T) {
+		client, err := newTestRPCClient(ctx, addr, nil)
+		assert.NotError(t, err)
+		_, err = client.List(ctx, options.All)
+		check.Error(t, err)
+	})
+	t.Run("ReadOnly", func(t *testing.T) {
+		client, err := NewRPCClient(ctx, addr, nil, AuthDialOptions(roptions.AuthCredentials{Token: "read-token"})...)
+		assert.NotError(t, err)
+		defer client.CloseConnection()
+
+		_, err = client.List(ctx, options.All)
+		check.NotError(t, err)
+		_, err = client.CreateProcess(ctx, opts.Copy())
+		check.Error(t, err)
+	})
+	t.Run("Signed", func(t *testing.T) {
+		client, err := NewRPCClient(ctx, addr, nil, AuthDialOptions(roptions.AuthCredentials{KeyID: "operator", Secret: "operator-secret"})...)
+		assert.NotError(t, err)
+		defer client.CloseConnection()
+
+		proc, err := client.CreateProcess(ctx, opts.Copy())
+		assert.NotError(t, err)
+		_, err = proc.Wait(ctx)
+		check.NotError(t, err)
+		check.Error(t, client.Close(ctx))
+	})
+	t.Run("TamperedRequest", func(t *testing.T) {
+		dialOpts := append(AuthDialOptions(roptions.AuthCredentials{KeyID: "operator", Secret: "operator-secret"}),
+			grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+				if createOpts, ok := req.(*internal.CreateOptions); ok {
+					createOpts.Args = []string{"echo", "tampered"}
+				}
+				return invoker(ctx, method, req, reply, cc, opts...)
+			}))
+		client, err := NewRPCClient(ctx, addr, nil, dialOpts...)
+		assert.NotError(t, err)
+		defer client.CloseConnection()
+
+		_, err = client.CreateProcess(ctx, opts.Copy())
+		check.Error(t, err)
+		check.Equal(t, status.Code(err), codes.Unauthenticated)
+	})
+}
+
+func TestMDBAuthSignature(t *testing.T) {
+	authenticator := NewHMACAuthenticator(map[string]string{"operator": "operator-secret"}, 0)
+	authenticate := func(doc *birch.Document) error {
+		msg, err := shell.RequestToMessage(mongowire.OP_QUERY, doc)
+		assert.NotError(t, err)
+		body, err := readMDBAuthBody(msg)
+		assert.NotError(t, err)
+
+		get := func(key string) string {
+			elem, err := doc.Search(key)
+			if err != nil {
+				return ""
+			}
+			return elem.Value().StringValue()
+		}
+		_, err = authenticator.Authenticate(AuthRequest{
+			Target:    "create_process",
+			Body:      body,
+			KeyID:     get("auth_key_id"),
+			Timestamp: get("auth_timestamp"),
+			Nonce:     get("auth_nonce"),
+			Signature: get("auth_signature"),
+		})
+		return err
+	}
+
+	sign := func() *birch.Document {
+		doc, err := addMDBAuth(birch.DC.Elements(birch.EC.String("create_process", "echo hello")),
+			&roptions.AuthCredentials{KeyID: "operator", Secret: "operator-secret"}, &createProcessRequest{})
+		assert.NotError(t, err)
+		return doc
+	}
+
+	check.NotError(t, authenticate(sign()))
+
+	tampered := sign()
+	tampered.Set(birch.EC.String("create_process", "echo tampered"))
+	check.Error(t, authenticate(tampered))
+}
@@ -5,12 +5,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"testing"
 	"time"
@@ -880,6 +883,219 @@ func TestManager(t *testing.T) {
 										assert.NotError(t, file.Close())
 										check.Error(t, client.DownloadFile(ctx, ropts.Download{URL: "https://example.com/foo", Path: file.Name()}))
 									},
+									"VerifiesChecksum": func(ctx context.Context, t *testing.T, client Manager, tempDir string) {
+										srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+											_, _ = io.WriteString(rw, "foo")
+										}))
+										defer srv.Close()
+
+										opts := ropts.Download{
+											URL:  srv.URL,
+											Path: filepath.Join(tempDir, "out.txt"),
+											Checksum: ropts.DownloadChecksum{
+												Algorithm: ropts.ChecksumSHA256,
+												Digest:    "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+											},
+										}
+										assert.NotError(t, client.DownloadFile(ctx, opts))
+
+										content, err := os.ReadFile(opts.Path)
+										assert.NotError(t, err)
+										check.Equal(t, string(content), "foo")
+										_, err = os.Stat(opts.Path + ".partial")
+										check.True(t, os.IsNotExist(err))
+									},
+									"FailsForChecksumMismatch": func(ctx context.Context, t *testing.T, client Manager, tempDir string) {
+										srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+											_, _ = io.WriteString(rw, "bar")
+										}))
+										defer srv.Close()
+
+										opts := ropts.Download{
+											URL:  srv.URL,
+											Path: filepath.Join(tempDir, "out.txt"),
+											Checksum: ropts.DownloadChecksum{
+												Algorithm: ropts.ChecksumSHA256,
+												Digest:    "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+											},
+										}
+										check.Error(t, client.DownloadFile(ctx, opts))
+
+										_, err := os.Stat(opts.Path)
+										check.True(t, os.IsNotExist(err))
+										_, err = os.Stat(opts.Path + ".partial")
+										check.True(t, os.IsNotExist(err))
+									},
+									"FailsForInvalidChecksum": func(ctx context.Context, t *testing.T, client Manager, tempDir string) {
+										opts := ropts.Download{
+											URL:      "https://example.com",
+											Path:     filepath.Join(tempDir, "out.txt"),
+											Checksum: ropts.DownloadChecksum{Algorithm: ropts.ChecksumSHA256, Digest: "foo"},
+										}
+										check.Error(t, client.DownloadFile(ctx, opts))
+									},
+									"RetriesServerErrors": func(ctx context.Context, t *testing.T, client Manager, tempDir string) {
+										var attempts atomic.Int64
+										srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+											if attempts.Add(1) < 3 {
+												rw.WriteHeader(http.StatusServiceUnavailable)
+												return
+											}
+											_, _ = io.WriteString(rw, "foo")
+										}))
+										defer srv.Close()
+
+										opts := ropts.Download{
+											URL:   srv.URL,
+											Path:  filepath.Join(tempDir, "out.txt"),
+											Retry: ropts.DownloadRetry{MaxAttempts: 3, Backoff: time.Millisecond},
+										}
+										assert.NotError(t, client.DownloadFile(ctx, opts))
+										check.Equal(t, attempts.Load(), 3)
+
+										content, err := os.ReadFile(opts.Path)
+										assert.NotError(t, err)
+										check.Equal(t, string(content), "foo")
+									},
+									"DoesNotRetryClientErrors": func(ctx context.Context, t *testing.T, client Manager, tempDir string) {
+										var attempts atomic.Int64
+										srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+											attempts.Add(1)
+											rw.WriteHeader(http.StatusNotFound)
+										}))
+										defer srv.Close()
+
+										opts := ropts.Download{
+											URL:   srv.URL,
+											Path:  filepath.Join(tempDir, "out.txt"),
+											Retry: ropts.DownloadRetry{MaxAttempts: 3, Backoff: time.Millisecond},
+										}
+										check.Error(t, client.DownloadFile(ctx, opts))
+										check.Equal(t, attempts.Load(), 1)
+									},
+									"ResumesPartialDownload": func(ctx context.Context, t *testing.T, client Manager, tempDir string) {
+										var rangeHeader atomic.Value
+										srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+											rangeHeader.Store(r.Header.Get("Range"))
+											http.ServeContent(rw, r, "out.txt", time.Time{}, strings.NewReader("foobar"))
+										}))
+										defer srv.Close()
+
+										opts := ropts.Download{
+											URL:    srv.URL,
+											Path:   filepath.Join(tempDir, "out.txt"),
+											Resume: true,
+										}
+										assert.NotError(t, os.WriteFile(opts.Path+".partial", []byte("foo"), 0o644))
+										assert.NotError(t, client.DownloadFile(ctx, opts))
+										check.Equal(t, rangeHeader.Load(), "bytes=3-")
+
+										content, err := os.ReadFile(opts.Path)
+										assert.NotError(t, err)
+										check.Equal(t, string(content), "foobar")
+									},
+									"RestartsStalePartialDownload": func(ctx context.Context, t *testing.T, client Manager, tempDir string) {
+										srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+											http.ServeContent(rw, r, "out.txt", time.Time{}, strings.NewReader("foo"))
+										}))
+										defer srv.Close()
+
+										opts := ropts.Download{
+											URL:    srv.URL,
+											Path:   filepath.Join(tempDir, "out.txt"),
+											Resume: true,
+										}
+										assert.NotError(t, os.WriteFile(opts.Path+".partial", []byte("stale content"), 0o644))
+										assert.NotError(t, client.DownloadFile(ctx, opts))
+
+										content, err := os.ReadFile(opts.Path)
+										assert.NotError(t, err)
+										check.Equal(t, string(content), "foo")
+									},
+									"RestartsWhenRangeIsNotHonored": func(ctx context.Context, t *testing.T, client Manager, tempDir string) {
+										srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+											if r.Header.Get("Range") != "" {
+												rw.Header().Set("Content-Range", "bytes 0-5/6")
+												rw.WriteHeader(http.StatusPartialContent)
+											}
+											_, _ = io.WriteString(rw, "foobar")
+										}))
+										defer srv.Close()
+
+										opts := ropts.Download{
+											URL:    srv.URL,
+											Path:   filepath.Join(tempDir, "out.txt"),
+											Resume: true,
+										}
+										assert.NotError(t, os.WriteFile(opts.Path+".partial", []byte("foo"), 0o644))
+										assert.NotError(t, client.DownloadFile(ctx, opts))
+
+										content, err := os.ReadFile(opts.Path)
+										assert.NotError(t, err)
+										check.Equal(t, string(content), "foobar")
+									},
+									"SendsHeadersAndCredentials": func(ctx context.Context, t *testing.T, client Manager, tempDir string) {
+										srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+											user, pass, ok := r.BasicAuth()
+											if !ok || user != "user" || pass != "pass" || r.Header.Get("X-Foo") != "bar" {
+												rw.WriteHeader(http.StatusUnauthorized)
+												return
+											}
+											_, _ = io.WriteString(rw, "foo")
+										}))
+										defer srv.Close()
+
+										opts := ropts.Download{
+											URL:      srv.URL,
+											Path:     filepath.Join(tempDir, "out.txt"),
+											Headers:  map[string]string{"X-Foo": "bar"},
+											Username: "user",
+											Password: "pass",
+										}
+										assert.NotError(t, client.DownloadFile(ctx, opts))
+
+										opts.Password = "wrong"
+										check.Error(t, client.DownloadFile(ctx, opts))
+									},
+									"UsesDownloadCache": func(ctx context.Context, t *testing.T, client Manager, tempDir string) {
+										var requests atomic.Int64
+										srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+											requests.Add(1)
+											_, _ = io.WriteString(rw, "foo")
+										}))
+										defer srv.Close()
+
+										cache, err := ropts.NewDownloadCache(ropts.DownloadCacheOptions{Directory: filepath.Join(tempDir, "cache")})
+										assert.NotError(t, err)
+										ropts.SetGlobalDownloadCache(cache)
+										defer ropts.SetGlobalDownloadCache(nil)
+
+										for _, name := range []string{"first.txt", "second.txt"} {
+											opts := ropts.Download{URL: srv.URL, Path: filepath.Join(tempDir, name), UseCache: true, Checksum: testContentChecksum("foo")}
+											assert.NotError(t, client.DownloadFile(ctx, opts))
+											content, err := os.ReadFile(opts.Path)
+											assert.NotError(t, err)
+											check.Equal(t, string(content), "foo")
+										}
+										check.Equal(t, requests.Load(), 1)
+
+										stats, err := client.GetDownloadCacheStats(ctx)
+										assert.NotError(t, err)
+										check.True(t, stats.Enabled)
+										check.Equal(t, stats.Entries, 1)
+										check.Equal(t, stats.Size, 3)
+										check.Equal(t, stats.Hits, 1)
+										check.Equal(t, stats.Misses, 1)
+
+										opts := ropts.Download{URL: srv.URL, Path: filepath.Join(tempDir, "uncached.txt"), UseCache: true}
+										assert.NotError(t, client.DownloadFile(ctx, opts))
+										check.Equal(t, requests.Load(), 2)
+									},
+									"ReportsDisabledDownloadCache": func(ctx context.Context, t *testing.T, client Manager, tempDir string) {
+										stats, err := client.GetDownloadCacheStats(ctx)
+										assert.NotError(t, err)
+										check.True(t, !stats.Enabled)
+									},
 									"FailsForInsufficientPermissions": func(ctx context.Context, t *testing.T, client Manager, tempDir string) {
 										if os.Geteuid() == 0 {
 											t.Skip("cannot test download permissions as root")
@@ -1312,3 +1528,765 @@ func AddFileToDirectory(dir string, fileName string, fileContents string) error
 	}
 	return file.Close()
 }
+
+func TestFollowLogStream(t *testing.T) {
+	for clientName, makeClient := range map[string]func(ctx context.Context, t *testing.T) Manager{
+		"REST": func(ctx context.Context, t *testing.T) Manager {
+			httpClient := testutil.GetHTTPClient()
+			t.Cleanup(func() { testutil.PutHTTPClient(httpClient) })
+
+			_, port, err := startRESTService(ctx, httpClient)
+			assert.NotError(t, err)
+			return &restClient{
+				prefix: fmt.Sprintf("http://localhost:%d/jasper/v1", port),
+				client: httpClient,
+			}
+		},
+		"RPC": func(ctx context.Context, t *testing.T) Manager {
+			client, err := makeInsecureRPCServiceAndClient(ctx, jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true})))
+			assert.NotError(t, err)
+			return client
+		},
+	} {
+		t.Run(clientName, func(t *testing.T) {
+			for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, client Manager){
+				"ReceivesLinesFromBothStreams": func(ctx context.Context, t *testing.T, client Manager) {
+					proc, err := client.CreateProcess(ctx, &options.Create{Args: []string{"sh", "-c", "echo one; echo two >&2; sleep 0.5; echo three"}})
+					assert.NotError(t, err)
+
+					lines := []jasper.LogLine{}
+					assert.NotError(t, client.FollowLogStream(ctx, proc.ID(), ropts.LogFollow{}, func(line jasper.LogLine) error {
+						lines = append(lines, line)
+						return nil
+					}))
+					assert.Equal(t, len(lines), 3)
+					check.Equal(t, lines[2].Line, "three")
+					check.Equal(t, lines[2].Stream, jasper.OutputStreamStdout)
+					for idx, line := range lines {
+						check.Equal(t, line.Offset, int64(idx))
+						if line.Line == "two" {
+							check.Equal(t, line.Stream, jasper.OutputStreamStderr)
+						}
+					}
+					check.True(t, proc.Complete(ctx))
+				},
+				"ResumesFromOffsetOnSelectedStream": func(ctx context.Context, t *testing.T, client Manager) {
+					proc, err := client.CreateProcess(ctx, &options.Create{Args: []string{"sh", "-c", "echo one; echo two; echo three >&2; echo four"}})
+					assert.NotError(t, err)
+
+					// Output on different streams is read concurrently, so the
+					// offsets are taken from the full log once all of the
+					// lines have been recorded.
+					all := []jasper.LogLine{}
+					assert.NotError(t, client.FollowLogStream(ctx, proc.ID(), ropts.LogFollow{}, func(line jasper.LogLine) error {
+						all = append(all, line)
+						return nil
+					}))
+					assert.Equal(t, len(all), 4)
+
+					var offset int64 = -1
+					for _, line := range all {
+						if line.Line == "two" {
+							offset = line.Offset
+						}
+					}
+					assert.True(t, offset >= 0)
+					expected := []string{}
+					for _, line := range all {
+						if line.Offset >= offset && line.Stream == jasper.OutputStreamStdout {
+							expected = append(expected, line.Line)
+						}
+					}
+
+					lines := []string{}
+					assert.NotError(t, client.FollowLogStream(ctx, proc.ID(), ropts.LogFollow{Offset: offset, Streams: []jasper.OutputStream{jasper.OutputStreamStdout}}, func(line jasper.LogLine) error {
+						check.Equal(t, line.Stream, jasper.OutputStreamStdout)
+						lines = append(lines, line.Line)
+						return nil
+					}))
+					check.EqualItems(t, lines, expected)
+					check.Equal(t, lines[0], "two")
+					check.Equal(t, lines[len(lines)-1], "four")
+				},
+				"StopsWhenCallbackErrors": func(ctx context.Context, t *testing.T, client Manager) {
+					proc, err := client.CreateProcess(ctx, testutil.YesCreateOpts(testutil.ProcessTestTimeout))
+					assert.NotError(t, err)
+
+					expected := errors.New("stop")
+					check.ErrorIs(t, client.FollowLogStream(ctx, proc.ID(), ropts.LogFollow{}, func(jasper.LogLine) error {
+						return expected
+					}), expected)
+					check.NotError(t, proc.Signal(ctx, syscall.SIGKILL))
+				},
+				"FailsWithNonexistentProcess": func(ctx context.Context, t *testing.T, client Manager) {
+					check.Error(t, client.FollowLogStream(ctx, "foo", ropts.LogFollow{}, func(jasper.LogLine) error { return nil }))
+				},
+				"FailsWithInvalidOptions": func(ctx context.Context, t *testing.T, client Manager) {
+					check.Error(t, client.FollowLogStream(ctx, "foo", ropts.LogFollow{Offset: -1}, func(jasper.LogLine) error { return nil }))
+				},
+			} {
+				t.Run(testName, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+					defer cancel()
+
+					testCase(ctx, t, makeClient(ctx, t))
+				})
+			}
+		})
+	}
+}
+
+func TestExec(t *testing.T) {
+	for clientName, makeClient := range map[string]func(ctx context.Context, t *testing.T) Manager{
+		"REST": func(ctx context.Context, t *testing.T) Manager {
+			httpClient := testutil.GetHTTPClient()
+			t.Cleanup(func() { testutil.PutHTTPClient(httpClient) })
+
+			_, port, err := startRESTService(ctx, httpClient)
+			assert.NotError(t, err)
+			return &restClient{
+				prefix: fmt.Sprintf("http://localhost:%d/jasper/v1", port),
+				client: httpClient,
+			}
+		},
+		"RPC": func(ctx context.Context, t *testing.T) Manager {
+			client, err := makeInsecureRPCServiceAndClient(ctx, jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true})))
+			assert.NotError(t, err)
+			return client
+		},
+	} {
+		t.Run(clientName, func(t *testing.T) {
+			for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, client Manager){
+				"AttachesInputAndOutput": func(ctx context.Context, t *testing.T, client Manager) {
+					session, err := client.Exec(ctx, &ropts.Exec{Create: &options.Create{Args: []string{"sh", "-c", "read x; echo $x; echo err >&2; exit 3"}}})
+					assert.NotError(t, err)
+					defer session.Close()
+					check.NotZero(t, session.ID())
+
+					stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+					exitCode, err := AttachExecSession(ctx, session, strings.NewReader("hello\n"), stdout, stderr)
+					check.Error(t, err)
+					check.Equal(t, exitCode, 3)
+					check.Equal(t, stdout.String(), "hello\n")
+					check.Equal(t, stderr.String(), "err\n")
+				},
+				"ClosesStandardInput": func(ctx context.Context, t *testing.T, client Manager) {
+					session, err := client.Exec(ctx, &ropts.Exec{Create: &options.Create{Args: []string{"cat"}}})
+					assert.NotError(t, err)
+					defer session.Close()
+
+					stdin := session.Stdin()
+					_, err = stdin.Write([]byte("foo"))
+					assert.NotError(t, err)
+					assert.NotError(t, stdin.Close())
+
+					exitCode, err := session.Wait(ctx)
+					assert.NotError(t, err)
+					check.Equal(t, exitCode, 0)
+
+					out, err := io.ReadAll(session.Stdout())
+					assert.NotError(t, err)
+					check.Equal(t, string(out), "foo")
+				},
+				"RunsInTerminal": func(ctx context.Context, t *testing.T, client Manager) {
+					if runtime.GOOS != "linux" {
+						t.Skip("pseudo-terminals are only supported on linux")
+					}
+					session, err := client.Exec(ctx, &ropts.Exec{
+						Create:     &options.Create{Args: []string{"sh", "-c", "test -t 0 && stty size"}},
+						TTY:        true,
+						WindowSize: ropts.WindowSize{Rows: 24, Cols: 80},
+					})
+					assert.NotError(t, err)
+					defer session.Close()
+
+					stdout := &bytes.Buffer{}
+					exitCode, err := AttachExecSession(ctx, session, nil, stdout, nil)
+					assert.NotError(t, err)
+					check.Equal(t, exitCode, 0)
+					check.Equal(t, strings.TrimSpace(stdout.String()), "24 80")
+				},
+				"CloseKillsProcess": func(ctx context.Context, t *testing.T, client Manager) {
+					session, err := client.Exec(ctx, &ropts.Exec{Create: testutil.SleepCreateOpts(10)})
+					assert.NotError(t, err)
+
+					proc, err := client.Get(ctx, session.ID())
+					assert.NotError(t, err)
+					assert.NotError(t, session.Close())
+
+					_, err = proc.Wait(ctx)
+					check.Error(t, err)
+					check.True(t, proc.Complete(ctx))
+				},
+				"FailsWithInvalidOptions": func(ctx context.Context, t *testing.T, client Manager) {
+					_, err := client.Exec(ctx, &ropts.Exec{Create: &options.Create{}})
+					check.Error(t, err)
+				},
+				"FailsWithStandardInputOptions": func(ctx context.Context, t *testing.T, client Manager) {
+					_, err := client.Exec(ctx, &ropts.Exec{Create: &options.Create{Args: []string{"cat"}, StandardInputBytes: []byte("foo")}})
+					check.Error(t, err)
+				},
+			} {
+				t.Run(testName, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+					defer cancel()
+
+					testCase(ctx, t, makeClient(ctx, t))
+				})
+			}
+		})
+	}
+}
+
+func TestFilesystem(t *testing.T) {
+	for clientName, makeClient := range map[string]func(ctx context.Context, t *testing.T) Manager{
+		"REST": func(ctx context.Context, t *testing.T) Manager {
+			httpClient := testutil.GetHTTPClient()
+			t.Cleanup(func() { testutil.PutHTTPClient(httpClient) })
+
+			_, port, err := startRESTService(ctx, httpClient)
+			assert.NotError(t, err)
+			return &restClient{
+				prefix: fmt.Sprintf("http://localhost:%d/jasper/v1", port),
+				client: httpClient,
+			}
+		},
+		"RPC": func(ctx context.Context, t *testing.T) Manager {
+			client, err := makeInsecureRPCServiceAndClient(ctx, jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true})))
+			assert.NotError(t, err)
+			return client
+		},
+		"MDB": func(ctx context.Context, t *testing.T) Manager {
+			t.SkipNow()
+			client, err := makeTestMDBServiceAndClient(ctx, jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true})))
+			assert.NotError(t, err)
+			return client
+		},
+	} {
+		t.Run(clientName, func(t *testing.T) {
+			for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, client Manager, dir string){
+				"ReadFileReturnsContents": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					path := filepath.Join(dir, "file")
+					assert.NotError(t, os.WriteFile(path, []byte("foobar"), 0o644))
+
+					file, err := client.ReadFile(ctx, ropts.ReadFile{Path: path})
+					assert.NotError(t, err)
+					content, err := io.ReadAll(file)
+					assert.NotError(t, err)
+					assert.NotError(t, file.Close())
+					check.Equal(t, string(content), "foobar")
+
+					file, err = client.ReadFile(ctx, ropts.ReadFile{Path: path, Offset: 1, Length: 3})
+					assert.NotError(t, err)
+					content, err = io.ReadAll(file)
+					assert.NotError(t, err)
+					assert.NotError(t, file.Close())
+					check.Equal(t, string(content), "oob")
+				},
+				"ReadFileStreamsLargeFiles": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					const mb = 1024 * 1024
+					expected := bytes.Repeat([]byte("abcdefgh"), mb/2)
+					path := filepath.Join(dir, "file")
+					assert.NotError(t, os.WriteFile(path, expected, 0o644))
+
+					file, err := client.ReadFile(ctx, ropts.ReadFile{Path: path})
+					assert.NotError(t, err)
+					defer file.Close()
+					content, err := io.ReadAll(file)
+					assert.NotError(t, err)
+					check.True(t, bytes.Equal(content, expected))
+				},
+				"ReadFileFailsWithNonexistentFile": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					_, err := client.ReadFile(ctx, ropts.ReadFile{Path: filepath.Join(dir, "foo")})
+					check.Error(t, err)
+				},
+				"ReadFileFailsWithRelativePath": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					_, err := client.ReadFile(ctx, ropts.ReadFile{Path: "foo"})
+					check.Error(t, err)
+				},
+				"CreateArchiveStreamsContents": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					src := filepath.Join(dir, "src")
+					assert.NotError(t, os.MkdirAll(filepath.Join(src, "sub"), 0o755))
+					assert.NotError(t, os.WriteFile(filepath.Join(src, "a.txt"), []byte("foo"), 0o644))
+					assert.NotError(t, os.WriteFile(filepath.Join(src, "sub", "b.txt"), []byte("bar"), 0o644))
+					assert.NotError(t, os.WriteFile(filepath.Join(src, "sub", "c.log"), []byte("baz"), 0o644))
+
+					for _, format := range []ropts.ArchiveFormat{ropts.ArchiveTar, ropts.ArchiveTarGz, ropts.ArchiveTarXz, ropts.ArchiveTarZst, ropts.ArchiveZip} {
+						t.Run(string(format), func(t *testing.T) {
+							archive, err := client.CreateArchive(ctx, ropts.CreateArchive{Path: src, Format: format, Exclude: []string{"*.log"}})
+							assert.NotError(t, err)
+							archivePath := filepath.Join(dir, "archive."+string(format))
+							file, err := os.Create(archivePath)
+							assert.NotError(t, err)
+							_, err = io.Copy(file, archive)
+							assert.NotError(t, err)
+							assert.NotError(t, file.Close())
+							assert.NotError(t, archive.Close())
+
+							target := filepath.Join(dir, "out-"+string(format))
+							assert.NotError(t, ropts.Archive{Format: format, TargetPath: target}.Extract(archivePath))
+
+							content, err := os.ReadFile(filepath.Join(target, "a.txt"))
+							assert.NotError(t, err)
+							check.Equal(t, string(content), "foo")
+							content, err = os.ReadFile(filepath.Join(target, "sub", "b.txt"))
+							assert.NotError(t, err)
+							check.Equal(t, string(content), "bar")
+							_, err = os.Stat(filepath.Join(target, "sub", "c.log"))
+							check.True(t, os.IsNotExist(err))
+						})
+					}
+				},
+				"CreateArchiveFailsWithNonexistentDirectory": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					_, err := client.CreateArchive(ctx, ropts.CreateArchive{Path: filepath.Join(dir, "foo")})
+					check.Error(t, err)
+				},
+				"CreateArchiveFailsWithFile": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					path := filepath.Join(dir, "file")
+					assert.NotError(t, os.WriteFile(path, []byte("foo"), 0o644))
+					_, err := client.CreateArchive(ctx, ropts.CreateArchive{Path: path})
+					check.Error(t, err)
+				},
+				"CreateArchiveFailsWithInvalidFormat": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					_, err := client.CreateArchive(ctx, ropts.CreateArchive{Path: dir, Format: ropts.ArchiveTarBz2})
+					check.Error(t, err)
+				},
+				"StatFileReturnsInfo": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					path := filepath.Join(dir, "file")
+					assert.NotError(t, os.WriteFile(path, []byte("foo"), 0o640))
+
+					info, err := client.StatFile(ctx, path)
+					assert.NotError(t, err)
+					check.Equal(t, info.Path, path)
+					check.Equal(t, info.Name, "file")
+					check.Equal(t, info.Size, int64(3))
+					check.Equal(t, info.Mode.Perm(), os.FileMode(0o640))
+					check.True(t, !info.IsDir)
+					check.True(t, !info.ModTime.IsZero())
+
+					info, err = client.StatFile(ctx, dir)
+					assert.NotError(t, err)
+					check.True(t, info.IsDir)
+				},
+				"StatFileFailsWithNonexistentFile": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					_, err := client.StatFile(ctx, filepath.Join(dir, "foo"))
+					check.Error(t, err)
+				},
+				"ListDirectoryReturnsContents": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					assert.NotError(t, os.WriteFile(filepath.Join(dir, "a"), []byte("foo"), 0o644))
+					assert.NotError(t, os.Mkdir(filepath.Join(dir, "b"), 0o755))
+					assert.NotError(t, os.WriteFile(filepath.Join(dir, "b", "c"), []byte("bar"), 0o644))
+
+					infos, err := client.ListDirectory(ctx, ropts.ListDirectory{Path: dir})
+					assert.NotError(t, err)
+					assert.Equal(t, len(infos), 2)
+					check.Equal(t, infos[0].Name, "a")
+					check.Equal(t, infos[1].Name, "b")
+					check.True(t, infos[1].IsDir)
+
+					infos, err = client.ListDirectory(ctx, ropts.ListDirectory{Path: dir, Recursive: true})
+					assert.NotError(t, err)
+					assert.Equal(t, len(infos), 3)
+					check.Equal(t, infos[2].Path, filepath.Join(dir, "b", "c"))
+				},
+				"ListDirectoryFailsWithNonexistentDirectory": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					_, err := client.ListDirectory(ctx, ropts.ListDirectory{Path: filepath.Join(dir, "foo")})
+					check.Error(t, err)
+				},
+				"GlobFilesReturnsMatches": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					for _, name := range []string{"a.txt", "b.txt", "c.log"} {
+						assert.NotError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o644))
+					}
+
+					paths, err := client.GlobFiles(ctx, filepath.Join(dir, "*.txt"))
+					assert.NotError(t, err)
+					check.EqualItems(t, paths, []string{filepath.Join(dir, "a.txt"), filepath.Join(dir, "b.txt")})
+
+					paths, err = client.GlobFiles(ctx, filepath.Join(dir, "*.foo"))
+					assert.NotError(t, err)
+					check.Equal(t, len(paths), 0)
+				},
+				"GlobFilesFailsWithInvalidPattern": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					_, err := client.GlobFiles(ctx, filepath.Join(dir, "["))
+					check.Error(t, err)
+				},
+				"RemoveFileRemovesFiles": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					path := filepath.Join(dir, "file")
+					assert.NotError(t, os.WriteFile(path, nil, 0o644))
+					assert.NotError(t, client.RemoveFile(ctx, ropts.RemoveFile{Path: path}))
+					_, err := os.Stat(path)
+					check.True(t, os.IsNotExist(err))
+
+					subdir := filepath.Join(dir, "subdir")
+					assert.NotError(t, os.Mkdir(subdir, 0o755))
+					assert.NotError(t, os.WriteFile(filepath.Join(subdir, "file"), nil, 0o644))
+					check.Error(t, client.RemoveFile(ctx, ropts.RemoveFile{Path: subdir}))
+					assert.NotError(t, client.RemoveFile(ctx, ropts.RemoveFile{Path: subdir, Recursive: true}))
+					_, err = os.Stat(subdir)
+					check.True(t, os.IsNotExist(err))
+				},
+				"RemoveFileFailsWithNonexistentFile": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					check.Error(t, client.RemoveFile(ctx, ropts.RemoveFile{Path: filepath.Join(dir, "foo"), Recursive: true}))
+				},
+				"RenameFileMovesFile": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					oldPath := filepath.Join(dir, "old")
+					newPath := filepath.Join(dir, "new")
+					assert.NotError(t, os.WriteFile(oldPath, []byte("foo"), 0o644))
+					assert.NotError(t, client.RenameFile(ctx, ropts.RenameFile{OldPath: oldPath, NewPath: newPath}))
+
+					content, err := os.ReadFile(newPath)
+					assert.NotError(t, err)
+					check.Equal(t, string(content), "foo")
+					_, err = os.Stat(oldPath)
+					check.True(t, os.IsNotExist(err))
+				},
+				"RenameFileFailsWithNonexistentFile": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					check.Error(t, client.RenameFile(ctx, ropts.RenameFile{OldPath: filepath.Join(dir, "foo"), NewPath: filepath.Join(dir, "bar")}))
+				},
+				"MakeDirectoryCreatesDirectory": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					path := filepath.Join(dir, "a", "b")
+					check.Error(t, client.MakeDirectory(ctx, ropts.MakeDirectory{Path: path}))
+					assert.NotError(t, client.MakeDirectory(ctx, ropts.MakeDirectory{Path: path, Parents: true}))
+					assert.NotError(t, client.MakeDirectory(ctx, ropts.MakeDirectory{Path: path, Parents: true}))
+
+					info, err := os.Stat(path)
+					assert.NotError(t, err)
+					check.True(t, info.IsDir())
+				},
+				"ChecksumFileComputesChecksum": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					path := filepath.Join(dir, "file")
+					assert.NotError(t, os.WriteFile(path, []byte("foo"), 0o644))
+
+					checksum, err := client.ChecksumFile(ctx, ropts.FileChecksum{Path: path})
+					assert.NotError(t, err)
+					check.Equal(t, checksum, "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")
+
+					checksum, err = client.ChecksumFile(ctx, ropts.FileChecksum{Path: path, Algorithm: ropts.ChecksumMD5})
+					assert.NotError(t, err)
+					check.Equal(t, checksum, "acbd18db4cc2f85cedef654fccc4a4d8")
+				},
+				"ChecksumFileFailsWithInvalidAlgorithm": func(ctx context.Context, t *testing.T, client Manager, dir string) {
+					path := filepath.Join(dir, "file")
+					assert.NotError(t, os.WriteFile(path, []byte("foo"), 0o644))
+
+					_, err := client.ChecksumFile(ctx, ropts.FileChecksum{Path: path, Algorithm: "foo"})
+					check.Error(t, err)
+				},
+			} {
+				t.Run(testName, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+					defer cancel()
+
+					testCase(ctx, t, makeClient(ctx, t), t.TempDir())
+				})
+			}
+		})
+	}
+}
+
+func TestHealthManagerClient(t *testing.T) {
+	requirePOSIX := func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("test requires POSIX utilities")
+		}
+	}
+
+	for clientName, makeClient := range testClientFactories() {
+		t.Run(clientName, func(t *testing.T) {
+			for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager){
+				"IdleTimeoutTerminatesSilentProcess": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					opts := testutil.SleepCreateOpts(100)
+					opts.IdleTimeout = &options.IdleTimeout{Duration: 100 * time.Millisecond, GracePeriod: time.Second}
+					proc, err := client.CreateProcess(ctx, opts)
+					assert.NotError(t, err)
+
+					_, err = proc.Wait(ctx)
+					check.Error(t, err)
+					info := proc.Info(ctx)
+					check.True(t, info.Timeout)
+					check.Equal(t, info.TimeoutReason, jasper.TimeoutReasonIdle)
+					assert.True(t, info.Options.IdleTimeout != nil)
+					check.Equal(t, info.Options.IdleTimeout.Duration, 100*time.Millisecond)
+				},
+				"LivenessRestartsFailingProcess": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					opts := testutil.SleepCreateOpts(5)
+					opts.Liveness = &options.Liveness{
+						Command:          testutil.FalseCreateOpts(),
+						Interval:         10 * time.Millisecond,
+						FailureThreshold: 2,
+						GracePeriod:      time.Second,
+						MaxRestarts:      1,
+					}
+					proc, err := client.CreateProcess(ctx, opts)
+					assert.NotError(t, err)
+
+					var newID string
+					for newID == "" {
+						info := proc.Info(ctx)
+						assert.True(t, info.Liveness != nil)
+						newID = info.Liveness.RestartedAs
+						select {
+						case <-ctx.Done():
+							t.Fatal("timed out waiting for process to restart")
+						case <-time.After(10 * time.Millisecond):
+						}
+					}
+
+					info := proc.Info(ctx)
+					check.True(t, info.Complete)
+					check.True(t, len(info.Liveness.Checks) >= 2)
+					check.True(t, !info.Liveness.Checks[len(info.Liveness.Checks)-1].Passed)
+					assert.True(t, info.Options.Liveness != nil)
+					check.Equal(t, info.Options.Liveness.FailureThreshold, 2)
+
+					newProc, err := client.Get(ctx, newID)
+					assert.NotError(t, err)
+					newInfo := newProc.Info(ctx)
+					assert.True(t, newInfo.Liveness != nil)
+					check.Equal(t, newInfo.Liveness.Restarts, 1)
+					check.Equal(t, newInfo.Liveness.RestartedFrom, proc.ID())
+				},
+				"WaitReadyBlocksUntilReady": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					requirePOSIX(t)
+					file := filepath.Join(t.TempDir(), "ready")
+					opts := &options.Create{Args: []string{"sh", "-c", "sleep 0.2; touch " + file + "; exec sleep 5"}}
+					opts.Readiness = &options.Readiness{File: file, Interval: 10 * time.Millisecond}
+					proc, err := client.CreateProcess(ctx, opts)
+					assert.NotError(t, err)
+					defer func() { check.NotError(t, proc.Signal(ctx, syscall.SIGKILL)) }()
+
+					assert.NotError(t, proc.WaitReady(ctx))
+					info := proc.Info(ctx)
+					check.True(t, info.Ready)
+					check.True(t, info.IsRunning)
+					assert.True(t, info.Options.Readiness != nil)
+					check.Equal(t, info.Options.Readiness.File, file)
+				},
+				"WaitReadyFailsIfProcessExitsBeforeReady": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					opts := testutil.TrueCreateOpts()
+					opts.Readiness = &options.Readiness{File: filepath.Join(t.TempDir(), "does-not-exist"), Interval: 10 * time.Millisecond}
+					proc, err := client.CreateProcess(ctx, opts)
+					assert.NotError(t, err)
+
+					check.Error(t, proc.WaitReady(ctx))
+					check.True(t, !proc.Info(ctx).Ready)
+				},
+				"WaitsForFollowUps": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					opts := testutil.TrueCreateOpts()
+					opts.OnSuccess = []*options.Create{testutil.TrueCreateOpts()}
+					proc, err := client.CreateProcess(ctx, opts)
+					assert.NotError(t, err)
+
+					_, err = jasper.WaitWithFollowUps(ctx, client, proc)
+					assert.NotError(t, err)
+
+					followUps := proc.Info(ctx).FollowUps
+					assert.Equal(t, len(followUps), 1)
+					check.Equal(t, followUps[0].Trigger, jasper.FollowUpOnSuccess)
+					check.True(t, followUps[0].Complete)
+					check.True(t, followUps[0].Successful)
+				},
+				"SurfacesFollowUpFailures": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					opts := testutil.FalseCreateOpts()
+					opts.OnFailure = []*options.Create{testutil.FalseCreateOpts(), {}}
+					proc, err := client.CreateProcess(ctx, opts)
+					assert.NotError(t, err)
+
+					_, err = jasper.WaitWithFollowUps(ctx, client, proc)
+					assert.Error(t, err)
+
+					followUps := proc.Info(ctx).FollowUps
+					assert.Equal(t, len(followUps), 2)
+					check.True(t, followUps[0].Complete)
+					check.True(t, !followUps[0].Successful)
+					check.NotZero(t, followUps[1].Error)
+				},
+				"OutputTriggerFailsProcess": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					requirePOSIX(t)
+					opts := &options.Create{
+						Args: []string{"sh", "-c", "echo 'ERROR: broken'"},
+						OutputTriggers: []options.OutputTrigger{
+							{Pattern: "^ERROR:", Tag: "errored", Fail: true},
+						},
+					}
+					proc, err := client.CreateProcess(ctx, opts)
+					assert.NotError(t, err)
+
+					// The REST client only reports errors from Wait for non-zero
+					// exit codes, so the failure is checked with the info.
+					_, _ = proc.Wait(ctx)
+					info := proc.Info(ctx)
+					check.Equal(t, info.ExitCode, 0)
+					check.True(t, info.Complete)
+					check.True(t, !info.Successful)
+					check.Contains(t, proc.GetTags(), "errored")
+					assert.Equal(t, len(info.Options.OutputTriggers), 1)
+					check.Equal(t, info.Options.OutputTriggers[0].Pattern, "^ERROR:")
+				},
+				"PipelinePipesOutputBetweenStages": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					requirePOSIX(t)
+					path := filepath.Join(t.TempDir(), "out")
+					opts := &options.Create{
+						Args: []string{"echo", "hello"},
+						Pipeline: []*options.Create{
+							{Args: []string{"tr", "a-z", "A-Z"}},
+							{Args: []string{"tee", path}},
+						},
+					}
+					proc, err := client.CreateProcess(ctx, opts)
+					assert.NotError(t, err)
+
+					_, err = proc.Wait(ctx)
+					assert.NotError(t, err)
+
+					out, err := os.ReadFile(path)
+					assert.NotError(t, err)
+					check.Equal(t, strings.TrimSpace(string(out)), "HELLO")
+
+					info := proc.Info(ctx)
+					check.True(t, info.Successful)
+					assert.Equal(t, len(info.Stages), 3)
+					check.Equal(t, len(info.Options.Pipeline), 2)
+					for _, stage := range info.Stages {
+						check.True(t, stage.Complete)
+						check.NotZero(t, stage.PID)
+					}
+				},
+				"PipelineFailsIfAnyStageFails": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					requirePOSIX(t)
+					opts := testutil.FalseCreateOpts()
+					opts.Pipeline = []*options.Create{{Args: []string{"cat"}}}
+					proc, err := client.CreateProcess(ctx, opts)
+					assert.NotError(t, err)
+
+					exitCode, err := proc.Wait(ctx)
+					assert.Error(t, err)
+					check.Equal(t, exitCode, 1)
+
+					info := proc.Info(ctx)
+					check.True(t, info.Complete)
+					check.True(t, !info.Successful)
+					assert.Equal(t, len(info.Stages), 2)
+					check.True(t, !info.Stages[0].Successful)
+					check.True(t, info.Stages[1].Successful)
+				},
+				"TriggerIDWritesInfoToFileOnExit": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					requirePOSIX(t)
+					path := filepath.Join(t.TempDir(), "info.json")
+					proc, err := client.CreateProcess(ctx, &options.Create{Args: []string{"sleep", "0.2"}})
+					assert.NotError(t, err)
+					assert.NotError(t, proc.RegisterTriggerID(ctx, jasper.WriteInfoToFileProcessTrigger, map[string]string{"path": path}))
+					_, err = proc.Wait(ctx)
+					assert.NotError(t, err)
+
+					data, err := os.ReadFile(path)
+					assert.NotError(t, err)
+					check.Substring(t, string(data), proc.ID())
+				},
+				"TriggerIDRemovesProcessFromManagerOnExit": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					requirePOSIX(t)
+					proc, err := client.CreateProcess(ctx, &options.Create{Args: []string{"sleep", "0.2"}})
+					assert.NotError(t, err)
+					assert.NotError(t, proc.RegisterTriggerID(ctx, jasper.RemoveFromManagerProcessTrigger, nil))
+					_, err = proc.Wait(ctx)
+					assert.NotError(t, err)
+
+					for {
+						if _, err := client.Get(ctx, proc.ID()); err != nil {
+							break
+						}
+						select {
+						case <-ctx.Done():
+							t.Fatal("process was not removed from manager")
+						case <-time.After(10 * time.Millisecond):
+						}
+					}
+				},
+				"TriggerIDFailsWithInvalidTrigger": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					proc, err := client.CreateProcess(ctx, testutil.SleepCreateOpts(1))
+					assert.NotError(t, err)
+					check.Error(t, proc.RegisterTriggerID(ctx, jasper.ProcessTriggerID("foo"), nil))
+					check.Error(t, proc.RegisterTriggerID(ctx, jasper.WriteInfoToFileProcessTrigger, nil))
+				},
+				"ScheduleCreatesProcesses": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					assert.NotError(t, client.AddSchedule(ctx, options.Schedule{
+						ID:       "foo",
+						Create:   testutil.TrueCreateOpts(),
+						Interval: 50 * time.Millisecond,
+						Overlap:  options.ScheduleOverlapQueue,
+					}))
+
+					var schedules []jasper.ScheduleInfo
+					for {
+						var err error
+						schedules, err = client.ListSchedules(ctx)
+						assert.NotError(t, err)
+						assert.Equal(t, len(schedules), 1)
+						if len(schedules[0].History) != 0 && schedules[0].History[0].Complete {
+							break
+						}
+						select {
+						case <-ctx.Done():
+							t.Fatal("schedule did not run")
+						case <-time.After(10 * time.Millisecond):
+						}
+					}
+					check.Equal(t, schedules[0].Schedule.ID, "foo")
+					check.Equal(t, schedules[0].Schedule.Overlap, options.ScheduleOverlapQueue)
+					check.Equal(t, schedules[0].Schedule.Interval, 50*time.Millisecond)
+					check.EqualItems(t, schedules[0].Schedule.Create.Args, testutil.TrueCreateOpts().Args)
+					check.True(t, schedules[0].History[0].Successful)
+
+					proc, err := client.Get(ctx, schedules[0].History[0].ProcessID)
+					assert.NotError(t, err)
+					check.Contains(t, proc.GetTags(), "foo")
+				},
+				"ScheduleIsRemoved": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					assert.NotError(t, client.AddSchedule(ctx, options.Schedule{ID: "foo", Create: testutil.TrueCreateOpts(), Cron: "@daily"}))
+					assert.NotError(t, client.RemoveSchedule(ctx, "foo"))
+
+					schedules, err := client.ListSchedules(ctx)
+					assert.NotError(t, err)
+					check.Equal(t, len(schedules), 0)
+					check.Error(t, client.RemoveSchedule(ctx, "foo"))
+				},
+				"AddScheduleFailsWithInvalidSchedule": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					check.Error(t, client.AddSchedule(ctx, options.Schedule{ID: "foo", Create: testutil.TrueCreateOpts(), Cron: "not a cron expression"}))
+					check.Error(t, client.AddSchedule(ctx, options.Schedule{ID: "foo", Cron: "@daily"}))
+				},
+				"AddScheduleFailsWithDuplicateID": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					assert.NotError(t, client.AddSchedule(ctx, options.Schedule{ID: "foo", Create: testutil.TrueCreateOpts(), Cron: "@daily"}))
+					check.Error(t, client.AddSchedule(ctx, options.Schedule{ID: "foo", Create: testutil.TrueCreateOpts(), Cron: "@daily"}))
+				},
+				"ScheduleDoesNotRunWhileDraining": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					_, err := client.Drain(ctx, ropts.Drain{})
+					assert.NotError(t, err)
+					assert.NotError(t, client.AddSchedule(ctx, options.Schedule{ID: "foo", Create: testutil.TrueCreateOpts(), Interval: 10 * time.Millisecond}))
+
+					for {
+						info, err := hm.Scheduler().Get("foo")
+						assert.NotError(t, err)
+						if len(info.History) != 0 {
+							check.Equal(t, info.History[0].ProcessID, "")
+							check.True(t, info.History[0].Error != "")
+							break
+						}
+						select {
+						case <-ctx.Done():
+							t.Fatal("schedule did not run")
+						case <-time.After(10 * time.Millisecond):
+						}
+					}
+				},
+			} {
+				t.Run(testName, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), testutil.RPCTestTimeout)
+					defer cancel()
+
+					hm := makeTestHealthManager(t, HealthOptions{})
+					testCase(ctx, t, hm, makeClient(ctx, t, hm))
+				})
+			}
+		})
+	}
+}
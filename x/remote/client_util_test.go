@@ -0,0 +1,46 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper"
+	"github.com/tychoish/jasper/testutil"
+)
+
+// testClientFactories returns constructors for clients of services that are
+// started around the given manager, keyed by the name of the protocol.
+func testClientFactories() map[string]func(ctx context.Context, t *testing.T, mngr jasper.Manager) Manager {
+	return map[string]func(ctx context.Context, t *testing.T, mngr jasper.Manager) Manager{
+		"REST": func(ctx context.Context, t *testing.T, mngr jasper.Manager) Manager {
+			addr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("localhost:%d", testutil.GetPortNumber()))
+			assert.NotError(t, err)
+			closeService, err := StartRestService(ctx, NewRestService(mngr), addr, nil, false)
+			assert.NotError(t, err)
+			t.Cleanup(func() { check.NotError(t, closeService()) })
+			return NewRestClient(addr)
+		},
+		"RPC": func(ctx context.Context, t *testing.T, mngr jasper.Manager) Manager {
+			client, err := makeInsecureRPCServiceAndClient(ctx, mngr)
+			assert.NotError(t, err)
+			return client
+		},
+		"MDB": func(ctx context.Context, t *testing.T, mngr jasper.Manager) Manager {
+			t.SkipNow()
+			client, err := makeTestMDBServiceAndClient(ctx, mngr)
+			assert.NotError(t, err)
+			return client
+		},
+	}
+}
+
+func makeTestHealthManager(t *testing.T, opts HealthOptions) *HealthManager {
+	mngr := jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true}))
+	hm := NewHealthManager(mngr, opts)
+	t.Cleanup(func() { check.NotError(t, hm.Close(context.Background())) })
+	return hm
+}
@@ -0,0 +1,215 @@
+package remote
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	ropts "github.com/tychoish/jasper/x/remote/options"
+)
+
+// testContentChecksum returns the SHA-256 checksum of the content.
+func testContentChecksum(content string) ropts.DownloadChecksum {
+	sum := sha256.Sum256([]byte(content))
+	return ropts.DownloadChecksum{Algorithm: ropts.ChecksumSHA256, Digest: hex.EncodeToString(sum[:])}
+}
+
+func TestDownloadCache(t *testing.T) {
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, dir string){
+		"DeduplicatesConcurrentDownloads": func(ctx context.Context, t *testing.T, dir string) {
+			var requests atomic.Int64
+			release := make(chan struct{})
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				<-release
+				_, _ = io.WriteString(rw, "foo")
+			}))
+			defer srv.Close()
+
+			cache, err := ropts.NewDownloadCache(ropts.DownloadCacheOptions{Directory: filepath.Join(dir, "cache")})
+			assert.NotError(t, err)
+
+			const downloads = 5
+			errs := make(chan error, downloads)
+			wg := &sync.WaitGroup{}
+			for i := 0; i < downloads; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					errs <- cache.Download(ctx, ropts.Download{URL: srv.URL, Path: filepath.Join(dir, strings.Repeat("a", i+1)), Checksum: testContentChecksum("foo")})
+				}(i)
+			}
+			close(release)
+			wg.Wait()
+			close(errs)
+
+			for err := range errs {
+				check.NotError(t, err)
+			}
+			check.Equal(t, requests.Load(), 1)
+			stats := cache.Stats()
+			check.Equal(t, stats.Misses, 1)
+			check.Equal(t, stats.Hits, downloads-1)
+		},
+		"SharesFilesWithSameChecksum": func(ctx context.Context, t *testing.T, dir string) {
+			var requests atomic.Int64
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				_, _ = io.WriteString(rw, "foo")
+			}))
+			defer srv.Close()
+
+			cache, err := ropts.NewDownloadCache(ropts.DownloadCacheOptions{Directory: filepath.Join(dir, "cache")})
+			assert.NotError(t, err)
+
+			checksum := testContentChecksum("foo")
+			for _, path := range []string{"/a", "/b"} {
+				opts := ropts.Download{URL: srv.URL + path, Path: filepath.Join(dir, "out"+path), Checksum: checksum}
+				assert.NotError(t, cache.Download(ctx, opts))
+				content, err := os.ReadFile(opts.Path)
+				assert.NotError(t, err)
+				check.Equal(t, string(content), "foo")
+			}
+			check.Equal(t, requests.Load(), 1)
+		},
+		"DoesNotShareCachedFiles": func(ctx context.Context, t *testing.T, dir string) {
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				_, _ = io.WriteString(rw, "foo")
+			}))
+			defer srv.Close()
+
+			cache, err := ropts.NewDownloadCache(ropts.DownloadCacheOptions{Directory: filepath.Join(dir, "cache")})
+			assert.NotError(t, err)
+
+			first := ropts.Download{URL: srv.URL, Path: filepath.Join(dir, "first"), Checksum: testContentChecksum("foo")}
+			assert.NotError(t, cache.Download(ctx, first))
+			file, err := os.OpenFile(first.Path, os.O_WRONLY|os.O_TRUNC, 0)
+			assert.NotError(t, err)
+			_, err = io.WriteString(file, "bar")
+			assert.NotError(t, err)
+			assert.NotError(t, file.Close())
+
+			second := ropts.Download{URL: srv.URL, Path: filepath.Join(dir, "second"), Checksum: testContentChecksum("foo")}
+			assert.NotError(t, cache.Download(ctx, second))
+			content, err := os.ReadFile(second.Path)
+			assert.NotError(t, err)
+			check.Equal(t, string(content), "foo")
+			check.Equal(t, cache.Stats().Hits, 1)
+		},
+		"SkipsDownloadsWithoutChecksum": func(ctx context.Context, t *testing.T, dir string) {
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				user, _, _ := r.BasicAuth()
+				_, _ = io.WriteString(rw, strings.TrimPrefix(r.URL.Path, "/")+user+r.Header.Get("X-Token"))
+			}))
+			defer srv.Close()
+
+			cache, err := ropts.NewDownloadCache(ropts.DownloadCacheOptions{Directory: filepath.Join(dir, "cache")})
+			assert.NotError(t, err)
+
+			for _, opts := range []ropts.Download{
+				{URL: srv.URL + "/anonymous", Path: filepath.Join(dir, "anonymous")},
+				{URL: srv.URL, Path: filepath.Join(dir, "alice"), Username: "alice"},
+				{URL: srv.URL, Path: filepath.Join(dir, "bob"), Username: "bob"},
+				{URL: srv.URL, Path: filepath.Join(dir, "token"), Headers: map[string]string{"X-Token": "token"}},
+			} {
+				assert.NotError(t, cache.Download(ctx, opts))
+				content, err := os.ReadFile(opts.Path)
+				assert.NotError(t, err)
+				check.Equal(t, string(content), filepath.Base(opts.Path))
+			}
+			stats := cache.Stats()
+			check.Equal(t, stats.Entries, 0)
+			check.Equal(t, stats.Hits+stats.Misses, 0)
+		},
+		"EvictsLeastRecentlyUsedFiles": func(ctx context.Context, t *testing.T, dir string) {
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				_, _ = io.WriteString(rw, strings.TrimPrefix(r.URL.Path, "/"))
+			}))
+			defer srv.Close()
+
+			cache, err := ropts.NewDownloadCache(ropts.DownloadCacheOptions{Directory: filepath.Join(dir, "cache"), MaxSize: 7})
+			assert.NotError(t, err)
+
+			download := func(content string) error {
+				return cache.Download(ctx, ropts.Download{URL: srv.URL + "/" + content, Path: filepath.Join(dir, "out"), Checksum: testContentChecksum(content)})
+			}
+			for _, content := range []string{"foo", "bar", "foo", "baz"} {
+				assert.NotError(t, download(content))
+			}
+
+			stats := cache.Stats()
+			check.Equal(t, stats.Entries, 2)
+			check.Equal(t, stats.Size, 6)
+			check.Equal(t, stats.Evictions, 1)
+
+			assert.NotError(t, download("foo"))
+			check.Equal(t, cache.Stats().Hits, 2)
+			// The least recently used file was evicted, so it must be
+			// fetched again.
+			assert.NotError(t, download("bar"))
+			check.Equal(t, cache.Stats().Misses, 4)
+		},
+		"LoadsExistingFiles": func(ctx context.Context, t *testing.T, dir string) {
+			cacheDir := filepath.Join(dir, "cache")
+			assert.NotError(t, os.MkdirAll(cacheDir, 0o755))
+			valid := "sha256-" + testContentChecksum("foo").Digest
+			corrupt := "sha256-" + testContentChecksum("bar").Digest
+			assert.NotError(t, os.WriteFile(filepath.Join(cacheDir, valid), []byte("foo"), 0o644))
+			assert.NotError(t, os.WriteFile(filepath.Join(cacheDir, corrupt), []byte("baz"), 0o644))
+			assert.NotError(t, os.WriteFile(filepath.Join(cacheDir, "foo"), []byte("foo"), 0o644))
+			assert.NotError(t, os.WriteFile(filepath.Join(cacheDir, "bar.partial"), []byte("bar"), 0o644))
+
+			cache, err := ropts.NewDownloadCache(ropts.DownloadCacheOptions{Directory: cacheDir})
+			assert.NotError(t, err)
+
+			stats := cache.Stats()
+			check.True(t, stats.Enabled)
+			check.Equal(t, stats.Entries, 1)
+			check.Equal(t, stats.Size, 3)
+			for _, name := range []string{corrupt, "foo", "bar.partial"} {
+				_, err = os.Stat(filepath.Join(cacheDir, name))
+				check.True(t, os.IsNotExist(err))
+			}
+
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				t.Error("cached file should not be downloaded")
+			}))
+			defer srv.Close()
+			assert.NotError(t, cache.Download(ctx, ropts.Download{URL: srv.URL, Path: filepath.Join(dir, "out"), Checksum: testContentChecksum("foo")}))
+			check.Equal(t, cache.Stats().Hits, 1)
+		},
+		"FailsWithRelativeDirectory": func(ctx context.Context, t *testing.T, dir string) {
+			_, err := ropts.NewDownloadCache(ropts.DownloadCacheOptions{Directory: "cache"})
+			check.Error(t, err)
+		},
+		"DoesNotCacheFailedDownloads": func(ctx context.Context, t *testing.T, dir string) {
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				rw.WriteHeader(http.StatusNotFound)
+			}))
+			defer srv.Close()
+
+			cache, err := ropts.NewDownloadCache(ropts.DownloadCacheOptions{Directory: filepath.Join(dir, "cache")})
+			assert.NotError(t, err)
+
+			check.Error(t, cache.Download(ctx, ropts.Download{URL: srv.URL, Path: filepath.Join(dir, "out"), Checksum: testContentChecksum("foo")}))
+			check.Equal(t, cache.Stats().Entries, 0)
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			testCase(ctx, t, t.TempDir())
+		})
+	}
+}
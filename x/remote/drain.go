@@ -0,0 +1,208 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/tychoish/fun/erc"
+	"github.com/tychoish/fun/ers"
+	"github.com/tychoish/grip"
+	"github.com/tychoish/jasper"
+	"github.com/tychoish/jasper/options"
+	roptions "github.com/tychoish/jasper/x/remote/options"
+)
+
+// ErrDraining is returned when a process is created, registered or
+// respawned on a manager that is draining.
+const ErrDraining = ers.Error("service is draining and not accepting new processes")
+
+// CreateProcess creates a process with the wrapped manager unless the
+// manager is draining. Processes that the process creates itself, such as
+// its liveness restarts, are also created with CreateProcess.
+func (m *HealthManager) CreateProcess(ctx context.Context, opts *options.Create) (jasper.Process, error) {
+	if m.isDraining() {
+		return nil, ErrDraining
+	}
+	return m.Manager.CreateProcess(jasper.WithProcessManager(ctx, m), opts)
+}
+
+// Register registers the process with the wrapped manager unless the
+// manager is draining.
+func (m *HealthManager) Register(ctx context.Context, proc jasper.Process) error {
+	if m.isDraining() {
+		return ErrDraining
+	}
+	return m.Manager.Register(ctx, proc)
+}
+
+// RespawnProcess respawns the process and registers the new process with the
+// wrapped manager unless the manager is draining.
+func (m *HealthManager) RespawnProcess(ctx context.Context, proc jasper.Process) (jasper.Process, error) {
+	if m.isDraining() {
+		return nil, ErrDraining
+	}
+	return respawnProcess(ctx, m.Manager, proc)
+}
+
+// processRespawner is implemented by managers that control how processes
+// are respawned.
+type processRespawner interface {
+	RespawnProcess(context.Context, jasper.Process) (jasper.Process, error)
+}
+
+// respawnProcess respawns the process with the manager if it controls
+// respawning, and otherwise respawns the process and registers the new
+// process with the manager.
+func respawnProcess(ctx context.Context, m jasper.Manager, proc jasper.Process) (jasper.Process, error) {
+	if r, ok := m.(processRespawner); ok {
+		return r.RespawnProcess(ctx, proc)
+	}
+
+	newProc, err := proc.Respawn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("problem respawning process: %w", err)
+	}
+	if err := m.Register(ctx, newProc); err != nil {
+		return nil, fmt.Errorf("problem registering respawned process: %w", err)
+	}
+	return newProc, nil
+}
+
+// CreateCommand creates a command with the wrapped manager that creates its
+// processes with CreateProcess, so that commands cannot start processes
+// while the manager is draining.
+func (m *HealthManager) CreateCommand(ctx context.Context) *jasper.Command {
+	return m.Manager.CreateCommand(ctx).ProcConstructor(m.CreateProcess)
+}
+
+func (m *HealthManager) isDraining() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.draining
+}
+
+// Drain stops the manager from creating new processes and waits for the
+// running processes to finish, terminating them once the timeout expires if
+// the options request it. Once the processes are done, the loggers in the
+// logging cache and the global logger are flushed. The loggers stay in the
+// logging cache, since the manager remains draining only until
+// SetDraining(false) is called, so a service that shuts down after draining
+// must clear the logging cache itself.
+func (m *HealthManager) Drain(ctx context.Context, opts roptions.Drain) (roptions.DrainReport, error) {
+	report := roptions.DrainReport{}
+	if err := opts.Validate(); err != nil {
+		return report, fmt.Errorf("invalid drain options: %w", err)
+	}
+
+	m.SetDraining(true)
+
+	procs, err := m.Manager.List(ctx, options.Running)
+	if err != nil {
+		return report, fmt.Errorf("problem listing running processes: %w", err)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	running := waitForProcesses(ctx, timeoutCtx, procs)
+	cancel()
+	report.Completed = processIDsExcept(procs, running)
+
+	if len(running) != 0 && opts.Terminate {
+		catcher := &erc.Collector{}
+		for _, proc := range running {
+			catcher.Push(jasper.Terminate(ctx, proc))
+		}
+		grip.Warning(catcher.Resolve())
+
+		graceCtx, cancel := context.WithTimeout(ctx, opts.GracePeriod)
+		remaining := waitForProcesses(ctx, graceCtx, running)
+		cancel()
+		report.Terminated = processIDsExcept(running, remaining)
+
+		if len(remaining) != 0 {
+			if err := jasper.KillAll(ctx, remaining); err != nil {
+				return report, fmt.Errorf("problem killing processes: %w", err)
+			}
+			report.Killed = processIDsExcept(remaining, nil)
+		}
+	}
+
+	// Processes may have been created while the drain started, so check
+	// again for any that are still running.
+	if procs, err = m.Manager.List(ctx, options.Running); err != nil {
+		return report, fmt.Errorf("problem listing running processes: %w", err)
+	}
+	report.Running = processIDsExcept(procs, nil)
+
+	catcher := &erc.Collector{}
+	if lc := m.Manager.LoggingCache(ctx); lc != nil {
+		catcher.Push(flushLoggingCache(ctx, lc))
+	}
+	catcher.Push(grip.Sender().Flush(ctx))
+	if err := catcher.Resolve(); err != nil {
+		return report, fmt.Errorf("problem flushing logs: %w", err)
+	}
+
+	return report, nil
+}
+
+// flushLoggingCache flushes the loggers in the logging cache without removing
+// them. Logging caches that cannot list their loggers are not flushed.
+func flushLoggingCache(ctx context.Context, lc jasper.LoggingCache) error {
+	lister, ok := lc.(jasper.LoggingCacheLister)
+	if !ok {
+		return nil
+	}
+
+	catcher := &erc.Collector{}
+	for _, logger := range lister.List() {
+		if logger.Output != nil {
+			catcher.Push(logger.Output.Flush(ctx))
+		}
+		if logger.Error != nil && logger.Error != logger.Output {
+			catcher.Push(logger.Error.Flush(ctx))
+		}
+	}
+	return catcher.Resolve()
+}
+
+// waitForProcesses waits for the processes to finish until the wait context
+// is done and returns the processes that are still running.
+func waitForProcesses(ctx, waitCtx context.Context, procs []jasper.Process) []jasper.Process {
+	wg := &sync.WaitGroup{}
+	for _, proc := range procs {
+		wg.Add(1)
+		go func(proc jasper.Process) {
+			defer wg.Done()
+			_, _ = proc.Wait(waitCtx)
+		}(proc)
+	}
+	wg.Wait()
+
+	running := []jasper.Process{}
+	for _, proc := range procs {
+		if !proc.Complete(ctx) {
+			running = append(running, proc)
+		}
+	}
+	return running
+}
+
+// processIDsExcept returns the IDs of the processes that are not excluded.
+func processIDsExcept(procs, exclude []jasper.Process) []string {
+	excluded := make(map[string]struct{}, len(exclude))
+	for _, proc := range exclude {
+		excluded[proc.ID()] = struct{}{}
+	}
+
+	ids := []string{}
+	for _, proc := range procs {
+		if _, ok := excluded[proc.ID()]; !ok {
+			ids = append(ids, proc.ID())
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	return ids
+}
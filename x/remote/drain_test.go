@@ -0,0 +1,215 @@
+package remote
+
+import (
+	"context"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/testutil"
+	roptions "github.com/tychoish/jasper/x/remote/options"
+)
+
+func TestHealthManagerDrain(t *testing.T) {
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, hm *HealthManager){
+		"RejectsProcessesWhileDraining": func(ctx context.Context, t *testing.T, hm *HealthManager) {
+			hm.SetDraining(true)
+			_, err := hm.CreateProcess(ctx, testutil.TrueCreateOpts())
+			check.ErrorIs(t, err, ErrDraining)
+			check.ErrorIs(t, hm.CreateCommand(ctx).Append("true").Run(ctx), ErrDraining)
+
+			hm.SetDraining(false)
+			_, err = hm.CreateProcess(ctx, testutil.TrueCreateOpts())
+			check.NotError(t, err)
+		},
+		"RejectsRegisterAndRespawnWhileDraining": func(ctx context.Context, t *testing.T, hm *HealthManager) {
+			proc, err := hm.CreateProcess(ctx, testutil.TrueCreateOpts())
+			assert.NotError(t, err)
+			other, err := jasper.NewProcess(ctx, testutil.TrueCreateOpts())
+			assert.NotError(t, err)
+
+			hm.SetDraining(true)
+			check.ErrorIs(t, hm.Register(ctx, other), ErrDraining)
+			_, err = hm.RespawnProcess(ctx, proc)
+			check.ErrorIs(t, err, ErrDraining)
+
+			procs, err := hm.List(ctx, options.All)
+			assert.NotError(t, err)
+			check.Equal(t, len(procs), 1)
+
+			hm.SetDraining(false)
+			newProc, err := hm.RespawnProcess(ctx, proc)
+			assert.NotError(t, err)
+			_, err = hm.Get(ctx, newProc.ID())
+			check.NotError(t, err)
+		},
+		"DoesNotRestartProcessesWhileDraining": func(ctx context.Context, t *testing.T, _ *HealthManager) {
+			events := make(chan jasper.ManagerEvent, 16)
+			hm := NewHealthManager(jasper.NewManager(jasper.ManagerOptionEventHandler(func(event jasper.ManagerEvent) {
+				events <- event
+			})), HealthOptions{})
+			defer func() { check.NotError(t, hm.Close(ctx)) }()
+
+			opts := testutil.SleepCreateOpts(10)
+			opts.Liveness = &options.Liveness{
+				Command:          testutil.FalseCreateOpts(),
+				InitialDelay:     100 * time.Millisecond,
+				Interval:         10 * time.Millisecond,
+				FailureThreshold: 1,
+				GracePeriod:      time.Second,
+			}
+			proc, err := hm.CreateProcess(ctx, opts)
+			assert.NotError(t, err)
+			hm.SetDraining(true)
+
+			for event := range events {
+				if event.Type == jasper.ManagerEventLivenessRestarted {
+					t.Fatal("process was restarted while draining")
+				}
+				if event.Type == jasper.ManagerEventLivenessRestartFailed {
+					check.Equal(t, event.ProcessID, proc.ID())
+					check.Substring(t, event.Error, ErrDraining.Error())
+					break
+				}
+			}
+
+			check.True(t, proc.Complete(ctx))
+			procs, err := hm.List(ctx, options.All)
+			assert.NotError(t, err)
+			check.Equal(t, len(procs), 1)
+		},
+		"FailsWithInvalidOptions": func(ctx context.Context, t *testing.T, hm *HealthManager) {
+			_, err := hm.Drain(ctx, roptions.Drain{Timeout: -time.Second})
+			check.Error(t, err)
+			check.True(t, !hm.isDraining())
+		},
+		"WaitsForProcessesToComplete": func(ctx context.Context, t *testing.T, hm *HealthManager) {
+			proc, err := hm.CreateProcess(ctx, testutil.SleepCreateOpts(1))
+			assert.NotError(t, err)
+
+			report, err := hm.Drain(ctx, roptions.Drain{Timeout: testutil.ProcessTestTimeout})
+			assert.NotError(t, err)
+			check.True(t, proc.Complete(ctx))
+			check.EqualItems(t, report.Completed, []string{proc.ID()})
+			check.Equal(t, len(report.Running), 0)
+
+			_, err = hm.CreateProcess(ctx, testutil.TrueCreateOpts())
+			check.ErrorIs(t, err, ErrDraining)
+		},
+		"LeavesProcessesRunningAfterTimeout": func(ctx context.Context, t *testing.T, hm *HealthManager) {
+			proc, err := hm.CreateProcess(ctx, testutil.SleepCreateOpts(10))
+			assert.NotError(t, err)
+			defer func() { check.NotError(t, jasper.Kill(ctx, proc)) }()
+
+			report, err := hm.Drain(ctx, roptions.Drain{Timeout: 100 * time.Millisecond})
+			assert.NotError(t, err)
+			check.True(t, proc.Running(ctx))
+			check.Equal(t, len(report.Completed), 0)
+			check.EqualItems(t, report.Running, []string{proc.ID()})
+		},
+		"KeepsCachedLoggers": func(ctx context.Context, t *testing.T, hm *HealthManager) {
+			lc := hm.LoggingCache(ctx)
+			_, err := lc.Create("logger", &options.Output{})
+			assert.NotError(t, err)
+
+			_, err = hm.Drain(ctx, roptions.Drain{})
+			assert.NotError(t, err)
+			check.Equal(t, lc.Len(), 1)
+
+			hm.SetDraining(false)
+			check.True(t, lc.Get("logger") != nil)
+		},
+		"TerminatesProcessesAfterTimeout": func(ctx context.Context, t *testing.T, hm *HealthManager) {
+			proc, err := hm.CreateProcess(ctx, testutil.SleepCreateOpts(10))
+			assert.NotError(t, err)
+
+			report, err := hm.Drain(ctx, roptions.Drain{
+				Timeout:     100 * time.Millisecond,
+				Terminate:   true,
+				GracePeriod: testutil.TestTimeout,
+			})
+			assert.NotError(t, err)
+			check.True(t, proc.Complete(ctx))
+			check.EqualItems(t, report.Terminated, []string{proc.ID()})
+			check.Equal(t, len(report.Killed), 0)
+			check.Equal(t, len(report.Running), 0)
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.ManagerTestTimeout)
+			defer cancel()
+
+			testCase(ctx, t, makeTestHealthManager(t, HealthOptions{}))
+		})
+	}
+}
+
+func TestDrain(t *testing.T) {
+	for clientName, makeClient := range testClientFactories() {
+		t.Run(clientName, func(t *testing.T) {
+			for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager){
+				"DrainsService": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					proc, err := client.CreateProcess(ctx, testutil.SleepCreateOpts(1))
+					assert.NotError(t, err)
+
+					report, err := client.Drain(ctx, roptions.Drain{Timeout: testutil.ProcessTestTimeout})
+					assert.NotError(t, err)
+					check.EqualItems(t, report.Completed, []string{proc.ID()})
+
+					health, err := client.CheckHealth(ctx)
+					assert.NotError(t, err)
+					check.True(t, !health.Ready)
+				},
+				"RejectsProcessesAfterDrain": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					_, err := client.Drain(ctx, roptions.Drain{})
+					assert.NotError(t, err)
+
+					_, err = client.CreateProcess(ctx, &options.Create{Args: []string{"echo", "hello"}})
+					check.Error(t, err)
+				},
+				"RejectsRespawnDuringDrain": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					proc, err := client.CreateProcess(ctx, testutil.SleepCreateOpts(10))
+					assert.NotError(t, err)
+
+					drained := make(chan error, 1)
+					go func() {
+						_, err := client.Drain(ctx, roptions.Drain{Timeout: testutil.RPCTestTimeout})
+						drained <- err
+					}()
+					for !hm.isDraining() {
+						select {
+						case <-ctx.Done():
+							t.Fatal("timed out waiting for drain to start")
+						case <-time.After(10 * time.Millisecond):
+						}
+					}
+
+					_, err = proc.Respawn(ctx)
+					check.Error(t, err)
+					procs, err := hm.List(ctx, options.All)
+					assert.NotError(t, err)
+					check.Equal(t, len(procs), 1)
+
+					assert.NotError(t, proc.Signal(ctx, syscall.SIGKILL))
+					check.NotError(t, <-drained)
+				},
+				"FailsWithInvalidOptions": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					_, err := client.Drain(ctx, roptions.Drain{GracePeriod: -time.Second})
+					check.Error(t, err)
+				},
+			} {
+				t.Run(testName, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), testutil.RPCTestTimeout)
+					defer cancel()
+
+					hm := makeTestHealthManager(t, HealthOptions{})
+					testCase(ctx, t, hm, makeClient(ctx, t, hm))
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+
+	roptions "github.com/tychoish/jasper/x/remote/options"
+)
+
+// ExecSession is an interactive session with a process started by
+// Manager.Exec.
+type ExecSession interface {
+	// ID returns the ID of the remote process.
+	ID() string
+	// Stdin returns a writer to the standard input of the process. Closing
+	// the writer closes the process's standard input.
+	Stdin() io.WriteCloser
+	// Stdout and Stderr return readers for the output of the process,
+	// which return io.EOF once the process has exited and all of its output
+	// has been read. Output is buffered until it is read. In TTY sessions,
+	// all output is read from Stdout.
+	Stdout() io.Reader
+	Stderr() io.Reader
+	// Resize changes the window size of the process's pseudo-terminal.
+	Resize(roptions.WindowSize) error
+	// Wait blocks until the process exits and returns its exit code. As
+	// with jasper.Process, the returned error is non-nil if the process
+	// did not exit successfully.
+	Wait(context.Context) (int, error)
+	// Close ends the session, killing the process if it is still running.
+	// Callers must close the session once they are done with it.
+	Close() error
+}
+
+// AttachExecSession copies stdin to the standard input of the process in the
+// session and the output of the process to stdout and stderr, and then
+// waits for the process to exit. The standard input of the process is closed
+// once stdin is exhausted.
+func AttachExecSession(ctx context.Context, session ExecSession, stdin io.Reader, stdout, stderr io.Writer) (int, error) {
+	if stdin != nil {
+		go func() {
+			in := session.Stdin()
+			_, _ = io.Copy(in, stdin)
+			_ = in.Close()
+		}()
+	}
+
+	wg := &sync.WaitGroup{}
+	copyErrs := make(chan error, 2)
+	for _, stream := range []struct {
+		from io.Reader
+		to   io.Writer
+	}{
+		{from: session.Stdout(), to: stdout},
+		{from: session.Stderr(), to: stderr},
+	} {
+		if stream.to == nil {
+			stream.to = io.Discard
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := io.Copy(stream.to, stream.from); err != nil {
+				copyErrs <- err
+			}
+		}()
+	}
+
+	exitCode, err := session.Wait(ctx)
+	wg.Wait()
+	close(copyErrs)
+	if err == nil {
+		if copyErr, ok := <-copyErrs; ok {
+			return exitCode, fmt.Errorf("problem copying output: %w", copyErr)
+		}
+	}
+
+	return exitCode, err
+}
+
+// execClientSession implements ExecSession on top of a transport that sends
+// and receives session messages.
+type execClientSession struct {
+	id     string
+	stdout *execOutputBuffer
+	stderr *execOutputBuffer
+
+	sendMu sync.Mutex
+	send   func(roptions.ExecInput) error
+	close  func() error
+
+	stdinOnce sync.Once
+	closeOnce sync.Once
+
+	done     chan struct{}
+	exitCode int
+	err      error
+}
+
+// newExecClientSession starts a session by sending the options and waiting
+// for the service to start the process. The close function closes the
+// transport.
+func newExecClientSession(opts *roptions.Exec, send func(roptions.ExecInput) error, recv func() (roptions.ExecOutput, error), closeTransport func() error) (*execClientSession, error) {
+	if err := send(roptions.ExecInput{Options: opts}); err != nil {
+		_ = closeTransport()
+		return nil, fmt.Errorf("problem sending session options: %w", err)
+	}
+
+	started, err := recv()
+	if err != nil {
+		_ = closeTransport()
+		return nil, fmt.Errorf("problem starting session: %w", err)
+	}
+	if started.ID == "" {
+		_ = closeTransport()
+		if started.Error != "" {
+			return nil, fmt.Errorf("problem starting session: %s", started.Error)
+		}
+		return nil, errors.New("service did not start the process")
+	}
+
+	s := &execClientSession{
+		id:     started.ID,
+		stdout: newExecOutputBuffer(),
+		stderr: newExecOutputBuffer(),
+		send:   send,
+		close:  closeTransport,
+		done:   make(chan struct{}),
+	}
+	go s.receive(recv)
+
+	return s, nil
+}
+
+func (s *execClientSession) receive(recv func() (roptions.ExecOutput, error)) {
+	defer close(s.done)
+	defer s.stdout.Close()
+	defer s.stderr.Close()
+
+	for {
+		out, err := recv()
+		if err != nil {
+			s.exitCode = -1
+			s.err = fmt.Errorf("session ended before the process exited: %w", err)
+			return
+		}
+
+		s.stdout.Write(out.Stdout)
+		s.stderr.Write(out.Stderr)
+
+		if out.Exited {
+			s.exitCode = out.ExitCode
+			if out.Error != "" {
+				s.err = errors.New(out.Error)
+			}
+			return
+		}
+	}
+}
+
+func (s *execClientSession) sendInput(in roptions.ExecInput) error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+
+	select {
+	case <-s.done:
+		return errors.New("session has ended")
+	default:
+		return s.send(in)
+	}
+}
+
+func (s *execClientSession) ID() string        { return s.id }
+func (s *execClientSession) Stdout() io.Reader { return s.stdout }
+func (s *execClientSession) Stderr() io.Reader { return s.stderr }
+
+func (s *execClientSession) Stdin() io.WriteCloser { return execStdin{session: s} }
+
+func (s *execClientSession) Resize(size roptions.WindowSize) error {
+	return s.sendInput(roptions.ExecInput{Resize: &size})
+}
+
+func (s *execClientSession) Wait(ctx context.Context) (int, error) {
+	select {
+	case <-ctx.Done():
+		return -1, ctx.Err()
+	case <-s.done:
+		return s.exitCode, s.err
+	}
+}
+
+func (s *execClientSession) Close() error {
+	var err error
+	s.closeOnce.Do(func() { err = s.close() })
+	return err
+}
+
+type execStdin struct {
+	session *execClientSession
+}
+
+func (w execStdin) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if err := w.session.sendInput(roptions.ExecInput{Stdin: slices.Clone(p)}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w execStdin) Close() error {
+	var err error
+	w.session.stdinOnce.Do(func() {
+		err = w.session.sendInput(roptions.ExecInput{CloseStdin: true})
+	})
+	return err
+}
+
+// execOutputBuffer is an unbounded buffer of output that blocks reads until
+// output is available or the buffer is closed.
+type execOutputBuffer struct {
+	mu     sync.Mutex
+	buf    bytes.Buffer
+	closed bool
+	notify chan struct{}
+}
+
+func newExecOutputBuffer() *execOutputBuffer {
+	return &execOutputBuffer{notify: make(chan struct{})}
+}
+
+func (b *execOutputBuffer) Write(p []byte) {
+	if len(p) == 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.buf.Write(p)
+	close(b.notify)
+	b.notify = make(chan struct{})
+}
+
+func (b *execOutputBuffer) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.closed {
+		b.closed = true
+		close(b.notify)
+	}
+}
+
+func (b *execOutputBuffer) Read(p []byte) (int, error) {
+	for {
+		b.mu.Lock()
+		if b.buf.Len() > 0 {
+			defer b.mu.Unlock()
+			return b.buf.Read(p)
+		}
+		if b.closed {
+			b.mu.Unlock()
+			return 0, io.EOF
+		}
+		notify := b.notify
+		b.mu.Unlock()
+
+		<-notify
+	}
+}
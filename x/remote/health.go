@@ -0,0 +1,337 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tychoish/grip"
+	"github.com/tychoish/grip/message"
+	"github.com/tychoish/grip/recovery"
+	"github.com/tychoish/jasper"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/x/remote/internal"
+	roptions "github.com/tychoish/jasper/x/remote/options"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// HealthCheck returns an error if the dependency it checks is not working.
+type HealthCheck func(context.Context) error
+
+// HealthOptions configures the built-in checks of a HealthManager.
+type HealthOptions struct {
+	// MaxProcesses is the number of running processes at which the service
+	// is at capacity and no longer ready. If zero, the service is never at
+	// capacity.
+	MaxProcesses int
+	// CheckTimeout is the maximum time each check may take before it fails.
+	// It defaults to 5 seconds.
+	CheckTimeout time.Duration
+}
+
+// HealthManager wraps a manager to track the health and readiness of the
+// services that use it. Services report that they are healthy if all health
+// checks pass, and ready if they are healthy, all readiness checks pass, and
+// the manager is not closed, at capacity or draining.
+//
+// The REST, RPC and MongoDB wire protocol services use the HealthManager
+// they are given, so pass one to a service to configure its checks. Services
+// given any other manager use a HealthManager with the default options.
+type HealthManager struct {
+	jasper.Manager
+	opts HealthOptions
+
+	mu              sync.RWMutex
+	closed          bool
+	draining        bool
+	healthChecks    map[string]HealthCheck
+	readinessChecks map[string]HealthCheck
+	scheduler       *jasper.Scheduler
+}
+
+// NewHealthManager returns a HealthManager that wraps the manager.
+func NewHealthManager(m jasper.Manager, opts HealthOptions) *HealthManager {
+	if opts.CheckTimeout <= 0 {
+		opts.CheckTimeout = 5 * time.Second
+	}
+	hm := &HealthManager{
+		Manager:         m,
+		opts:            opts,
+		healthChecks:    map[string]HealthCheck{},
+		readinessChecks: map[string]HealthCheck{},
+	}
+	hm.scheduler = jasper.NewScheduler(hm)
+	return hm
+}
+
+// makeHealthManager returns the manager if it is already a HealthManager, or
+// wraps it in a HealthManager with the default options.
+func makeHealthManager(m jasper.Manager) *HealthManager {
+	if hm, ok := m.(*HealthManager); ok {
+		return hm
+	}
+	return NewHealthManager(m, HealthOptions{})
+}
+
+// AddHealthCheck adds a check that must pass for the service to be healthy,
+// replacing any existing health check with the same name.
+func (m *HealthManager) AddHealthCheck(name string, check HealthCheck) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.healthChecks[name] = check
+}
+
+// AddReadinessCheck adds a check that must pass for the service to be ready,
+// replacing any existing readiness check with the same name.
+func (m *HealthManager) AddReadinessCheck(name string, check HealthCheck) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.readinessChecks[name] = check
+}
+
+// SetDraining sets whether the service is draining. A draining service is
+// not ready and rejects requests to create processes.
+func (m *HealthManager) SetDraining(draining bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.draining = draining
+}
+
+// Scheduler returns the scheduler for the processes that the services create
+// on a schedule. Scheduled processes are created with CreateProcess, so no
+// runs start while the manager is draining.
+func (m *HealthManager) Scheduler() *jasper.Scheduler { return m.scheduler }
+
+// Unwrap returns the wrapped manager.
+func (m *HealthManager) Unwrap() jasper.Manager { return m.Manager }
+
+// Close stops the schedules and closes the wrapped manager. Once closed, the
+// service is no longer ready.
+func (m *HealthManager) Close(ctx context.Context) error {
+	grip.Warning(m.scheduler.Close())
+	err := m.Manager.Close(ctx)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.closed = true
+
+	return err
+}
+
+// Check runs the health and readiness checks and reports the results.
+func (m *HealthManager) Check(ctx context.Context) roptions.HealthReport {
+	m.mu.RLock()
+	closed, draining := m.closed, m.draining
+	healthChecks := make(map[string]HealthCheck, len(m.healthChecks))
+	for name, check := range m.healthChecks {
+		healthChecks[name] = check
+	}
+	readinessChecks := make(map[string]HealthCheck, len(m.readinessChecks))
+	for name, check := range m.readinessChecks {
+		readinessChecks[name] = check
+	}
+	m.mu.RUnlock()
+
+	failures := map[string]string{}
+	for name, check := range healthChecks {
+		if err := m.runCheck(ctx, check); err != nil {
+			failures[name] = err.Error()
+		}
+	}
+	healthy := len(failures) == 0
+
+	if closed {
+		failures["closed"] = "manager is closed"
+	}
+	if draining {
+		failures["draining"] = "service is draining"
+	}
+	if m.opts.MaxProcesses > 0 {
+		if err := m.runCheck(ctx, m.checkCapacity); err != nil {
+			failures["capacity"] = err.Error()
+		}
+	}
+	for name, check := range readinessChecks {
+		if err := m.runCheck(ctx, check); err != nil {
+			failures[name] = err.Error()
+		}
+	}
+
+	report := roptions.HealthReport{
+		Healthy: healthy,
+		Ready:   len(failures) == 0,
+	}
+	if len(failures) != 0 {
+		report.Failures = failures
+	}
+	return report
+}
+
+func (m *HealthManager) runCheck(ctx context.Context, check HealthCheck) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, m.opts.CheckTimeout)
+	defer cancel()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("check panicked: %v", r)
+		}
+	}()
+
+	return check(ctx)
+}
+
+func (m *HealthManager) checkCapacity(ctx context.Context) error {
+	procs, err := m.Manager.List(ctx, options.Running)
+	if err != nil {
+		return fmt.Errorf("problem listing running processes: %w", err)
+	}
+	if len(procs) >= m.opts.MaxProcesses {
+		return fmt.Errorf("%d of %d processes are running", len(procs), m.opts.MaxProcesses)
+	}
+	return nil
+}
+
+// PruneLoggingCache removes loggers that were last accessed more than maxAge
+// ago from the manager's logging cache every interval until the context is
+// canceled. If interval is zero, jasper.DefaultCachePruneDelay is used. The
+// returned check fails once the pruner has stopped or has not pruned the
+// cache within twice the interval, and can be added to a HealthManager as a
+// health check.
+func PruneLoggingCache(ctx context.Context, m jasper.Manager, interval, maxAge time.Duration) HealthCheck {
+	if interval <= 0 {
+		interval = jasper.DefaultCachePruneDelay
+	}
+
+	var (
+		mu       sync.Mutex
+		lastRun  = time.Now()
+		stopped  bool
+		pruneErr error
+	)
+
+	go func() {
+		defer recovery.LogStackTraceAndContinue("logging cache pruner")
+		defer func() {
+			mu.Lock()
+			defer mu.Unlock()
+			stopped = true
+		}()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				var err error
+				if lc := m.LoggingCache(ctx); lc != nil {
+					lc.Prune(time.Now().Add(-maxAge))
+				} else {
+					err = errors.New("manager does not have a logging cache")
+				}
+				grip.Warning(message.WrapError(err, "problem pruning logging cache"))
+
+				mu.Lock()
+				lastRun, pruneErr = time.Now(), err
+				mu.Unlock()
+			}
+		}
+	}()
+
+	return func(context.Context) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case stopped:
+			return errors.New("logging cache pruner has stopped")
+		case pruneErr != nil:
+			return pruneErr
+		case time.Since(lastRun) > 2*interval:
+			return fmt.Errorf("logging cache has not been pruned since %s", lastRun.Format(time.RFC3339))
+		default:
+			return nil
+		}
+	}
+}
+
+// healthWatchInterval is how often the RPC health service checks for changes
+// to the status of a watched service.
+const healthWatchInterval = time.Second
+
+// rpcReadinessService is the service name that reports readiness using the
+// gRPC health checking protocol. The empty service name reports health.
+var rpcReadinessService = internal.JasperProcessManager_ServiceDesc.ServiceName
+
+// rpcHealthService implements the gRPC health checking protocol.
+type rpcHealthService struct {
+	healthpb.UnimplementedHealthServer
+	manager *HealthManager
+}
+
+func (s *rpcHealthService) status(ctx context.Context, service string) (healthpb.HealthCheckResponse_ServingStatus, bool) {
+	var serving bool
+	switch service {
+	case "":
+		serving = s.manager.Check(ctx).Healthy
+	case rpcReadinessService:
+		serving = s.manager.Check(ctx).Ready
+	default:
+		return healthpb.HealthCheckResponse_SERVICE_UNKNOWN, false
+	}
+
+	if serving {
+		return healthpb.HealthCheckResponse_SERVING, true
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING, true
+}
+
+func (s *rpcHealthService) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	st, ok := s.status(ctx, req.GetService())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown service '%s'", req.GetService())
+	}
+	return &healthpb.HealthCheckResponse{Status: st}, nil
+}
+
+func (s *rpcHealthService) List(ctx context.Context, _ *healthpb.HealthListRequest) (*healthpb.HealthListResponse, error) {
+	report := s.manager.Check(ctx)
+	toStatus := func(serving bool) *healthpb.HealthCheckResponse {
+		if serving {
+			return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}
+		}
+		return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_NOT_SERVING}
+	}
+
+	return &healthpb.HealthListResponse{Statuses: map[string]*healthpb.HealthCheckResponse{
+		"":                  toStatus(report.Healthy),
+		rpcReadinessService: toStatus(report.Ready),
+	}}, nil
+}
+
+func (s *rpcHealthService) Watch(req *healthpb.HealthCheckRequest, stream grpc.ServerStreamingServer[healthpb.HealthCheckResponse]) error {
+	ctx := stream.Context()
+	ticker := time.NewTicker(healthWatchInterval)
+	defer ticker.Stop()
+
+	last := healthpb.HealthCheckResponse_ServingStatus(-1)
+	for {
+		if st, _ := s.status(ctx, req.GetService()); st != last {
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: st}); err != nil {
+				return fmt.Errorf("problem sending health status: %w", err)
+			}
+			last = st
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	empty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/testutil"
+	"github.com/tychoish/jasper/x/remote/internal"
+	roptions "github.com/tychoish/jasper/x/remote/options"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestHealthManager(t *testing.T) {
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T){
+		"ReportsHealthyAndReady": func(ctx context.Context, t *testing.T) {
+			report := makeTestHealthManager(t, HealthOptions{}).Check(ctx)
+			check.True(t, report.Healthy)
+			check.True(t, report.Ready)
+			check.Equal(t, len(report.Failures), 0)
+		},
+		"ClosedManagerIsNotReady": func(ctx context.Context, t *testing.T) {
+			hm := makeTestHealthManager(t, HealthOptions{})
+			assert.NotError(t, hm.Close(ctx))
+
+			report := hm.Check(ctx)
+			check.True(t, report.Healthy)
+			check.True(t, !report.Ready)
+			check.NotZero(t, report.Failures["closed"])
+		},
+		"DrainingManagerIsNotReady": func(ctx context.Context, t *testing.T) {
+			hm := makeTestHealthManager(t, HealthOptions{})
+			hm.SetDraining(true)
+			report := hm.Check(ctx)
+			check.True(t, report.Healthy)
+			check.True(t, !report.Ready)
+			check.NotZero(t, report.Failures["draining"])
+
+			hm.SetDraining(false)
+			check.True(t, hm.Check(ctx).Ready)
+		},
+		"ManagerAtCapacityIsNotReady": func(ctx context.Context, t *testing.T) {
+			hm := makeTestHealthManager(t, HealthOptions{MaxProcesses: 1})
+			check.True(t, hm.Check(ctx).Ready)
+
+			_, err := hm.CreateProcess(ctx, testutil.SleepCreateOpts(10))
+			assert.NotError(t, err)
+
+			report := hm.Check(ctx)
+			check.True(t, report.Healthy)
+			check.True(t, !report.Ready)
+			check.NotZero(t, report.Failures["capacity"])
+		},
+		"FailedHealthCheckIsNotHealthyOrReady": func(ctx context.Context, t *testing.T) {
+			hm := makeTestHealthManager(t, HealthOptions{})
+			hm.AddHealthCheck("foo", func(context.Context) error { return errors.New("bar") })
+
+			report := hm.Check(ctx)
+			check.True(t, !report.Healthy)
+			check.True(t, !report.Ready)
+			check.Equal(t, report.Failures["foo"], "bar")
+		},
+		"FailedReadinessCheckIsNotReady": func(ctx context.Context, t *testing.T) {
+			hm := makeTestHealthManager(t, HealthOptions{})
+			hm.AddReadinessCheck("foo", func(context.Context) error { return errors.New("bar") })
+
+			report := hm.Check(ctx)
+			check.True(t, report.Healthy)
+			check.True(t, !report.Ready)
+			check.Equal(t, report.Failures["foo"], "bar")
+		},
+		"ChecksTimeOut": func(ctx context.Context, t *testing.T) {
+			hm := makeTestHealthManager(t, HealthOptions{CheckTimeout: 10 * time.Millisecond})
+			hm.AddHealthCheck("foo", func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			})
+
+			report := hm.Check(ctx)
+			check.True(t, !report.Healthy)
+			check.NotZero(t, report.Failures["foo"])
+		},
+		"PanickingCheckFails": func(ctx context.Context, t *testing.T) {
+			hm := makeTestHealthManager(t, HealthOptions{})
+			hm.AddHealthCheck("foo", func(context.Context) error { panic("bar") })
+
+			report := hm.Check(ctx)
+			check.True(t, !report.Healthy)
+			check.NotZero(t, report.Failures["foo"])
+		},
+		"LoggingCachePrunerIsHealthyWhileRunning": func(ctx context.Context, t *testing.T) {
+			hm := makeTestHealthManager(t, HealthOptions{})
+			_, err := hm.LoggingCache(ctx).Create("foo", &options.Output{})
+			assert.NotError(t, err)
+
+			pruneCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			hm.AddHealthCheck("pruner", PruneLoggingCache(pruneCtx, hm, 10*time.Millisecond, 0))
+
+			time.Sleep(50 * time.Millisecond)
+			check.Equal(t, hm.LoggingCache(ctx).Len(), 0)
+			check.True(t, hm.Check(ctx).Healthy)
+
+			cancel()
+			time.Sleep(50 * time.Millisecond)
+			report := hm.Check(ctx)
+			check.True(t, !report.Healthy)
+			check.NotZero(t, report.Failures["pruner"])
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+			defer cancel()
+			testCase(ctx, t)
+		})
+	}
+}
+
+func TestCheckHealth(t *testing.T) {
+	for clientName, makeClient := range testClientFactories() {
+		t.Run(clientName, func(t *testing.T) {
+			for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager){
+				"ReportsHealthyAndReady": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					report, err := client.CheckHealth(ctx)
+					assert.NotError(t, err)
+					check.True(t, report.Healthy)
+					check.True(t, report.Ready)
+				},
+				"ReportsNotReadyAfterClose": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					assert.NotError(t, client.Close(ctx))
+
+					report, err := client.CheckHealth(ctx)
+					assert.NotError(t, err)
+					check.True(t, report.Healthy)
+					check.True(t, !report.Ready)
+				},
+				"ReportsUnhealthy": func(ctx context.Context, t *testing.T, hm *HealthManager, client Manager) {
+					hm.AddHealthCheck("foo", func(context.Context) error { return errors.New("bar") })
+
+					report, err := client.CheckHealth(ctx)
+					assert.NotError(t, err)
+					check.True(t, !report.Healthy)
+					check.True(t, !report.Ready)
+				},
+			} {
+				t.Run(testName, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), testutil.RPCTestTimeout)
+					defer cancel()
+
+					hm := makeTestHealthManager(t, HealthOptions{})
+					testCase(ctx, t, hm, makeClient(ctx, t, hm))
+				})
+			}
+		})
+	}
+}
+
+func TestRestHealthRoutes(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+	defer cancel()
+
+	hm := makeTestHealthManager(t, HealthOptions{})
+	addr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("localhost:%d", testutil.GetPortNumber()))
+	assert.NotError(t, err)
+	closeService, err := StartRestService(ctx, NewRestService(hm), addr, nil, false)
+	assert.NotError(t, err)
+	defer func() { check.NotError(t, closeService()) }()
+
+	getStatus := func(route string) int {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s/jasper/v1/%s", addr, route), nil)
+		assert.NotError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		assert.NotError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	check.Equal(t, getStatus("healthz"), http.StatusOK)
+	check.Equal(t, getStatus("readyz"), http.StatusOK)
+
+	hm.SetDraining(true)
+	check.Equal(t, getStatus("healthz"), http.StatusOK)
+	check.Equal(t, getStatus("readyz"), http.StatusServiceUnavailable)
+
+	hm.AddHealthCheck("foo", func(context.Context) error { return errors.New("bar") })
+	check.Equal(t, getStatus("healthz"), http.StatusServiceUnavailable)
+}
+
+func TestRPCHealthService(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.RPCTestTimeout)
+	defer cancel()
+
+	hm := makeTestHealthManager(t, HealthOptions{})
+	addr, err := tryStartRPCService(ctx, func(ctx context.Context, addr net.Addr) error {
+		return startTestRPCService(ctx, hm, addr, nil)
+	})
+	assert.NotError(t, err)
+
+	conn, err := grpc.NewClient(addr.String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NotError(t, err)
+	defer func() { check.NotError(t, conn.Close()) }()
+	client := healthpb.NewHealthClient(conn)
+
+	t.Run("CheckFailsForUnknownService", func(t *testing.T) {
+		_, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: "foo"})
+		check.Equal(t, status.Code(err), codes.NotFound)
+	})
+	t.Run("ListReportsHealthAndReadiness", func(t *testing.T) {
+		resp, err := client.List(ctx, &healthpb.HealthListRequest{})
+		assert.NotError(t, err)
+		check.Equal(t, resp.Statuses[""].Status, healthpb.HealthCheckResponse_SERVING)
+		check.Equal(t, resp.Statuses[rpcReadinessService].Status, healthpb.HealthCheckResponse_SERVING)
+	})
+	t.Run("WatchReportsChanges", func(t *testing.T) {
+		stream, err := client.Watch(ctx, &healthpb.HealthCheckRequest{Service: rpcReadinessService})
+		assert.NotError(t, err)
+
+		resp, err := stream.Recv()
+		assert.NotError(t, err)
+		check.Equal(t, resp.Status, healthpb.HealthCheckResponse_SERVING)
+
+		hm.SetDraining(true)
+		defer hm.SetDraining(false)
+
+		resp, err = stream.Recv()
+		assert.NotError(t, err)
+		check.Equal(t, resp.Status, healthpb.HealthCheckResponse_NOT_SERVING)
+	})
+}
+
+func TestHealthChecksWithAuth(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.RPCTestTimeout)
+	defer cancel()
+
+	authorizer, err := NewAuthorizerFromConfig(roptions.AuthConfig{
+		Tokens: map[string]string{"reader": "read-token"},
+		Policy: roptions.AuthPolicy{Identities: map[string][]string{"reader": {roptions.RoleReadOnly}}},
+	})
+	assert.NotError(t, err)
+	hm := makeTestHealthManager(t, HealthOptions{})
+
+	t.Run("REST", func(t *testing.T) {
+		addr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("localhost:%d", testutil.GetPortNumber()))
+		assert.NotError(t, err)
+		closeService, err := StartRestService(ctx, NewRestServiceWithAuth(hm, authorizer), addr, nil, false)
+		assert.NotError(t, err)
+		defer func() { check.NotError(t, closeService()) }()
+
+		for route, code := range map[string]int{
+			"healthz": http.StatusOK,
+			"readyz":  http.StatusOK,
+			"id":      http.StatusUnauthorized,
+		} {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s/jasper/v1/%s", addr, route), nil)
+			assert.NotError(t, err)
+			resp, err := http.DefaultClient.Do(req)
+			assert.NotError(t, err)
+			resp.Body.Close()
+			check.Equal(t, resp.StatusCode, code)
+		}
+	})
+	t.Run("RPC", func(t *testing.T) {
+		addr, err := tryStartRPCService(ctx, func(ctx context.Context, addr net.Addr) error {
+			closeService, err := StartRPCService(ctx, hm, addr, nil, AuthServerOptions(authorizer)...)
+			if err != nil {
+				return err
+			}
+			go func() {
+				<-ctx.Done()
+				check.NotError(t, closeService())
+			}()
+			return nil
+		})
+		assert.NotError(t, err)
+
+		conn, err := grpc.NewClient(addr.String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		assert.NotError(t, err)
+		defer func() { check.NotError(t, conn.Close()) }()
+
+		resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+		assert.NotError(t, err)
+		check.Equal(t, resp.Status, healthpb.HealthCheckResponse_SERVING)
+
+		_, err = internal.NewJasperProcessManagerClient(conn).ID(ctx, &empty.Empty{})
+		check.Equal(t, status.Code(err), codes.Unauthenticated)
+	})
+}
@@ -2,6 +2,7 @@ package remote
 
 import (
 	"context"
+	"io"
 
 	"github.com/tychoish/jasper"
 	"github.com/tychoish/jasper/options"
@@ -18,7 +19,53 @@ type Manager interface {
 	CloseConnection() error
 	DownloadFile(ctx context.Context, opts roptions.Download) error
 	GetLogStream(ctx context.Context, id string, count int) (jasper.LogStream, error)
+	// FollowLogStream calls fn with each line of output from the process,
+	// starting at the offset in the options, as the lines are produced. It
+	// returns once the process has exited and all of its output has been
+	// received, or if the context is canceled or fn returns an error. Only
+	// the output of processes created through the service can be followed.
+	FollowLogStream(ctx context.Context, id string, opts roptions.LogFollow, fn func(jasper.LogLine) error) error
+	// Exec starts a process and attaches to its standard input and output
+	// in an interactive session. The session ends if the context is
+	// canceled.
+	Exec(ctx context.Context, opts *roptions.Exec) (ExecSession, error)
 	SignalEvent(ctx context.Context, name string) error
+	// GetDownloadCacheStats returns the state of the download cache used by
+	// downloads that set UseCache.
+	GetDownloadCacheStats(ctx context.Context) (roptions.DownloadCacheStats, error)
+	// CheckHealth returns the health and readiness of the service.
+	CheckHealth(ctx context.Context) (roptions.HealthReport, error)
+	// Drain stops the service from creating new processes and waits for
+	// its running processes to finish, as configured by the options. It
+	// returns once the drain is complete. The service continues to serve
+	// other requests until it is stopped.
+	Drain(ctx context.Context, opts roptions.Drain) (roptions.DrainReport, error)
+	// Snapshot returns the current state of the service's manager, which
+	// can be imported into another manager with ImportSnapshot.
+	Snapshot(ctx context.Context) (roptions.Snapshot, error)
+	// AddSchedule adds a schedule on which the service creates processes.
+	AddSchedule(ctx context.Context, opts options.Schedule) error
+	// RemoveSchedule stops the schedule with the given ID. The process from
+	// its most recent run, if any, is left running.
+	RemoveSchedule(ctx context.Context, id string) error
+	// ListSchedules returns the state of the service's schedules, including
+	// the recent runs of each.
+	ListSchedules(ctx context.Context) ([]jasper.ScheduleInfo, error)
+
+	// ReadFile opens a file on the remote host for reading. The contents are
+	// streamed as they are read, and the caller must close the reader.
+	ReadFile(ctx context.Context, opts roptions.ReadFile) (io.ReadCloser, error)
+	StatFile(ctx context.Context, path string) (roptions.FileInfo, error)
+	ListDirectory(ctx context.Context, opts roptions.ListDirectory) ([]roptions.FileInfo, error)
+	GlobFiles(ctx context.Context, pattern string) ([]string, error)
+	RemoveFile(ctx context.Context, opts roptions.RemoveFile) error
+	RenameFile(ctx context.Context, opts roptions.RenameFile) error
+	MakeDirectory(ctx context.Context, opts roptions.MakeDirectory) error
+	ChecksumFile(ctx context.Context, opts roptions.FileChecksum) (string, error)
+	// CreateArchive creates an archive of a directory on the remote host.
+	// The archive is streamed as it is created, and the caller must close
+	// the reader.
+	CreateArchive(ctx context.Context, opts roptions.CreateArchive) (io.ReadCloser, error)
 
 	CreateScripting(context.Context, options.ScriptingHarness) (scripting.Harness, error)
 	GetScripting(context.Context, string) (scripting.Harness, error)
@@ -0,0 +1,267 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"slices"
+	"sync"
+
+	"github.com/tychoish/grip"
+	"github.com/tychoish/grip/message"
+	"github.com/tychoish/jasper"
+	"github.com/tychoish/jasper/executor"
+	"github.com/tychoish/jasper/options"
+	roptions "github.com/tychoish/jasper/x/remote/options"
+)
+
+// ServeExec runs the server side of an interactive session. The first
+// message received from the client must contain the session options, which
+// are used to create the process with the create function. The process is
+// bound to the context, so it is killed if the session ends before the
+// process exits. ServeExec returns once the final message of the session has
+// been sent, or with an error if the session could not be started.
+//
+// The recv function returns the next message from the client, or io.EOF
+// once the client has finished sending messages, and the send function sends
+// a message to the client. Calls to send are not concurrent.
+func ServeExec(ctx context.Context, create func(context.Context, *options.Create) (jasper.Process, error), recv func() (roptions.ExecInput, error), send func(roptions.ExecOutput) error) error {
+	first, err := recv()
+	if err != nil {
+		return fmt.Errorf("problem receiving session options: %w", err)
+	}
+	if first.Options == nil {
+		return errors.New("first message of the session must contain the session options")
+	}
+	opts := first.Options
+	if err = opts.Validate(); err != nil {
+		return fmt.Errorf("invalid session options: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	session := &execSession{send: send}
+	if opts.TTY {
+		err = session.startTTY(ctx, create, opts)
+	} else {
+		err = session.start(ctx, create, opts)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = session.sendOutput(roptions.ExecOutput{ID: session.proc.ID()}); err != nil {
+		return fmt.Errorf("problem sending process ID: %w", err)
+	}
+
+	go session.receive(recv, cancel)
+
+	exitCode, waitErr := session.proc.Wait(ctx)
+	outputDone := make(chan struct{})
+	go func() {
+		session.output.Wait()
+		close(outputDone)
+	}()
+	select {
+	case <-outputDone:
+	case <-ctx.Done():
+	}
+	session.closeStdin()
+
+	out := roptions.ExecOutput{Exited: true, ExitCode: exitCode}
+	if waitErr != nil {
+		out.Error = waitErr.Error()
+	}
+	if err = session.sendOutput(out); err != nil {
+		return fmt.Errorf("problem sending exit status: %w", err)
+	}
+
+	return nil
+}
+
+type execSession struct {
+	proc jasper.Process
+
+	// stdin is the write end of the process's standard input, or the
+	// pseudo-terminal for TTY sessions.
+	stdin     *os.File
+	tty       *os.File
+	stdinOnce sync.Once
+
+	// output tracks the goroutines that send the process's output.
+	output sync.WaitGroup
+
+	sendMu sync.Mutex
+	send   func(roptions.ExecOutput) error
+}
+
+func (s *execSession) sendOutput(out roptions.ExecOutput) error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+	return s.send(out)
+}
+
+func (s *execSession) start(ctx context.Context, create func(context.Context, *options.Create) (jasper.Process, error), opts *roptions.Exec) error {
+	stdin, stdinWriter, err := os.Pipe()
+	if err != nil {
+		return fmt.Errorf("problem creating standard input pipe: %w", err)
+	}
+	defer stdin.Close()
+
+	createOpts := opts.Create
+	createOpts.StandardInput = stdin
+	createOpts.Output.Output = execOutputWriter{session: s, stderr: false}
+	createOpts.Output.Error = execOutputWriter{session: s, stderr: true}
+
+	s.proc, err = create(ctx, createOpts)
+	if err != nil {
+		stdinWriter.Close()
+		return fmt.Errorf("problem creating process: %w", err)
+	}
+	s.stdin = stdinWriter
+
+	return nil
+}
+
+func (s *execSession) startTTY(ctx context.Context, create func(context.Context, *options.Create) (jasper.Process, error), opts *roptions.Exec) error {
+	tty, pts, err := openPTY()
+	if err != nil {
+		return fmt.Errorf("problem opening pseudo-terminal: %w", err)
+	}
+	defer pts.Close()
+
+	if !opts.WindowSize.IsZero() {
+		if err = resizePTY(tty, opts.WindowSize); err != nil {
+			tty.Close()
+			return fmt.Errorf("problem setting window size: %w", err)
+		}
+	}
+
+	createOpts := opts.Create
+	createOpts.ResolveExecutor = func(ctx context.Context, args []string) (executor.Executor, error) {
+		cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+		cmd.Stdin = pts
+		cmd.Stdout = pts
+		cmd.Stderr = pts
+		cmd.SysProcAttr = ptySysProcAttr()
+		return ttyExecutor{Executor: executor.MakeLocal(cmd)}, nil
+	}
+
+	s.proc, err = create(ctx, createOpts)
+	if err != nil {
+		tty.Close()
+		return fmt.Errorf("problem creating process: %w", err)
+	}
+	s.stdin = tty
+	s.tty = tty
+
+	s.output.Add(1)
+	go func() {
+		defer s.output.Done()
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := tty.Read(buf)
+			if n > 0 {
+				if err := s.sendOutput(roptions.ExecOutput{Stdout: slices.Clone(buf[:n])}); err != nil {
+					return
+				}
+			}
+			// Reading from the pseudo-terminal fails once the process
+			// and all of its children have closed it.
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// receive handles the messages from the client until the client stops
+// sending messages. If the client finishes sending messages, the standard
+// input of the process is closed, but if the client disconnects, the
+// session is canceled.
+func (s *execSession) receive(recv func() (roptions.ExecInput, error), cancel context.CancelFunc) {
+	for {
+		in, err := recv()
+		if errors.Is(err, io.EOF) {
+			if s.tty == nil {
+				s.closeStdin()
+			}
+			return
+		} else if err != nil {
+			cancel()
+			return
+		}
+
+		switch {
+		case in.Resize != nil:
+			if s.tty == nil {
+				grip.Debug(message.Fields{
+					"message": "ignoring resize request for session without a TTY",
+					"id":      s.proc.ID(),
+				})
+				continue
+			}
+			grip.Warning(message.WrapError(resizePTY(s.tty, *in.Resize), message.Fields{
+				"message": "problem resizing pseudo-terminal",
+				"id":      s.proc.ID(),
+			}))
+		case len(in.Stdin) != 0:
+			if _, err := s.stdin.Write(in.Stdin); err != nil {
+				grip.Debug(message.WrapError(err, message.Fields{
+					"message": "problem writing to standard input",
+					"id":      s.proc.ID(),
+				}))
+			}
+		case in.CloseStdin:
+			if s.tty != nil {
+				// The pseudo-terminal also carries the process's output,
+				// so send an end-of-file character instead of closing it.
+				_, _ = s.tty.Write([]byte{0x04})
+				continue
+			}
+			s.closeStdin()
+		}
+	}
+}
+
+func (s *execSession) closeStdin() {
+	s.stdinOnce.Do(func() {
+		if s.stdin != nil {
+			_ = s.stdin.Close()
+		}
+	})
+}
+
+type execOutputWriter struct {
+	session *execSession
+	stderr  bool
+}
+
+func (w execOutputWriter) Write(p []byte) (int, error) {
+	out := roptions.ExecOutput{}
+	if w.stderr {
+		out.Stderr = slices.Clone(p)
+	} else {
+		out.Stdout = slices.Clone(p)
+	}
+	if err := w.session.sendOutput(out); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// ttyExecutor is a local executor whose standard streams are attached to a
+// pseudo-terminal, which the process options cannot override.
+type ttyExecutor struct {
+	executor.Executor
+}
+
+func (ttyExecutor) SetStdin(io.Reader)  {}
+func (ttyExecutor) SetStdout(io.Writer) {}
+func (ttyExecutor) SetStderr(io.Writer) {}
@@ -54,6 +54,34 @@ func (opts *CreateOptions) Export() (*options.Create, error) {
 		out.Output = exportedOutput
 	}
 
+	if opts.IdleTimeout != nil {
+		out.IdleTimeout = opts.IdleTimeout.Export()
+	}
+
+	if opts.Readiness != nil {
+		readiness, err := opts.Readiness.Export()
+		if err != nil {
+			return nil, fmt.Errorf("problem exporting readiness options: %w", err)
+		}
+		out.Readiness = readiness
+	}
+
+	if opts.Liveness != nil {
+		liveness, err := opts.Liveness.Export()
+		if err != nil {
+			return nil, fmt.Errorf("problem exporting liveness options: %w", err)
+		}
+		out.Liveness = liveness
+	}
+
+	for _, trigger := range opts.OutputTriggers {
+		exportedTrigger, err := trigger.Export()
+		if err != nil {
+			return nil, fmt.Errorf("problem exporting output trigger: %w", err)
+		}
+		out.OutputTriggers = append(out.OutputTriggers, *exportedTrigger)
+	}
+
 	for _, opt := range opts.OnSuccess {
 		exportedOpt, err := opt.Export()
 		if err != nil {
@@ -75,6 +103,13 @@ func (opts *CreateOptions) Export() (*options.Create, error) {
 		}
 		out.OnTimeout = append(out.OnTimeout, exportedOpt)
 	}
+	for _, opt := range opts.Pipeline {
+		exportedOpt, err := opt.Export()
+		if err != nil {
+			return nil, fmt.Errorf("problem exporting pipeline create options: %w", err)
+		}
+		out.Pipeline = append(out.Pipeline, exportedOpt)
+	}
 
 	return out, nil
 }
@@ -109,6 +144,34 @@ func ConvertCreateOptions(opts *options.Create) (*CreateOptions, error) {
 		stw.NewMap(co.Environment).Extend(irt.KVsplit(opts.Environment.IteratorFront()))
 	}
 
+	if opts.IdleTimeout != nil {
+		co.IdleTimeout = ConvertIdleTimeoutOptions(*opts.IdleTimeout)
+	}
+
+	if opts.Readiness != nil {
+		readiness, err := ConvertReadinessOptions(*opts.Readiness)
+		if err != nil {
+			return nil, fmt.Errorf("problem converting readiness options: %w", err)
+		}
+		co.Readiness = readiness
+	}
+
+	if opts.Liveness != nil {
+		liveness, err := ConvertLivenessOptions(*opts.Liveness)
+		if err != nil {
+			return nil, fmt.Errorf("problem converting liveness options: %w", err)
+		}
+		co.Liveness = liveness
+	}
+
+	for _, trigger := range opts.OutputTriggers {
+		convertedTrigger, err := ConvertOutputTrigger(trigger)
+		if err != nil {
+			return nil, fmt.Errorf("problem converting output trigger: %w", err)
+		}
+		co.OutputTriggers = append(co.OutputTriggers, convertedTrigger)
+	}
+
 	for _, opt := range opts.OnSuccess {
 		convertedOpts, err := ConvertCreateOptions(opt)
 		if err != nil {
@@ -130,10 +193,250 @@ func ConvertCreateOptions(opts *options.Create) (*CreateOptions, error) {
 		}
 		co.OnTimeout = append(co.OnTimeout, convertedOpts)
 	}
+	for _, opt := range opts.Pipeline {
+		convertedOpts, err := ConvertCreateOptions(opt)
+		if err != nil {
+			return nil, fmt.Errorf("problem converting pipeline create options: %w", err)
+		}
+		co.Pipeline = append(co.Pipeline, convertedOpts)
+	}
 
 	return co, nil
 }
 
+// Export takes a protobuf RPC IdleTimeoutOptions struct and returns the
+// analogous Jasper IdleTimeout struct.
+func (opts *IdleTimeoutOptions) Export() *options.IdleTimeout {
+	return &options.IdleTimeout{
+		Duration:    opts.Duration.AsDuration(),
+		StackDump:   opts.StackDump,
+		GracePeriod: opts.GracePeriod.AsDuration(),
+	}
+}
+
+// ConvertIdleTimeoutOptions takes a Jasper IdleTimeout struct and returns an
+// equivalent protobuf RPC *IdleTimeoutOptions struct.
+func ConvertIdleTimeoutOptions(opts options.IdleTimeout) *IdleTimeoutOptions {
+	return &IdleTimeoutOptions{
+		Duration:    durationpb.New(opts.Duration),
+		StackDump:   opts.StackDump,
+		GracePeriod: durationpb.New(opts.GracePeriod),
+	}
+}
+
+// Export takes a protobuf RPC ReadinessOptions struct and returns the
+// analogous Jasper Readiness struct.
+func (opts *ReadinessOptions) Export() (*options.Readiness, error) {
+	out := &options.Readiness{
+		TCP:           opts.Tcp,
+		HTTP:          opts.Http,
+		OutputPattern: opts.OutputPattern,
+		File:          opts.File,
+		Interval:      opts.Interval.AsDuration(),
+		Timeout:       opts.Timeout.AsDuration(),
+	}
+	if opts.Command != nil {
+		cmd, err := opts.Command.Export()
+		if err != nil {
+			return nil, fmt.Errorf("problem exporting command: %w", err)
+		}
+		out.Command = cmd
+	}
+	return out, nil
+}
+
+// ConvertReadinessOptions takes a Jasper Readiness struct and returns an
+// equivalent protobuf RPC *ReadinessOptions struct.
+func ConvertReadinessOptions(opts options.Readiness) (*ReadinessOptions, error) {
+	out := &ReadinessOptions{
+		Tcp:           opts.TCP,
+		Http:          opts.HTTP,
+		OutputPattern: opts.OutputPattern,
+		File:          opts.File,
+		Interval:      durationpb.New(opts.Interval),
+		Timeout:       durationpb.New(opts.Timeout),
+	}
+	if opts.Command != nil {
+		cmd, err := ConvertCreateOptions(opts.Command)
+		if err != nil {
+			return nil, fmt.Errorf("problem converting command: %w", err)
+		}
+		out.Command = cmd
+	}
+	return out, nil
+}
+
+// Export takes a protobuf RPC LivenessOptions struct and returns the
+// analogous Jasper Liveness struct.
+func (opts *LivenessOptions) Export() (*options.Liveness, error) {
+	out := &options.Liveness{
+		TCP:              opts.Tcp,
+		HTTP:             opts.Http,
+		InitialDelay:     opts.InitialDelay.AsDuration(),
+		Interval:         opts.Interval.AsDuration(),
+		Timeout:          opts.Timeout.AsDuration(),
+		FailureThreshold: int(opts.FailureThreshold),
+		GracePeriod:      opts.GracePeriod.AsDuration(),
+		MaxRestarts:      int(opts.MaxRestarts),
+	}
+	if opts.Command != nil {
+		cmd, err := opts.Command.Export()
+		if err != nil {
+			return nil, fmt.Errorf("problem exporting command: %w", err)
+		}
+		out.Command = cmd
+	}
+	return out, nil
+}
+
+// ConvertLivenessOptions takes a Jasper Liveness struct and returns an
+// equivalent protobuf RPC *LivenessOptions struct.
+func ConvertLivenessOptions(opts options.Liveness) (*LivenessOptions, error) {
+	out := &LivenessOptions{
+		Tcp:              opts.TCP,
+		Http:             opts.HTTP,
+		InitialDelay:     durationpb.New(opts.InitialDelay),
+		Interval:         durationpb.New(opts.Interval),
+		Timeout:          durationpb.New(opts.Timeout),
+		FailureThreshold: int64(opts.FailureThreshold),
+		GracePeriod:      durationpb.New(opts.GracePeriod),
+		MaxRestarts:      int64(opts.MaxRestarts),
+	}
+	if opts.Command != nil {
+		cmd, err := ConvertCreateOptions(opts.Command)
+		if err != nil {
+			return nil, fmt.Errorf("problem converting command: %w", err)
+		}
+		out.Command = cmd
+	}
+	return out, nil
+}
+
+// Export takes a protobuf RPC LivenessInfo struct and returns the analogous
+// Jasper LivenessInfo struct.
+func (info *LivenessInfo) Export() *jasper.LivenessInfo {
+	out := &jasper.LivenessInfo{
+		ConsecutiveFailures: int(info.ConsecutiveFailures),
+		Restarts:            int(info.Restarts),
+		RestartedFrom:       info.RestartedFrom,
+		RestartedAs:         info.RestartedAs,
+	}
+	for _, check := range info.Checks {
+		out.Checks = append(out.Checks, jasper.LivenessCheck{
+			Time:   check.Time.AsTime(),
+			Passed: check.Passed,
+			Error:  check.Error,
+		})
+	}
+	return out
+}
+
+// ConvertLivenessInfo takes a Jasper LivenessInfo struct and returns an
+// equivalent protobuf RPC *LivenessInfo struct.
+func ConvertLivenessInfo(info jasper.LivenessInfo) *LivenessInfo {
+	out := &LivenessInfo{
+		ConsecutiveFailures: int64(info.ConsecutiveFailures),
+		Restarts:            int64(info.Restarts),
+		RestartedFrom:       info.RestartedFrom,
+		RestartedAs:         info.RestartedAs,
+	}
+	for _, check := range info.Checks {
+		out.Checks = append(out.Checks, &LivenessCheck{
+			Time:   timestamppb.New(check.Time),
+			Passed: check.Passed,
+			Error:  check.Error,
+		})
+	}
+	return out
+}
+
+// Export takes a protobuf RPC FollowUpInfo struct and returns the analogous
+// Jasper FollowUpInfo struct.
+func (info *FollowUpInfo) Export() jasper.FollowUpInfo {
+	return jasper.FollowUpInfo{
+		Trigger:    jasper.FollowUpTrigger(info.Trigger),
+		ID:         info.Id,
+		Error:      info.Error,
+		Complete:   info.Complete,
+		Successful: info.Successful,
+		ExitCode:   int(info.ExitCode),
+	}
+}
+
+// ConvertFollowUpInfo takes a Jasper FollowUpInfo struct and returns an
+// equivalent protobuf RPC *FollowUpInfo struct.
+func ConvertFollowUpInfo(info jasper.FollowUpInfo) *FollowUpInfo {
+	return &FollowUpInfo{
+		Trigger:    string(info.Trigger),
+		Id:         info.ID,
+		Error:      info.Error,
+		Complete:   info.Complete,
+		Successful: info.Successful,
+		ExitCode:   int32(info.ExitCode),
+	}
+}
+
+// HasPendingFollowUps returns true if any of the follow-up processes of the
+// process have not exited yet, in which case the info may still change after
+// the process itself exits.
+func (info *ProcessInfo) HasPendingFollowUps() bool {
+	for _, followUp := range info.FollowUps {
+		if followUp.Id != "" && !followUp.Complete {
+			return true
+		}
+	}
+	return false
+}
+
+// Export takes a protobuf RPC OutputTrigger struct and returns the analogous
+// Jasper OutputTrigger struct.
+func (t *OutputTrigger) Export() (*options.OutputTrigger, error) {
+	out := &options.OutputTrigger{
+		Pattern:   t.Pattern,
+		Stream:    t.Stream,
+		Once:      t.Once,
+		Fail:      t.Fail,
+		Tag:       t.Tag,
+		TriggerID: t.TriggerId,
+		Signal:    t.Signal.Export(),
+	}
+	if t.Create != nil {
+		opts, err := t.Create.Export()
+		if err != nil {
+			return nil, fmt.Errorf("problem exporting create options: %w", err)
+		}
+		out.Create = opts
+	}
+	return out, nil
+}
+
+// ConvertOutputTrigger takes a Jasper OutputTrigger struct and returns an
+// equivalent protobuf RPC *OutputTrigger struct. As with ConvertSignal,
+// signals that the RPC interface does not support cause an error.
+func ConvertOutputTrigger(t options.OutputTrigger) (*OutputTrigger, error) {
+	sig, err := ConvertSignal(t.Signal)
+	if err != nil {
+		return nil, fmt.Errorf("problem converting signal: %w", err)
+	}
+	out := &OutputTrigger{
+		Pattern:   t.Pattern,
+		Stream:    t.Stream,
+		Once:      t.Once,
+		Fail:      t.Fail,
+		Tag:       t.Tag,
+		TriggerId: t.TriggerID,
+		Signal:    sig,
+	}
+	if t.Create != nil {
+		opts, err := ConvertCreateOptions(t.Create)
+		if err != nil {
+			return nil, fmt.Errorf("problem converting create options: %w", err)
+		}
+		out.Create = opts
+	}
+	return out, nil
+}
+
 // Export takes a protobuf RPC ProcessInfo struct and returns the analogous
 // Jasper ProcessInfo struct.
 func (info *ProcessInfo) Export() (jasper.ProcessInfo, error) {
@@ -150,6 +453,22 @@ func (info *ProcessInfo) Export() (jasper.ProcessInfo, error) {
 	if err != nil {
 		return jasper.ProcessInfo{}, fmt.Errorf("problem exporting create options: %w", err)
 	}
+	var liveness *jasper.LivenessInfo
+	if info.Liveness != nil {
+		liveness = info.Liveness.Export()
+	}
+	var followUps []jasper.FollowUpInfo
+	for _, followUp := range info.FollowUps {
+		followUps = append(followUps, followUp.Export())
+	}
+	var stages []jasper.ProcessInfo
+	for _, stage := range info.Stages {
+		exportedStage, err := stage.Export()
+		if err != nil {
+			return jasper.ProcessInfo{}, fmt.Errorf("problem exporting pipeline stage info: %w", err)
+		}
+		stages = append(stages, exportedStage)
+	}
 	return jasper.ProcessInfo{
 		ID:         info.Id,
 		PID:        int(info.Pid),
@@ -161,6 +480,12 @@ func (info *ProcessInfo) Export() (jasper.ProcessInfo, error) {
 		Options:    *opts,
 		StartAt:    startAt,
 		EndAt:      endAt,
+
+		TimeoutReason: jasper.TimeoutReason(info.TimeoutReason),
+		Ready:         info.Ready,
+		Liveness:      liveness,
+		FollowUps:     followUps,
+		Stages:        stages,
 	}, nil
 }
 
@@ -172,6 +497,22 @@ func ConvertProcessInfo(info jasper.ProcessInfo) (*ProcessInfo, error) {
 	if err != nil {
 		return nil, fmt.Errorf("problem converting create options: %w", err)
 	}
+	var liveness *LivenessInfo
+	if info.Liveness != nil {
+		liveness = ConvertLivenessInfo(*info.Liveness)
+	}
+	var followUps []*FollowUpInfo
+	for _, followUp := range info.FollowUps {
+		followUps = append(followUps, ConvertFollowUpInfo(followUp))
+	}
+	var stages []*ProcessInfo
+	for _, stage := range info.Stages {
+		convertedStage, err := ConvertProcessInfo(stage)
+		if err != nil {
+			return nil, fmt.Errorf("problem converting pipeline stage info: %w", err)
+		}
+		stages = append(stages, convertedStage)
+	}
 	return &ProcessInfo{
 		Id:         info.ID,
 		Pid:        int64(info.PID),
@@ -183,6 +524,12 @@ func ConvertProcessInfo(info jasper.ProcessInfo) (*ProcessInfo, error) {
 		StartAt:    timestamppb.New(info.StartAt),
 		EndAt:      timestamppb.New(info.EndAt),
 		Options:    opts,
+
+		TimeoutReason: string(info.TimeoutReason),
+		Ready:         info.Ready,
+		Liveness:      liveness,
+		FollowUps:     followUps,
+		Stages:        stages,
 	}, nil
 }
 
@@ -201,25 +548,35 @@ func (s Signals) Export() syscall.Signal {
 	case Signals_ABRT:
 		return syscall.SIGABRT
 	default:
-		return syscall.Signal(0)
+		sig, _ := exportUserSignal(s)
+		return sig
 	}
 }
 
 // ConvertSignal takes a syscall.Signal and returns an
 // equivalent protobuf RPC Signals struct. ConvertSignals is the
-// inverse of (Signals) Export().
-func ConvertSignal(s syscall.Signal) Signals {
+// inverse of (Signals) Export(). The zero signal converts to
+// Signals_UNKNOWN, and other signals that the RPC interface does not support
+// cause an error.
+func ConvertSignal(s syscall.Signal) (Signals, error) {
 	switch s {
+	case syscall.Signal(0):
+		return Signals_UNKNOWN, nil
 	case syscall.SIGHUP:
-		return Signals_HANGUP
+		return Signals_HANGUP, nil
 	case syscall.SIGINT:
-		return Signals_INIT
+		return Signals_INIT, nil
 	case syscall.SIGTERM:
-		return Signals_TERMINATE
+		return Signals_TERMINATE, nil
 	case syscall.SIGKILL:
-		return Signals_KILL
+		return Signals_KILL, nil
+	case syscall.SIGABRT:
+		return Signals_ABRT, nil
 	default:
-		return Signals_UNKNOWN
+		if sig, ok := convertUserSignal(s); ok {
+			return sig, nil
+		}
+		return Signals_UNKNOWN, fmt.Errorf("signal '%s' is not supported", s)
 	}
 }
 
@@ -508,6 +865,13 @@ func (opts *DownloadInfo) Export() roptions.Download {
 		Path:        opts.Path,
 		URL:         opts.Url,
 		ArchiveOpts: opts.ArchiveOpts.Export(),
+		Checksum:    opts.Checksum.Export(),
+		Headers:     opts.Headers,
+		Username:    opts.Username,
+		Password:    opts.Password,
+		Resume:      opts.Resume,
+		Retry:       opts.Retry.Export(),
+		UseCache:    opts.UseCache,
 	}
 }
 
@@ -519,6 +883,305 @@ func ConvertDownloadOptions(opts roptions.Download) *DownloadInfo {
 		Path:        opts.Path,
 		Url:         opts.URL,
 		ArchiveOpts: ConvertArchiveOptions(opts.ArchiveOpts),
+		Checksum:    ConvertDownloadChecksum(opts.Checksum),
+		Headers:     opts.Headers,
+		Username:    opts.Username,
+		Password:    opts.Password,
+		Resume:      opts.Resume,
+		Retry:       ConvertDownloadRetry(opts.Retry),
+		UseCache:    opts.UseCache,
+	}
+}
+
+// Export takes a protobuf RPC DownloadCacheStats struct and returns the
+// analogous options.DownloadCacheStats struct.
+func (s *DownloadCacheStats) Export() roptions.DownloadCacheStats {
+	return roptions.DownloadCacheStats{
+		Enabled:   s.Enabled,
+		Directory: s.Directory,
+		Entries:   int(s.Entries),
+		Size:      s.Size,
+		MaxSize:   s.MaxSize,
+		Hits:      s.Hits,
+		Misses:    s.Misses,
+		Evictions: s.Evictions,
+	}
+}
+
+// ConvertDownloadCacheStats takes an options.DownloadCacheStats struct and
+// returns an equivalent protobuf RPC DownloadCacheStats struct.
+func ConvertDownloadCacheStats(s roptions.DownloadCacheStats) *DownloadCacheStats {
+	return &DownloadCacheStats{
+		Enabled:   s.Enabled,
+		Directory: s.Directory,
+		Entries:   int64(s.Entries),
+		Size:      s.Size,
+		MaxSize:   s.MaxSize,
+		Hits:      s.Hits,
+		Misses:    s.Misses,
+		Evictions: s.Evictions,
+	}
+}
+
+// Export takes a protobuf RPC DrainOptions struct and returns the analogous
+// options.Drain struct.
+func (opts *DrainOptions) Export() roptions.Drain {
+	return roptions.Drain{
+		Timeout:     opts.Timeout.AsDuration(),
+		Terminate:   opts.Terminate,
+		GracePeriod: opts.GracePeriod.AsDuration(),
+	}
+}
+
+// ConvertDrainOptions takes an options.Drain struct and returns an equivalent
+// protobuf RPC DrainOptions struct.
+func ConvertDrainOptions(opts roptions.Drain) *DrainOptions {
+	return &DrainOptions{
+		Timeout:     durationpb.New(opts.Timeout),
+		Terminate:   opts.Terminate,
+		GracePeriod: durationpb.New(opts.GracePeriod),
+	}
+}
+
+// Export takes a protobuf RPC DrainReport struct and returns the analogous
+// options.DrainReport struct.
+func (r *DrainReport) Export() roptions.DrainReport {
+	return roptions.DrainReport{
+		Completed:  r.Completed,
+		Terminated: r.Terminated,
+		Killed:     r.Killed,
+		Running:    r.Running,
+	}
+}
+
+// ConvertDrainReport takes an options.DrainReport struct and returns an
+// equivalent protobuf RPC DrainReport struct.
+func ConvertDrainReport(r roptions.DrainReport) *DrainReport {
+	return &DrainReport{
+		Completed:  r.Completed,
+		Terminated: r.Terminated,
+		Killed:     r.Killed,
+		Running:    r.Running,
+	}
+}
+
+// Export takes a protobuf RPC Snapshot struct and returns the analogous
+// options.Snapshot struct.
+func (s *Snapshot) Export() (roptions.Snapshot, error) {
+	out := roptions.Snapshot{
+		Version:             int(s.Version),
+		ManagerID:           s.ManagerId,
+		Hostname:            s.Hostname,
+		CreatedAt:           s.CreatedAt.AsTime(),
+		Processes:           make([]roptions.ProcessSnapshot, 0, len(s.Processes)),
+		SignalTriggerIDs:    make([]jasper.SignalTriggerID, 0, len(s.SignalTriggerIds)),
+		LoggingCache:        make([]options.CachedLogger, 0, len(s.LoggingCache)),
+		ScriptingHarnessIDs: s.ScriptingHarnessIds,
+	}
+	if out.ScriptingHarnessIDs == nil {
+		out.ScriptingHarnessIDs = []string{}
+	}
+
+	for _, ps := range s.Processes {
+		info, err := ps.Info.Export()
+		if err != nil {
+			return roptions.Snapshot{}, fmt.Errorf("problem exporting process info: %w", err)
+		}
+		out.Processes = append(out.Processes, roptions.ProcessSnapshot{Info: info, Tags: ps.Tags})
+	}
+	for _, id := range s.SignalTriggerIds {
+		out.SignalTriggerIDs = append(out.SignalTriggerIDs, jasper.SignalTriggerID(id))
+	}
+	for _, l := range s.LoggingCache {
+		out.LoggingCache = append(out.LoggingCache, options.CachedLogger{
+			ID:       l.Id,
+			Manager:  l.Manager,
+			Accessed: l.Accessed.AsTime(),
+		})
+	}
+
+	return out, nil
+}
+
+// ConvertSnapshot takes an options.Snapshot struct and returns an equivalent
+// protobuf RPC Snapshot struct. ConvertSnapshot is the inverse of
+// (*Snapshot) Export().
+func ConvertSnapshot(s roptions.Snapshot) (*Snapshot, error) {
+	out := &Snapshot{
+		Version:             int32(s.Version),
+		ManagerId:           s.ManagerID,
+		Hostname:            s.Hostname,
+		CreatedAt:           timestamppb.New(s.CreatedAt),
+		Processes:           make([]*ProcessSnapshot, 0, len(s.Processes)),
+		SignalTriggerIds:    make([]string, 0, len(s.SignalTriggerIDs)),
+		LoggingCache:        make([]*LoggingCacheInstance, 0, len(s.LoggingCache)),
+		ScriptingHarnessIds: s.ScriptingHarnessIDs,
+	}
+
+	for _, ps := range s.Processes {
+		info, err := ConvertProcessInfo(ps.Info)
+		if err != nil {
+			return nil, fmt.Errorf("problem converting process info: %w", err)
+		}
+		out.Processes = append(out.Processes, &ProcessSnapshot{Info: info, Tags: ps.Tags})
+	}
+	for _, id := range s.SignalTriggerIDs {
+		out.SignalTriggerIds = append(out.SignalTriggerIds, string(id))
+	}
+	for i := range s.LoggingCache {
+		out.LoggingCache = append(out.LoggingCache, ConvertCachedLogger(&s.LoggingCache[i]))
+	}
+
+	return out, nil
+}
+
+// Export takes a protobuf RPC ScheduleOptions struct and returns the
+// analogous options.Schedule struct.
+func (opts *ScheduleOptions) Export() (options.Schedule, error) {
+	out := options.Schedule{
+		ID:          opts.Id,
+		Cron:        opts.Cron,
+		Interval:    opts.Interval.AsDuration(),
+		Jitter:      opts.Jitter.AsDuration(),
+		Overlap:     options.ScheduleOverlap(opts.Overlap),
+		HistorySize: int(opts.HistorySize),
+	}
+	if opts.Create != nil {
+		create, err := opts.Create.Export()
+		if err != nil {
+			return options.Schedule{}, fmt.Errorf("problem exporting create options: %w", err)
+		}
+		out.Create = create
+	}
+
+	return out, nil
+}
+
+// ConvertScheduleOptions takes an options.Schedule struct and returns an
+// equivalent protobuf RPC ScheduleOptions struct.
+func ConvertScheduleOptions(opts options.Schedule) (*ScheduleOptions, error) {
+	out := &ScheduleOptions{
+		Id:          opts.ID,
+		Cron:        opts.Cron,
+		Interval:    durationpb.New(opts.Interval),
+		Jitter:      durationpb.New(opts.Jitter),
+		Overlap:     string(opts.Overlap),
+		HistorySize: int64(opts.HistorySize),
+	}
+	if opts.Create != nil {
+		create, err := ConvertCreateOptions(opts.Create)
+		if err != nil {
+			return nil, fmt.Errorf("problem converting create options: %w", err)
+		}
+		out.Create = create
+	}
+
+	return out, nil
+}
+
+// Export takes a protobuf RPC ScheduleInfo struct and returns the analogous
+// jasper.ScheduleInfo struct.
+func (info *ScheduleInfo) Export() (jasper.ScheduleInfo, error) {
+	schedule, err := info.Schedule.Export()
+	if err != nil {
+		return jasper.ScheduleInfo{}, err
+	}
+
+	out := jasper.ScheduleInfo{
+		Schedule:         schedule,
+		NextRun:          info.NextRun.AsTime(),
+		RunningProcessID: info.RunningProcessId,
+		Queued:           int(info.Queued),
+		History:          make([]jasper.ScheduleRun, 0, len(info.History)),
+	}
+	for _, run := range info.History {
+		out.History = append(out.History, jasper.ScheduleRun{
+			ScheduledAt: run.ScheduledAt.AsTime(),
+			StartedAt:   run.StartedAt.AsTime(),
+			ProcessID:   run.ProcessId,
+			Skipped:     run.Skipped,
+			Complete:    run.Complete,
+			Successful:  run.Successful,
+			ExitCode:    int(run.ExitCode),
+			Error:       run.Error,
+		})
+	}
+
+	return out, nil
+}
+
+// ConvertScheduleInfo takes a jasper.ScheduleInfo struct and returns an
+// equivalent protobuf RPC ScheduleInfo struct.
+func ConvertScheduleInfo(info jasper.ScheduleInfo) (*ScheduleInfo, error) {
+	schedule, err := ConvertScheduleOptions(info.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &ScheduleInfo{
+		Schedule:         schedule,
+		NextRun:          timestamppb.New(info.NextRun),
+		RunningProcessId: info.RunningProcessID,
+		Queued:           int64(info.Queued),
+		History:          make([]*ScheduleRun, 0, len(info.History)),
+	}
+	for _, run := range info.History {
+		out.History = append(out.History, &ScheduleRun{
+			ScheduledAt: timestamppb.New(run.ScheduledAt),
+			StartedAt:   timestamppb.New(run.StartedAt),
+			ProcessId:   run.ProcessID,
+			Skipped:     run.Skipped,
+			Complete:    run.Complete,
+			Successful:  run.Successful,
+			ExitCode:    int64(run.ExitCode),
+			Error:       run.Error,
+		})
+	}
+
+	return out, nil
+}
+
+// Export takes a protobuf RPC DownloadChecksum struct and returns the
+// analogous options.DownloadChecksum struct.
+func (c *DownloadChecksum) Export() roptions.DownloadChecksum {
+	if c == nil {
+		return roptions.DownloadChecksum{}
+	}
+	return roptions.DownloadChecksum{
+		Algorithm: roptions.ChecksumAlgorithm(c.Algorithm),
+		Digest:    c.Digest,
+	}
+}
+
+// ConvertDownloadChecksum takes an options.DownloadChecksum struct and returns
+// an equivalent protobuf RPC DownloadChecksum struct.
+func ConvertDownloadChecksum(c roptions.DownloadChecksum) *DownloadChecksum {
+	return &DownloadChecksum{
+		Algorithm: string(c.Algorithm),
+		Digest:    c.Digest,
+	}
+}
+
+// Export takes a protobuf RPC DownloadRetry struct and returns the analogous
+// options.DownloadRetry struct.
+func (r *DownloadRetry) Export() roptions.DownloadRetry {
+	if r == nil {
+		return roptions.DownloadRetry{}
+	}
+	return roptions.DownloadRetry{
+		MaxAttempts: int(r.MaxAttempts),
+		Backoff:     r.Backoff.AsDuration(),
+		MaxBackoff:  r.MaxBackoff.AsDuration(),
+	}
+}
+
+// ConvertDownloadRetry takes an options.DownloadRetry struct and returns an
+// equivalent protobuf RPC DownloadRetry struct.
+func ConvertDownloadRetry(r roptions.DownloadRetry) *DownloadRetry {
+	return &DownloadRetry{
+		MaxAttempts: int64(r.MaxAttempts),
+		Backoff:     durationpb.New(r.Backoff),
+		MaxBackoff:  durationpb.New(r.MaxBackoff),
 	}
 }
 
@@ -545,6 +1208,197 @@ func ConvertWriteFileOptions(opts options.WriteFile) *WriteFileInfo {
 	}
 }
 
+// Export takes a protobuf RPC FileInfo struct and returns the analogous
+// FileInfo struct.
+func (info *FileInfo) Export() roptions.FileInfo {
+	return roptions.FileInfo{
+		Path:    info.Path,
+		Name:    info.Name,
+		Size:    info.Size,
+		Mode:    os.FileMode(info.Mode),
+		ModTime: info.ModTime.AsTime(),
+		IsDir:   info.IsDir,
+	}
+}
+
+// ConvertFileInfo takes a FileInfo struct and returns an equivalent protobuf
+// RPC FileInfo struct. ConvertFileInfo is the inverse of (*FileInfo)
+// Export().
+func ConvertFileInfo(info roptions.FileInfo) *FileInfo {
+	return &FileInfo{
+		Path:    info.Path,
+		Name:    info.Name,
+		Size:    info.Size,
+		Mode:    uint32(info.Mode),
+		ModTime: timestamppb.New(info.ModTime),
+		IsDir:   info.IsDir,
+	}
+}
+
+// Export takes a protobuf RPC FileInfoList struct and returns the analogous
+// slice of FileInfo structs.
+func (l *FileInfoList) Export() []roptions.FileInfo {
+	out := make([]roptions.FileInfo, 0, len(l.Files))
+	for _, info := range l.Files {
+		out = append(out, info.Export())
+	}
+	return out
+}
+
+// ConvertFileInfoList takes a slice of FileInfo structs and returns an
+// equivalent protobuf RPC FileInfoList struct. ConvertFileInfoList is the
+// inverse of (*FileInfoList) Export().
+func ConvertFileInfoList(infos []roptions.FileInfo) *FileInfoList {
+	out := &FileInfoList{Files: make([]*FileInfo, 0, len(infos))}
+	for _, info := range infos {
+		out.Files = append(out.Files, ConvertFileInfo(info))
+	}
+	return out
+}
+
+// Export takes a protobuf RPC CreateArchiveInfo struct and returns the
+// analogous CreateArchive struct.
+func (opts *CreateArchiveInfo) Export() roptions.CreateArchive {
+	return roptions.CreateArchive{
+		Path:    opts.Path,
+		Format:  opts.Format.Export(),
+		Include: opts.Include,
+		Exclude: opts.Exclude,
+	}
+}
+
+// ConvertCreateArchiveOptions takes a CreateArchive struct and returns an
+// equivalent protobuf RPC CreateArchiveInfo struct.
+// ConvertCreateArchiveOptions is the inverse of (*CreateArchiveInfo) Export().
+func ConvertCreateArchiveOptions(opts roptions.CreateArchive) *CreateArchiveInfo {
+	return &CreateArchiveInfo{
+		Path:    opts.Path,
+		Format:  ConvertArchiveFormat(opts.Format),
+		Include: opts.Include,
+		Exclude: opts.Exclude,
+	}
+}
+
+// Export takes a protobuf RPC ReadFileInfo struct and returns the analogous
+// ReadFile struct.
+func (opts *ReadFileInfo) Export() roptions.ReadFile {
+	return roptions.ReadFile{
+		Path:   opts.Path,
+		Offset: opts.Offset,
+		Length: opts.Length,
+	}
+}
+
+// ConvertReadFileOptions takes a ReadFile struct and returns an equivalent
+// protobuf RPC ReadFileInfo struct. ConvertReadFileOptions is the inverse of
+// (*ReadFileInfo) Export().
+func ConvertReadFileOptions(opts roptions.ReadFile) *ReadFileInfo {
+	return &ReadFileInfo{
+		Path:   opts.Path,
+		Offset: opts.Offset,
+		Length: opts.Length,
+	}
+}
+
+// Export takes a protobuf RPC ListDirectoryInfo struct and returns the
+// analogous ListDirectory struct.
+func (opts *ListDirectoryInfo) Export() roptions.ListDirectory {
+	return roptions.ListDirectory{
+		Path:      opts.Path,
+		Recursive: opts.Recursive,
+	}
+}
+
+// ConvertListDirectoryOptions takes a ListDirectory struct and returns an
+// equivalent protobuf RPC ListDirectoryInfo struct.
+// ConvertListDirectoryOptions is the inverse of (*ListDirectoryInfo)
+// Export().
+func ConvertListDirectoryOptions(opts roptions.ListDirectory) *ListDirectoryInfo {
+	return &ListDirectoryInfo{
+		Path:      opts.Path,
+		Recursive: opts.Recursive,
+	}
+}
+
+// Export takes a protobuf RPC RemoveFileInfo struct and returns the analogous
+// RemoveFile struct.
+func (opts *RemoveFileInfo) Export() roptions.RemoveFile {
+	return roptions.RemoveFile{
+		Path:      opts.Path,
+		Recursive: opts.Recursive,
+	}
+}
+
+// ConvertRemoveFileOptions takes a RemoveFile struct and returns an
+// equivalent protobuf RPC RemoveFileInfo struct. ConvertRemoveFileOptions is
+// the inverse of (*RemoveFileInfo) Export().
+func ConvertRemoveFileOptions(opts roptions.RemoveFile) *RemoveFileInfo {
+	return &RemoveFileInfo{
+		Path:      opts.Path,
+		Recursive: opts.Recursive,
+	}
+}
+
+// Export takes a protobuf RPC RenameFileInfo struct and returns the analogous
+// RenameFile struct.
+func (opts *RenameFileInfo) Export() roptions.RenameFile {
+	return roptions.RenameFile{
+		OldPath: opts.OldPath,
+		NewPath: opts.NewPath,
+	}
+}
+
+// ConvertRenameFileOptions takes a RenameFile struct and returns an
+// equivalent protobuf RPC RenameFileInfo struct. ConvertRenameFileOptions is
+// the inverse of (*RenameFileInfo) Export().
+func ConvertRenameFileOptions(opts roptions.RenameFile) *RenameFileInfo {
+	return &RenameFileInfo{
+		OldPath: opts.OldPath,
+		NewPath: opts.NewPath,
+	}
+}
+
+// Export takes a protobuf RPC MakeDirectoryInfo struct and returns the
+// analogous MakeDirectory struct.
+func (opts *MakeDirectoryInfo) Export() roptions.MakeDirectory {
+	return roptions.MakeDirectory{
+		Path:    opts.Path,
+		Perm:    os.FileMode(opts.Perm),
+		Parents: opts.Parents,
+	}
+}
+
+// ConvertMakeDirectoryOptions takes a MakeDirectory struct and returns an
+// equivalent protobuf RPC MakeDirectoryInfo struct.
+// ConvertMakeDirectoryOptions is the inverse of (*MakeDirectoryInfo)
+// Export().
+func ConvertMakeDirectoryOptions(opts roptions.MakeDirectory) *MakeDirectoryInfo {
+	return &MakeDirectoryInfo{
+		Path:    opts.Path,
+		Perm:    uint32(opts.Perm),
+		Parents: opts.Parents,
+	}
+}
+
+// Export takes a protobuf RPC FileChecksumInfo struct and returns the
+// analogous FileChecksum struct.
+func (opts *FileChecksumInfo) Export() roptions.FileChecksum {
+	return roptions.FileChecksum{
+		Path:      opts.Path,
+		Algorithm: roptions.ChecksumAlgorithm(opts.Algorithm),
+	}
+}
+
+// ConvertFileChecksumOptions takes a FileChecksum struct and returns an
+// equivalent protobuf RPC FileChecksumInfo struct.
+// ConvertFileChecksumOptions is the inverse of (*FileChecksumInfo) Export().
+func ConvertFileChecksumOptions(opts roptions.FileChecksum) *FileChecksumInfo {
+	return &FileChecksumInfo{
+		Path:      opts.Path,
+		Algorithm: string(opts.Algorithm),
+	}
+}
+
 // Export takes a protobuf RPC ArchiveFormat struct and returns the analogous
 // Jasper ArchiveFormat struct.
 func (format ArchiveFormat) Export() roptions.ArchiveFormat {
@@ -555,6 +1409,14 @@ func (format ArchiveFormat) Export() roptions.ArchiveFormat {
 		return roptions.ArchiveTarGz
 	case ArchiveFormat_ARCHIVEZIP:
 		return roptions.ArchiveZip
+	case ArchiveFormat_ARCHIVETAR:
+		return roptions.ArchiveTar
+	case ArchiveFormat_ARCHIVETARXZ:
+		return roptions.ArchiveTarXz
+	case ArchiveFormat_ARCHIVETARZST:
+		return roptions.ArchiveTarZst
+	case ArchiveFormat_ARCHIVETARBZ2:
+		return roptions.ArchiveTarBz2
 	default:
 		return roptions.ArchiveFormat("")
 	}
@@ -571,6 +1433,14 @@ func ConvertArchiveFormat(format roptions.ArchiveFormat) ArchiveFormat {
 		return ArchiveFormat_ARCHIVETARGZ
 	case roptions.ArchiveZip:
 		return ArchiveFormat_ARCHIVEZIP
+	case roptions.ArchiveTar:
+		return ArchiveFormat_ARCHIVETAR
+	case roptions.ArchiveTarXz:
+		return ArchiveFormat_ARCHIVETARXZ
+	case roptions.ArchiveTarZst:
+		return ArchiveFormat_ARCHIVETARZST
+	case roptions.ArchiveTarBz2:
+		return ArchiveFormat_ARCHIVETARBZ2
 	default:
 		return ArchiveFormat_ARCHIVEUNKNOWN
 	}
@@ -580,9 +1450,10 @@ func ConvertArchiveFormat(format roptions.ArchiveFormat) ArchiveFormat {
 // Jasper ArchiveOptions struct.
 func (opts ArchiveOptions) Export() roptions.Archive {
 	return roptions.Archive{
-		ShouldExtract: opts.ShouldExtract,
-		Format:        opts.Format.Export(),
-		TargetPath:    opts.TargetPath,
+		ShouldExtract:   opts.ShouldExtract,
+		Format:          opts.Format.Export(),
+		TargetPath:      opts.TargetPath,
+		StripComponents: int(opts.StripComponents),
 	}
 }
 
@@ -591,9 +1462,10 @@ func (opts ArchiveOptions) Export() roptions.Archive {
 // inverse of (ArchiveOptions) Export().
 func ConvertArchiveOptions(opts roptions.Archive) *ArchiveOptions {
 	return &ArchiveOptions{
-		ShouldExtract: opts.ShouldExtract,
-		Format:        ConvertArchiveFormat(opts.Format),
-		TargetPath:    opts.TargetPath,
+		ShouldExtract:   opts.ShouldExtract,
+		Format:          ConvertArchiveFormat(opts.Format),
+		TargetPath:      opts.TargetPath,
+		StripComponents: int64(opts.StripComponents),
 	}
 }
 
@@ -613,6 +1485,24 @@ func ConvertSignalTriggerParams(jasperProcessID string, signalTriggerID jasper.S
 	}
 }
 
+// Export takes a protobuf RPC ProcessTriggerParams struct and returns the
+// analogous Jasper process ID, ProcessTriggerID and trigger parameters.
+func (t *ProcessTriggerParams) Export() (string, jasper.ProcessTriggerID, map[string]string) {
+	return t.ProcessID.GetValue(), jasper.ProcessTriggerID(t.TriggerID), t.Params
+}
+
+// ConvertProcessTriggerParams takes a Jasper process ID, a ProcessTriggerID
+// and the trigger parameters and returns an equivalent protobuf RPC
+// ProcessTriggerParams struct. ConvertProcessTriggerParams is the inverse of
+// (*ProcessTriggerParams) Export().
+func ConvertProcessTriggerParams(jasperProcessID string, triggerID jasper.ProcessTriggerID, params map[string]string) *ProcessTriggerParams {
+	return &ProcessTriggerParams{
+		ProcessID: &JasperProcessID{Value: jasperProcessID},
+		TriggerID: string(triggerID),
+		Params:    params,
+	}
+}
+
 // Export takes a protobuf RPC SignalTriggerID and returns the analogous
 // Jasper SignalTriggerID.
 func (t SignalTriggerID) Export() jasper.SignalTriggerID {
@@ -655,6 +1545,146 @@ func ConvertLogStream(l jasper.LogStream) *LogStream {
 	}
 }
 
+// Export takes a protobuf RPC LogFollowRequest and returns the analogous
+// LogFollow options.
+func (r *LogFollowRequest) Export() roptions.LogFollow {
+	opts := roptions.LogFollow{Offset: r.Offset}
+	for _, stream := range r.Streams {
+		opts.Streams = append(opts.Streams, jasper.OutputStream(stream))
+	}
+	return opts
+}
+
+// ConvertLogFollow takes a process ID and LogFollow options and returns an
+// equivalent protobuf RPC LogFollowRequest. ConvertLogFollow is the inverse
+// of (*LogFollowRequest) Export().
+func ConvertLogFollow(id string, opts roptions.LogFollow) *LogFollowRequest {
+	req := &LogFollowRequest{
+		Id:     &JasperProcessID{Value: id},
+		Offset: opts.Offset,
+	}
+	for _, stream := range opts.Streams {
+		req.Streams = append(req.Streams, string(stream))
+	}
+	return req
+}
+
+// Export takes a protobuf RPC LogLine and returns the analogous Jasper
+// LogLine.
+func (l *LogLine) Export() jasper.LogLine {
+	return jasper.LogLine{
+		Offset: l.Offset,
+		Stream: jasper.OutputStream(l.Stream),
+		Line:   l.Line,
+		Time:   l.Time.AsTime(),
+	}
+}
+
+// ConvertLogLine takes a Jasper LogLine and returns an equivalent protobuf
+// RPC LogLine. ConvertLogLine is the inverse of (*LogLine) Export().
+func ConvertLogLine(l jasper.LogLine) *LogLine {
+	return &LogLine{
+		Offset: l.Offset,
+		Stream: string(l.Stream),
+		Line:   l.Line,
+		Time:   timestamppb.New(l.Time),
+	}
+}
+
+// Export takes a protobuf RPC ExecWindowSize and returns the analogous
+// WindowSize.
+func (s *ExecWindowSize) Export() roptions.WindowSize {
+	return roptions.WindowSize{Rows: uint16(s.GetRows()), Cols: uint16(s.GetCols())}
+}
+
+// ConvertExecWindowSize takes a WindowSize and returns an equivalent protobuf
+// RPC ExecWindowSize. ConvertExecWindowSize is the inverse of
+// (*ExecWindowSize) Export().
+func ConvertExecWindowSize(s roptions.WindowSize) *ExecWindowSize {
+	return &ExecWindowSize{Rows: uint32(s.Rows), Cols: uint32(s.Cols)}
+}
+
+// Export takes a protobuf RPC ExecInput and returns the analogous ExecInput
+// message.
+func (in *ExecInput) Export() (roptions.ExecInput, error) {
+	out := roptions.ExecInput{
+		Stdin:      in.Stdin,
+		CloseStdin: in.CloseStdin,
+	}
+	if in.Resize != nil {
+		size := in.Resize.Export()
+		out.Resize = &size
+	}
+	if in.Options != nil {
+		out.Options = &roptions.Exec{
+			TTY:        in.Options.Tty,
+			WindowSize: in.Options.WindowSize.Export(),
+		}
+		if in.Options.Create != nil {
+			opts, err := in.Options.Create.Export()
+			if err != nil {
+				return out, fmt.Errorf("problem exporting create options: %w", err)
+			}
+			out.Options.Create = opts
+		}
+	}
+	return out, nil
+}
+
+// ConvertExecInput takes an ExecInput message and returns an equivalent
+// protobuf RPC ExecInput. ConvertExecInput is the inverse of (*ExecInput)
+// Export().
+func ConvertExecInput(in roptions.ExecInput) (*ExecInput, error) {
+	out := &ExecInput{
+		Stdin:      in.Stdin,
+		CloseStdin: in.CloseStdin,
+	}
+	if in.Resize != nil {
+		out.Resize = ConvertExecWindowSize(*in.Resize)
+	}
+	if in.Options != nil {
+		out.Options = &ExecOptions{
+			Tty:        in.Options.TTY,
+			WindowSize: ConvertExecWindowSize(in.Options.WindowSize),
+		}
+		if in.Options.Create != nil {
+			opts, err := ConvertCreateOptions(in.Options.Create)
+			if err != nil {
+				return nil, fmt.Errorf("problem converting create options: %w", err)
+			}
+			out.Options.Create = opts
+		}
+	}
+	return out, nil
+}
+
+// Export takes a protobuf RPC ExecOutput and returns the analogous
+// ExecOutput message.
+func (out *ExecOutput) Export() roptions.ExecOutput {
+	return roptions.ExecOutput{
+		ID:       out.Id,
+		Stdout:   out.Stdout,
+		Stderr:   out.Stderr,
+		Exited:   out.Exited,
+		ExitCode: int(out.ExitCode),
+		Error:    out.Error,
+	}
+}
+
+// ConvertExecOutput takes an ExecOutput message and returns an equivalent
+// protobuf RPC ExecOutput. ConvertExecOutput is the inverse of (*ExecOutput)
+// Export().
+func ConvertExecOutput(out roptions.ExecOutput) *ExecOutput {
+	return &ExecOutput{
+		Id:       out.ID,
+		Stdout:   out.Stdout,
+		Stderr:   out.Stderr,
+		Exited:   out.Exited,
+		ExitCode: int32(out.ExitCode),
+		Error:    out.Error,
+	}
+}
+
 // Export takes a protobuf RPC ScriptingOptions and returns the analogous
 // ScriptingHarness options.
 func (o *ScriptingOptions) Export() (options.ScriptingHarness, error) {
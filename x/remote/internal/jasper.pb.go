@@ -251,6 +251,10 @@ const (
 	ArchiveFormat_ARCHIVEAUTO    ArchiveFormat = 1
 	ArchiveFormat_ARCHIVETARGZ   ArchiveFormat = 2
 	ArchiveFormat_ARCHIVEZIP     ArchiveFormat = 3
+	ArchiveFormat_ARCHIVETAR     ArchiveFormat = 4
+	ArchiveFormat_ARCHIVETARXZ   ArchiveFormat = 5
+	ArchiveFormat_ARCHIVETARZST  ArchiveFormat = 6
+	ArchiveFormat_ARCHIVETARBZ2  ArchiveFormat = 7
 )
 
 // Enum value maps for ArchiveFormat.
@@ -260,12 +264,20 @@ var (
 		1: "ARCHIVEAUTO",
 		2: "ARCHIVETARGZ",
 		3: "ARCHIVEZIP",
+		4: "ARCHIVETAR",
+		5: "ARCHIVETARXZ",
+		6: "ARCHIVETARZST",
+		7: "ARCHIVETARBZ2",
 	}
 	ArchiveFormat_value = map[string]int32{
 		"ARCHIVEUNKNOWN": 0,
 		"ARCHIVEAUTO":    1,
 		"ARCHIVETARGZ":   2,
 		"ARCHIVEZIP":     3,
+		"ARCHIVETAR":     4,
+		"ARCHIVETARXZ":   5,
+		"ARCHIVETARZST":  6,
+		"ARCHIVETARBZ2":  7,
 	}
 )
 
@@ -1165,6 +1177,11 @@ type CreateOptions struct {
 	OnTimeout          []*CreateOptions       `protobuf:"bytes,9,rep,name=on_timeout,json=onTimeout,proto3" json:"on_timeout,omitempty"`
 	Output             *OutputOptions         `protobuf:"bytes,10,opt,name=output,proto3" json:"output,omitempty"`
 	StandardInputBytes []byte                 `protobuf:"bytes,11,opt,name=standard_input_bytes,json=standardInputBytes,proto3" json:"standard_input_bytes,omitempty"`
+	IdleTimeout        *IdleTimeoutOptions    `protobuf:"bytes,12,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"`
+	OutputTriggers     []*OutputTrigger       `protobuf:"bytes,13,rep,name=output_triggers,json=outputTriggers,proto3" json:"output_triggers,omitempty"`
+	Readiness          *ReadinessOptions      `protobuf:"bytes,14,opt,name=readiness,proto3" json:"readiness,omitempty"`
+	Liveness           *LivenessOptions       `protobuf:"bytes,15,opt,name=liveness,proto3" json:"liveness,omitempty"`
+	Pipeline           []*CreateOptions       `protobuf:"bytes,16,rep,name=pipeline,proto3" json:"pipeline,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -1276,27 +1293,69 @@ func (x *CreateOptions) GetStandardInputBytes() []byte {
 	return nil
 }
 
-type IDResponse struct {
+func (x *CreateOptions) GetIdleTimeout() *IdleTimeoutOptions {
+	if x != nil {
+		return x.IdleTimeout
+	}
+	return nil
+}
+
+func (x *CreateOptions) GetOutputTriggers() []*OutputTrigger {
+	if x != nil {
+		return x.OutputTriggers
+	}
+	return nil
+}
+
+func (x *CreateOptions) GetReadiness() *ReadinessOptions {
+	if x != nil {
+		return x.Readiness
+	}
+	return nil
+}
+
+func (x *CreateOptions) GetLiveness() *LivenessOptions {
+	if x != nil {
+		return x.Liveness
+	}
+	return nil
+}
+
+func (x *CreateOptions) GetPipeline() []*CreateOptions {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+type OutputTrigger struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Pattern       string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Stream        string                 `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	Once          bool                   `protobuf:"varint,3,opt,name=once,proto3" json:"once,omitempty"`
+	Fail          bool                   `protobuf:"varint,4,opt,name=fail,proto3" json:"fail,omitempty"`
+	Tag           string                 `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	TriggerId     string                 `protobuf:"bytes,6,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
+	Create        *CreateOptions         `protobuf:"bytes,7,opt,name=create,proto3" json:"create,omitempty"`
+	Signal        Signals                `protobuf:"varint,8,opt,name=signal,proto3,enum=jasper.Signals" json:"signal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IDResponse) Reset() {
-	*x = IDResponse{}
+func (x *OutputTrigger) Reset() {
+	*x = OutputTrigger{}
 	mi := &file_jasper_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IDResponse) String() string {
+func (x *OutputTrigger) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IDResponse) ProtoMessage() {}
+func (*OutputTrigger) ProtoMessage() {}
 
-func (x *IDResponse) ProtoReflect() protoreflect.Message {
+func (x *OutputTrigger) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1308,49 +1367,90 @@ func (x *IDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IDResponse.ProtoReflect.Descriptor instead.
-func (*IDResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OutputTrigger.ProtoReflect.Descriptor instead.
+func (*OutputTrigger) Descriptor() ([]byte, []int) {
 	return file_jasper_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *IDResponse) GetValue() string {
+func (x *OutputTrigger) GetPattern() string {
 	if x != nil {
-		return x.Value
+		return x.Pattern
 	}
 	return ""
 }
 
-type ProcessInfo struct {
+func (x *OutputTrigger) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *OutputTrigger) GetOnce() bool {
+	if x != nil {
+		return x.Once
+	}
+	return false
+}
+
+func (x *OutputTrigger) GetFail() bool {
+	if x != nil {
+		return x.Fail
+	}
+	return false
+}
+
+func (x *OutputTrigger) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *OutputTrigger) GetTriggerId() string {
+	if x != nil {
+		return x.TriggerId
+	}
+	return ""
+}
+
+func (x *OutputTrigger) GetCreate() *CreateOptions {
+	if x != nil {
+		return x.Create
+	}
+	return nil
+}
+
+func (x *OutputTrigger) GetSignal() Signals {
+	if x != nil {
+		return x.Signal
+	}
+	return Signals_UNKNOWN
+}
+
+type IdleTimeoutOptions struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Pid           int64                  `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
-	HostId        string                 `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Running       bool                   `protobuf:"varint,4,opt,name=running,proto3" json:"running,omitempty"`
-	Successful    bool                   `protobuf:"varint,5,opt,name=successful,proto3" json:"successful,omitempty"`
-	Complete      bool                   `protobuf:"varint,6,opt,name=complete,proto3" json:"complete,omitempty"`
-	Timedout      bool                   `protobuf:"varint,7,opt,name=timedout,proto3" json:"timedout,omitempty"`
-	Options       *CreateOptions         `protobuf:"bytes,8,opt,name=options,proto3" json:"options,omitempty"`
-	ExitCode      int32                  `protobuf:"varint,9,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
-	StartAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
-	EndAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	StackDump     bool                   `protobuf:"varint,2,opt,name=stack_dump,json=stackDump,proto3" json:"stack_dump,omitempty"`
+	GracePeriod   *durationpb.Duration   `protobuf:"bytes,3,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessInfo) Reset() {
-	*x = ProcessInfo{}
+func (x *IdleTimeoutOptions) Reset() {
+	*x = IdleTimeoutOptions{}
 	mi := &file_jasper_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessInfo) String() string {
+func (x *IdleTimeoutOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessInfo) ProtoMessage() {}
+func (*IdleTimeoutOptions) ProtoMessage() {}
 
-func (x *ProcessInfo) ProtoReflect() protoreflect.Message {
+func (x *IdleTimeoutOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_jasper_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1362,111 +1462,2608 @@ func (x *ProcessInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessInfo.ProtoReflect.Descriptor instead.
-func (*ProcessInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use IdleTimeoutOptions.ProtoReflect.Descriptor instead.
+func (*IdleTimeoutOptions) Descriptor() ([]byte, []int) {
 	return file_jasper_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ProcessInfo) GetId() string {
+func (x *IdleTimeoutOptions) GetDuration() *durationpb.Duration {
 	if x != nil {
-		return x.Id
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *IdleTimeoutOptions) GetStackDump() bool {
+	if x != nil {
+		return x.StackDump
+	}
+	return false
+}
+
+func (x *IdleTimeoutOptions) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+type ReadinessOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tcp           string                 `protobuf:"bytes,1,opt,name=tcp,proto3" json:"tcp,omitempty"`
+	Http          string                 `protobuf:"bytes,2,opt,name=http,proto3" json:"http,omitempty"`
+	OutputPattern string                 `protobuf:"bytes,3,opt,name=output_pattern,json=outputPattern,proto3" json:"output_pattern,omitempty"`
+	File          string                 `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
+	Command       *CreateOptions         `protobuf:"bytes,5,opt,name=command,proto3" json:"command,omitempty"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadinessOptions) Reset() {
+	*x = ReadinessOptions{}
+	mi := &file_jasper_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadinessOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadinessOptions) ProtoMessage() {}
+
+func (x *ReadinessOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadinessOptions.ProtoReflect.Descriptor instead.
+func (*ReadinessOptions) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReadinessOptions) GetTcp() string {
+	if x != nil {
+		return x.Tcp
 	}
 	return ""
 }
 
-func (x *ProcessInfo) GetPid() int64 {
+func (x *ReadinessOptions) GetHttp() string {
 	if x != nil {
-		return x.Pid
+		return x.Http
 	}
-	return 0
+	return ""
 }
 
-func (x *ProcessInfo) GetHostId() string {
+func (x *ReadinessOptions) GetOutputPattern() string {
 	if x != nil {
-		return x.HostId
+		return x.OutputPattern
 	}
 	return ""
 }
 
-func (x *ProcessInfo) GetRunning() bool {
+func (x *ReadinessOptions) GetFile() string {
 	if x != nil {
-		return x.Running
+		return x.File
 	}
-	return false
+	return ""
 }
 
-func (x *ProcessInfo) GetSuccessful() bool {
+func (x *ReadinessOptions) GetCommand() *CreateOptions {
 	if x != nil {
-		return x.Successful
+		return x.Command
 	}
-	return false
+	return nil
 }
 
-func (x *ProcessInfo) GetComplete() bool {
+func (x *ReadinessOptions) GetInterval() *durationpb.Duration {
 	if x != nil {
-		return x.Complete
+		return x.Interval
 	}
-	return false
+	return nil
 }
 
-func (x *ProcessInfo) GetTimedout() bool {
+func (x *ReadinessOptions) GetTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.Timedout
+		return x.Timeout
 	}
-	return false
+	return nil
 }
 
-func (x *ProcessInfo) GetOptions() *CreateOptions {
+type LivenessOptions struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Tcp              string                 `protobuf:"bytes,1,opt,name=tcp,proto3" json:"tcp,omitempty"`
+	Http             string                 `protobuf:"bytes,2,opt,name=http,proto3" json:"http,omitempty"`
+	Command          *CreateOptions         `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	InitialDelay     *durationpb.Duration   `protobuf:"bytes,4,opt,name=initial_delay,json=initialDelay,proto3" json:"initial_delay,omitempty"`
+	Interval         *durationpb.Duration   `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	Timeout          *durationpb.Duration   `protobuf:"bytes,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	FailureThreshold int64                  `protobuf:"varint,7,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"`
+	GracePeriod      *durationpb.Duration   `protobuf:"bytes,8,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	MaxRestarts      int64                  `protobuf:"varint,9,opt,name=max_restarts,json=maxRestarts,proto3" json:"max_restarts,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LivenessOptions) Reset() {
+	*x = LivenessOptions{}
+	mi := &file_jasper_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LivenessOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LivenessOptions) ProtoMessage() {}
+
+func (x *LivenessOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[16]
 	if x != nil {
-		return x.Options
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LivenessOptions.ProtoReflect.Descriptor instead.
+func (*LivenessOptions) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LivenessOptions) GetTcp() string {
+	if x != nil {
+		return x.Tcp
+	}
+	return ""
+}
+
+func (x *LivenessOptions) GetHttp() string {
+	if x != nil {
+		return x.Http
+	}
+	return ""
+}
+
+func (x *LivenessOptions) GetCommand() *CreateOptions {
+	if x != nil {
+		return x.Command
 	}
 	return nil
 }
 
-func (x *ProcessInfo) GetExitCode() int32 {
+func (x *LivenessOptions) GetInitialDelay() *durationpb.Duration {
 	if x != nil {
-		return x.ExitCode
+		return x.InitialDelay
 	}
-	return 0
+	return nil
 }
 
-func (x *ProcessInfo) GetStartAt() *timestamppb.Timestamp {
+func (x *LivenessOptions) GetInterval() *durationpb.Duration {
 	if x != nil {
-		return x.StartAt
+		return x.Interval
 	}
 	return nil
 }
 
-func (x *ProcessInfo) GetEndAt() *timestamppb.Timestamp {
+func (x *LivenessOptions) GetTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.EndAt
+		return x.Timeout
 	}
 	return nil
 }
 
-type StatusResponse struct {
+func (x *LivenessOptions) GetFailureThreshold() int64 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
+}
+
+func (x *LivenessOptions) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+func (x *LivenessOptions) GetMaxRestarts() int64 {
+	if x != nil {
+		return x.MaxRestarts
+	}
+	return 0
+}
+
+type IDResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HostId        string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
-	Active        bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StatusResponse) Reset() {
-	*x = StatusResponse{}
-	mi := &file_jasper_proto_msgTypes[15]
+func (x *IDResponse) Reset() {
+	*x = IDResponse{}
+	mi := &file_jasper_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IDResponse) ProtoMessage() {}
+
+func (x *IDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IDResponse.ProtoReflect.Descriptor instead.
+func (*IDResponse) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *IDResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ProcessInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pid           int64                  `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
+	HostId        string                 `protobuf:"bytes,3,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Running       bool                   `protobuf:"varint,4,opt,name=running,proto3" json:"running,omitempty"`
+	Successful    bool                   `protobuf:"varint,5,opt,name=successful,proto3" json:"successful,omitempty"`
+	Complete      bool                   `protobuf:"varint,6,opt,name=complete,proto3" json:"complete,omitempty"`
+	Timedout      bool                   `protobuf:"varint,7,opt,name=timedout,proto3" json:"timedout,omitempty"`
+	Options       *CreateOptions         `protobuf:"bytes,8,opt,name=options,proto3" json:"options,omitempty"`
+	ExitCode      int32                  `protobuf:"varint,9,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	StartAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	TimeoutReason string                 `protobuf:"bytes,12,opt,name=timeout_reason,json=timeoutReason,proto3" json:"timeout_reason,omitempty"`
+	Ready         bool                   `protobuf:"varint,13,opt,name=ready,proto3" json:"ready,omitempty"`
+	Liveness      *LivenessInfo          `protobuf:"bytes,14,opt,name=liveness,proto3" json:"liveness,omitempty"`
+	FollowUps     []*FollowUpInfo        `protobuf:"bytes,15,rep,name=follow_ups,json=followUps,proto3" json:"follow_ups,omitempty"`
+	Stages        []*ProcessInfo         `protobuf:"bytes,16,rep,name=stages,proto3" json:"stages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessInfo) Reset() {
+	*x = ProcessInfo{}
+	mi := &file_jasper_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessInfo) ProtoMessage() {}
+
+func (x *ProcessInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessInfo.ProtoReflect.Descriptor instead.
+func (*ProcessInfo) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ProcessInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *ProcessInfo) GetSuccessful() bool {
+	if x != nil {
+		return x.Successful
+	}
+	return false
+}
+
+func (x *ProcessInfo) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *ProcessInfo) GetTimedout() bool {
+	if x != nil {
+		return x.Timedout
+	}
+	return false
+}
+
+func (x *ProcessInfo) GetOptions() *CreateOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetTimeoutReason() string {
+	if x != nil {
+		return x.TimeoutReason
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *ProcessInfo) GetLiveness() *LivenessInfo {
+	if x != nil {
+		return x.Liveness
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetFollowUps() []*FollowUpInfo {
+	if x != nil {
+		return x.FollowUps
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetStages() []*ProcessInfo {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+type FollowUpInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trigger       string                 `protobuf:"bytes,1,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Complete      bool                   `protobuf:"varint,4,opt,name=complete,proto3" json:"complete,omitempty"`
+	Successful    bool                   `protobuf:"varint,5,opt,name=successful,proto3" json:"successful,omitempty"`
+	ExitCode      int32                  `protobuf:"varint,6,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowUpInfo) Reset() {
+	*x = FollowUpInfo{}
+	mi := &file_jasper_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowUpInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUpInfo) ProtoMessage() {}
+
+func (x *FollowUpInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUpInfo.ProtoReflect.Descriptor instead.
+func (*FollowUpInfo) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FollowUpInfo) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *FollowUpInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FollowUpInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FollowUpInfo) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *FollowUpInfo) GetSuccessful() bool {
+	if x != nil {
+		return x.Successful
+	}
+	return false
+}
+
+func (x *FollowUpInfo) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type LivenessCheck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Passed        bool                   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LivenessCheck) Reset() {
+	*x = LivenessCheck{}
+	mi := &file_jasper_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LivenessCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LivenessCheck) ProtoMessage() {}
+
+func (x *LivenessCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LivenessCheck.ProtoReflect.Descriptor instead.
+func (*LivenessCheck) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LivenessCheck) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *LivenessCheck) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *LivenessCheck) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type LivenessInfo struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Checks              []*LivenessCheck       `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
+	ConsecutiveFailures int64                  `protobuf:"varint,2,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	Restarts            int64                  `protobuf:"varint,3,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	RestartedFrom       string                 `protobuf:"bytes,4,opt,name=restarted_from,json=restartedFrom,proto3" json:"restarted_from,omitempty"`
+	RestartedAs         string                 `protobuf:"bytes,5,opt,name=restarted_as,json=restartedAs,proto3" json:"restarted_as,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *LivenessInfo) Reset() {
+	*x = LivenessInfo{}
+	mi := &file_jasper_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LivenessInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LivenessInfo) ProtoMessage() {}
+
+func (x *LivenessInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LivenessInfo.ProtoReflect.Descriptor instead.
+func (*LivenessInfo) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LivenessInfo) GetChecks() []*LivenessCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *LivenessInfo) GetConsecutiveFailures() int64 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *LivenessInfo) GetRestarts() int64 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *LivenessInfo) GetRestartedFrom() string {
+	if x != nil {
+		return x.RestartedFrom
+	}
+	return ""
+}
+
+func (x *LivenessInfo) GetRestartedAs() string {
+	if x != nil {
+		return x.RestartedAs
+	}
+	return ""
+}
+
+type StatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HostId        string                 `protobuf:"bytes,1,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
+	Active        bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	mi := &file_jasper_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StatusResponse) GetHostId() string {
+	if x != nil {
+		return x.HostId
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type Filter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          FilterSpecifications   `protobuf:"varint,1,opt,name=name,proto3,enum=jasper.FilterSpecifications" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Filter) Reset() {
+	*x = Filter{}
+	mi := &file_jasper_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter) ProtoMessage() {}
+
+func (x *Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
+func (*Filter) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Filter) GetName() FilterSpecifications {
+	if x != nil {
+		return x.Name
+	}
+	return FilterSpecifications_ALL
+}
+
+type SignalProcess struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProcessID     *JasperProcessID       `protobuf:"bytes,1,opt,name=ProcessID,proto3" json:"ProcessID,omitempty"`
+	Signal        Signals                `protobuf:"varint,2,opt,name=signal,proto3,enum=jasper.Signals" json:"signal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignalProcess) Reset() {
+	*x = SignalProcess{}
+	mi := &file_jasper_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignalProcess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalProcess) ProtoMessage() {}
+
+func (x *SignalProcess) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalProcess.ProtoReflect.Descriptor instead.
+func (*SignalProcess) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SignalProcess) GetProcessID() *JasperProcessID {
+	if x != nil {
+		return x.ProcessID
+	}
+	return nil
+}
+
+func (x *SignalProcess) GetSignal() Signals {
+	if x != nil {
+		return x.Signal
+	}
+	return Signals_UNKNOWN
+}
+
+type TagName struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagName) Reset() {
+	*x = TagName{}
+	mi := &file_jasper_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagName) ProtoMessage() {}
+
+func (x *TagName) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagName.ProtoReflect.Descriptor instead.
+func (*TagName) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TagName) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ProcessTags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProcessID     string                 `protobuf:"bytes,1,opt,name=processID,proto3" json:"processID,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessTags) Reset() {
+	*x = ProcessTags{}
+	mi := &file_jasper_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessTags) ProtoMessage() {}
+
+func (x *ProcessTags) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessTags.ProtoReflect.Descriptor instead.
+func (*ProcessTags) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ProcessTags) GetProcessID() string {
+	if x != nil {
+		return x.ProcessID
+	}
+	return ""
+}
+
+func (x *ProcessTags) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type JasperProcessID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JasperProcessID) Reset() {
+	*x = JasperProcessID{}
+	mi := &file_jasper_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JasperProcessID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JasperProcessID) ProtoMessage() {}
+
+func (x *JasperProcessID) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JasperProcessID.ProtoReflect.Descriptor instead.
+func (*JasperProcessID) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *JasperProcessID) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type OperationOutcome struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	ExitCode      int32                  `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperationOutcome) Reset() {
+	*x = OperationOutcome{}
+	mi := &file_jasper_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationOutcome) ProtoMessage() {}
+
+func (x *OperationOutcome) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationOutcome.ProtoReflect.Descriptor instead.
+func (*OperationOutcome) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *OperationOutcome) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *OperationOutcome) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *OperationOutcome) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type ArchiveOptions struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ShouldExtract   bool                   `protobuf:"varint,1,opt,name=should_extract,json=shouldExtract,proto3" json:"should_extract,omitempty"`
+	Format          ArchiveFormat          `protobuf:"varint,2,opt,name=format,proto3,enum=jasper.ArchiveFormat" json:"format,omitempty"`
+	TargetPath      string                 `protobuf:"bytes,3,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	StripComponents int64                  `protobuf:"varint,4,opt,name=strip_components,json=stripComponents,proto3" json:"strip_components,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ArchiveOptions) Reset() {
+	*x = ArchiveOptions{}
+	mi := &file_jasper_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveOptions) ProtoMessage() {}
+
+func (x *ArchiveOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveOptions.ProtoReflect.Descriptor instead.
+func (*ArchiveOptions) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ArchiveOptions) GetShouldExtract() bool {
+	if x != nil {
+		return x.ShouldExtract
+	}
+	return false
+}
+
+func (x *ArchiveOptions) GetFormat() ArchiveFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ArchiveFormat_ARCHIVEUNKNOWN
+}
+
+func (x *ArchiveOptions) GetTargetPath() string {
+	if x != nil {
+		return x.TargetPath
+	}
+	return ""
+}
+
+func (x *ArchiveOptions) GetStripComponents() int64 {
+	if x != nil {
+		return x.StripComponents
+	}
+	return 0
+}
+
+type DownloadChecksum struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Algorithm     string                 `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Digest        string                 `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadChecksum) Reset() {
+	*x = DownloadChecksum{}
+	mi := &file_jasper_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadChecksum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadChecksum) ProtoMessage() {}
+
+func (x *DownloadChecksum) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadChecksum.ProtoReflect.Descriptor instead.
+func (*DownloadChecksum) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DownloadChecksum) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *DownloadChecksum) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+type DownloadRetry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxAttempts   int64                  `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	Backoff       *durationpb.Duration   `protobuf:"bytes,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	MaxBackoff    *durationpb.Duration   `protobuf:"bytes,3,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadRetry) Reset() {
+	*x = DownloadRetry{}
+	mi := &file_jasper_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadRetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadRetry) ProtoMessage() {}
+
+func (x *DownloadRetry) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadRetry.ProtoReflect.Descriptor instead.
+func (*DownloadRetry) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DownloadRetry) GetMaxAttempts() int64 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *DownloadRetry) GetBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.Backoff
+	}
+	return nil
+}
+
+func (x *DownloadRetry) GetMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return nil
+}
+
+type DownloadInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	ArchiveOpts   *ArchiveOptions        `protobuf:"bytes,3,opt,name=archive_opts,json=archiveOpts,proto3" json:"archive_opts,omitempty"`
+	Checksum      *DownloadChecksum      `protobuf:"bytes,4,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Username      string                 `protobuf:"bytes,6,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,7,opt,name=password,proto3" json:"password,omitempty"`
+	Resume        bool                   `protobuf:"varint,8,opt,name=resume,proto3" json:"resume,omitempty"`
+	Retry         *DownloadRetry         `protobuf:"bytes,9,opt,name=retry,proto3" json:"retry,omitempty"`
+	UseCache      bool                   `protobuf:"varint,10,opt,name=use_cache,json=useCache,proto3" json:"use_cache,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadInfo) Reset() {
+	*x = DownloadInfo{}
+	mi := &file_jasper_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadInfo) ProtoMessage() {}
+
+func (x *DownloadInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadInfo.ProtoReflect.Descriptor instead.
+func (*DownloadInfo) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DownloadInfo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DownloadInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DownloadInfo) GetArchiveOpts() *ArchiveOptions {
+	if x != nil {
+		return x.ArchiveOpts
+	}
+	return nil
+}
+
+func (x *DownloadInfo) GetChecksum() *DownloadChecksum {
+	if x != nil {
+		return x.Checksum
+	}
+	return nil
+}
+
+func (x *DownloadInfo) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *DownloadInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DownloadInfo) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DownloadInfo) GetResume() bool {
+	if x != nil {
+		return x.Resume
+	}
+	return false
+}
+
+func (x *DownloadInfo) GetRetry() *DownloadRetry {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *DownloadInfo) GetUseCache() bool {
+	if x != nil {
+		return x.UseCache
+	}
+	return false
+}
+
+type DownloadCacheStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Directory     string                 `protobuf:"bytes,2,opt,name=directory,proto3" json:"directory,omitempty"`
+	Entries       int64                  `protobuf:"varint,3,opt,name=entries,proto3" json:"entries,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	MaxSize       int64                  `protobuf:"varint,5,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	Hits          int64                  `protobuf:"varint,6,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses        int64                  `protobuf:"varint,7,opt,name=misses,proto3" json:"misses,omitempty"`
+	Evictions     int64                  `protobuf:"varint,8,opt,name=evictions,proto3" json:"evictions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadCacheStats) Reset() {
+	*x = DownloadCacheStats{}
+	mi := &file_jasper_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadCacheStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadCacheStats) ProtoMessage() {}
+
+func (x *DownloadCacheStats) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadCacheStats.ProtoReflect.Descriptor instead.
+func (*DownloadCacheStats) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DownloadCacheStats) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *DownloadCacheStats) GetDirectory() string {
+	if x != nil {
+		return x.Directory
+	}
+	return ""
+}
+
+func (x *DownloadCacheStats) GetEntries() int64 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *DownloadCacheStats) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DownloadCacheStats) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *DownloadCacheStats) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *DownloadCacheStats) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *DownloadCacheStats) GetEvictions() int64 {
+	if x != nil {
+		return x.Evictions
+	}
+	return 0
+}
+
+type DrainOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timeout       *durationpb.Duration   `protobuf:"bytes,1,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Terminate     bool                   `protobuf:"varint,2,opt,name=terminate,proto3" json:"terminate,omitempty"`
+	GracePeriod   *durationpb.Duration   `protobuf:"bytes,3,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrainOptions) Reset() {
+	*x = DrainOptions{}
+	mi := &file_jasper_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainOptions) ProtoMessage() {}
+
+func (x *DrainOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainOptions.ProtoReflect.Descriptor instead.
+func (*DrainOptions) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DrainOptions) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *DrainOptions) GetTerminate() bool {
+	if x != nil {
+		return x.Terminate
+	}
+	return false
+}
+
+func (x *DrainOptions) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+type DrainReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Completed     []string               `protobuf:"bytes,1,rep,name=completed,proto3" json:"completed,omitempty"`
+	Terminated    []string               `protobuf:"bytes,2,rep,name=terminated,proto3" json:"terminated,omitempty"`
+	Killed        []string               `protobuf:"bytes,3,rep,name=killed,proto3" json:"killed,omitempty"`
+	Running       []string               `protobuf:"bytes,4,rep,name=running,proto3" json:"running,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrainReport) Reset() {
+	*x = DrainReport{}
+	mi := &file_jasper_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainReport) ProtoMessage() {}
+
+func (x *DrainReport) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainReport.ProtoReflect.Descriptor instead.
+func (*DrainReport) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DrainReport) GetCompleted() []string {
+	if x != nil {
+		return x.Completed
+	}
+	return nil
+}
+
+func (x *DrainReport) GetTerminated() []string {
+	if x != nil {
+		return x.Terminated
+	}
+	return nil
+}
+
+func (x *DrainReport) GetKilled() []string {
+	if x != nil {
+		return x.Killed
+	}
+	return nil
+}
+
+func (x *DrainReport) GetRunning() []string {
+	if x != nil {
+		return x.Running
+	}
+	return nil
+}
+
+type ProcessSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *ProcessInfo           `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessSnapshot) Reset() {
+	*x = ProcessSnapshot{}
+	mi := &file_jasper_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessSnapshot) ProtoMessage() {}
+
+func (x *ProcessSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessSnapshot.ProtoReflect.Descriptor instead.
+func (*ProcessSnapshot) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ProcessSnapshot) GetInfo() *ProcessInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *ProcessSnapshot) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type Snapshot struct {
+	state               protoimpl.MessageState  `protogen:"open.v1"`
+	Version             int32                   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ManagerId           string                  `protobuf:"bytes,2,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	Hostname            string                  `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	CreatedAt           *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Processes           []*ProcessSnapshot      `protobuf:"bytes,5,rep,name=processes,proto3" json:"processes,omitempty"`
+	SignalTriggerIds    []string                `protobuf:"bytes,6,rep,name=signal_trigger_ids,json=signalTriggerIds,proto3" json:"signal_trigger_ids,omitempty"`
+	LoggingCache        []*LoggingCacheInstance `protobuf:"bytes,7,rep,name=logging_cache,json=loggingCache,proto3" json:"logging_cache,omitempty"`
+	ScriptingHarnessIds []string                `protobuf:"bytes,8,rep,name=scripting_harness_ids,json=scriptingHarnessIds,proto3" json:"scripting_harness_ids,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	mi := &file_jasper_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Snapshot) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Snapshot) GetManagerId() string {
+	if x != nil {
+		return x.ManagerId
+	}
+	return ""
+}
+
+func (x *Snapshot) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *Snapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Snapshot) GetProcesses() []*ProcessSnapshot {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+func (x *Snapshot) GetSignalTriggerIds() []string {
+	if x != nil {
+		return x.SignalTriggerIds
+	}
+	return nil
+}
+
+func (x *Snapshot) GetLoggingCache() []*LoggingCacheInstance {
+	if x != nil {
+		return x.LoggingCache
+	}
+	return nil
+}
+
+func (x *Snapshot) GetScriptingHarnessIds() []string {
+	if x != nil {
+		return x.ScriptingHarnessIds
+	}
+	return nil
+}
+
+type ScheduleOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Create        *CreateOptions         `protobuf:"bytes,2,opt,name=create,proto3" json:"create,omitempty"`
+	Cron          string                 `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Jitter        *durationpb.Duration   `protobuf:"bytes,5,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	Overlap       string                 `protobuf:"bytes,6,opt,name=overlap,proto3" json:"overlap,omitempty"`
+	HistorySize   int64                  `protobuf:"varint,7,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleOptions) Reset() {
+	*x = ScheduleOptions{}
+	mi := &file_jasper_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleOptions) ProtoMessage() {}
+
+func (x *ScheduleOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleOptions.ProtoReflect.Descriptor instead.
+func (*ScheduleOptions) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ScheduleOptions) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduleOptions) GetCreate() *CreateOptions {
+	if x != nil {
+		return x.Create
+	}
+	return nil
+}
+
+func (x *ScheduleOptions) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *ScheduleOptions) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *ScheduleOptions) GetJitter() *durationpb.Duration {
+	if x != nil {
+		return x.Jitter
+	}
+	return nil
+}
+
+func (x *ScheduleOptions) GetOverlap() string {
+	if x != nil {
+		return x.Overlap
+	}
+	return ""
+}
+
+func (x *ScheduleOptions) GetHistorySize() int64 {
+	if x != nil {
+		return x.HistorySize
+	}
+	return 0
+}
+
+type ScheduleRun struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	ProcessId     string                 `protobuf:"bytes,3,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	Skipped       bool                   `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Complete      bool                   `protobuf:"varint,5,opt,name=complete,proto3" json:"complete,omitempty"`
+	Successful    bool                   `protobuf:"varint,6,opt,name=successful,proto3" json:"successful,omitempty"`
+	ExitCode      int64                  `protobuf:"varint,7,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleRun) Reset() {
+	*x = ScheduleRun{}
+	mi := &file_jasper_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleRun) ProtoMessage() {}
+
+func (x *ScheduleRun) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleRun.ProtoReflect.Descriptor instead.
+func (*ScheduleRun) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ScheduleRun) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *ScheduleRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ScheduleRun) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *ScheduleRun) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+func (x *ScheduleRun) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *ScheduleRun) GetSuccessful() bool {
+	if x != nil {
+		return x.Successful
+	}
+	return false
+}
+
+func (x *ScheduleRun) GetExitCode() int64 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ScheduleRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ScheduleInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Schedule         *ScheduleOptions       `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	NextRun          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	RunningProcessId string                 `protobuf:"bytes,3,opt,name=running_process_id,json=runningProcessId,proto3" json:"running_process_id,omitempty"`
+	Queued           int64                  `protobuf:"varint,4,opt,name=queued,proto3" json:"queued,omitempty"`
+	History          []*ScheduleRun         `protobuf:"bytes,5,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ScheduleInfo) Reset() {
+	*x = ScheduleInfo{}
+	mi := &file_jasper_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleInfo) ProtoMessage() {}
+
+func (x *ScheduleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleInfo.ProtoReflect.Descriptor instead.
+func (*ScheduleInfo) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ScheduleInfo) GetSchedule() *ScheduleOptions {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *ScheduleInfo) GetNextRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRun
+	}
+	return nil
+}
+
+func (x *ScheduleInfo) GetRunningProcessId() string {
+	if x != nil {
+		return x.RunningProcessId
+	}
+	return ""
+}
+
+func (x *ScheduleInfo) GetQueued() int64 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *ScheduleInfo) GetHistory() []*ScheduleRun {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type ScheduleInfoList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*ScheduleInfo        `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleInfoList) Reset() {
+	*x = ScheduleInfoList{}
+	mi := &file_jasper_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleInfoList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleInfoList) ProtoMessage() {}
+
+func (x *ScheduleInfoList) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleInfoList.ProtoReflect.Descriptor instead.
+func (*ScheduleInfoList) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ScheduleInfoList) GetSchedules() []*ScheduleInfo {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type ScheduleID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleID) Reset() {
+	*x = ScheduleID{}
+	mi := &file_jasper_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleID) ProtoMessage() {}
+
+func (x *ScheduleID) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleID.ProtoReflect.Descriptor instead.
+func (*ScheduleID) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ScheduleID) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type WriteFileInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Append        bool                   `protobuf:"varint,4,opt,name=append,proto3" json:"append,omitempty"`
+	Perm          uint32                 `protobuf:"varint,3,opt,name=perm,proto3" json:"perm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteFileInfo) Reset() {
+	*x = WriteFileInfo{}
+	mi := &file_jasper_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteFileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteFileInfo) ProtoMessage() {}
+
+func (x *WriteFileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteFileInfo.ProtoReflect.Descriptor instead.
+func (*WriteFileInfo) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WriteFileInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *WriteFileInfo) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *WriteFileInfo) GetAppend() bool {
+	if x != nil {
+		return x.Append
+	}
+	return false
+}
+
+func (x *WriteFileInfo) GetPerm() uint32 {
+	if x != nil {
+		return x.Perm
+	}
+	return 0
+}
+
+type FilePath struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilePath) Reset() {
+	*x = FilePath{}
+	mi := &file_jasper_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilePath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilePath) ProtoMessage() {}
+
+func (x *FilePath) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilePath.ProtoReflect.Descriptor instead.
+func (*FilePath) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *FilePath) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type FileInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Mode          uint32                 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	ModTime       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=mod_time,json=modTime,proto3" json:"mod_time,omitempty"`
+	IsDir         bool                   `protobuf:"varint,6,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileInfo) Reset() {
+	*x = FileInfo{}
+	mi := &file_jasper_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileInfo) ProtoMessage() {}
+
+func (x *FileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
+func (*FileInfo) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *FileInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileInfo) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *FileInfo) GetModTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModTime
+	}
+	return nil
+}
+
+func (x *FileInfo) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
+	}
+	return false
+}
+
+type FileInfoList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*FileInfo            `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileInfoList) Reset() {
+	*x = FileInfoList{}
+	mi := &file_jasper_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileInfoList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileInfoList) ProtoMessage() {}
+
+func (x *FileInfoList) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileInfoList.ProtoReflect.Descriptor instead.
+func (*FileInfoList) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *FileInfoList) GetFiles() []*FileInfo {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type FilePathList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Paths         []string               `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilePathList) Reset() {
+	*x = FilePathList{}
+	mi := &file_jasper_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilePathList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilePathList) ProtoMessage() {}
+
+func (x *FilePathList) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilePathList.ProtoReflect.Descriptor instead.
+func (*FilePathList) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *FilePathList) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type ReadFileInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length        int64                  `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadFileInfo) Reset() {
+	*x = ReadFileInfo{}
+	mi := &file_jasper_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadFileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadFileInfo) ProtoMessage() {}
+
+func (x *ReadFileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadFileInfo.ProtoReflect.Descriptor instead.
+func (*ReadFileInfo) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReadFileInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ReadFileInfo) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ReadFileInfo) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type FileChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileChunk) Reset() {
+	*x = FileChunk{}
+	mi := &file_jasper_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileChunk) ProtoMessage() {}
+
+func (x *FileChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileChunk.ProtoReflect.Descriptor instead.
+func (*FileChunk) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FileChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListDirectoryInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Recursive     bool                   `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDirectoryInfo) Reset() {
+	*x = ListDirectoryInfo{}
+	mi := &file_jasper_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDirectoryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDirectoryInfo) ProtoMessage() {}
+
+func (x *ListDirectoryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDirectoryInfo.ProtoReflect.Descriptor instead.
+func (*ListDirectoryInfo) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListDirectoryInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ListDirectoryInfo) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+type RemoveFileInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Recursive     bool                   `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFileInfo) Reset() {
+	*x = RemoveFileInfo{}
+	mi := &file_jasper_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFileInfo) ProtoMessage() {}
+
+func (x *RemoveFileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFileInfo.ProtoReflect.Descriptor instead.
+func (*RemoveFileInfo) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RemoveFileInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RemoveFileInfo) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+type RenameFileInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldPath       string                 `protobuf:"bytes,1,opt,name=old_path,json=oldPath,proto3" json:"old_path,omitempty"`
+	NewPath       string                 `protobuf:"bytes,2,opt,name=new_path,json=newPath,proto3" json:"new_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameFileInfo) Reset() {
+	*x = RenameFileInfo{}
+	mi := &file_jasper_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameFileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameFileInfo) ProtoMessage() {}
+
+func (x *RenameFileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameFileInfo.ProtoReflect.Descriptor instead.
+func (*RenameFileInfo) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RenameFileInfo) GetOldPath() string {
+	if x != nil {
+		return x.OldPath
+	}
+	return ""
+}
+
+func (x *RenameFileInfo) GetNewPath() string {
+	if x != nil {
+		return x.NewPath
+	}
+	return ""
+}
+
+type MakeDirectoryInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Perm          uint32                 `protobuf:"varint,2,opt,name=perm,proto3" json:"perm,omitempty"`
+	Parents       bool                   `protobuf:"varint,3,opt,name=parents,proto3" json:"parents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MakeDirectoryInfo) Reset() {
+	*x = MakeDirectoryInfo{}
+	mi := &file_jasper_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatusResponse) String() string {
+func (x *MakeDirectoryInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse) ProtoMessage() {}
+func (*MakeDirectoryInfo) ProtoMessage() {}
 
-func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[15]
+func (x *MakeDirectoryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1477,47 +4074,55 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
-func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use MakeDirectoryInfo.ProtoReflect.Descriptor instead.
+func (*MakeDirectoryInfo) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *StatusResponse) GetHostId() string {
+func (x *MakeDirectoryInfo) GetPath() string {
 	if x != nil {
-		return x.HostId
+		return x.Path
 	}
 	return ""
 }
 
-func (x *StatusResponse) GetActive() bool {
+func (x *MakeDirectoryInfo) GetPerm() uint32 {
 	if x != nil {
-		return x.Active
+		return x.Perm
+	}
+	return 0
+}
+
+func (x *MakeDirectoryInfo) GetParents() bool {
+	if x != nil {
+		return x.Parents
 	}
 	return false
 }
 
-type Filter struct {
+type FileChecksumInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          FilterSpecifications   `protobuf:"varint,1,opt,name=name,proto3,enum=jasper.FilterSpecifications" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Algorithm     string                 `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Filter) Reset() {
-	*x = Filter{}
-	mi := &file_jasper_proto_msgTypes[16]
+func (x *FileChecksumInfo) Reset() {
+	*x = FileChecksumInfo{}
+	mi := &file_jasper_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Filter) String() string {
+func (x *FileChecksumInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Filter) ProtoMessage() {}
+func (*FileChecksumInfo) ProtoMessage() {}
 
-func (x *Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[16]
+func (x *FileChecksumInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1528,41 +4133,47 @@ func (x *Filter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
-func (*Filter) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use FileChecksumInfo.ProtoReflect.Descriptor instead.
+func (*FileChecksumInfo) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *Filter) GetName() FilterSpecifications {
+func (x *FileChecksumInfo) GetPath() string {
 	if x != nil {
-		return x.Name
+		return x.Path
 	}
-	return FilterSpecifications_ALL
+	return ""
 }
 
-type SignalProcess struct {
+func (x *FileChecksumInfo) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type FileChecksumResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProcessID     *JasperProcessID       `protobuf:"bytes,1,opt,name=ProcessID,proto3" json:"ProcessID,omitempty"`
-	Signal        Signals                `protobuf:"varint,2,opt,name=signal,proto3,enum=jasper.Signals" json:"signal,omitempty"`
+	Checksum      string                 `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SignalProcess) Reset() {
-	*x = SignalProcess{}
-	mi := &file_jasper_proto_msgTypes[17]
+func (x *FileChecksumResponse) Reset() {
+	*x = FileChecksumResponse{}
+	mi := &file_jasper_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SignalProcess) String() string {
+func (x *FileChecksumResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignalProcess) ProtoMessage() {}
+func (*FileChecksumResponse) ProtoMessage() {}
 
-func (x *SignalProcess) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[17]
+func (x *FileChecksumResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1573,47 +4184,43 @@ func (x *SignalProcess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignalProcess.ProtoReflect.Descriptor instead.
-func (*SignalProcess) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *SignalProcess) GetProcessID() *JasperProcessID {
-	if x != nil {
-		return x.ProcessID
-	}
-	return nil
+// Deprecated: Use FileChecksumResponse.ProtoReflect.Descriptor instead.
+func (*FileChecksumResponse) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *SignalProcess) GetSignal() Signals {
+func (x *FileChecksumResponse) GetChecksum() string {
 	if x != nil {
-		return x.Signal
+		return x.Checksum
 	}
-	return Signals_UNKNOWN
+	return ""
 }
 
-type TagName struct {
+type CreateArchiveInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Format        ArchiveFormat          `protobuf:"varint,2,opt,name=format,proto3,enum=jasper.ArchiveFormat" json:"format,omitempty"`
+	Include       []string               `protobuf:"bytes,3,rep,name=include,proto3" json:"include,omitempty"`
+	Exclude       []string               `protobuf:"bytes,4,rep,name=exclude,proto3" json:"exclude,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TagName) Reset() {
-	*x = TagName{}
-	mi := &file_jasper_proto_msgTypes[18]
+func (x *CreateArchiveInfo) Reset() {
+	*x = CreateArchiveInfo{}
+	mi := &file_jasper_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TagName) String() string {
+func (x *CreateArchiveInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TagName) ProtoMessage() {}
+func (*CreateArchiveInfo) ProtoMessage() {}
 
-func (x *TagName) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[18]
+func (x *CreateArchiveInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1624,41 +4231,61 @@ func (x *TagName) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TagName.ProtoReflect.Descriptor instead.
-func (*TagName) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use CreateArchiveInfo.ProtoReflect.Descriptor instead.
+func (*CreateArchiveInfo) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *TagName) GetValue() string {
+func (x *CreateArchiveInfo) GetPath() string {
 	if x != nil {
-		return x.Value
+		return x.Path
 	}
 	return ""
 }
 
-type ProcessTags struct {
+func (x *CreateArchiveInfo) GetFormat() ArchiveFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ArchiveFormat_ARCHIVEUNKNOWN
+}
+
+func (x *CreateArchiveInfo) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *CreateArchiveInfo) GetExclude() []string {
+	if x != nil {
+		return x.Exclude
+	}
+	return nil
+}
+
+type BuildloggerURLs struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProcessID     string                 `protobuf:"bytes,1,opt,name=processID,proto3" json:"processID,omitempty"`
-	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Urls          []string               `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessTags) Reset() {
-	*x = ProcessTags{}
-	mi := &file_jasper_proto_msgTypes[19]
+func (x *BuildloggerURLs) Reset() {
+	*x = BuildloggerURLs{}
+	mi := &file_jasper_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessTags) String() string {
+func (x *BuildloggerURLs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessTags) ProtoMessage() {}
+func (*BuildloggerURLs) ProtoMessage() {}
 
-func (x *ProcessTags) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[19]
+func (x *BuildloggerURLs) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1669,47 +4296,41 @@ func (x *ProcessTags) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessTags.ProtoReflect.Descriptor instead.
-func (*ProcessTags) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *ProcessTags) GetProcessID() string {
-	if x != nil {
-		return x.ProcessID
-	}
-	return ""
+// Deprecated: Use BuildloggerURLs.ProtoReflect.Descriptor instead.
+func (*BuildloggerURLs) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ProcessTags) GetTags() []string {
+func (x *BuildloggerURLs) GetUrls() []string {
 	if x != nil {
-		return x.Tags
+		return x.Urls
 	}
 	return nil
 }
 
-type JasperProcessID struct {
+type LogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Id            *JasperProcessID       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JasperProcessID) Reset() {
-	*x = JasperProcessID{}
-	mi := &file_jasper_proto_msgTypes[20]
+func (x *LogRequest) Reset() {
+	*x = LogRequest{}
+	mi := &file_jasper_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JasperProcessID) String() string {
+func (x *LogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JasperProcessID) ProtoMessage() {}
+func (*LogRequest) ProtoMessage() {}
 
-func (x *JasperProcessID) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[20]
+func (x *LogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1720,42 +4341,48 @@ func (x *JasperProcessID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JasperProcessID.ProtoReflect.Descriptor instead.
-func (*JasperProcessID) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use LogRequest.ProtoReflect.Descriptor instead.
+func (*LogRequest) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *JasperProcessID) GetValue() string {
+func (x *LogRequest) GetId() *JasperProcessID {
 	if x != nil {
-		return x.Value
+		return x.Id
 	}
-	return ""
+	return nil
 }
 
-type OperationOutcome struct {
+func (x *LogRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type LogStream struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	ExitCode      int32                  `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Logs          []string               `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Done          bool                   `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OperationOutcome) Reset() {
-	*x = OperationOutcome{}
-	mi := &file_jasper_proto_msgTypes[21]
+func (x *LogStream) Reset() {
+	*x = LogStream{}
+	mi := &file_jasper_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OperationOutcome) String() string {
+func (x *LogStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationOutcome) ProtoMessage() {}
+func (*LogStream) ProtoMessage() {}
 
-func (x *OperationOutcome) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[21]
+func (x *LogStream) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1766,56 +4393,49 @@ func (x *OperationOutcome) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationOutcome.ProtoReflect.Descriptor instead.
-func (*OperationOutcome) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *OperationOutcome) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use LogStream.ProtoReflect.Descriptor instead.
+func (*LogStream) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *OperationOutcome) GetText() string {
+func (x *LogStream) GetLogs() []string {
 	if x != nil {
-		return x.Text
+		return x.Logs
 	}
-	return ""
+	return nil
 }
 
-func (x *OperationOutcome) GetExitCode() int32 {
+func (x *LogStream) GetDone() bool {
 	if x != nil {
-		return x.ExitCode
+		return x.Done
 	}
-	return 0
+	return false
 }
 
-type ArchiveOptions struct {
+type LogFollowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShouldExtract bool                   `protobuf:"varint,1,opt,name=should_extract,json=shouldExtract,proto3" json:"should_extract,omitempty"`
-	Format        ArchiveFormat          `protobuf:"varint,2,opt,name=format,proto3,enum=jasper.ArchiveFormat" json:"format,omitempty"`
-	TargetPath    string                 `protobuf:"bytes,3,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	Id            *JasperProcessID       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Streams       []string               `protobuf:"bytes,3,rep,name=streams,proto3" json:"streams,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArchiveOptions) Reset() {
-	*x = ArchiveOptions{}
-	mi := &file_jasper_proto_msgTypes[22]
+func (x *LogFollowRequest) Reset() {
+	*x = LogFollowRequest{}
+	mi := &file_jasper_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArchiveOptions) String() string {
+func (x *LogFollowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveOptions) ProtoMessage() {}
+func (*LogFollowRequest) ProtoMessage() {}
 
-func (x *ArchiveOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[22]
+func (x *LogFollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1826,56 +4446,57 @@ func (x *ArchiveOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveOptions.ProtoReflect.Descriptor instead.
-func (*ArchiveOptions) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use LogFollowRequest.ProtoReflect.Descriptor instead.
+func (*LogFollowRequest) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ArchiveOptions) GetShouldExtract() bool {
+func (x *LogFollowRequest) GetId() *JasperProcessID {
 	if x != nil {
-		return x.ShouldExtract
+		return x.Id
 	}
-	return false
+	return nil
 }
 
-func (x *ArchiveOptions) GetFormat() ArchiveFormat {
+func (x *LogFollowRequest) GetOffset() int64 {
 	if x != nil {
-		return x.Format
+		return x.Offset
 	}
-	return ArchiveFormat_ARCHIVEUNKNOWN
+	return 0
 }
 
-func (x *ArchiveOptions) GetTargetPath() string {
+func (x *LogFollowRequest) GetStreams() []string {
 	if x != nil {
-		return x.TargetPath
+		return x.Streams
 	}
-	return ""
+	return nil
 }
 
-type DownloadInfo struct {
+type LogLine struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	ArchiveOpts   *ArchiveOptions        `protobuf:"bytes,3,opt,name=archive_opts,json=archiveOpts,proto3" json:"archive_opts,omitempty"`
+	Offset        int64                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Stream        string                 `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	Line          string                 `protobuf:"bytes,3,opt,name=line,proto3" json:"line,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadInfo) Reset() {
-	*x = DownloadInfo{}
-	mi := &file_jasper_proto_msgTypes[23]
+func (x *LogLine) Reset() {
+	*x = LogLine{}
+	mi := &file_jasper_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadInfo) String() string {
+func (x *LogLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadInfo) ProtoMessage() {}
+func (*LogLine) ProtoMessage() {}
 
-func (x *DownloadInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[23]
+func (x *LogLine) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1886,57 +4507,62 @@ func (x *DownloadInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadInfo.ProtoReflect.Descriptor instead.
-func (*DownloadInfo) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use LogLine.ProtoReflect.Descriptor instead.
+func (*LogLine) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *DownloadInfo) GetUrl() string {
+func (x *LogLine) GetOffset() int64 {
 	if x != nil {
-		return x.Url
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *LogLine) GetStream() string {
+	if x != nil {
+		return x.Stream
 	}
 	return ""
 }
 
-func (x *DownloadInfo) GetPath() string {
+func (x *LogLine) GetLine() string {
 	if x != nil {
-		return x.Path
+		return x.Line
 	}
 	return ""
 }
 
-func (x *DownloadInfo) GetArchiveOpts() *ArchiveOptions {
+func (x *LogLine) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ArchiveOpts
+		return x.Time
 	}
 	return nil
 }
 
-type WriteFileInfo struct {
+type ExecWindowSize struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Append        bool                   `protobuf:"varint,4,opt,name=append,proto3" json:"append,omitempty"`
-	Perm          uint32                 `protobuf:"varint,3,opt,name=perm,proto3" json:"perm,omitempty"`
+	Rows          uint32                 `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Cols          uint32                 `protobuf:"varint,2,opt,name=cols,proto3" json:"cols,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WriteFileInfo) Reset() {
-	*x = WriteFileInfo{}
-	mi := &file_jasper_proto_msgTypes[24]
+func (x *ExecWindowSize) Reset() {
+	*x = ExecWindowSize{}
+	mi := &file_jasper_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WriteFileInfo) String() string {
+func (x *ExecWindowSize) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteFileInfo) ProtoMessage() {}
+func (*ExecWindowSize) ProtoMessage() {}
 
-func (x *WriteFileInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[24]
+func (x *ExecWindowSize) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1947,61 +4573,49 @@ func (x *WriteFileInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteFileInfo.ProtoReflect.Descriptor instead.
-func (*WriteFileInfo) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *WriteFileInfo) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *WriteFileInfo) GetContent() []byte {
-	if x != nil {
-		return x.Content
-	}
-	return nil
+// Deprecated: Use ExecWindowSize.ProtoReflect.Descriptor instead.
+func (*ExecWindowSize) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *WriteFileInfo) GetAppend() bool {
+func (x *ExecWindowSize) GetRows() uint32 {
 	if x != nil {
-		return x.Append
+		return x.Rows
 	}
-	return false
+	return 0
 }
 
-func (x *WriteFileInfo) GetPerm() uint32 {
+func (x *ExecWindowSize) GetCols() uint32 {
 	if x != nil {
-		return x.Perm
+		return x.Cols
 	}
 	return 0
 }
 
-type BuildloggerURLs struct {
+type ExecOptions struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Urls          []string               `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	Create        *CreateOptions         `protobuf:"bytes,1,opt,name=create,proto3" json:"create,omitempty"`
+	Tty           bool                   `protobuf:"varint,2,opt,name=tty,proto3" json:"tty,omitempty"`
+	WindowSize    *ExecWindowSize        `protobuf:"bytes,3,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildloggerURLs) Reset() {
-	*x = BuildloggerURLs{}
-	mi := &file_jasper_proto_msgTypes[25]
+func (x *ExecOptions) Reset() {
+	*x = ExecOptions{}
+	mi := &file_jasper_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildloggerURLs) String() string {
+func (x *ExecOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildloggerURLs) ProtoMessage() {}
+func (*ExecOptions) ProtoMessage() {}
 
-func (x *BuildloggerURLs) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[25]
+func (x *ExecOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2012,41 +4626,57 @@ func (x *BuildloggerURLs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildloggerURLs.ProtoReflect.Descriptor instead.
-func (*BuildloggerURLs) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ExecOptions.ProtoReflect.Descriptor instead.
+func (*ExecOptions) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *BuildloggerURLs) GetUrls() []string {
+func (x *ExecOptions) GetCreate() *CreateOptions {
 	if x != nil {
-		return x.Urls
+		return x.Create
 	}
 	return nil
 }
 
-type LogRequest struct {
+func (x *ExecOptions) GetTty() bool {
+	if x != nil {
+		return x.Tty
+	}
+	return false
+}
+
+func (x *ExecOptions) GetWindowSize() *ExecWindowSize {
+	if x != nil {
+		return x.WindowSize
+	}
+	return nil
+}
+
+type ExecInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *JasperProcessID       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Options       *ExecOptions           `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	Stdin         []byte                 `protobuf:"bytes,2,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	CloseStdin    bool                   `protobuf:"varint,3,opt,name=close_stdin,json=closeStdin,proto3" json:"close_stdin,omitempty"`
+	Resize        *ExecWindowSize        `protobuf:"bytes,4,opt,name=resize,proto3" json:"resize,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogRequest) Reset() {
-	*x = LogRequest{}
-	mi := &file_jasper_proto_msgTypes[26]
+func (x *ExecInput) Reset() {
+	*x = ExecInput{}
+	mi := &file_jasper_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogRequest) String() string {
+func (x *ExecInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogRequest) ProtoMessage() {}
+func (*ExecInput) ProtoMessage() {}
 
-func (x *LogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[26]
+func (x *ExecInput) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2057,48 +4687,66 @@ func (x *LogRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogRequest.ProtoReflect.Descriptor instead.
-func (*LogRequest) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ExecInput.ProtoReflect.Descriptor instead.
+func (*ExecInput) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *LogRequest) GetId() *JasperProcessID {
+func (x *ExecInput) GetOptions() *ExecOptions {
 	if x != nil {
-		return x.Id
+		return x.Options
 	}
 	return nil
 }
 
-func (x *LogRequest) GetCount() int64 {
+func (x *ExecInput) GetStdin() []byte {
 	if x != nil {
-		return x.Count
+		return x.Stdin
 	}
-	return 0
+	return nil
 }
 
-type LogStream struct {
+func (x *ExecInput) GetCloseStdin() bool {
+	if x != nil {
+		return x.CloseStdin
+	}
+	return false
+}
+
+func (x *ExecInput) GetResize() *ExecWindowSize {
+	if x != nil {
+		return x.Resize
+	}
+	return nil
+}
+
+type ExecOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Logs          []string               `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
-	Done          bool                   `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Stdout        []byte                 `protobuf:"bytes,2,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr        []byte                 `protobuf:"bytes,3,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	Exited        bool                   `protobuf:"varint,4,opt,name=exited,proto3" json:"exited,omitempty"`
+	ExitCode      int32                  `protobuf:"varint,5,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogStream) Reset() {
-	*x = LogStream{}
-	mi := &file_jasper_proto_msgTypes[27]
+func (x *ExecOutput) Reset() {
+	*x = ExecOutput{}
+	mi := &file_jasper_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogStream) String() string {
+func (x *ExecOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogStream) ProtoMessage() {}
+func (*ExecOutput) ProtoMessage() {}
 
-func (x *LogStream) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[27]
+func (x *ExecOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2109,25 +4757,53 @@ func (x *LogStream) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogStream.ProtoReflect.Descriptor instead.
-func (*LogStream) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ExecOutput.ProtoReflect.Descriptor instead.
+func (*ExecOutput) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *LogStream) GetLogs() []string {
+func (x *ExecOutput) GetId() string {
 	if x != nil {
-		return x.Logs
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExecOutput) GetStdout() []byte {
+	if x != nil {
+		return x.Stdout
 	}
 	return nil
 }
 
-func (x *LogStream) GetDone() bool {
+func (x *ExecOutput) GetStderr() []byte {
 	if x != nil {
-		return x.Done
+		return x.Stderr
+	}
+	return nil
+}
+
+func (x *ExecOutput) GetExited() bool {
+	if x != nil {
+		return x.Exited
 	}
 	return false
 }
 
+func (x *ExecOutput) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ExecOutput) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type SignalTriggerParams struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ProcessID       *JasperProcessID       `protobuf:"bytes,1,opt,name=processID,proto3" json:"processID,omitempty"`
@@ -2138,7 +4814,7 @@ type SignalTriggerParams struct {
 
 func (x *SignalTriggerParams) Reset() {
 	*x = SignalTriggerParams{}
-	mi := &file_jasper_proto_msgTypes[28]
+	mi := &file_jasper_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2150,7 +4826,7 @@ func (x *SignalTriggerParams) String() string {
 func (*SignalTriggerParams) ProtoMessage() {}
 
 func (x *SignalTriggerParams) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[28]
+	mi := &file_jasper_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2163,7 +4839,7 @@ func (x *SignalTriggerParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignalTriggerParams.ProtoReflect.Descriptor instead.
 func (*SignalTriggerParams) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{28}
+	return file_jasper_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *SignalTriggerParams) GetProcessID() *JasperProcessID {
@@ -2180,6 +4856,66 @@ func (x *SignalTriggerParams) GetSignalTriggerID() SignalTriggerID {
 	return SignalTriggerID_NONE
 }
 
+type ProcessTriggerParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProcessID     *JasperProcessID       `protobuf:"bytes,1,opt,name=processID,proto3" json:"processID,omitempty"`
+	TriggerID     string                 `protobuf:"bytes,2,opt,name=triggerID,proto3" json:"triggerID,omitempty"`
+	Params        map[string]string      `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessTriggerParams) Reset() {
+	*x = ProcessTriggerParams{}
+	mi := &file_jasper_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessTriggerParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessTriggerParams) ProtoMessage() {}
+
+func (x *ProcessTriggerParams) ProtoReflect() protoreflect.Message {
+	mi := &file_jasper_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessTriggerParams.ProtoReflect.Descriptor instead.
+func (*ProcessTriggerParams) Descriptor() ([]byte, []int) {
+	return file_jasper_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ProcessTriggerParams) GetProcessID() *JasperProcessID {
+	if x != nil {
+		return x.ProcessID
+	}
+	return nil
+}
+
+func (x *ProcessTriggerParams) GetTriggerID() string {
+	if x != nil {
+		return x.TriggerID
+	}
+	return ""
+}
+
+func (x *ProcessTriggerParams) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
 type EventName struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
@@ -2189,7 +4925,7 @@ type EventName struct {
 
 func (x *EventName) Reset() {
 	*x = EventName{}
-	mi := &file_jasper_proto_msgTypes[29]
+	mi := &file_jasper_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2201,7 +4937,7 @@ func (x *EventName) String() string {
 func (*EventName) ProtoMessage() {}
 
 func (x *EventName) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[29]
+	mi := &file_jasper_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2214,7 +4950,7 @@ func (x *EventName) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventName.ProtoReflect.Descriptor instead.
 func (*EventName) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{29}
+	return file_jasper_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *EventName) GetValue() string {
@@ -2234,7 +4970,7 @@ type ScriptingHarnessID struct {
 
 func (x *ScriptingHarnessID) Reset() {
 	*x = ScriptingHarnessID{}
-	mi := &file_jasper_proto_msgTypes[30]
+	mi := &file_jasper_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2246,7 +4982,7 @@ func (x *ScriptingHarnessID) String() string {
 func (*ScriptingHarnessID) ProtoMessage() {}
 
 func (x *ScriptingHarnessID) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[30]
+	mi := &file_jasper_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2259,7 +4995,7 @@ func (x *ScriptingHarnessID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScriptingHarnessID.ProtoReflect.Descriptor instead.
 func (*ScriptingHarnessID) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{30}
+	return file_jasper_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *ScriptingHarnessID) GetId() string {
@@ -2289,7 +5025,7 @@ type ScriptingOptionsGolang struct {
 
 func (x *ScriptingOptionsGolang) Reset() {
 	*x = ScriptingOptionsGolang{}
-	mi := &file_jasper_proto_msgTypes[31]
+	mi := &file_jasper_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2301,7 +5037,7 @@ func (x *ScriptingOptionsGolang) String() string {
 func (*ScriptingOptionsGolang) ProtoMessage() {}
 
 func (x *ScriptingOptionsGolang) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[31]
+	mi := &file_jasper_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2314,7 +5050,7 @@ func (x *ScriptingOptionsGolang) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScriptingOptionsGolang.ProtoReflect.Descriptor instead.
 func (*ScriptingOptionsGolang) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{31}
+	return file_jasper_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *ScriptingOptionsGolang) GetGopath() string {
@@ -2366,7 +5102,7 @@ type ScriptingOptionsPython struct {
 
 func (x *ScriptingOptionsPython) Reset() {
 	*x = ScriptingOptionsPython{}
-	mi := &file_jasper_proto_msgTypes[32]
+	mi := &file_jasper_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2378,7 +5114,7 @@ func (x *ScriptingOptionsPython) String() string {
 func (*ScriptingOptionsPython) ProtoMessage() {}
 
 func (x *ScriptingOptionsPython) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[32]
+	mi := &file_jasper_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2391,7 +5127,7 @@ func (x *ScriptingOptionsPython) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScriptingOptionsPython.ProtoReflect.Descriptor instead.
 func (*ScriptingOptionsPython) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{32}
+	return file_jasper_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *ScriptingOptionsPython) GetVirtualEnvPath() string {
@@ -2447,7 +5183,7 @@ type ScriptingOptionsRoswell struct {
 
 func (x *ScriptingOptionsRoswell) Reset() {
 	*x = ScriptingOptionsRoswell{}
-	mi := &file_jasper_proto_msgTypes[33]
+	mi := &file_jasper_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2459,7 +5195,7 @@ func (x *ScriptingOptionsRoswell) String() string {
 func (*ScriptingOptionsRoswell) ProtoMessage() {}
 
 func (x *ScriptingOptionsRoswell) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[33]
+	mi := &file_jasper_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2472,7 +5208,7 @@ func (x *ScriptingOptionsRoswell) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScriptingOptionsRoswell.ProtoReflect.Descriptor instead.
 func (*ScriptingOptionsRoswell) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{33}
+	return file_jasper_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *ScriptingOptionsRoswell) GetPath() string {
@@ -2513,7 +5249,7 @@ type ScriptingOptions struct {
 
 func (x *ScriptingOptions) Reset() {
 	*x = ScriptingOptions{}
-	mi := &file_jasper_proto_msgTypes[34]
+	mi := &file_jasper_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2525,7 +5261,7 @@ func (x *ScriptingOptions) String() string {
 func (*ScriptingOptions) ProtoMessage() {}
 
 func (x *ScriptingOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[34]
+	mi := &file_jasper_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2538,7 +5274,7 @@ func (x *ScriptingOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScriptingOptions.ProtoReflect.Descriptor instead.
 func (*ScriptingOptions) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{34}
+	return file_jasper_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *ScriptingOptions) GetValue() isScriptingOptions_Value {
@@ -2628,7 +5364,7 @@ type ScriptingHarnessRunArgs struct {
 
 func (x *ScriptingHarnessRunArgs) Reset() {
 	*x = ScriptingHarnessRunArgs{}
-	mi := &file_jasper_proto_msgTypes[35]
+	mi := &file_jasper_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2640,7 +5376,7 @@ func (x *ScriptingHarnessRunArgs) String() string {
 func (*ScriptingHarnessRunArgs) ProtoMessage() {}
 
 func (x *ScriptingHarnessRunArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[35]
+	mi := &file_jasper_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2653,7 +5389,7 @@ func (x *ScriptingHarnessRunArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScriptingHarnessRunArgs.ProtoReflect.Descriptor instead.
 func (*ScriptingHarnessRunArgs) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{35}
+	return file_jasper_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *ScriptingHarnessRunArgs) GetId() string {
@@ -2681,7 +5417,7 @@ type ScriptingHarnessBuildArgs struct {
 
 func (x *ScriptingHarnessBuildArgs) Reset() {
 	*x = ScriptingHarnessBuildArgs{}
-	mi := &file_jasper_proto_msgTypes[36]
+	mi := &file_jasper_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2693,7 +5429,7 @@ func (x *ScriptingHarnessBuildArgs) String() string {
 func (*ScriptingHarnessBuildArgs) ProtoMessage() {}
 
 func (x *ScriptingHarnessBuildArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[36]
+	mi := &file_jasper_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2706,7 +5442,7 @@ func (x *ScriptingHarnessBuildArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScriptingHarnessBuildArgs.ProtoReflect.Descriptor instead.
 func (*ScriptingHarnessBuildArgs) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{36}
+	return file_jasper_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *ScriptingHarnessBuildArgs) GetId() string {
@@ -2740,7 +5476,7 @@ type ScriptingHarnessBuildResponse struct {
 
 func (x *ScriptingHarnessBuildResponse) Reset() {
 	*x = ScriptingHarnessBuildResponse{}
-	mi := &file_jasper_proto_msgTypes[37]
+	mi := &file_jasper_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2752,7 +5488,7 @@ func (x *ScriptingHarnessBuildResponse) String() string {
 func (*ScriptingHarnessBuildResponse) ProtoMessage() {}
 
 func (x *ScriptingHarnessBuildResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[37]
+	mi := &file_jasper_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2765,7 +5501,7 @@ func (x *ScriptingHarnessBuildResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScriptingHarnessBuildResponse.ProtoReflect.Descriptor instead.
 func (*ScriptingHarnessBuildResponse) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{37}
+	return file_jasper_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *ScriptingHarnessBuildResponse) GetOutcome() *OperationOutcome {
@@ -2792,7 +5528,7 @@ type ScriptingHarnessRunScriptArgs struct {
 
 func (x *ScriptingHarnessRunScriptArgs) Reset() {
 	*x = ScriptingHarnessRunScriptArgs{}
-	mi := &file_jasper_proto_msgTypes[38]
+	mi := &file_jasper_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2804,7 +5540,7 @@ func (x *ScriptingHarnessRunScriptArgs) String() string {
 func (*ScriptingHarnessRunScriptArgs) ProtoMessage() {}
 
 func (x *ScriptingHarnessRunScriptArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[38]
+	mi := &file_jasper_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2817,7 +5553,7 @@ func (x *ScriptingHarnessRunScriptArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScriptingHarnessRunScriptArgs.ProtoReflect.Descriptor instead.
 func (*ScriptingHarnessRunScriptArgs) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{38}
+	return file_jasper_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *ScriptingHarnessRunScriptArgs) GetId() string {
@@ -2845,7 +5581,7 @@ type ScriptingHarnessTestArgs struct {
 
 func (x *ScriptingHarnessTestArgs) Reset() {
 	*x = ScriptingHarnessTestArgs{}
-	mi := &file_jasper_proto_msgTypes[39]
+	mi := &file_jasper_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2857,7 +5593,7 @@ func (x *ScriptingHarnessTestArgs) String() string {
 func (*ScriptingHarnessTestArgs) ProtoMessage() {}
 
 func (x *ScriptingHarnessTestArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[39]
+	mi := &file_jasper_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2870,7 +5606,7 @@ func (x *ScriptingHarnessTestArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScriptingHarnessTestArgs.ProtoReflect.Descriptor instead.
 func (*ScriptingHarnessTestArgs) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{39}
+	return file_jasper_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *ScriptingHarnessTestArgs) GetId() string {
@@ -2907,7 +5643,7 @@ type ScriptingHarnessTestOptions struct {
 
 func (x *ScriptingHarnessTestOptions) Reset() {
 	*x = ScriptingHarnessTestOptions{}
-	mi := &file_jasper_proto_msgTypes[40]
+	mi := &file_jasper_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2919,7 +5655,7 @@ func (x *ScriptingHarnessTestOptions) String() string {
 func (*ScriptingHarnessTestOptions) ProtoMessage() {}
 
 func (x *ScriptingHarnessTestOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[40]
+	mi := &file_jasper_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2932,7 +5668,7 @@ func (x *ScriptingHarnessTestOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScriptingHarnessTestOptions.ProtoReflect.Descriptor instead.
 func (*ScriptingHarnessTestOptions) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{40}
+	return file_jasper_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *ScriptingHarnessTestOptions) GetName() string {
@@ -2982,7 +5718,7 @@ type ScriptingHarnessTestResult struct {
 
 func (x *ScriptingHarnessTestResult) Reset() {
 	*x = ScriptingHarnessTestResult{}
-	mi := &file_jasper_proto_msgTypes[41]
+	mi := &file_jasper_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2994,7 +5730,7 @@ func (x *ScriptingHarnessTestResult) String() string {
 func (*ScriptingHarnessTestResult) ProtoMessage() {}
 
 func (x *ScriptingHarnessTestResult) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[41]
+	mi := &file_jasper_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3007,7 +5743,7 @@ func (x *ScriptingHarnessTestResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScriptingHarnessTestResult.ProtoReflect.Descriptor instead.
 func (*ScriptingHarnessTestResult) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{41}
+	return file_jasper_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *ScriptingHarnessTestResult) GetName() string {
@@ -3048,7 +5784,7 @@ type ScriptingHarnessTestResponse struct {
 
 func (x *ScriptingHarnessTestResponse) Reset() {
 	*x = ScriptingHarnessTestResponse{}
-	mi := &file_jasper_proto_msgTypes[42]
+	mi := &file_jasper_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3060,7 +5796,7 @@ func (x *ScriptingHarnessTestResponse) String() string {
 func (*ScriptingHarnessTestResponse) ProtoMessage() {}
 
 func (x *ScriptingHarnessTestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[42]
+	mi := &file_jasper_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3073,7 +5809,7 @@ func (x *ScriptingHarnessTestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScriptingHarnessTestResponse.ProtoReflect.Descriptor instead.
 func (*ScriptingHarnessTestResponse) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{42}
+	return file_jasper_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *ScriptingHarnessTestResponse) GetOutcome() *OperationOutcome {
@@ -3100,7 +5836,7 @@ type LoggingCacheCreateArgs struct {
 
 func (x *LoggingCacheCreateArgs) Reset() {
 	*x = LoggingCacheCreateArgs{}
-	mi := &file_jasper_proto_msgTypes[43]
+	mi := &file_jasper_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3112,7 +5848,7 @@ func (x *LoggingCacheCreateArgs) String() string {
 func (*LoggingCacheCreateArgs) ProtoMessage() {}
 
 func (x *LoggingCacheCreateArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[43]
+	mi := &file_jasper_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3125,7 +5861,7 @@ func (x *LoggingCacheCreateArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoggingCacheCreateArgs.ProtoReflect.Descriptor instead.
 func (*LoggingCacheCreateArgs) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{43}
+	return file_jasper_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *LoggingCacheCreateArgs) GetName() string {
@@ -3151,7 +5887,7 @@ type LoggingCacheArgs struct {
 
 func (x *LoggingCacheArgs) Reset() {
 	*x = LoggingCacheArgs{}
-	mi := &file_jasper_proto_msgTypes[44]
+	mi := &file_jasper_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3163,7 +5899,7 @@ func (x *LoggingCacheArgs) String() string {
 func (*LoggingCacheArgs) ProtoMessage() {}
 
 func (x *LoggingCacheArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[44]
+	mi := &file_jasper_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3176,7 +5912,7 @@ func (x *LoggingCacheArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoggingCacheArgs.ProtoReflect.Descriptor instead.
 func (*LoggingCacheArgs) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{44}
+	return file_jasper_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *LoggingCacheArgs) GetName() string {
@@ -3198,7 +5934,7 @@ type LoggingCacheInstance struct {
 
 func (x *LoggingCacheInstance) Reset() {
 	*x = LoggingCacheInstance{}
-	mi := &file_jasper_proto_msgTypes[45]
+	mi := &file_jasper_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3210,7 +5946,7 @@ func (x *LoggingCacheInstance) String() string {
 func (*LoggingCacheInstance) ProtoMessage() {}
 
 func (x *LoggingCacheInstance) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[45]
+	mi := &file_jasper_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3223,7 +5959,7 @@ func (x *LoggingCacheInstance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoggingCacheInstance.ProtoReflect.Descriptor instead.
 func (*LoggingCacheInstance) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{45}
+	return file_jasper_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *LoggingCacheInstance) GetOutcome() *OperationOutcome {
@@ -3265,7 +6001,7 @@ type LoggingCacheSize struct {
 
 func (x *LoggingCacheSize) Reset() {
 	*x = LoggingCacheSize{}
-	mi := &file_jasper_proto_msgTypes[46]
+	mi := &file_jasper_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3277,7 +6013,7 @@ func (x *LoggingCacheSize) String() string {
 func (*LoggingCacheSize) ProtoMessage() {}
 
 func (x *LoggingCacheSize) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[46]
+	mi := &file_jasper_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3290,7 +6026,7 @@ func (x *LoggingCacheSize) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoggingCacheSize.ProtoReflect.Descriptor instead.
 func (*LoggingCacheSize) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{46}
+	return file_jasper_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *LoggingCacheSize) GetOutcome() *OperationOutcome {
@@ -3327,7 +6063,7 @@ type LoggingPayloadData struct {
 
 func (x *LoggingPayloadData) Reset() {
 	*x = LoggingPayloadData{}
-	mi := &file_jasper_proto_msgTypes[47]
+	mi := &file_jasper_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3339,7 +6075,7 @@ func (x *LoggingPayloadData) String() string {
 func (*LoggingPayloadData) ProtoMessage() {}
 
 func (x *LoggingPayloadData) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[47]
+	mi := &file_jasper_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3352,7 +6088,7 @@ func (x *LoggingPayloadData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoggingPayloadData.ProtoReflect.Descriptor instead.
 func (*LoggingPayloadData) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{47}
+	return file_jasper_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *LoggingPayloadData) GetData() isLoggingPayloadData_Data {
@@ -3411,7 +6147,7 @@ type LoggingPayload struct {
 
 func (x *LoggingPayload) Reset() {
 	*x = LoggingPayload{}
-	mi := &file_jasper_proto_msgTypes[48]
+	mi := &file_jasper_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3423,7 +6159,7 @@ func (x *LoggingPayload) String() string {
 func (*LoggingPayload) ProtoMessage() {}
 
 func (x *LoggingPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_jasper_proto_msgTypes[48]
+	mi := &file_jasper_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3436,7 +6172,7 @@ func (x *LoggingPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoggingPayload.ProtoReflect.Descriptor instead.
 func (*LoggingPayload) Descriptor() ([]byte, []int) {
-	return file_jasper_proto_rawDescGZIP(), []int{48}
+	return file_jasper_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *LoggingPayload) GetLoggerID() string {
@@ -3541,7 +6277,7 @@ const file_jasper_proto_rawDesc = "" +
 	"\x0fsuppress_output\x18\x02 \x01(\bR\x0esuppressOutput\x12%\n" +
 	"\x0esuppress_error\x18\x03 \x01(\bR\rsuppressError\x127\n" +
 	"\x18redirect_output_to_error\x18\x04 \x01(\bR\x15redirectOutputToError\x127\n" +
-	"\x18redirect_error_to_output\x18\x05 \x01(\bR\x15redirectErrorToOutput\"\xc5\x04\n" +
+	"\x18redirect_error_to_output\x18\x05 \x01(\bR\x15redirectErrorToOutput\"\xe4\x06\n" +
 	"\rCreateOptions\x12\x12\n" +
 	"\x04args\x18\x01 \x03(\tR\x04args\x12+\n" +
 	"\x11working_directory\x18\x02 \x01(\tR\x10workingDirectory\x12H\n" +
@@ -3557,13 +6293,51 @@ const file_jasper_proto_rawDesc = "" +
 	"on_timeout\x18\t \x03(\v2\x15.jasper.CreateOptionsR\tonTimeout\x12-\n" +
 	"\x06output\x18\n" +
 	" \x01(\v2\x15.jasper.OutputOptionsR\x06output\x120\n" +
-	"\x14standard_input_bytes\x18\v \x01(\fR\x12standardInputBytes\x1a>\n" +
+	"\x14standard_input_bytes\x18\v \x01(\fR\x12standardInputBytes\x12=\n" +
+	"\fidle_timeout\x18\f \x01(\v2\x1a.jasper.IdleTimeoutOptionsR\vidleTimeout\x12>\n" +
+	"\x0foutput_triggers\x18\r \x03(\v2\x15.jasper.OutputTriggerR\x0eoutputTriggers\x126\n" +
+	"\treadiness\x18\x0e \x01(\v2\x18.jasper.ReadinessOptionsR\treadiness\x123\n" +
+	"\bliveness\x18\x0f \x01(\v2\x17.jasper.LivenessOptionsR\bliveness\x121\n" +
+	"\bpipeline\x18\x10 \x03(\v2\x15.jasper.CreateOptionsR\bpipeline\x1a>\n" +
 	"\x10EnvironmentEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\"\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf2\x01\n" +
+	"\rOutputTrigger\x12\x18\n" +
+	"\apattern\x18\x01 \x01(\tR\apattern\x12\x16\n" +
+	"\x06stream\x18\x02 \x01(\tR\x06stream\x12\x12\n" +
+	"\x04once\x18\x03 \x01(\bR\x04once\x12\x12\n" +
+	"\x04fail\x18\x04 \x01(\bR\x04fail\x12\x10\n" +
+	"\x03tag\x18\x05 \x01(\tR\x03tag\x12\x1d\n" +
+	"\n" +
+	"trigger_id\x18\x06 \x01(\tR\ttriggerId\x12-\n" +
+	"\x06create\x18\a \x01(\v2\x15.jasper.CreateOptionsR\x06create\x12'\n" +
+	"\x06signal\x18\b \x01(\x0e2\x0f.jasper.SignalsR\x06signal\"\xa8\x01\n" +
+	"\x12IdleTimeoutOptions\x125\n" +
+	"\bduration\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x1d\n" +
+	"\n" +
+	"stack_dump\x18\x02 \x01(\bR\tstackDump\x12<\n" +
+	"\fgrace_period\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vgracePeriod\"\x90\x02\n" +
+	"\x10ReadinessOptions\x12\x10\n" +
+	"\x03tcp\x18\x01 \x01(\tR\x03tcp\x12\x12\n" +
+	"\x04http\x18\x02 \x01(\tR\x04http\x12%\n" +
+	"\x0eoutput_pattern\x18\x03 \x01(\tR\routputPattern\x12\x12\n" +
+	"\x04file\x18\x04 \x01(\tR\x04file\x12/\n" +
+	"\acommand\x18\x05 \x01(\v2\x15.jasper.CreateOptionsR\acommand\x125\n" +
+	"\binterval\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\binterval\x123\n" +
+	"\atimeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xa2\x03\n" +
+	"\x0fLivenessOptions\x12\x10\n" +
+	"\x03tcp\x18\x01 \x01(\tR\x03tcp\x12\x12\n" +
+	"\x04http\x18\x02 \x01(\tR\x04http\x12/\n" +
+	"\acommand\x18\x03 \x01(\v2\x15.jasper.CreateOptionsR\acommand\x12>\n" +
+	"\rinitial_delay\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\finitialDelay\x125\n" +
+	"\binterval\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\binterval\x123\n" +
+	"\atimeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12+\n" +
+	"\x11failure_threshold\x18\a \x01(\x03R\x10failureThreshold\x12<\n" +
+	"\fgrace_period\x18\b \x01(\v2\x19.google.protobuf.DurationR\vgracePeriod\x12!\n" +
+	"\fmax_restarts\x18\t \x01(\x03R\vmaxRestarts\"\"\n" +
 	"\n" +
 	"IDResponse\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"\xf2\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"\xc3\x04\n" +
 	"\vProcessInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
 	"\x03pid\x18\x02 \x01(\x03R\x03pid\x12\x17\n" +
@@ -3578,7 +6352,32 @@ const file_jasper_proto_rawDesc = "" +
 	"\texit_code\x18\t \x01(\x05R\bexitCode\x125\n" +
 	"\bstart_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\astartAt\x121\n" +
-	"\x06end_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\"A\n" +
+	"\x06end_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x05endAt\x12%\n" +
+	"\x0etimeout_reason\x18\f \x01(\tR\rtimeoutReason\x12\x14\n" +
+	"\x05ready\x18\r \x01(\bR\x05ready\x120\n" +
+	"\bliveness\x18\x0e \x01(\v2\x14.jasper.LivenessInfoR\bliveness\x123\n" +
+	"\n" +
+	"follow_ups\x18\x0f \x03(\v2\x14.jasper.FollowUpInfoR\tfollowUps\x12+\n" +
+	"\x06stages\x18\x10 \x03(\v2\x13.jasper.ProcessInfoR\x06stages\"\xa7\x01\n" +
+	"\fFollowUpInfo\x12\x18\n" +
+	"\atrigger\x18\x01 \x01(\tR\atrigger\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1a\n" +
+	"\bcomplete\x18\x04 \x01(\bR\bcomplete\x12\x1e\n" +
+	"\n" +
+	"successful\x18\x05 \x01(\bR\n" +
+	"successful\x12\x1b\n" +
+	"\texit_code\x18\x06 \x01(\x05R\bexitCode\"m\n" +
+	"\rLivenessCheck\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xd6\x01\n" +
+	"\fLivenessInfo\x12-\n" +
+	"\x06checks\x18\x01 \x03(\v2\x15.jasper.LivenessCheckR\x06checks\x121\n" +
+	"\x14consecutive_failures\x18\x02 \x01(\x03R\x13consecutiveFailures\x12\x1a\n" +
+	"\brestarts\x18\x03 \x01(\x03R\brestarts\x12%\n" +
+	"\x0erestarted_from\x18\x04 \x01(\tR\rrestartedFrom\x12!\n" +
+	"\frestarted_as\x18\x05 \x01(\tR\vrestartedAs\"A\n" +
 	"\x0eStatusResponse\x12\x17\n" +
 	"\ahost_id\x18\x01 \x01(\tR\x06hostId\x12\x16\n" +
 	"\x06active\x18\x02 \x01(\bR\x06active\":\n" +
@@ -3597,21 +6396,149 @@ const file_jasper_proto_rawDesc = "" +
 	"\x10OperationOutcome\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x12\n" +
 	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1b\n" +
-	"\texit_code\x18\x03 \x01(\x05R\bexitCode\"\x87\x01\n" +
+	"\texit_code\x18\x03 \x01(\x05R\bexitCode\"\xb2\x01\n" +
 	"\x0eArchiveOptions\x12%\n" +
 	"\x0eshould_extract\x18\x01 \x01(\bR\rshouldExtract\x12-\n" +
 	"\x06format\x18\x02 \x01(\x0e2\x15.jasper.ArchiveFormatR\x06format\x12\x1f\n" +
 	"\vtarget_path\x18\x03 \x01(\tR\n" +
-	"targetPath\"o\n" +
+	"targetPath\x12)\n" +
+	"\x10strip_components\x18\x04 \x01(\x03R\x0fstripComponents\"H\n" +
+	"\x10DownloadChecksum\x12\x1c\n" +
+	"\talgorithm\x18\x01 \x01(\tR\talgorithm\x12\x16\n" +
+	"\x06digest\x18\x02 \x01(\tR\x06digest\"\xa3\x01\n" +
+	"\rDownloadRetry\x12!\n" +
+	"\fmax_attempts\x18\x01 \x01(\x03R\vmaxAttempts\x123\n" +
+	"\abackoff\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\abackoff\x12:\n" +
+	"\vmax_backoff\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"maxBackoff\"\xb8\x03\n" +
 	"\fDownloadInfo\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x129\n" +
-	"\farchive_opts\x18\x03 \x01(\v2\x16.jasper.ArchiveOptionsR\varchiveOpts\"i\n" +
+	"\farchive_opts\x18\x03 \x01(\v2\x16.jasper.ArchiveOptionsR\varchiveOpts\x124\n" +
+	"\bchecksum\x18\x04 \x01(\v2\x18.jasper.DownloadChecksumR\bchecksum\x12;\n" +
+	"\aheaders\x18\x05 \x03(\v2!.jasper.DownloadInfo.HeadersEntryR\aheaders\x12\x1a\n" +
+	"\busername\x18\x06 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\a \x01(\tR\bpassword\x12\x16\n" +
+	"\x06resume\x18\b \x01(\bR\x06resume\x12+\n" +
+	"\x05retry\x18\t \x01(\v2\x15.jasper.DownloadRetryR\x05retry\x12\x1b\n" +
+	"\tuse_cache\x18\n" +
+	" \x01(\bR\buseCache\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xdf\x01\n" +
+	"\x12DownloadCacheStats\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1c\n" +
+	"\tdirectory\x18\x02 \x01(\tR\tdirectory\x12\x18\n" +
+	"\aentries\x18\x03 \x01(\x03R\aentries\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x19\n" +
+	"\bmax_size\x18\x05 \x01(\x03R\amaxSize\x12\x12\n" +
+	"\x04hits\x18\x06 \x01(\x03R\x04hits\x12\x16\n" +
+	"\x06misses\x18\a \x01(\x03R\x06misses\x12\x1c\n" +
+	"\tevictions\x18\b \x01(\x03R\tevictions\"\x9f\x01\n" +
+	"\fDrainOptions\x123\n" +
+	"\atimeout\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12\x1c\n" +
+	"\tterminate\x18\x02 \x01(\bR\tterminate\x12<\n" +
+	"\fgrace_period\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vgracePeriod\"}\n" +
+	"\vDrainReport\x12\x1c\n" +
+	"\tcompleted\x18\x01 \x03(\tR\tcompleted\x12\x1e\n" +
+	"\n" +
+	"terminated\x18\x02 \x03(\tR\n" +
+	"terminated\x12\x16\n" +
+	"\x06killed\x18\x03 \x03(\tR\x06killed\x12\x18\n" +
+	"\arunning\x18\x04 \x03(\tR\arunning\"N\n" +
+	"\x0fProcessSnapshot\x12'\n" +
+	"\x04info\x18\x01 \x01(\v2\x13.jasper.ProcessInfoR\x04info\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"\xf6\x02\n" +
+	"\bSnapshot\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1d\n" +
+	"\n" +
+	"manager_id\x18\x02 \x01(\tR\tmanagerId\x12\x1a\n" +
+	"\bhostname\x18\x03 \x01(\tR\bhostname\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x125\n" +
+	"\tprocesses\x18\x05 \x03(\v2\x17.jasper.ProcessSnapshotR\tprocesses\x12,\n" +
+	"\x12signal_trigger_ids\x18\x06 \x03(\tR\x10signalTriggerIds\x12A\n" +
+	"\rlogging_cache\x18\a \x03(\v2\x1c.jasper.LoggingCacheInstanceR\floggingCache\x122\n" +
+	"\x15scripting_harness_ids\x18\b \x03(\tR\x13scriptingHarnessIds\"\x8b\x02\n" +
+	"\x0fScheduleOptions\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x06create\x18\x02 \x01(\v2\x15.jasper.CreateOptionsR\x06create\x12\x12\n" +
+	"\x04cron\x18\x03 \x01(\tR\x04cron\x125\n" +
+	"\binterval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\binterval\x121\n" +
+	"\x06jitter\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x06jitter\x12\x18\n" +
+	"\aoverlap\x18\x06 \x01(\tR\aoverlap\x12!\n" +
+	"\fhistory_size\x18\a \x01(\x03R\vhistorySize\"\xaf\x02\n" +
+	"\vScheduleRun\x12=\n" +
+	"\fscheduled_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x129\n" +
+	"\n" +
+	"started_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12\x1d\n" +
+	"\n" +
+	"process_id\x18\x03 \x01(\tR\tprocessId\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\bR\askipped\x12\x1a\n" +
+	"\bcomplete\x18\x05 \x01(\bR\bcomplete\x12\x1e\n" +
+	"\n" +
+	"successful\x18\x06 \x01(\bR\n" +
+	"successful\x12\x1b\n" +
+	"\texit_code\x18\a \x01(\x03R\bexitCode\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"\xef\x01\n" +
+	"\fScheduleInfo\x123\n" +
+	"\bschedule\x18\x01 \x01(\v2\x17.jasper.ScheduleOptionsR\bschedule\x125\n" +
+	"\bnext_run\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\anextRun\x12,\n" +
+	"\x12running_process_id\x18\x03 \x01(\tR\x10runningProcessId\x12\x16\n" +
+	"\x06queued\x18\x04 \x01(\x03R\x06queued\x12-\n" +
+	"\ahistory\x18\x05 \x03(\v2\x13.jasper.ScheduleRunR\ahistory\"F\n" +
+	"\x10ScheduleInfoList\x122\n" +
+	"\tschedules\x18\x01 \x03(\v2\x14.jasper.ScheduleInfoR\tschedules\"\"\n" +
+	"\n" +
+	"ScheduleID\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"i\n" +
 	"\rWriteFileInfo\x12\x12\n" +
 	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\fR\acontent\x12\x16\n" +
 	"\x06append\x18\x04 \x01(\bR\x06append\x12\x12\n" +
-	"\x04perm\x18\x03 \x01(\rR\x04perm\"%\n" +
+	"\x04perm\x18\x03 \x01(\rR\x04perm\"\x1e\n" +
+	"\bFilePath\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\"\xa8\x01\n" +
+	"\bFileInfo\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\rR\x04mode\x125\n" +
+	"\bmod_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\amodTime\x12\x15\n" +
+	"\x06is_dir\x18\x06 \x01(\bR\x05isDir\"6\n" +
+	"\fFileInfoList\x12&\n" +
+	"\x05files\x18\x01 \x03(\v2\x10.jasper.FileInfoR\x05files\"$\n" +
+	"\fFilePathList\x12\x14\n" +
+	"\x05paths\x18\x01 \x03(\tR\x05paths\"R\n" +
+	"\fReadFileInfo\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\x03R\x06length\"\x1f\n" +
+	"\tFileChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"E\n" +
+	"\x11ListDirectoryInfo\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1c\n" +
+	"\trecursive\x18\x02 \x01(\bR\trecursive\"B\n" +
+	"\x0eRemoveFileInfo\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1c\n" +
+	"\trecursive\x18\x02 \x01(\bR\trecursive\"F\n" +
+	"\x0eRenameFileInfo\x12\x19\n" +
+	"\bold_path\x18\x01 \x01(\tR\aoldPath\x12\x19\n" +
+	"\bnew_path\x18\x02 \x01(\tR\anewPath\"U\n" +
+	"\x11MakeDirectoryInfo\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04perm\x18\x02 \x01(\rR\x04perm\x12\x18\n" +
+	"\aparents\x18\x03 \x01(\bR\aparents\"D\n" +
+	"\x10FileChecksumInfo\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1c\n" +
+	"\talgorithm\x18\x02 \x01(\tR\talgorithm\"2\n" +
+	"\x14FileChecksumResponse\x12\x1a\n" +
+	"\bchecksum\x18\x01 \x01(\tR\bchecksum\"\x8a\x01\n" +
+	"\x11CreateArchiveInfo\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12-\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x15.jasper.ArchiveFormatR\x06format\x12\x18\n" +
+	"\ainclude\x18\x03 \x03(\tR\ainclude\x12\x18\n" +
+	"\aexclude\x18\x04 \x03(\tR\aexclude\"%\n" +
 	"\x0fBuildloggerURLs\x12\x12\n" +
 	"\x04urls\x18\x01 \x03(\tR\x04urls\"K\n" +
 	"\n" +
@@ -3620,10 +6547,48 @@ const file_jasper_proto_rawDesc = "" +
 	"\x05count\x18\x02 \x01(\x03R\x05count\"3\n" +
 	"\tLogStream\x12\x12\n" +
 	"\x04logs\x18\x01 \x03(\tR\x04logs\x12\x12\n" +
-	"\x04done\x18\x02 \x01(\bR\x04done\"\x8f\x01\n" +
+	"\x04done\x18\x02 \x01(\bR\x04done\"m\n" +
+	"\x10LogFollowRequest\x12'\n" +
+	"\x02id\x18\x01 \x01(\v2\x17.jasper.JasperProcessIDR\x02id\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x18\n" +
+	"\astreams\x18\x03 \x03(\tR\astreams\"}\n" +
+	"\aLogLine\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x03R\x06offset\x12\x16\n" +
+	"\x06stream\x18\x02 \x01(\tR\x06stream\x12\x12\n" +
+	"\x04line\x18\x03 \x01(\tR\x04line\x12.\n" +
+	"\x04time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"8\n" +
+	"\x0eExecWindowSize\x12\x12\n" +
+	"\x04rows\x18\x01 \x01(\rR\x04rows\x12\x12\n" +
+	"\x04cols\x18\x02 \x01(\rR\x04cols\"\x87\x01\n" +
+	"\vExecOptions\x12-\n" +
+	"\x06create\x18\x01 \x01(\v2\x15.jasper.CreateOptionsR\x06create\x12\x10\n" +
+	"\x03tty\x18\x02 \x01(\bR\x03tty\x127\n" +
+	"\vwindow_size\x18\x03 \x01(\v2\x16.jasper.ExecWindowSizeR\n" +
+	"windowSize\"\xa1\x01\n" +
+	"\tExecInput\x12-\n" +
+	"\aoptions\x18\x01 \x01(\v2\x13.jasper.ExecOptionsR\aoptions\x12\x14\n" +
+	"\x05stdin\x18\x02 \x01(\fR\x05stdin\x12\x1f\n" +
+	"\vclose_stdin\x18\x03 \x01(\bR\n" +
+	"closeStdin\x12.\n" +
+	"\x06resize\x18\x04 \x01(\v2\x16.jasper.ExecWindowSizeR\x06resize\"\x97\x01\n" +
+	"\n" +
+	"ExecOutput\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06stdout\x18\x02 \x01(\fR\x06stdout\x12\x16\n" +
+	"\x06stderr\x18\x03 \x01(\fR\x06stderr\x12\x16\n" +
+	"\x06exited\x18\x04 \x01(\bR\x06exited\x12\x1b\n" +
+	"\texit_code\x18\x05 \x01(\x05R\bexitCode\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\x8f\x01\n" +
 	"\x13SignalTriggerParams\x125\n" +
 	"\tprocessID\x18\x01 \x01(\v2\x17.jasper.JasperProcessIDR\tprocessID\x12A\n" +
-	"\x0fsignalTriggerID\x18\x02 \x01(\x0e2\x17.jasper.SignalTriggerIDR\x0fsignalTriggerID\"!\n" +
+	"\x0fsignalTriggerID\x18\x02 \x01(\x0e2\x17.jasper.SignalTriggerIDR\x0fsignalTriggerID\"\xe8\x01\n" +
+	"\x14ProcessTriggerParams\x125\n" +
+	"\tprocessID\x18\x01 \x01(\v2\x17.jasper.JasperProcessIDR\tprocessID\x12\x1c\n" +
+	"\ttriggerID\x18\x02 \x01(\tR\ttriggerID\x12@\n" +
+	"\x06params\x18\x03 \x03(\v2(.jasper.ProcessTriggerParams.ParamsEntryR\x06params\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"!\n" +
 	"\tEventName\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\":\n" +
 	"\x12ScriptingHarnessID\x12\x0e\n" +
@@ -3741,13 +6706,18 @@ const file_jasper_proto_rawDesc = "" +
 	"\x04INIT\x10\x04\x12\t\n" +
 	"\x05USER1\x10\x05\x12\t\n" +
 	"\x05USER2\x10\x06\x12\b\n" +
-	"\x04ABRT\x10\a*V\n" +
+	"\x04ABRT\x10\a*\x9e\x01\n" +
 	"\rArchiveFormat\x12\x12\n" +
 	"\x0eARCHIVEUNKNOWN\x10\x00\x12\x0f\n" +
 	"\vARCHIVEAUTO\x10\x01\x12\x10\n" +
 	"\fARCHIVETARGZ\x10\x02\x12\x0e\n" +
 	"\n" +
-	"ARCHIVEZIP\x10\x03*1\n" +
+	"ARCHIVEZIP\x10\x03\x12\x0e\n" +
+	"\n" +
+	"ARCHIVETAR\x10\x04\x12\x10\n" +
+	"\fARCHIVETARXZ\x10\x05\x12\x11\n" +
+	"\rARCHIVETARZST\x10\x06\x12\x11\n" +
+	"\rARCHIVETARBZ2\x10\a*1\n" +
 	"\x0fSignalTriggerID\x12\b\n" +
 	"\x04NONE\x10\x00\x12\x14\n" +
 	"\x10CLEANTERMINATION\x10\x01*[\n" +
@@ -3757,7 +6727,7 @@ const file_jasper_proto_rawDesc = "" +
 	"FORMATBSON\x10\x01\x12\x0e\n" +
 	"\n" +
 	"FORMATJSON\x10\x02\x12\x10\n" +
-	"\fFORMATSTRING\x10\x032\xf3\x12\n" +
+	"\fFORMATSTRING\x10\x032\x9f\x1c\n" +
 	"\x14JasperProcessManager\x120\n" +
 	"\x02ID\x12\x16.google.protobuf.Empty\x1a\x12.jasper.IDResponse\x124\n" +
 	"\x06Create\x12\x15.jasper.CreateOptions\x1a\x13.jasper.ProcessInfo\x12-\n" +
@@ -3771,8 +6741,10 @@ const file_jasper_proto_rawDesc = "" +
 	"TagProcess\x12\x13.jasper.ProcessTags\x1a\x18.jasper.OperationOutcome\x12>\n" +
 	"\tResetTags\x12\x17.jasper.JasperProcessID\x1a\x18.jasper.OperationOutcome\x127\n" +
 	"\aGetTags\x12\x17.jasper.JasperProcessID\x1a\x13.jasper.ProcessTags\x12P\n" +
-	"\x17RegisterSignalTriggerID\x12\x1b.jasper.SignalTriggerParams\x1a\x18.jasper.OperationOutcome\x129\n" +
-	"\x04Wait\x12\x17.jasper.JasperProcessID\x1a\x18.jasper.OperationOutcome\x127\n" +
+	"\x17RegisterSignalTriggerID\x12\x1b.jasper.SignalTriggerParams\x1a\x18.jasper.OperationOutcome\x12K\n" +
+	"\x11RegisterTriggerID\x12\x1c.jasper.ProcessTriggerParams\x1a\x18.jasper.OperationOutcome\x129\n" +
+	"\x04Wait\x12\x17.jasper.JasperProcessID\x1a\x18.jasper.OperationOutcome\x12>\n" +
+	"\tWaitReady\x12\x17.jasper.JasperProcessID\x1a\x18.jasper.OperationOutcome\x127\n" +
 	"\aRespawn\x12\x17.jasper.JasperProcessID\x1a\x13.jasper.ProcessInfo\x12N\n" +
 	"\x16ScriptingHarnessCreate\x12\x18.jasper.ScriptingOptions\x1a\x1a.jasper.ScriptingHarnessID\x12M\n" +
 	"\x15ScriptingHarnessCheck\x12\x1a.jasper.ScriptingHarnessID\x1a\x18.jasper.OperationOutcome\x12M\n" +
@@ -3790,10 +6762,29 @@ const file_jasper_proto_rawDesc = "" +
 	"\x0fLoggingCacheLen\x12\x16.google.protobuf.Empty\x1a\x18.jasper.LoggingCacheSize\x12I\n" +
 	"\x11LoggingCachePrune\x12\x1a.google.protobuf.Timestamp\x1a\x18.jasper.OperationOutcome\x128\n" +
 	"\x06Status\x12\x16.google.protobuf.Empty\x1a\x16.jasper.StatusResponse\x12>\n" +
-	"\fDownloadFile\x12\x14.jasper.DownloadInfo\x1a\x18.jasper.OperationOutcome\x125\n" +
-	"\fGetLogStream\x12\x12.jasper.LogRequest\x1a\x11.jasper.LogStream\x12:\n" +
+	"\fDownloadFile\x12\x14.jasper.DownloadInfo\x1a\x18.jasper.OperationOutcome\x12K\n" +
+	"\x15GetDownloadCacheStats\x12\x16.google.protobuf.Empty\x1a\x1a.jasper.DownloadCacheStats\x122\n" +
+	"\x05Drain\x12\x14.jasper.DrainOptions\x1a\x13.jasper.DrainReport\x127\n" +
+	"\vGetSnapshot\x12\x16.google.protobuf.Empty\x1a\x10.jasper.Snapshot\x12@\n" +
+	"\vAddSchedule\x12\x17.jasper.ScheduleOptions\x1a\x18.jasper.OperationOutcome\x12>\n" +
+	"\x0eRemoveSchedule\x12\x12.jasper.ScheduleID\x1a\x18.jasper.OperationOutcome\x12A\n" +
+	"\rListSchedules\x12\x16.google.protobuf.Empty\x1a\x18.jasper.ScheduleInfoList\x125\n" +
+	"\fGetLogStream\x12\x12.jasper.LogRequest\x1a\x11.jasper.LogStream\x12>\n" +
+	"\x0fFollowLogStream\x12\x18.jasper.LogFollowRequest\x1a\x0f.jasper.LogLine0\x01\x121\n" +
+	"\x04Exec\x12\x11.jasper.ExecInput\x1a\x12.jasper.ExecOutput(\x010\x01\x12:\n" +
 	"\vSignalEvent\x12\x11.jasper.EventName\x1a\x18.jasper.OperationOutcome\x12>\n" +
-	"\tWriteFile\x12\x15.jasper.WriteFileInfo\x1a\x18.jasper.OperationOutcome(\x01\x12@\n" +
+	"\tWriteFile\x12\x15.jasper.WriteFileInfo\x1a\x18.jasper.OperationOutcome(\x01\x125\n" +
+	"\bReadFile\x12\x14.jasper.ReadFileInfo\x1a\x11.jasper.FileChunk0\x01\x12.\n" +
+	"\bStatFile\x12\x10.jasper.FilePath\x1a\x10.jasper.FileInfo\x12@\n" +
+	"\rListDirectory\x12\x19.jasper.ListDirectoryInfo\x1a\x14.jasper.FileInfoList\x123\n" +
+	"\tGlobFiles\x12\x10.jasper.FilePath\x1a\x14.jasper.FilePathList\x12>\n" +
+	"\n" +
+	"RemoveFile\x12\x16.jasper.RemoveFileInfo\x1a\x18.jasper.OperationOutcome\x12>\n" +
+	"\n" +
+	"RenameFile\x12\x16.jasper.RenameFileInfo\x1a\x18.jasper.OperationOutcome\x12D\n" +
+	"\rMakeDirectory\x12\x19.jasper.MakeDirectoryInfo\x1a\x18.jasper.OperationOutcome\x12F\n" +
+	"\fChecksumFile\x12\x18.jasper.FileChecksumInfo\x1a\x1c.jasper.FileChecksumResponse\x12?\n" +
+	"\rCreateArchive\x12\x19.jasper.CreateArchiveInfo\x1a\x11.jasper.FileChunk0\x01\x12@\n" +
 	"\fSendMessages\x12\x16.jasper.LoggingPayload\x1a\x18.jasper.OperationOutcomeB\x15Z\x13./x/remote/internalb\x06proto3"
 
 var (
@@ -3809,7 +6800,7 @@ func file_jasper_proto_rawDescGZIP() []byte {
 }
 
 var file_jasper_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_jasper_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_jasper_proto_msgTypes = make([]protoimpl.MessageInfo, 92)
 var file_jasper_proto_goTypes = []any{
 	(LogFormat)(0),                        // 0: jasper.LogFormat
 	(RawLoggerConfigFormat)(0),            // 1: jasper.RawLoggerConfigFormat
@@ -3831,175 +6822,307 @@ var file_jasper_proto_goTypes = []any{
 	(*RawLoggerConfig)(nil),               // 17: jasper.RawLoggerConfig
 	(*OutputOptions)(nil),                 // 18: jasper.OutputOptions
 	(*CreateOptions)(nil),                 // 19: jasper.CreateOptions
-	(*IDResponse)(nil),                    // 20: jasper.IDResponse
-	(*ProcessInfo)(nil),                   // 21: jasper.ProcessInfo
-	(*StatusResponse)(nil),                // 22: jasper.StatusResponse
-	(*Filter)(nil),                        // 23: jasper.Filter
-	(*SignalProcess)(nil),                 // 24: jasper.SignalProcess
-	(*TagName)(nil),                       // 25: jasper.TagName
-	(*ProcessTags)(nil),                   // 26: jasper.ProcessTags
-	(*JasperProcessID)(nil),               // 27: jasper.JasperProcessID
-	(*OperationOutcome)(nil),              // 28: jasper.OperationOutcome
-	(*ArchiveOptions)(nil),                // 29: jasper.ArchiveOptions
-	(*DownloadInfo)(nil),                  // 30: jasper.DownloadInfo
-	(*WriteFileInfo)(nil),                 // 31: jasper.WriteFileInfo
-	(*BuildloggerURLs)(nil),               // 32: jasper.BuildloggerURLs
-	(*LogRequest)(nil),                    // 33: jasper.LogRequest
-	(*LogStream)(nil),                     // 34: jasper.LogStream
-	(*SignalTriggerParams)(nil),           // 35: jasper.SignalTriggerParams
-	(*EventName)(nil),                     // 36: jasper.EventName
-	(*ScriptingHarnessID)(nil),            // 37: jasper.ScriptingHarnessID
-	(*ScriptingOptionsGolang)(nil),        // 38: jasper.ScriptingOptionsGolang
-	(*ScriptingOptionsPython)(nil),        // 39: jasper.ScriptingOptionsPython
-	(*ScriptingOptionsRoswell)(nil),       // 40: jasper.ScriptingOptionsRoswell
-	(*ScriptingOptions)(nil),              // 41: jasper.ScriptingOptions
-	(*ScriptingHarnessRunArgs)(nil),       // 42: jasper.ScriptingHarnessRunArgs
-	(*ScriptingHarnessBuildArgs)(nil),     // 43: jasper.ScriptingHarnessBuildArgs
-	(*ScriptingHarnessBuildResponse)(nil), // 44: jasper.ScriptingHarnessBuildResponse
-	(*ScriptingHarnessRunScriptArgs)(nil), // 45: jasper.ScriptingHarnessRunScriptArgs
-	(*ScriptingHarnessTestArgs)(nil),      // 46: jasper.ScriptingHarnessTestArgs
-	(*ScriptingHarnessTestOptions)(nil),   // 47: jasper.ScriptingHarnessTestOptions
-	(*ScriptingHarnessTestResult)(nil),    // 48: jasper.ScriptingHarnessTestResult
-	(*ScriptingHarnessTestResponse)(nil),  // 49: jasper.ScriptingHarnessTestResponse
-	(*LoggingCacheCreateArgs)(nil),        // 50: jasper.LoggingCacheCreateArgs
-	(*LoggingCacheArgs)(nil),              // 51: jasper.LoggingCacheArgs
-	(*LoggingCacheInstance)(nil),          // 52: jasper.LoggingCacheInstance
-	(*LoggingCacheSize)(nil),              // 53: jasper.LoggingCacheSize
-	(*LoggingPayloadData)(nil),            // 54: jasper.LoggingPayloadData
-	(*LoggingPayload)(nil),                // 55: jasper.LoggingPayload
-	nil,                                   // 56: jasper.CreateOptions.EnvironmentEntry
-	nil,                                   // 57: jasper.ScriptingOptions.EnvironmentEntry
-	(*timestamppb.Timestamp)(nil),         // 58: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),           // 59: google.protobuf.Duration
-	(*emptypb.Empty)(nil),                 // 60: google.protobuf.Empty
+	(*OutputTrigger)(nil),                 // 20: jasper.OutputTrigger
+	(*IdleTimeoutOptions)(nil),            // 21: jasper.IdleTimeoutOptions
+	(*ReadinessOptions)(nil),              // 22: jasper.ReadinessOptions
+	(*LivenessOptions)(nil),               // 23: jasper.LivenessOptions
+	(*IDResponse)(nil),                    // 24: jasper.IDResponse
+	(*ProcessInfo)(nil),                   // 25: jasper.ProcessInfo
+	(*FollowUpInfo)(nil),                  // 26: jasper.FollowUpInfo
+	(*LivenessCheck)(nil),                 // 27: jasper.LivenessCheck
+	(*LivenessInfo)(nil),                  // 28: jasper.LivenessInfo
+	(*StatusResponse)(nil),                // 29: jasper.StatusResponse
+	(*Filter)(nil),                        // 30: jasper.Filter
+	(*SignalProcess)(nil),                 // 31: jasper.SignalProcess
+	(*TagName)(nil),                       // 32: jasper.TagName
+	(*ProcessTags)(nil),                   // 33: jasper.ProcessTags
+	(*JasperProcessID)(nil),               // 34: jasper.JasperProcessID
+	(*OperationOutcome)(nil),              // 35: jasper.OperationOutcome
+	(*ArchiveOptions)(nil),                // 36: jasper.ArchiveOptions
+	(*DownloadChecksum)(nil),              // 37: jasper.DownloadChecksum
+	(*DownloadRetry)(nil),                 // 38: jasper.DownloadRetry
+	(*DownloadInfo)(nil),                  // 39: jasper.DownloadInfo
+	(*DownloadCacheStats)(nil),            // 40: jasper.DownloadCacheStats
+	(*DrainOptions)(nil),                  // 41: jasper.DrainOptions
+	(*DrainReport)(nil),                   // 42: jasper.DrainReport
+	(*ProcessSnapshot)(nil),               // 43: jasper.ProcessSnapshot
+	(*Snapshot)(nil),                      // 44: jasper.Snapshot
+	(*ScheduleOptions)(nil),               // 45: jasper.ScheduleOptions
+	(*ScheduleRun)(nil),                   // 46: jasper.ScheduleRun
+	(*ScheduleInfo)(nil),                  // 47: jasper.ScheduleInfo
+	(*ScheduleInfoList)(nil),              // 48: jasper.ScheduleInfoList
+	(*ScheduleID)(nil),                    // 49: jasper.ScheduleID
+	(*WriteFileInfo)(nil),                 // 50: jasper.WriteFileInfo
+	(*FilePath)(nil),                      // 51: jasper.FilePath
+	(*FileInfo)(nil),                      // 52: jasper.FileInfo
+	(*FileInfoList)(nil),                  // 53: jasper.FileInfoList
+	(*FilePathList)(nil),                  // 54: jasper.FilePathList
+	(*ReadFileInfo)(nil),                  // 55: jasper.ReadFileInfo
+	(*FileChunk)(nil),                     // 56: jasper.FileChunk
+	(*ListDirectoryInfo)(nil),             // 57: jasper.ListDirectoryInfo
+	(*RemoveFileInfo)(nil),                // 58: jasper.RemoveFileInfo
+	(*RenameFileInfo)(nil),                // 59: jasper.RenameFileInfo
+	(*MakeDirectoryInfo)(nil),             // 60: jasper.MakeDirectoryInfo
+	(*FileChecksumInfo)(nil),              // 61: jasper.FileChecksumInfo
+	(*FileChecksumResponse)(nil),          // 62: jasper.FileChecksumResponse
+	(*CreateArchiveInfo)(nil),             // 63: jasper.CreateArchiveInfo
+	(*BuildloggerURLs)(nil),               // 64: jasper.BuildloggerURLs
+	(*LogRequest)(nil),                    // 65: jasper.LogRequest
+	(*LogStream)(nil),                     // 66: jasper.LogStream
+	(*LogFollowRequest)(nil),              // 67: jasper.LogFollowRequest
+	(*LogLine)(nil),                       // 68: jasper.LogLine
+	(*ExecWindowSize)(nil),                // 69: jasper.ExecWindowSize
+	(*ExecOptions)(nil),                   // 70: jasper.ExecOptions
+	(*ExecInput)(nil),                     // 71: jasper.ExecInput
+	(*ExecOutput)(nil),                    // 72: jasper.ExecOutput
+	(*SignalTriggerParams)(nil),           // 73: jasper.SignalTriggerParams
+	(*ProcessTriggerParams)(nil),          // 74: jasper.ProcessTriggerParams
+	(*EventName)(nil),                     // 75: jasper.EventName
+	(*ScriptingHarnessID)(nil),            // 76: jasper.ScriptingHarnessID
+	(*ScriptingOptionsGolang)(nil),        // 77: jasper.ScriptingOptionsGolang
+	(*ScriptingOptionsPython)(nil),        // 78: jasper.ScriptingOptionsPython
+	(*ScriptingOptionsRoswell)(nil),       // 79: jasper.ScriptingOptionsRoswell
+	(*ScriptingOptions)(nil),              // 80: jasper.ScriptingOptions
+	(*ScriptingHarnessRunArgs)(nil),       // 81: jasper.ScriptingHarnessRunArgs
+	(*ScriptingHarnessBuildArgs)(nil),     // 82: jasper.ScriptingHarnessBuildArgs
+	(*ScriptingHarnessBuildResponse)(nil), // 83: jasper.ScriptingHarnessBuildResponse
+	(*ScriptingHarnessRunScriptArgs)(nil), // 84: jasper.ScriptingHarnessRunScriptArgs
+	(*ScriptingHarnessTestArgs)(nil),      // 85: jasper.ScriptingHarnessTestArgs
+	(*ScriptingHarnessTestOptions)(nil),   // 86: jasper.ScriptingHarnessTestOptions
+	(*ScriptingHarnessTestResult)(nil),    // 87: jasper.ScriptingHarnessTestResult
+	(*ScriptingHarnessTestResponse)(nil),  // 88: jasper.ScriptingHarnessTestResponse
+	(*LoggingCacheCreateArgs)(nil),        // 89: jasper.LoggingCacheCreateArgs
+	(*LoggingCacheArgs)(nil),              // 90: jasper.LoggingCacheArgs
+	(*LoggingCacheInstance)(nil),          // 91: jasper.LoggingCacheInstance
+	(*LoggingCacheSize)(nil),              // 92: jasper.LoggingCacheSize
+	(*LoggingPayloadData)(nil),            // 93: jasper.LoggingPayloadData
+	(*LoggingPayload)(nil),                // 94: jasper.LoggingPayload
+	nil,                                   // 95: jasper.CreateOptions.EnvironmentEntry
+	nil,                                   // 96: jasper.DownloadInfo.HeadersEntry
+	nil,                                   // 97: jasper.ProcessTriggerParams.ParamsEntry
+	nil,                                   // 98: jasper.ScriptingOptions.EnvironmentEntry
+	(*durationpb.Duration)(nil),           // 99: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),         // 100: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                 // 101: google.protobuf.Empty
 }
 var file_jasper_proto_depIdxs = []int32{
-	11, // 0: jasper.LoggerConfig.default:type_name -> jasper.DefaultLoggerOptions
-	12, // 1: jasper.LoggerConfig.file:type_name -> jasper.FileLoggerOptions
-	13, // 2: jasper.LoggerConfig.inherited:type_name -> jasper.InheritedLoggerOptions
-	14, // 3: jasper.LoggerConfig.in_memory:type_name -> jasper.InMemoryLoggerOptions
-	17, // 4: jasper.LoggerConfig.raw:type_name -> jasper.RawLoggerConfig
-	16, // 5: jasper.LoggerConfig.splunk:type_name -> jasper.SplunkLoggerOptions
-	8,  // 6: jasper.BaseOptions.level:type_name -> jasper.LogLevel
-	9,  // 7: jasper.BaseOptions.buffer:type_name -> jasper.BufferOptions
-	0,  // 8: jasper.BaseOptions.format:type_name -> jasper.LogFormat
-	10, // 9: jasper.DefaultLoggerOptions.base:type_name -> jasper.BaseOptions
-	10, // 10: jasper.FileLoggerOptions.base:type_name -> jasper.BaseOptions
-	10, // 11: jasper.InheritedLoggerOptions.base:type_name -> jasper.BaseOptions
-	10, // 12: jasper.InMemoryLoggerOptions.base:type_name -> jasper.BaseOptions
-	15, // 13: jasper.SplunkLoggerOptions.splunk:type_name -> jasper.SplunkInfo
-	10, // 14: jasper.SplunkLoggerOptions.base:type_name -> jasper.BaseOptions
-	1,  // 15: jasper.RawLoggerConfig.format:type_name -> jasper.RawLoggerConfigFormat
-	7,  // 16: jasper.OutputOptions.loggers:type_name -> jasper.LoggerConfig
-	56, // 17: jasper.CreateOptions.environment:type_name -> jasper.CreateOptions.EnvironmentEntry
-	19, // 18: jasper.CreateOptions.on_success:type_name -> jasper.CreateOptions
-	19, // 19: jasper.CreateOptions.on_failure:type_name -> jasper.CreateOptions
-	19, // 20: jasper.CreateOptions.on_timeout:type_name -> jasper.CreateOptions
-	18, // 21: jasper.CreateOptions.output:type_name -> jasper.OutputOptions
-	19, // 22: jasper.ProcessInfo.options:type_name -> jasper.CreateOptions
-	58, // 23: jasper.ProcessInfo.start_at:type_name -> google.protobuf.Timestamp
-	58, // 24: jasper.ProcessInfo.end_at:type_name -> google.protobuf.Timestamp
-	2,  // 25: jasper.Filter.name:type_name -> jasper.FilterSpecifications
-	27, // 26: jasper.SignalProcess.ProcessID:type_name -> jasper.JasperProcessID
-	3,  // 27: jasper.SignalProcess.signal:type_name -> jasper.Signals
-	4,  // 28: jasper.ArchiveOptions.format:type_name -> jasper.ArchiveFormat
-	29, // 29: jasper.DownloadInfo.archive_opts:type_name -> jasper.ArchiveOptions
-	27, // 30: jasper.LogRequest.id:type_name -> jasper.JasperProcessID
-	27, // 31: jasper.SignalTriggerParams.processID:type_name -> jasper.JasperProcessID
-	5,  // 32: jasper.SignalTriggerParams.signalTriggerID:type_name -> jasper.SignalTriggerID
-	38, // 33: jasper.ScriptingOptions.golang:type_name -> jasper.ScriptingOptionsGolang
-	39, // 34: jasper.ScriptingOptions.python:type_name -> jasper.ScriptingOptionsPython
-	40, // 35: jasper.ScriptingOptions.roswell:type_name -> jasper.ScriptingOptionsRoswell
-	57, // 36: jasper.ScriptingOptions.environment:type_name -> jasper.ScriptingOptions.EnvironmentEntry
-	18, // 37: jasper.ScriptingOptions.output:type_name -> jasper.OutputOptions
-	28, // 38: jasper.ScriptingHarnessBuildResponse.outcome:type_name -> jasper.OperationOutcome
-	47, // 39: jasper.ScriptingHarnessTestArgs.options:type_name -> jasper.ScriptingHarnessTestOptions
-	59, // 40: jasper.ScriptingHarnessTestOptions.timeout:type_name -> google.protobuf.Duration
-	58, // 41: jasper.ScriptingHarnessTestResult.start_at:type_name -> google.protobuf.Timestamp
-	59, // 42: jasper.ScriptingHarnessTestResult.duration:type_name -> google.protobuf.Duration
-	28, // 43: jasper.ScriptingHarnessTestResponse.outcome:type_name -> jasper.OperationOutcome
-	48, // 44: jasper.ScriptingHarnessTestResponse.results:type_name -> jasper.ScriptingHarnessTestResult
-	18, // 45: jasper.LoggingCacheCreateArgs.options:type_name -> jasper.OutputOptions
-	28, // 46: jasper.LoggingCacheInstance.outcome:type_name -> jasper.OperationOutcome
-	58, // 47: jasper.LoggingCacheInstance.accessed:type_name -> google.protobuf.Timestamp
-	28, // 48: jasper.LoggingCacheSize.outcome:type_name -> jasper.OperationOutcome
-	6,  // 49: jasper.LoggingPayload.format:type_name -> jasper.LoggingPayloadFormat
-	54, // 50: jasper.LoggingPayload.data:type_name -> jasper.LoggingPayloadData
-	60, // 51: jasper.JasperProcessManager.ID:input_type -> google.protobuf.Empty
-	19, // 52: jasper.JasperProcessManager.Create:input_type -> jasper.CreateOptions
-	23, // 53: jasper.JasperProcessManager.List:input_type -> jasper.Filter
-	25, // 54: jasper.JasperProcessManager.Group:input_type -> jasper.TagName
-	27, // 55: jasper.JasperProcessManager.Get:input_type -> jasper.JasperProcessID
-	24, // 56: jasper.JasperProcessManager.Signal:input_type -> jasper.SignalProcess
-	60, // 57: jasper.JasperProcessManager.Clear:input_type -> google.protobuf.Empty
-	60, // 58: jasper.JasperProcessManager.Close:input_type -> google.protobuf.Empty
-	26, // 59: jasper.JasperProcessManager.TagProcess:input_type -> jasper.ProcessTags
-	27, // 60: jasper.JasperProcessManager.ResetTags:input_type -> jasper.JasperProcessID
-	27, // 61: jasper.JasperProcessManager.GetTags:input_type -> jasper.JasperProcessID
-	35, // 62: jasper.JasperProcessManager.RegisterSignalTriggerID:input_type -> jasper.SignalTriggerParams
-	27, // 63: jasper.JasperProcessManager.Wait:input_type -> jasper.JasperProcessID
-	27, // 64: jasper.JasperProcessManager.Respawn:input_type -> jasper.JasperProcessID
-	41, // 65: jasper.JasperProcessManager.ScriptingHarnessCreate:input_type -> jasper.ScriptingOptions
-	37, // 66: jasper.JasperProcessManager.ScriptingHarnessCheck:input_type -> jasper.ScriptingHarnessID
-	37, // 67: jasper.JasperProcessManager.ScriptingHarnessSetup:input_type -> jasper.ScriptingHarnessID
-	37, // 68: jasper.JasperProcessManager.ScriptingHarnessCleanup:input_type -> jasper.ScriptingHarnessID
-	42, // 69: jasper.JasperProcessManager.ScriptingHarnessRun:input_type -> jasper.ScriptingHarnessRunArgs
-	43, // 70: jasper.JasperProcessManager.ScriptingHarnessBuild:input_type -> jasper.ScriptingHarnessBuildArgs
-	45, // 71: jasper.JasperProcessManager.ScriptingHarnessRunScript:input_type -> jasper.ScriptingHarnessRunScriptArgs
-	46, // 72: jasper.JasperProcessManager.ScriptingHarnessTest:input_type -> jasper.ScriptingHarnessTestArgs
-	50, // 73: jasper.JasperProcessManager.LoggingCacheCreate:input_type -> jasper.LoggingCacheCreateArgs
-	51, // 74: jasper.JasperProcessManager.LoggingCacheGet:input_type -> jasper.LoggingCacheArgs
-	51, // 75: jasper.JasperProcessManager.LoggingCacheRemove:input_type -> jasper.LoggingCacheArgs
-	51, // 76: jasper.JasperProcessManager.LoggingCacheCloseAndRemove:input_type -> jasper.LoggingCacheArgs
-	60, // 77: jasper.JasperProcessManager.LoggingCacheClear:input_type -> google.protobuf.Empty
-	60, // 78: jasper.JasperProcessManager.LoggingCacheLen:input_type -> google.protobuf.Empty
-	58, // 79: jasper.JasperProcessManager.LoggingCachePrune:input_type -> google.protobuf.Timestamp
-	60, // 80: jasper.JasperProcessManager.Status:input_type -> google.protobuf.Empty
-	30, // 81: jasper.JasperProcessManager.DownloadFile:input_type -> jasper.DownloadInfo
-	33, // 82: jasper.JasperProcessManager.GetLogStream:input_type -> jasper.LogRequest
-	36, // 83: jasper.JasperProcessManager.SignalEvent:input_type -> jasper.EventName
-	31, // 84: jasper.JasperProcessManager.WriteFile:input_type -> jasper.WriteFileInfo
-	55, // 85: jasper.JasperProcessManager.SendMessages:input_type -> jasper.LoggingPayload
-	20, // 86: jasper.JasperProcessManager.ID:output_type -> jasper.IDResponse
-	21, // 87: jasper.JasperProcessManager.Create:output_type -> jasper.ProcessInfo
-	21, // 88: jasper.JasperProcessManager.List:output_type -> jasper.ProcessInfo
-	21, // 89: jasper.JasperProcessManager.Group:output_type -> jasper.ProcessInfo
-	21, // 90: jasper.JasperProcessManager.Get:output_type -> jasper.ProcessInfo
-	28, // 91: jasper.JasperProcessManager.Signal:output_type -> jasper.OperationOutcome
-	28, // 92: jasper.JasperProcessManager.Clear:output_type -> jasper.OperationOutcome
-	28, // 93: jasper.JasperProcessManager.Close:output_type -> jasper.OperationOutcome
-	28, // 94: jasper.JasperProcessManager.TagProcess:output_type -> jasper.OperationOutcome
-	28, // 95: jasper.JasperProcessManager.ResetTags:output_type -> jasper.OperationOutcome
-	26, // 96: jasper.JasperProcessManager.GetTags:output_type -> jasper.ProcessTags
-	28, // 97: jasper.JasperProcessManager.RegisterSignalTriggerID:output_type -> jasper.OperationOutcome
-	28, // 98: jasper.JasperProcessManager.Wait:output_type -> jasper.OperationOutcome
-	21, // 99: jasper.JasperProcessManager.Respawn:output_type -> jasper.ProcessInfo
-	37, // 100: jasper.JasperProcessManager.ScriptingHarnessCreate:output_type -> jasper.ScriptingHarnessID
-	28, // 101: jasper.JasperProcessManager.ScriptingHarnessCheck:output_type -> jasper.OperationOutcome
-	28, // 102: jasper.JasperProcessManager.ScriptingHarnessSetup:output_type -> jasper.OperationOutcome
-	28, // 103: jasper.JasperProcessManager.ScriptingHarnessCleanup:output_type -> jasper.OperationOutcome
-	28, // 104: jasper.JasperProcessManager.ScriptingHarnessRun:output_type -> jasper.OperationOutcome
-	44, // 105: jasper.JasperProcessManager.ScriptingHarnessBuild:output_type -> jasper.ScriptingHarnessBuildResponse
-	28, // 106: jasper.JasperProcessManager.ScriptingHarnessRunScript:output_type -> jasper.OperationOutcome
-	49, // 107: jasper.JasperProcessManager.ScriptingHarnessTest:output_type -> jasper.ScriptingHarnessTestResponse
-	52, // 108: jasper.JasperProcessManager.LoggingCacheCreate:output_type -> jasper.LoggingCacheInstance
-	52, // 109: jasper.JasperProcessManager.LoggingCacheGet:output_type -> jasper.LoggingCacheInstance
-	28, // 110: jasper.JasperProcessManager.LoggingCacheRemove:output_type -> jasper.OperationOutcome
-	28, // 111: jasper.JasperProcessManager.LoggingCacheCloseAndRemove:output_type -> jasper.OperationOutcome
-	28, // 112: jasper.JasperProcessManager.LoggingCacheClear:output_type -> jasper.OperationOutcome
-	53, // 113: jasper.JasperProcessManager.LoggingCacheLen:output_type -> jasper.LoggingCacheSize
-	28, // 114: jasper.JasperProcessManager.LoggingCachePrune:output_type -> jasper.OperationOutcome
-	22, // 115: jasper.JasperProcessManager.Status:output_type -> jasper.StatusResponse
-	28, // 116: jasper.JasperProcessManager.DownloadFile:output_type -> jasper.OperationOutcome
-	34, // 117: jasper.JasperProcessManager.GetLogStream:output_type -> jasper.LogStream
-	28, // 118: jasper.JasperProcessManager.SignalEvent:output_type -> jasper.OperationOutcome
-	28, // 119: jasper.JasperProcessManager.WriteFile:output_type -> jasper.OperationOutcome
-	28, // 120: jasper.JasperProcessManager.SendMessages:output_type -> jasper.OperationOutcome
-	86, // [86:121] is the sub-list for method output_type
-	51, // [51:86] is the sub-list for method input_type
-	51, // [51:51] is the sub-list for extension type_name
-	51, // [51:51] is the sub-list for extension extendee
-	0,  // [0:51] is the sub-list for field type_name
+	11,  // 0: jasper.LoggerConfig.default:type_name -> jasper.DefaultLoggerOptions
+	12,  // 1: jasper.LoggerConfig.file:type_name -> jasper.FileLoggerOptions
+	13,  // 2: jasper.LoggerConfig.inherited:type_name -> jasper.InheritedLoggerOptions
+	14,  // 3: jasper.LoggerConfig.in_memory:type_name -> jasper.InMemoryLoggerOptions
+	17,  // 4: jasper.LoggerConfig.raw:type_name -> jasper.RawLoggerConfig
+	16,  // 5: jasper.LoggerConfig.splunk:type_name -> jasper.SplunkLoggerOptions
+	8,   // 6: jasper.BaseOptions.level:type_name -> jasper.LogLevel
+	9,   // 7: jasper.BaseOptions.buffer:type_name -> jasper.BufferOptions
+	0,   // 8: jasper.BaseOptions.format:type_name -> jasper.LogFormat
+	10,  // 9: jasper.DefaultLoggerOptions.base:type_name -> jasper.BaseOptions
+	10,  // 10: jasper.FileLoggerOptions.base:type_name -> jasper.BaseOptions
+	10,  // 11: jasper.InheritedLoggerOptions.base:type_name -> jasper.BaseOptions
+	10,  // 12: jasper.InMemoryLoggerOptions.base:type_name -> jasper.BaseOptions
+	15,  // 13: jasper.SplunkLoggerOptions.splunk:type_name -> jasper.SplunkInfo
+	10,  // 14: jasper.SplunkLoggerOptions.base:type_name -> jasper.BaseOptions
+	1,   // 15: jasper.RawLoggerConfig.format:type_name -> jasper.RawLoggerConfigFormat
+	7,   // 16: jasper.OutputOptions.loggers:type_name -> jasper.LoggerConfig
+	95,  // 17: jasper.CreateOptions.environment:type_name -> jasper.CreateOptions.EnvironmentEntry
+	19,  // 18: jasper.CreateOptions.on_success:type_name -> jasper.CreateOptions
+	19,  // 19: jasper.CreateOptions.on_failure:type_name -> jasper.CreateOptions
+	19,  // 20: jasper.CreateOptions.on_timeout:type_name -> jasper.CreateOptions
+	18,  // 21: jasper.CreateOptions.output:type_name -> jasper.OutputOptions
+	21,  // 22: jasper.CreateOptions.idle_timeout:type_name -> jasper.IdleTimeoutOptions
+	20,  // 23: jasper.CreateOptions.output_triggers:type_name -> jasper.OutputTrigger
+	22,  // 24: jasper.CreateOptions.readiness:type_name -> jasper.ReadinessOptions
+	23,  // 25: jasper.CreateOptions.liveness:type_name -> jasper.LivenessOptions
+	19,  // 26: jasper.CreateOptions.pipeline:type_name -> jasper.CreateOptions
+	19,  // 27: jasper.OutputTrigger.create:type_name -> jasper.CreateOptions
+	3,   // 28: jasper.OutputTrigger.signal:type_name -> jasper.Signals
+	99,  // 29: jasper.IdleTimeoutOptions.duration:type_name -> google.protobuf.Duration
+	99,  // 30: jasper.IdleTimeoutOptions.grace_period:type_name -> google.protobuf.Duration
+	19,  // 31: jasper.ReadinessOptions.command:type_name -> jasper.CreateOptions
+	99,  // 32: jasper.ReadinessOptions.interval:type_name -> google.protobuf.Duration
+	99,  // 33: jasper.ReadinessOptions.timeout:type_name -> google.protobuf.Duration
+	19,  // 34: jasper.LivenessOptions.command:type_name -> jasper.CreateOptions
+	99,  // 35: jasper.LivenessOptions.initial_delay:type_name -> google.protobuf.Duration
+	99,  // 36: jasper.LivenessOptions.interval:type_name -> google.protobuf.Duration
+	99,  // 37: jasper.LivenessOptions.timeout:type_name -> google.protobuf.Duration
+	99,  // 38: jasper.LivenessOptions.grace_period:type_name -> google.protobuf.Duration
+	19,  // 39: jasper.ProcessInfo.options:type_name -> jasper.CreateOptions
+	100, // 40: jasper.ProcessInfo.start_at:type_name -> google.protobuf.Timestamp
+	100, // 41: jasper.ProcessInfo.end_at:type_name -> google.protobuf.Timestamp
+	28,  // 42: jasper.ProcessInfo.liveness:type_name -> jasper.LivenessInfo
+	26,  // 43: jasper.ProcessInfo.follow_ups:type_name -> jasper.FollowUpInfo
+	25,  // 44: jasper.ProcessInfo.stages:type_name -> jasper.ProcessInfo
+	100, // 45: jasper.LivenessCheck.time:type_name -> google.protobuf.Timestamp
+	27,  // 46: jasper.LivenessInfo.checks:type_name -> jasper.LivenessCheck
+	2,   // 47: jasper.Filter.name:type_name -> jasper.FilterSpecifications
+	34,  // 48: jasper.SignalProcess.ProcessID:type_name -> jasper.JasperProcessID
+	3,   // 49: jasper.SignalProcess.signal:type_name -> jasper.Signals
+	4,   // 50: jasper.ArchiveOptions.format:type_name -> jasper.ArchiveFormat
+	99,  // 51: jasper.DownloadRetry.backoff:type_name -> google.protobuf.Duration
+	99,  // 52: jasper.DownloadRetry.max_backoff:type_name -> google.protobuf.Duration
+	36,  // 53: jasper.DownloadInfo.archive_opts:type_name -> jasper.ArchiveOptions
+	37,  // 54: jasper.DownloadInfo.checksum:type_name -> jasper.DownloadChecksum
+	96,  // 55: jasper.DownloadInfo.headers:type_name -> jasper.DownloadInfo.HeadersEntry
+	38,  // 56: jasper.DownloadInfo.retry:type_name -> jasper.DownloadRetry
+	99,  // 57: jasper.DrainOptions.timeout:type_name -> google.protobuf.Duration
+	99,  // 58: jasper.DrainOptions.grace_period:type_name -> google.protobuf.Duration
+	25,  // 59: jasper.ProcessSnapshot.info:type_name -> jasper.ProcessInfo
+	100, // 60: jasper.Snapshot.created_at:type_name -> google.protobuf.Timestamp
+	43,  // 61: jasper.Snapshot.processes:type_name -> jasper.ProcessSnapshot
+	91,  // 62: jasper.Snapshot.logging_cache:type_name -> jasper.LoggingCacheInstance
+	19,  // 63: jasper.ScheduleOptions.create:type_name -> jasper.CreateOptions
+	99,  // 64: jasper.ScheduleOptions.interval:type_name -> google.protobuf.Duration
+	99,  // 65: jasper.ScheduleOptions.jitter:type_name -> google.protobuf.Duration
+	100, // 66: jasper.ScheduleRun.scheduled_at:type_name -> google.protobuf.Timestamp
+	100, // 67: jasper.ScheduleRun.started_at:type_name -> google.protobuf.Timestamp
+	45,  // 68: jasper.ScheduleInfo.schedule:type_name -> jasper.ScheduleOptions
+	100, // 69: jasper.ScheduleInfo.next_run:type_name -> google.protobuf.Timestamp
+	46,  // 70: jasper.ScheduleInfo.history:type_name -> jasper.ScheduleRun
+	47,  // 71: jasper.ScheduleInfoList.schedules:type_name -> jasper.ScheduleInfo
+	100, // 72: jasper.FileInfo.mod_time:type_name -> google.protobuf.Timestamp
+	52,  // 73: jasper.FileInfoList.files:type_name -> jasper.FileInfo
+	4,   // 74: jasper.CreateArchiveInfo.format:type_name -> jasper.ArchiveFormat
+	34,  // 75: jasper.LogRequest.id:type_name -> jasper.JasperProcessID
+	34,  // 76: jasper.LogFollowRequest.id:type_name -> jasper.JasperProcessID
+	100, // 77: jasper.LogLine.time:type_name -> google.protobuf.Timestamp
+	19,  // 78: jasper.ExecOptions.create:type_name -> jasper.CreateOptions
+	69,  // 79: jasper.ExecOptions.window_size:type_name -> jasper.ExecWindowSize
+	70,  // 80: jasper.ExecInput.options:type_name -> jasper.ExecOptions
+	69,  // 81: jasper.ExecInput.resize:type_name -> jasper.ExecWindowSize
+	34,  // 82: jasper.SignalTriggerParams.processID:type_name -> jasper.JasperProcessID
+	5,   // 83: jasper.SignalTriggerParams.signalTriggerID:type_name -> jasper.SignalTriggerID
+	34,  // 84: jasper.ProcessTriggerParams.processID:type_name -> jasper.JasperProcessID
+	97,  // 85: jasper.ProcessTriggerParams.params:type_name -> jasper.ProcessTriggerParams.ParamsEntry
+	77,  // 86: jasper.ScriptingOptions.golang:type_name -> jasper.ScriptingOptionsGolang
+	78,  // 87: jasper.ScriptingOptions.python:type_name -> jasper.ScriptingOptionsPython
+	79,  // 88: jasper.ScriptingOptions.roswell:type_name -> jasper.ScriptingOptionsRoswell
+	98,  // 89: jasper.ScriptingOptions.environment:type_name -> jasper.ScriptingOptions.EnvironmentEntry
+	18,  // 90: jasper.ScriptingOptions.output:type_name -> jasper.OutputOptions
+	35,  // 91: jasper.ScriptingHarnessBuildResponse.outcome:type_name -> jasper.OperationOutcome
+	86,  // 92: jasper.ScriptingHarnessTestArgs.options:type_name -> jasper.ScriptingHarnessTestOptions
+	99,  // 93: jasper.ScriptingHarnessTestOptions.timeout:type_name -> google.protobuf.Duration
+	100, // 94: jasper.ScriptingHarnessTestResult.start_at:type_name -> google.protobuf.Timestamp
+	99,  // 95: jasper.ScriptingHarnessTestResult.duration:type_name -> google.protobuf.Duration
+	35,  // 96: jasper.ScriptingHarnessTestResponse.outcome:type_name -> jasper.OperationOutcome
+	87,  // 97: jasper.ScriptingHarnessTestResponse.results:type_name -> jasper.ScriptingHarnessTestResult
+	18,  // 98: jasper.LoggingCacheCreateArgs.options:type_name -> jasper.OutputOptions
+	35,  // 99: jasper.LoggingCacheInstance.outcome:type_name -> jasper.OperationOutcome
+	100, // 100: jasper.LoggingCacheInstance.accessed:type_name -> google.protobuf.Timestamp
+	35,  // 101: jasper.LoggingCacheSize.outcome:type_name -> jasper.OperationOutcome
+	6,   // 102: jasper.LoggingPayload.format:type_name -> jasper.LoggingPayloadFormat
+	93,  // 103: jasper.LoggingPayload.data:type_name -> jasper.LoggingPayloadData
+	101, // 104: jasper.JasperProcessManager.ID:input_type -> google.protobuf.Empty
+	19,  // 105: jasper.JasperProcessManager.Create:input_type -> jasper.CreateOptions
+	30,  // 106: jasper.JasperProcessManager.List:input_type -> jasper.Filter
+	32,  // 107: jasper.JasperProcessManager.Group:input_type -> jasper.TagName
+	34,  // 108: jasper.JasperProcessManager.Get:input_type -> jasper.JasperProcessID
+	31,  // 109: jasper.JasperProcessManager.Signal:input_type -> jasper.SignalProcess
+	101, // 110: jasper.JasperProcessManager.Clear:input_type -> google.protobuf.Empty
+	101, // 111: jasper.JasperProcessManager.Close:input_type -> google.protobuf.Empty
+	33,  // 112: jasper.JasperProcessManager.TagProcess:input_type -> jasper.ProcessTags
+	34,  // 113: jasper.JasperProcessManager.ResetTags:input_type -> jasper.JasperProcessID
+	34,  // 114: jasper.JasperProcessManager.GetTags:input_type -> jasper.JasperProcessID
+	73,  // 115: jasper.JasperProcessManager.RegisterSignalTriggerID:input_type -> jasper.SignalTriggerParams
+	74,  // 116: jasper.JasperProcessManager.RegisterTriggerID:input_type -> jasper.ProcessTriggerParams
+	34,  // 117: jasper.JasperProcessManager.Wait:input_type -> jasper.JasperProcessID
+	34,  // 118: jasper.JasperProcessManager.WaitReady:input_type -> jasper.JasperProcessID
+	34,  // 119: jasper.JasperProcessManager.Respawn:input_type -> jasper.JasperProcessID
+	80,  // 120: jasper.JasperProcessManager.ScriptingHarnessCreate:input_type -> jasper.ScriptingOptions
+	76,  // 121: jasper.JasperProcessManager.ScriptingHarnessCheck:input_type -> jasper.ScriptingHarnessID
+	76,  // 122: jasper.JasperProcessManager.ScriptingHarnessSetup:input_type -> jasper.ScriptingHarnessID
+	76,  // 123: jasper.JasperProcessManager.ScriptingHarnessCleanup:input_type -> jasper.ScriptingHarnessID
+	81,  // 124: jasper.JasperProcessManager.ScriptingHarnessRun:input_type -> jasper.ScriptingHarnessRunArgs
+	82,  // 125: jasper.JasperProcessManager.ScriptingHarnessBuild:input_type -> jasper.ScriptingHarnessBuildArgs
+	84,  // 126: jasper.JasperProcessManager.ScriptingHarnessRunScript:input_type -> jasper.ScriptingHarnessRunScriptArgs
+	85,  // 127: jasper.JasperProcessManager.ScriptingHarnessTest:input_type -> jasper.ScriptingHarnessTestArgs
+	89,  // 128: jasper.JasperProcessManager.LoggingCacheCreate:input_type -> jasper.LoggingCacheCreateArgs
+	90,  // 129: jasper.JasperProcessManager.LoggingCacheGet:input_type -> jasper.LoggingCacheArgs
+	90,  // 130: jasper.JasperProcessManager.LoggingCacheRemove:input_type -> jasper.LoggingCacheArgs
+	90,  // 131: jasper.JasperProcessManager.LoggingCacheCloseAndRemove:input_type -> jasper.LoggingCacheArgs
+	101, // 132: jasper.JasperProcessManager.LoggingCacheClear:input_type -> google.protobuf.Empty
+	101, // 133: jasper.JasperProcessManager.LoggingCacheLen:input_type -> google.protobuf.Empty
+	100, // 134: jasper.JasperProcessManager.LoggingCachePrune:input_type -> google.protobuf.Timestamp
+	101, // 135: jasper.JasperProcessManager.Status:input_type -> google.protobuf.Empty
+	39,  // 136: jasper.JasperProcessManager.DownloadFile:input_type -> jasper.DownloadInfo
+	101, // 137: jasper.JasperProcessManager.GetDownloadCacheStats:input_type -> google.protobuf.Empty
+	41,  // 138: jasper.JasperProcessManager.Drain:input_type -> jasper.DrainOptions
+	101, // 139: jasper.JasperProcessManager.GetSnapshot:input_type -> google.protobuf.Empty
+	45,  // 140: jasper.JasperProcessManager.AddSchedule:input_type -> jasper.ScheduleOptions
+	49,  // 141: jasper.JasperProcessManager.RemoveSchedule:input_type -> jasper.ScheduleID
+	101, // 142: jasper.JasperProcessManager.ListSchedules:input_type -> google.protobuf.Empty
+	65,  // 143: jasper.JasperProcessManager.GetLogStream:input_type -> jasper.LogRequest
+	67,  // 144: jasper.JasperProcessManager.FollowLogStream:input_type -> jasper.LogFollowRequest
+	71,  // 145: jasper.JasperProcessManager.Exec:input_type -> jasper.ExecInput
+	75,  // 146: jasper.JasperProcessManager.SignalEvent:input_type -> jasper.EventName
+	50,  // 147: jasper.JasperProcessManager.WriteFile:input_type -> jasper.WriteFileInfo
+	55,  // 148: jasper.JasperProcessManager.ReadFile:input_type -> jasper.ReadFileInfo
+	51,  // 149: jasper.JasperProcessManager.StatFile:input_type -> jasper.FilePath
+	57,  // 150: jasper.JasperProcessManager.ListDirectory:input_type -> jasper.ListDirectoryInfo
+	51,  // 151: jasper.JasperProcessManager.GlobFiles:input_type -> jasper.FilePath
+	58,  // 152: jasper.JasperProcessManager.RemoveFile:input_type -> jasper.RemoveFileInfo
+	59,  // 153: jasper.JasperProcessManager.RenameFile:input_type -> jasper.RenameFileInfo
+	60,  // 154: jasper.JasperProcessManager.MakeDirectory:input_type -> jasper.MakeDirectoryInfo
+	61,  // 155: jasper.JasperProcessManager.ChecksumFile:input_type -> jasper.FileChecksumInfo
+	63,  // 156: jasper.JasperProcessManager.CreateArchive:input_type -> jasper.CreateArchiveInfo
+	94,  // 157: jasper.JasperProcessManager.SendMessages:input_type -> jasper.LoggingPayload
+	24,  // 158: jasper.JasperProcessManager.ID:output_type -> jasper.IDResponse
+	25,  // 159: jasper.JasperProcessManager.Create:output_type -> jasper.ProcessInfo
+	25,  // 160: jasper.JasperProcessManager.List:output_type -> jasper.ProcessInfo
+	25,  // 161: jasper.JasperProcessManager.Group:output_type -> jasper.ProcessInfo
+	25,  // 162: jasper.JasperProcessManager.Get:output_type -> jasper.ProcessInfo
+	35,  // 163: jasper.JasperProcessManager.Signal:output_type -> jasper.OperationOutcome
+	35,  // 164: jasper.JasperProcessManager.Clear:output_type -> jasper.OperationOutcome
+	35,  // 165: jasper.JasperProcessManager.Close:output_type -> jasper.OperationOutcome
+	35,  // 166: jasper.JasperProcessManager.TagProcess:output_type -> jasper.OperationOutcome
+	35,  // 167: jasper.JasperProcessManager.ResetTags:output_type -> jasper.OperationOutcome
+	33,  // 168: jasper.JasperProcessManager.GetTags:output_type -> jasper.ProcessTags
+	35,  // 169: jasper.JasperProcessManager.RegisterSignalTriggerID:output_type -> jasper.OperationOutcome
+	35,  // 170: jasper.JasperProcessManager.RegisterTriggerID:output_type -> jasper.OperationOutcome
+	35,  // 171: jasper.JasperProcessManager.Wait:output_type -> jasper.OperationOutcome
+	35,  // 172: jasper.JasperProcessManager.WaitReady:output_type -> jasper.OperationOutcome
+	25,  // 173: jasper.JasperProcessManager.Respawn:output_type -> jasper.ProcessInfo
+	76,  // 174: jasper.JasperProcessManager.ScriptingHarnessCreate:output_type -> jasper.ScriptingHarnessID
+	35,  // 175: jasper.JasperProcessManager.ScriptingHarnessCheck:output_type -> jasper.OperationOutcome
+	35,  // 176: jasper.JasperProcessManager.ScriptingHarnessSetup:output_type -> jasper.OperationOutcome
+	35,  // 177: jasper.JasperProcessManager.ScriptingHarnessCleanup:output_type -> jasper.OperationOutcome
+	35,  // 178: jasper.JasperProcessManager.ScriptingHarnessRun:output_type -> jasper.OperationOutcome
+	83,  // 179: jasper.JasperProcessManager.ScriptingHarnessBuild:output_type -> jasper.ScriptingHarnessBuildResponse
+	35,  // 180: jasper.JasperProcessManager.ScriptingHarnessRunScript:output_type -> jasper.OperationOutcome
+	88,  // 181: jasper.JasperProcessManager.ScriptingHarnessTest:output_type -> jasper.ScriptingHarnessTestResponse
+	91,  // 182: jasper.JasperProcessManager.LoggingCacheCreate:output_type -> jasper.LoggingCacheInstance
+	91,  // 183: jasper.JasperProcessManager.LoggingCacheGet:output_type -> jasper.LoggingCacheInstance
+	35,  // 184: jasper.JasperProcessManager.LoggingCacheRemove:output_type -> jasper.OperationOutcome
+	35,  // 185: jasper.JasperProcessManager.LoggingCacheCloseAndRemove:output_type -> jasper.OperationOutcome
+	35,  // 186: jasper.JasperProcessManager.LoggingCacheClear:output_type -> jasper.OperationOutcome
+	92,  // 187: jasper.JasperProcessManager.LoggingCacheLen:output_type -> jasper.LoggingCacheSize
+	35,  // 188: jasper.JasperProcessManager.LoggingCachePrune:output_type -> jasper.OperationOutcome
+	29,  // 189: jasper.JasperProcessManager.Status:output_type -> jasper.StatusResponse
+	35,  // 190: jasper.JasperProcessManager.DownloadFile:output_type -> jasper.OperationOutcome
+	40,  // 191: jasper.JasperProcessManager.GetDownloadCacheStats:output_type -> jasper.DownloadCacheStats
+	42,  // 192: jasper.JasperProcessManager.Drain:output_type -> jasper.DrainReport
+	44,  // 193: jasper.JasperProcessManager.GetSnapshot:output_type -> jasper.Snapshot
+	35,  // 194: jasper.JasperProcessManager.AddSchedule:output_type -> jasper.OperationOutcome
+	35,  // 195: jasper.JasperProcessManager.RemoveSchedule:output_type -> jasper.OperationOutcome
+	48,  // 196: jasper.JasperProcessManager.ListSchedules:output_type -> jasper.ScheduleInfoList
+	66,  // 197: jasper.JasperProcessManager.GetLogStream:output_type -> jasper.LogStream
+	68,  // 198: jasper.JasperProcessManager.FollowLogStream:output_type -> jasper.LogLine
+	72,  // 199: jasper.JasperProcessManager.Exec:output_type -> jasper.ExecOutput
+	35,  // 200: jasper.JasperProcessManager.SignalEvent:output_type -> jasper.OperationOutcome
+	35,  // 201: jasper.JasperProcessManager.WriteFile:output_type -> jasper.OperationOutcome
+	56,  // 202: jasper.JasperProcessManager.ReadFile:output_type -> jasper.FileChunk
+	52,  // 203: jasper.JasperProcessManager.StatFile:output_type -> jasper.FileInfo
+	53,  // 204: jasper.JasperProcessManager.ListDirectory:output_type -> jasper.FileInfoList
+	54,  // 205: jasper.JasperProcessManager.GlobFiles:output_type -> jasper.FilePathList
+	35,  // 206: jasper.JasperProcessManager.RemoveFile:output_type -> jasper.OperationOutcome
+	35,  // 207: jasper.JasperProcessManager.RenameFile:output_type -> jasper.OperationOutcome
+	35,  // 208: jasper.JasperProcessManager.MakeDirectory:output_type -> jasper.OperationOutcome
+	62,  // 209: jasper.JasperProcessManager.ChecksumFile:output_type -> jasper.FileChecksumResponse
+	56,  // 210: jasper.JasperProcessManager.CreateArchive:output_type -> jasper.FileChunk
+	35,  // 211: jasper.JasperProcessManager.SendMessages:output_type -> jasper.OperationOutcome
+	158, // [158:212] is the sub-list for method output_type
+	104, // [104:158] is the sub-list for method input_type
+	104, // [104:104] is the sub-list for extension type_name
+	104, // [104:104] is the sub-list for extension extendee
+	0,   // [0:104] is the sub-list for field type_name
 }
 
 func init() { file_jasper_proto_init() }
@@ -4015,12 +7138,12 @@ func file_jasper_proto_init() {
 		(*LoggerConfig_Raw)(nil),
 		(*LoggerConfig_Splunk)(nil),
 	}
-	file_jasper_proto_msgTypes[34].OneofWrappers = []any{
+	file_jasper_proto_msgTypes[73].OneofWrappers = []any{
 		(*ScriptingOptions_Golang)(nil),
 		(*ScriptingOptions_Python)(nil),
 		(*ScriptingOptions_Roswell)(nil),
 	}
-	file_jasper_proto_msgTypes[47].OneofWrappers = []any{
+	file_jasper_proto_msgTypes[86].OneofWrappers = []any{
 		(*LoggingPayloadData_Msg)(nil),
 		(*LoggingPayloadData_Raw)(nil),
 	}
@@ -4030,7 +7153,7 @@ func file_jasper_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jasper_proto_rawDesc), len(file_jasper_proto_rawDesc)),
 			NumEnums:      7,
-			NumMessages:   51,
+			NumMessages:   92,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
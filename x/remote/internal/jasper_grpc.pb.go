@@ -33,7 +33,9 @@ const (
 	JasperProcessManager_ResetTags_FullMethodName                  = "/jasper.JasperProcessManager/ResetTags"
 	JasperProcessManager_GetTags_FullMethodName                    = "/jasper.JasperProcessManager/GetTags"
 	JasperProcessManager_RegisterSignalTriggerID_FullMethodName    = "/jasper.JasperProcessManager/RegisterSignalTriggerID"
+	JasperProcessManager_RegisterTriggerID_FullMethodName          = "/jasper.JasperProcessManager/RegisterTriggerID"
 	JasperProcessManager_Wait_FullMethodName                       = "/jasper.JasperProcessManager/Wait"
+	JasperProcessManager_WaitReady_FullMethodName                  = "/jasper.JasperProcessManager/WaitReady"
 	JasperProcessManager_Respawn_FullMethodName                    = "/jasper.JasperProcessManager/Respawn"
 	JasperProcessManager_ScriptingHarnessCreate_FullMethodName     = "/jasper.JasperProcessManager/ScriptingHarnessCreate"
 	JasperProcessManager_ScriptingHarnessCheck_FullMethodName      = "/jasper.JasperProcessManager/ScriptingHarnessCheck"
@@ -52,9 +54,26 @@ const (
 	JasperProcessManager_LoggingCachePrune_FullMethodName          = "/jasper.JasperProcessManager/LoggingCachePrune"
 	JasperProcessManager_Status_FullMethodName                     = "/jasper.JasperProcessManager/Status"
 	JasperProcessManager_DownloadFile_FullMethodName               = "/jasper.JasperProcessManager/DownloadFile"
+	JasperProcessManager_GetDownloadCacheStats_FullMethodName      = "/jasper.JasperProcessManager/GetDownloadCacheStats"
+	JasperProcessManager_Drain_FullMethodName                      = "/jasper.JasperProcessManager/Drain"
+	JasperProcessManager_GetSnapshot_FullMethodName                = "/jasper.JasperProcessManager/GetSnapshot"
+	JasperProcessManager_AddSchedule_FullMethodName                = "/jasper.JasperProcessManager/AddSchedule"
+	JasperProcessManager_RemoveSchedule_FullMethodName             = "/jasper.JasperProcessManager/RemoveSchedule"
+	JasperProcessManager_ListSchedules_FullMethodName              = "/jasper.JasperProcessManager/ListSchedules"
 	JasperProcessManager_GetLogStream_FullMethodName               = "/jasper.JasperProcessManager/GetLogStream"
+	JasperProcessManager_FollowLogStream_FullMethodName            = "/jasper.JasperProcessManager/FollowLogStream"
+	JasperProcessManager_Exec_FullMethodName                       = "/jasper.JasperProcessManager/Exec"
 	JasperProcessManager_SignalEvent_FullMethodName                = "/jasper.JasperProcessManager/SignalEvent"
 	JasperProcessManager_WriteFile_FullMethodName                  = "/jasper.JasperProcessManager/WriteFile"
+	JasperProcessManager_ReadFile_FullMethodName                   = "/jasper.JasperProcessManager/ReadFile"
+	JasperProcessManager_StatFile_FullMethodName                   = "/jasper.JasperProcessManager/StatFile"
+	JasperProcessManager_ListDirectory_FullMethodName              = "/jasper.JasperProcessManager/ListDirectory"
+	JasperProcessManager_GlobFiles_FullMethodName                  = "/jasper.JasperProcessManager/GlobFiles"
+	JasperProcessManager_RemoveFile_FullMethodName                 = "/jasper.JasperProcessManager/RemoveFile"
+	JasperProcessManager_RenameFile_FullMethodName                 = "/jasper.JasperProcessManager/RenameFile"
+	JasperProcessManager_MakeDirectory_FullMethodName              = "/jasper.JasperProcessManager/MakeDirectory"
+	JasperProcessManager_ChecksumFile_FullMethodName               = "/jasper.JasperProcessManager/ChecksumFile"
+	JasperProcessManager_CreateArchive_FullMethodName              = "/jasper.JasperProcessManager/CreateArchive"
 	JasperProcessManager_SendMessages_FullMethodName               = "/jasper.JasperProcessManager/SendMessages"
 )
 
@@ -76,7 +95,9 @@ type JasperProcessManagerClient interface {
 	ResetTags(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*OperationOutcome, error)
 	GetTags(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*ProcessTags, error)
 	RegisterSignalTriggerID(ctx context.Context, in *SignalTriggerParams, opts ...grpc.CallOption) (*OperationOutcome, error)
+	RegisterTriggerID(ctx context.Context, in *ProcessTriggerParams, opts ...grpc.CallOption) (*OperationOutcome, error)
 	Wait(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*OperationOutcome, error)
+	WaitReady(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*OperationOutcome, error)
 	Respawn(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*ProcessInfo, error)
 	// ScriptingHarness functions
 	ScriptingHarnessCreate(ctx context.Context, in *ScriptingOptions, opts ...grpc.CallOption) (*ScriptingHarnessID, error)
@@ -98,9 +119,26 @@ type JasperProcessManagerClient interface {
 	// Remote specific functions
 	Status(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StatusResponse, error)
 	DownloadFile(ctx context.Context, in *DownloadInfo, opts ...grpc.CallOption) (*OperationOutcome, error)
+	GetDownloadCacheStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DownloadCacheStats, error)
+	Drain(ctx context.Context, in *DrainOptions, opts ...grpc.CallOption) (*DrainReport, error)
+	GetSnapshot(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Snapshot, error)
+	AddSchedule(ctx context.Context, in *ScheduleOptions, opts ...grpc.CallOption) (*OperationOutcome, error)
+	RemoveSchedule(ctx context.Context, in *ScheduleID, opts ...grpc.CallOption) (*OperationOutcome, error)
+	ListSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ScheduleInfoList, error)
 	GetLogStream(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (*LogStream, error)
+	FollowLogStream(ctx context.Context, in *LogFollowRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogLine], error)
+	Exec(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ExecInput, ExecOutput], error)
 	SignalEvent(ctx context.Context, in *EventName, opts ...grpc.CallOption) (*OperationOutcome, error)
 	WriteFile(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[WriteFileInfo, OperationOutcome], error)
+	ReadFile(ctx context.Context, in *ReadFileInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileChunk], error)
+	StatFile(ctx context.Context, in *FilePath, opts ...grpc.CallOption) (*FileInfo, error)
+	ListDirectory(ctx context.Context, in *ListDirectoryInfo, opts ...grpc.CallOption) (*FileInfoList, error)
+	GlobFiles(ctx context.Context, in *FilePath, opts ...grpc.CallOption) (*FilePathList, error)
+	RemoveFile(ctx context.Context, in *RemoveFileInfo, opts ...grpc.CallOption) (*OperationOutcome, error)
+	RenameFile(ctx context.Context, in *RenameFileInfo, opts ...grpc.CallOption) (*OperationOutcome, error)
+	MakeDirectory(ctx context.Context, in *MakeDirectoryInfo, opts ...grpc.CallOption) (*OperationOutcome, error)
+	ChecksumFile(ctx context.Context, in *FileChecksumInfo, opts ...grpc.CallOption) (*FileChecksumResponse, error)
+	CreateArchive(ctx context.Context, in *CreateArchiveInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileChunk], error)
 	SendMessages(ctx context.Context, in *LoggingPayload, opts ...grpc.CallOption) (*OperationOutcome, error)
 }
 
@@ -250,6 +288,16 @@ func (c *jasperProcessManagerClient) RegisterSignalTriggerID(ctx context.Context
 	return out, nil
 }
 
+func (c *jasperProcessManagerClient) RegisterTriggerID(ctx context.Context, in *ProcessTriggerParams, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, JasperProcessManager_RegisterTriggerID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jasperProcessManagerClient) Wait(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*OperationOutcome, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(OperationOutcome)
@@ -260,6 +308,16 @@ func (c *jasperProcessManagerClient) Wait(ctx context.Context, in *JasperProcess
 	return out, nil
 }
 
+func (c *jasperProcessManagerClient) WaitReady(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, JasperProcessManager_WaitReady_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jasperProcessManagerClient) Respawn(ctx context.Context, in *JasperProcessID, opts ...grpc.CallOption) (*ProcessInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ProcessInfo)
@@ -440,6 +498,66 @@ func (c *jasperProcessManagerClient) DownloadFile(ctx context.Context, in *Downl
 	return out, nil
 }
 
+func (c *jasperProcessManagerClient) GetDownloadCacheStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DownloadCacheStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DownloadCacheStats)
+	err := c.cc.Invoke(ctx, JasperProcessManager_GetDownloadCacheStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) Drain(ctx context.Context, in *DrainOptions, opts ...grpc.CallOption) (*DrainReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrainReport)
+	err := c.cc.Invoke(ctx, JasperProcessManager_Drain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) GetSnapshot(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Snapshot, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Snapshot)
+	err := c.cc.Invoke(ctx, JasperProcessManager_GetSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) AddSchedule(ctx context.Context, in *ScheduleOptions, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, JasperProcessManager_AddSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) RemoveSchedule(ctx context.Context, in *ScheduleID, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, JasperProcessManager_RemoveSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) ListSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ScheduleInfoList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleInfoList)
+	err := c.cc.Invoke(ctx, JasperProcessManager_ListSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *jasperProcessManagerClient) GetLogStream(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (*LogStream, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LogStream)
@@ -450,6 +568,38 @@ func (c *jasperProcessManagerClient) GetLogStream(ctx context.Context, in *LogRe
 	return out, nil
 }
 
+func (c *jasperProcessManagerClient) FollowLogStream(ctx context.Context, in *LogFollowRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogLine], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &JasperProcessManager_ServiceDesc.Streams[2], JasperProcessManager_FollowLogStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[LogFollowRequest, LogLine]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JasperProcessManager_FollowLogStreamClient = grpc.ServerStreamingClient[LogLine]
+
+func (c *jasperProcessManagerClient) Exec(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ExecInput, ExecOutput], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &JasperProcessManager_ServiceDesc.Streams[3], JasperProcessManager_Exec_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExecInput, ExecOutput]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JasperProcessManager_ExecClient = grpc.BidiStreamingClient[ExecInput, ExecOutput]
+
 func (c *jasperProcessManagerClient) SignalEvent(ctx context.Context, in *EventName, opts ...grpc.CallOption) (*OperationOutcome, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(OperationOutcome)
@@ -462,7 +612,7 @@ func (c *jasperProcessManagerClient) SignalEvent(ctx context.Context, in *EventN
 
 func (c *jasperProcessManagerClient) WriteFile(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[WriteFileInfo, OperationOutcome], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &JasperProcessManager_ServiceDesc.Streams[2], JasperProcessManager_WriteFile_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &JasperProcessManager_ServiceDesc.Streams[4], JasperProcessManager_WriteFile_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -473,6 +623,114 @@ func (c *jasperProcessManagerClient) WriteFile(ctx context.Context, opts ...grpc
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type JasperProcessManager_WriteFileClient = grpc.ClientStreamingClient[WriteFileInfo, OperationOutcome]
 
+func (c *jasperProcessManagerClient) ReadFile(ctx context.Context, in *ReadFileInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &JasperProcessManager_ServiceDesc.Streams[5], JasperProcessManager_ReadFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReadFileInfo, FileChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JasperProcessManager_ReadFileClient = grpc.ServerStreamingClient[FileChunk]
+
+func (c *jasperProcessManagerClient) StatFile(ctx context.Context, in *FilePath, opts ...grpc.CallOption) (*FileInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileInfo)
+	err := c.cc.Invoke(ctx, JasperProcessManager_StatFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) ListDirectory(ctx context.Context, in *ListDirectoryInfo, opts ...grpc.CallOption) (*FileInfoList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileInfoList)
+	err := c.cc.Invoke(ctx, JasperProcessManager_ListDirectory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) GlobFiles(ctx context.Context, in *FilePath, opts ...grpc.CallOption) (*FilePathList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FilePathList)
+	err := c.cc.Invoke(ctx, JasperProcessManager_GlobFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) RemoveFile(ctx context.Context, in *RemoveFileInfo, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, JasperProcessManager_RemoveFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) RenameFile(ctx context.Context, in *RenameFileInfo, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, JasperProcessManager_RenameFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) MakeDirectory(ctx context.Context, in *MakeDirectoryInfo, opts ...grpc.CallOption) (*OperationOutcome, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OperationOutcome)
+	err := c.cc.Invoke(ctx, JasperProcessManager_MakeDirectory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) ChecksumFile(ctx context.Context, in *FileChecksumInfo, opts ...grpc.CallOption) (*FileChecksumResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileChecksumResponse)
+	err := c.cc.Invoke(ctx, JasperProcessManager_ChecksumFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jasperProcessManagerClient) CreateArchive(ctx context.Context, in *CreateArchiveInfo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FileChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &JasperProcessManager_ServiceDesc.Streams[6], JasperProcessManager_CreateArchive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CreateArchiveInfo, FileChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JasperProcessManager_CreateArchiveClient = grpc.ServerStreamingClient[FileChunk]
+
 func (c *jasperProcessManagerClient) SendMessages(ctx context.Context, in *LoggingPayload, opts ...grpc.CallOption) (*OperationOutcome, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(OperationOutcome)
@@ -501,7 +759,9 @@ type JasperProcessManagerServer interface {
 	ResetTags(context.Context, *JasperProcessID) (*OperationOutcome, error)
 	GetTags(context.Context, *JasperProcessID) (*ProcessTags, error)
 	RegisterSignalTriggerID(context.Context, *SignalTriggerParams) (*OperationOutcome, error)
+	RegisterTriggerID(context.Context, *ProcessTriggerParams) (*OperationOutcome, error)
 	Wait(context.Context, *JasperProcessID) (*OperationOutcome, error)
+	WaitReady(context.Context, *JasperProcessID) (*OperationOutcome, error)
 	Respawn(context.Context, *JasperProcessID) (*ProcessInfo, error)
 	// ScriptingHarness functions
 	ScriptingHarnessCreate(context.Context, *ScriptingOptions) (*ScriptingHarnessID, error)
@@ -523,9 +783,26 @@ type JasperProcessManagerServer interface {
 	// Remote specific functions
 	Status(context.Context, *emptypb.Empty) (*StatusResponse, error)
 	DownloadFile(context.Context, *DownloadInfo) (*OperationOutcome, error)
+	GetDownloadCacheStats(context.Context, *emptypb.Empty) (*DownloadCacheStats, error)
+	Drain(context.Context, *DrainOptions) (*DrainReport, error)
+	GetSnapshot(context.Context, *emptypb.Empty) (*Snapshot, error)
+	AddSchedule(context.Context, *ScheduleOptions) (*OperationOutcome, error)
+	RemoveSchedule(context.Context, *ScheduleID) (*OperationOutcome, error)
+	ListSchedules(context.Context, *emptypb.Empty) (*ScheduleInfoList, error)
 	GetLogStream(context.Context, *LogRequest) (*LogStream, error)
+	FollowLogStream(*LogFollowRequest, grpc.ServerStreamingServer[LogLine]) error
+	Exec(grpc.BidiStreamingServer[ExecInput, ExecOutput]) error
 	SignalEvent(context.Context, *EventName) (*OperationOutcome, error)
 	WriteFile(grpc.ClientStreamingServer[WriteFileInfo, OperationOutcome]) error
+	ReadFile(*ReadFileInfo, grpc.ServerStreamingServer[FileChunk]) error
+	StatFile(context.Context, *FilePath) (*FileInfo, error)
+	ListDirectory(context.Context, *ListDirectoryInfo) (*FileInfoList, error)
+	GlobFiles(context.Context, *FilePath) (*FilePathList, error)
+	RemoveFile(context.Context, *RemoveFileInfo) (*OperationOutcome, error)
+	RenameFile(context.Context, *RenameFileInfo) (*OperationOutcome, error)
+	MakeDirectory(context.Context, *MakeDirectoryInfo) (*OperationOutcome, error)
+	ChecksumFile(context.Context, *FileChecksumInfo) (*FileChecksumResponse, error)
+	CreateArchive(*CreateArchiveInfo, grpc.ServerStreamingServer[FileChunk]) error
 	SendMessages(context.Context, *LoggingPayload) (*OperationOutcome, error)
 	mustEmbedUnimplementedJasperProcessManagerServer()
 }
@@ -573,9 +850,15 @@ func (UnimplementedJasperProcessManagerServer) GetTags(context.Context, *JasperP
 func (UnimplementedJasperProcessManagerServer) RegisterSignalTriggerID(context.Context, *SignalTriggerParams) (*OperationOutcome, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterSignalTriggerID not implemented")
 }
+func (UnimplementedJasperProcessManagerServer) RegisterTriggerID(context.Context, *ProcessTriggerParams) (*OperationOutcome, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterTriggerID not implemented")
+}
 func (UnimplementedJasperProcessManagerServer) Wait(context.Context, *JasperProcessID) (*OperationOutcome, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Wait not implemented")
 }
+func (UnimplementedJasperProcessManagerServer) WaitReady(context.Context, *JasperProcessID) (*OperationOutcome, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitReady not implemented")
+}
 func (UnimplementedJasperProcessManagerServer) Respawn(context.Context, *JasperProcessID) (*ProcessInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Respawn not implemented")
 }
@@ -630,15 +913,66 @@ func (UnimplementedJasperProcessManagerServer) Status(context.Context, *emptypb.
 func (UnimplementedJasperProcessManagerServer) DownloadFile(context.Context, *DownloadInfo) (*OperationOutcome, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DownloadFile not implemented")
 }
+func (UnimplementedJasperProcessManagerServer) GetDownloadCacheStats(context.Context, *emptypb.Empty) (*DownloadCacheStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDownloadCacheStats not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) Drain(context.Context, *DrainOptions) (*DrainReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) GetSnapshot(context.Context, *emptypb.Empty) (*Snapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshot not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) AddSchedule(context.Context, *ScheduleOptions) (*OperationOutcome, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSchedule not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) RemoveSchedule(context.Context, *ScheduleID) (*OperationOutcome, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSchedule not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) ListSchedules(context.Context, *emptypb.Empty) (*ScheduleInfoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
 func (UnimplementedJasperProcessManagerServer) GetLogStream(context.Context, *LogRequest) (*LogStream, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLogStream not implemented")
 }
+func (UnimplementedJasperProcessManagerServer) FollowLogStream(*LogFollowRequest, grpc.ServerStreamingServer[LogLine]) error {
+	return status.Errorf(codes.Unimplemented, "method FollowLogStream not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) Exec(grpc.BidiStreamingServer[ExecInput, ExecOutput]) error {
+	return status.Errorf(codes.Unimplemented, "method Exec not implemented")
+}
 func (UnimplementedJasperProcessManagerServer) SignalEvent(context.Context, *EventName) (*OperationOutcome, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignalEvent not implemented")
 }
 func (UnimplementedJasperProcessManagerServer) WriteFile(grpc.ClientStreamingServer[WriteFileInfo, OperationOutcome]) error {
 	return status.Errorf(codes.Unimplemented, "method WriteFile not implemented")
 }
+func (UnimplementedJasperProcessManagerServer) ReadFile(*ReadFileInfo, grpc.ServerStreamingServer[FileChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ReadFile not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) StatFile(context.Context, *FilePath) (*FileInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatFile not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) ListDirectory(context.Context, *ListDirectoryInfo) (*FileInfoList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDirectory not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) GlobFiles(context.Context, *FilePath) (*FilePathList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GlobFiles not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) RemoveFile(context.Context, *RemoveFileInfo) (*OperationOutcome, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFile not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) RenameFile(context.Context, *RenameFileInfo) (*OperationOutcome, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameFile not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) MakeDirectory(context.Context, *MakeDirectoryInfo) (*OperationOutcome, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MakeDirectory not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) ChecksumFile(context.Context, *FileChecksumInfo) (*FileChecksumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChecksumFile not implemented")
+}
+func (UnimplementedJasperProcessManagerServer) CreateArchive(*CreateArchiveInfo, grpc.ServerStreamingServer[FileChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method CreateArchive not implemented")
+}
 func (UnimplementedJasperProcessManagerServer) SendMessages(context.Context, *LoggingPayload) (*OperationOutcome, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMessages not implemented")
 }
@@ -865,6 +1199,24 @@ func _JasperProcessManager_RegisterSignalTriggerID_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JasperProcessManager_RegisterTriggerID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessTriggerParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).RegisterTriggerID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JasperProcessManager_RegisterTriggerID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).RegisterTriggerID(ctx, req.(*ProcessTriggerParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JasperProcessManager_Wait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(JasperProcessID)
 	if err := dec(in); err != nil {
@@ -883,6 +1235,24 @@ func _JasperProcessManager_Wait_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JasperProcessManager_WaitReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JasperProcessID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).WaitReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JasperProcessManager_WaitReady_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).WaitReady(ctx, req.(*JasperProcessID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JasperProcessManager_Respawn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(JasperProcessID)
 	if err := dec(in); err != nil {
@@ -1207,6 +1577,114 @@ func _JasperProcessManager_DownloadFile_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JasperProcessManager_GetDownloadCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).GetDownloadCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JasperProcessManager_GetDownloadCacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).GetDownloadCacheStats(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JasperProcessManager_Drain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).Drain(ctx, req.(*DrainOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_GetSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).GetSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JasperProcessManager_GetSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).GetSnapshot(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_AddSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).AddSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JasperProcessManager_AddSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).AddSchedule(ctx, req.(*ScheduleOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_RemoveSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).RemoveSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JasperProcessManager_RemoveSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).RemoveSchedule(ctx, req.(*ScheduleID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JasperProcessManager_ListSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).ListSchedules(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JasperProcessManager_GetLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LogRequest)
 	if err := dec(in); err != nil {
@@ -1225,6 +1703,24 @@ func _JasperProcessManager_GetLogStream_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JasperProcessManager_FollowLogStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogFollowRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JasperProcessManagerServer).FollowLogStream(m, &grpc.GenericServerStream[LogFollowRequest, LogLine]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JasperProcessManager_FollowLogStreamServer = grpc.ServerStreamingServer[LogLine]
+
+func _JasperProcessManager_Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(JasperProcessManagerServer).Exec(&grpc.GenericServerStream[ExecInput, ExecOutput]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JasperProcessManager_ExecServer = grpc.BidiStreamingServer[ExecInput, ExecOutput]
+
 func _JasperProcessManager_SignalEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EventName)
 	if err := dec(in); err != nil {
@@ -1250,6 +1746,154 @@ func _JasperProcessManager_WriteFile_Handler(srv interface{}, stream grpc.Server
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type JasperProcessManager_WriteFileServer = grpc.ClientStreamingServer[WriteFileInfo, OperationOutcome]
 
+func _JasperProcessManager_ReadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadFileInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JasperProcessManagerServer).ReadFile(m, &grpc.GenericServerStream[ReadFileInfo, FileChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JasperProcessManager_ReadFileServer = grpc.ServerStreamingServer[FileChunk]
+
+func _JasperProcessManager_StatFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilePath)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).StatFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JasperProcessManager_StatFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).StatFile(ctx, req.(*FilePath))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_ListDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDirectoryInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).ListDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JasperProcessManager_ListDirectory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).ListDirectory(ctx, req.(*ListDirectoryInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_GlobFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilePath)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).GlobFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JasperProcessManager_GlobFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).GlobFiles(ctx, req.(*FilePath))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_RemoveFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFileInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).RemoveFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JasperProcessManager_RemoveFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).RemoveFile(ctx, req.(*RemoveFileInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_RenameFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameFileInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).RenameFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JasperProcessManager_RenameFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).RenameFile(ctx, req.(*RenameFileInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_MakeDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MakeDirectoryInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).MakeDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JasperProcessManager_MakeDirectory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).MakeDirectory(ctx, req.(*MakeDirectoryInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_ChecksumFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileChecksumInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JasperProcessManagerServer).ChecksumFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JasperProcessManager_ChecksumFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JasperProcessManagerServer).ChecksumFile(ctx, req.(*FileChecksumInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JasperProcessManager_CreateArchive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CreateArchiveInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JasperProcessManagerServer).CreateArchive(m, &grpc.GenericServerStream[CreateArchiveInfo, FileChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JasperProcessManager_CreateArchiveServer = grpc.ServerStreamingServer[FileChunk]
+
 func _JasperProcessManager_SendMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LoggingPayload)
 	if err := dec(in); err != nil {
@@ -1315,10 +1959,18 @@ var JasperProcessManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterSignalTriggerID",
 			Handler:    _JasperProcessManager_RegisterSignalTriggerID_Handler,
 		},
+		{
+			MethodName: "RegisterTriggerID",
+			Handler:    _JasperProcessManager_RegisterTriggerID_Handler,
+		},
 		{
 			MethodName: "Wait",
 			Handler:    _JasperProcessManager_Wait_Handler,
 		},
+		{
+			MethodName: "WaitReady",
+			Handler:    _JasperProcessManager_WaitReady_Handler,
+		},
 		{
 			MethodName: "Respawn",
 			Handler:    _JasperProcessManager_Respawn_Handler,
@@ -1391,6 +2043,30 @@ var JasperProcessManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DownloadFile",
 			Handler:    _JasperProcessManager_DownloadFile_Handler,
 		},
+		{
+			MethodName: "GetDownloadCacheStats",
+			Handler:    _JasperProcessManager_GetDownloadCacheStats_Handler,
+		},
+		{
+			MethodName: "Drain",
+			Handler:    _JasperProcessManager_Drain_Handler,
+		},
+		{
+			MethodName: "GetSnapshot",
+			Handler:    _JasperProcessManager_GetSnapshot_Handler,
+		},
+		{
+			MethodName: "AddSchedule",
+			Handler:    _JasperProcessManager_AddSchedule_Handler,
+		},
+		{
+			MethodName: "RemoveSchedule",
+			Handler:    _JasperProcessManager_RemoveSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _JasperProcessManager_ListSchedules_Handler,
+		},
 		{
 			MethodName: "GetLogStream",
 			Handler:    _JasperProcessManager_GetLogStream_Handler,
@@ -1399,6 +2075,34 @@ var JasperProcessManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignalEvent",
 			Handler:    _JasperProcessManager_SignalEvent_Handler,
 		},
+		{
+			MethodName: "StatFile",
+			Handler:    _JasperProcessManager_StatFile_Handler,
+		},
+		{
+			MethodName: "ListDirectory",
+			Handler:    _JasperProcessManager_ListDirectory_Handler,
+		},
+		{
+			MethodName: "GlobFiles",
+			Handler:    _JasperProcessManager_GlobFiles_Handler,
+		},
+		{
+			MethodName: "RemoveFile",
+			Handler:    _JasperProcessManager_RemoveFile_Handler,
+		},
+		{
+			MethodName: "RenameFile",
+			Handler:    _JasperProcessManager_RenameFile_Handler,
+		},
+		{
+			MethodName: "MakeDirectory",
+			Handler:    _JasperProcessManager_MakeDirectory_Handler,
+		},
+		{
+			MethodName: "ChecksumFile",
+			Handler:    _JasperProcessManager_ChecksumFile_Handler,
+		},
 		{
 			MethodName: "SendMessages",
 			Handler:    _JasperProcessManager_SendMessages_Handler,
@@ -1415,11 +2119,32 @@ var JasperProcessManager_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _JasperProcessManager_Group_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "FollowLogStream",
+			Handler:       _JasperProcessManager_FollowLogStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Exec",
+			Handler:       _JasperProcessManager_Exec_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "WriteFile",
 			Handler:       _JasperProcessManager_WriteFile_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ReadFile",
+			Handler:       _JasperProcessManager_ReadFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CreateArchive",
+			Handler:       _JasperProcessManager_CreateArchive_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "jasper.proto",
 }
@@ -0,0 +1,49 @@
+package internal
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+
+	roptions "github.com/tychoish/jasper/x/remote/options"
+	"golang.org/x/sys/unix"
+)
+
+// openPTY opens a new pseudo-terminal, returning the controlling side and
+// the terminal device to attach to the process.
+func openPTY() (*os.File, *os.File, error) {
+	tty, err := os.OpenFile("/dev/ptmx", os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = unix.IoctlSetPointerInt(int(tty.Fd()), unix.TIOCSPTLCK, 0); err != nil {
+		tty.Close()
+		return nil, nil, fmt.Errorf("problem unlocking pseudo-terminal: %w", err)
+	}
+
+	num, err := unix.IoctlGetInt(int(tty.Fd()), unix.TIOCGPTN)
+	if err != nil {
+		tty.Close()
+		return nil, nil, fmt.Errorf("problem getting pseudo-terminal number: %w", err)
+	}
+
+	pts, err := os.OpenFile(fmt.Sprintf("/dev/pts/%d", num), os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		tty.Close()
+		return nil, nil, err
+	}
+
+	return tty, pts, nil
+}
+
+// resizePTY sets the window size of the pseudo-terminal.
+func resizePTY(tty *os.File, size roptions.WindowSize) error {
+	return unix.IoctlSetWinsize(int(tty.Fd()), unix.TIOCSWINSZ, &unix.Winsize{Row: size.Rows, Col: size.Cols})
+}
+
+// ptySysProcAttr starts the process in a new session with the
+// pseudo-terminal on its standard input as the controlling terminal.
+func ptySysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setsid: true, Setctty: true}
+}
@@ -0,0 +1,19 @@
+//go:build !linux
+
+package internal
+
+import (
+	"errors"
+	"os"
+	"syscall"
+
+	roptions "github.com/tychoish/jasper/x/remote/options"
+)
+
+var errPTYNotSupported = errors.New("pseudo-terminals are not supported on this platform")
+
+func openPTY() (*os.File, *os.File, error) { return nil, nil, errPTYNotSupported }
+
+func resizePTY(*os.File, roptions.WindowSize) error { return errPTYNotSupported }
+
+func ptySysProcAttr() *syscall.SysProcAttr { return nil }
@@ -1,10 +1,12 @@
 package internal
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	fmt "fmt"
 	"io"
+	"io/fs"
 	"os"
 	"strings"
 	"time"
@@ -14,6 +16,7 @@ import (
 	"github.com/tychoish/jasper"
 	"github.com/tychoish/jasper/options"
 	"github.com/tychoish/jasper/scripting"
+	roptions "github.com/tychoish/jasper/x/remote/options"
 	grpc "google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -38,6 +41,7 @@ func AttachService(ctx context.Context, manager jasper.Manager, s *grpc.Server)
 	srv := &jasperService{
 		hostID:    hn,
 		manager:   manager,
+		outputs:   jasper.NewOutputBufferRegistry(manager, jasper.DefaultOutputBufferSize),
 		scripting: scripting.NewCache(),
 	}
 
@@ -55,6 +59,7 @@ func getProcInfoNoHang(ctx context.Context, p jasper.Process) jasper.ProcessInfo
 type jasperService struct {
 	hostID    string
 	manager   jasper.Manager
+	outputs   *jasper.OutputBufferRegistry
 	scripting scripting.HarnessCache
 	UnimplementedJasperProcessManagerServer
 }
@@ -79,9 +84,9 @@ func (s *jasperService) Create(ctx context.Context, opts *CreateOptions) (*Proce
 	// Spawn a new context so that the process' context is not potentially
 	// canceled by the request's. See how rest_service.go's createProcess() does
 	// this same thing.
-	pctx, cancel := context.WithCancel(context.Background())
+	pctx, cancel := context.WithCancel(jasper.WithDetachedFollowUps(context.Background()))
 
-	proc, err := s.manager.CreateProcess(pctx, jopts)
+	proc, err := s.outputs.CreateProcess(pctx, jopts)
 	if err != nil {
 		cancel()
 		return nil, err
@@ -222,6 +227,55 @@ func (s *jasperService) Wait(ctx context.Context, id *JasperProcessID) (*Operati
 	}, nil
 }
 
+func (s *jasperService) WaitReady(ctx context.Context, id *JasperProcessID) (*OperationOutcome, error) {
+	proc, err := s.manager.Get(ctx, id.Value)
+	if err != nil {
+		err = fmt.Errorf("problem finding process '%s': %w", id.Value, err)
+		return &OperationOutcome{
+			Success:  false,
+			Text:     err.Error(),
+			ExitCode: -2,
+		}, nil
+	}
+
+	if err := proc.WaitReady(ctx); err != nil {
+		err = fmt.Errorf("problem encountered while waiting for readiness: %w", err)
+		return &OperationOutcome{
+			Success:  false,
+			Text:     err.Error(),
+			ExitCode: -3,
+		}, nil
+	}
+
+	return &OperationOutcome{
+		Success: true,
+		Text:    fmt.Sprintf("process with id '%s' is ready", id.Value),
+	}, nil
+}
+
+// respawner is implemented by managers that control how processes are
+// respawned, such as managers that refuse new processes while draining.
+type respawner interface {
+	RespawnProcess(context.Context, jasper.Process) (jasper.Process, error)
+}
+
+// respawn respawns the process and registers the new process with the
+// manager.
+func (s *jasperService) respawn(ctx context.Context, proc jasper.Process) (jasper.Process, error) {
+	if r, ok := s.manager.(respawner); ok {
+		return r.RespawnProcess(ctx, proc)
+	}
+
+	newProc, err := proc.Respawn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("problem encountered while respawning: %w", err)
+	}
+	if err := s.manager.Register(ctx, newProc); err != nil {
+		return nil, err
+	}
+	return newProc, nil
+}
+
 func (s *jasperService) Respawn(ctx context.Context, id *JasperProcessID) (*ProcessInfo, error) {
 	proc, err := s.manager.Get(ctx, id.Value)
 	if err != nil {
@@ -232,14 +286,9 @@ func (s *jasperService) Respawn(ctx context.Context, id *JasperProcessID) (*Proc
 	// Spawn a new context so that the process' context is not potentially
 	// canceled by the request's. See how rest_service.go's createProcess() does
 	// this same thing.
-	pctx, cancel := context.WithCancel(context.Background())
-	newProc, err := proc.Respawn(pctx)
+	pctx, cancel := context.WithCancel(jasper.WithDetachedFollowUps(context.Background()))
+	newProc, err := s.respawn(pctx, proc)
 	if err != nil {
-		err = fmt.Errorf("problem encountered while respawning: %w", err)
-		cancel()
-		return nil, err
-	}
-	if err := s.manager.Register(ctx, newProc); err != nil {
 		cancel()
 		return nil, err
 	}
@@ -261,13 +310,13 @@ func (s *jasperService) Respawn(ctx context.Context, id *JasperProcessID) (*Proc
 }
 
 func (s *jasperService) Clear(ctx context.Context, _ *empty.Empty) (*OperationOutcome, error) {
-	s.manager.Clear(ctx)
+	s.outputs.Clear(ctx)
 
 	return &OperationOutcome{Success: true, Text: "service cleared", ExitCode: 0}, nil
 }
 
 func (s *jasperService) Close(ctx context.Context, _ *empty.Empty) (*OperationOutcome, error) {
-	if err := s.manager.Close(ctx); err != nil {
+	if err := s.outputs.Close(ctx); err != nil {
 		err = fmt.Errorf("problem encountered closing service: %w", err)
 		return &OperationOutcome{
 			Success:  false,
@@ -343,6 +392,112 @@ func (s *jasperService) DownloadFile(ctx context.Context, opts *DownloadInfo) (*
 	}, nil
 }
 
+func (s *jasperService) GetDownloadCacheStats(ctx context.Context, _ *empty.Empty) (*DownloadCacheStats, error) {
+	return ConvertDownloadCacheStats(roptions.GetGlobalDownloadCache().Stats()), nil
+}
+
+// drainer is implemented by managers that can be drained.
+type drainer interface {
+	Drain(context.Context, roptions.Drain) (roptions.DrainReport, error)
+}
+
+func (s *jasperService) Drain(ctx context.Context, opts *DrainOptions) (*DrainReport, error) {
+	d, ok := s.manager.(drainer)
+	if !ok {
+		return nil, errors.New("manager does not support draining")
+	}
+
+	report, err := d.Drain(ctx, opts.Export())
+	if err != nil {
+		return nil, fmt.Errorf("problem draining service: %w", err)
+	}
+	return ConvertDrainReport(report), nil
+}
+
+func (s *jasperService) GetSnapshot(ctx context.Context, _ *empty.Empty) (*Snapshot, error) {
+	snapshot, err := roptions.NewSnapshot(ctx, s.manager, s.scripting)
+	if err != nil {
+		return nil, fmt.Errorf("problem creating snapshot: %w", err)
+	}
+	return ConvertSnapshot(snapshot)
+}
+
+// schedulerProvider is implemented by managers that have a scheduler.
+type schedulerProvider interface {
+	Scheduler() *jasper.Scheduler
+}
+
+func (s *jasperService) getScheduler() (*jasper.Scheduler, error) {
+	sp, ok := s.manager.(schedulerProvider)
+	if !ok {
+		return nil, errors.New("manager does not support schedules")
+	}
+	return sp.Scheduler(), nil
+}
+
+func (s *jasperService) AddSchedule(ctx context.Context, opts *ScheduleOptions) (*OperationOutcome, error) {
+	scheduler, err := s.getScheduler()
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := opts.Export()
+	if err != nil {
+		return nil, fmt.Errorf("problem exporting schedule options: %w", err)
+	}
+	if err := scheduler.Add(schedule); err != nil {
+		return &OperationOutcome{
+			Success:  false,
+			Text:     fmt.Sprintf("problem adding schedule '%s': %s", schedule.ID, err.Error()),
+			ExitCode: -2,
+		}, nil
+	}
+
+	return &OperationOutcome{
+		Success:  true,
+		Text:     fmt.Sprintf("added schedule '%s'", schedule.ID),
+		ExitCode: 0,
+	}, nil
+}
+
+func (s *jasperService) RemoveSchedule(ctx context.Context, id *ScheduleID) (*OperationOutcome, error) {
+	scheduler, err := s.getScheduler()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := scheduler.Remove(id.Value); err != nil {
+		return &OperationOutcome{
+			Success:  false,
+			Text:     fmt.Sprintf("problem removing schedule '%s': %s", id.Value, err.Error()),
+			ExitCode: -2,
+		}, nil
+	}
+
+	return &OperationOutcome{
+		Success:  true,
+		Text:     fmt.Sprintf("removed schedule '%s'", id.Value),
+		ExitCode: 0,
+	}, nil
+}
+
+func (s *jasperService) ListSchedules(ctx context.Context, _ *empty.Empty) (*ScheduleInfoList, error) {
+	scheduler, err := s.getScheduler()
+	if err != nil {
+		return nil, err
+	}
+
+	out := &ScheduleInfoList{}
+	for _, info := range scheduler.List() {
+		converted, err := ConvertScheduleInfo(info)
+		if err != nil {
+			return nil, fmt.Errorf("problem converting schedule '%s': %w", info.Schedule.ID, err)
+		}
+		out.Schedules = append(out.Schedules, converted)
+	}
+	return out, nil
+}
+
 func (s *jasperService) GetLogStream(ctx context.Context, request *LogRequest) (*LogStream, error) {
 	id := request.Id
 	proc, err := s.manager.Get(ctx, id.Value)
@@ -360,6 +515,50 @@ func (s *jasperService) GetLogStream(ctx context.Context, request *LogRequest) (
 	return stream, nil
 }
 
+func (s *jasperService) FollowLogStream(request *LogFollowRequest, stream JasperProcessManager_FollowLogStreamServer) error {
+	ctx := stream.Context()
+	opts := request.Export()
+	if err := opts.Validate(); err != nil {
+		return newGRPCError(codes.InvalidArgument, err)
+	}
+
+	id := request.Id.GetValue()
+	if _, err := s.manager.Get(ctx, id); err != nil {
+		return newGRPCError(codes.NotFound, fmt.Errorf("problem finding process '%s': %w", id, err))
+	}
+
+	buf, err := s.outputs.Get(id)
+	if err != nil {
+		return newGRPCError(codes.FailedPrecondition, err)
+	}
+
+	return buf.Follow(ctx, opts.Offset, opts.Streams, func(line jasper.LogLine) error {
+		if err := stream.Send(ConvertLogLine(line)); err != nil {
+			return fmt.Errorf("problem sending log line: %w", err)
+		}
+		return nil
+	})
+}
+
+func (s *jasperService) Exec(stream JasperProcessManager_ExecServer) error {
+	err := ServeExec(stream.Context(), s.outputs.CreateProcess,
+		func() (roptions.ExecInput, error) {
+			in, err := stream.Recv()
+			if err != nil {
+				return roptions.ExecInput{}, err
+			}
+			return in.Export()
+		},
+		func(out roptions.ExecOutput) error {
+			return stream.Send(ConvertExecOutput(out))
+		},
+	)
+	if err != nil {
+		return newGRPCError(codes.FailedPrecondition, err)
+	}
+	return nil
+}
+
 func (s *jasperService) RegisterSignalTriggerID(ctx context.Context, params *SignalTriggerParams) (*OperationOutcome, error) {
 	jasperProcessID, signalTriggerID := params.Export()
 
@@ -398,6 +597,35 @@ func (s *jasperService) RegisterSignalTriggerID(ctx context.Context, params *Sig
 	}, nil
 }
 
+func (s *jasperService) RegisterTriggerID(ctx context.Context, params *ProcessTriggerParams) (*OperationOutcome, error) {
+	jasperProcessID, triggerID, triggerParams := params.Export()
+
+	proc, err := s.manager.Get(ctx, jasperProcessID)
+	if err != nil {
+		err = fmt.Errorf("problem finding process '%s': %w", jasperProcessID, err)
+		return &OperationOutcome{
+			Success:  false,
+			Text:     err.Error(),
+			ExitCode: -2,
+		}, nil
+	}
+
+	if err := proc.RegisterTriggerID(ctx, triggerID, triggerParams); err != nil {
+		err = fmt.Errorf("problem registering trigger '%s': %w", triggerID, err)
+		return &OperationOutcome{
+			Success:  false,
+			Text:     err.Error(),
+			ExitCode: -3,
+		}, nil
+	}
+
+	return &OperationOutcome{
+		Success:  true,
+		Text:     fmt.Sprintf("registered trigger with id '%s' on process with id '%s'", triggerID, jasperProcessID),
+		ExitCode: 0,
+	}, nil
+}
+
 func (s *jasperService) SignalEvent(ctx context.Context, name *EventName) (*OperationOutcome, error) {
 	eventName := name.Value
 
@@ -478,6 +706,186 @@ func (s *jasperService) WriteFile(stream JasperProcessManager_WriteFileServer) e
 	return nil
 }
 
+// fileChunkSize is the maximum size of the file contents sent in each
+// message when reading a file.
+const fileChunkSize = 1024 * 1024
+
+// fileErrorCode returns the gRPC status code for an error from a filesystem
+// operation.
+func fileErrorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return codes.NotFound
+	case errors.Is(err, fs.ErrExist):
+		return codes.AlreadyExists
+	case errors.Is(err, fs.ErrPermission):
+		return codes.PermissionDenied
+	default:
+		return codes.Internal
+	}
+}
+
+func (s *jasperService) ReadFile(request *ReadFileInfo, stream JasperProcessManager_ReadFileServer) error {
+	opts := request.Export()
+	if err := opts.Validate(); err != nil {
+		return newGRPCError(codes.InvalidArgument, fmt.Errorf("problem validating read file options: %w", err))
+	}
+
+	file, err := opts.Open()
+	if err != nil {
+		return newGRPCError(fileErrorCode(err), fmt.Errorf("problem opening file %s: %w", opts.Path, err))
+	}
+	defer file.Close()
+
+	buf := make([]byte, fileChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			if sendErr := stream.Send(&FileChunk{Data: buf[:n]}); sendErr != nil {
+				return fmt.Errorf("problem sending file contents: %w", sendErr)
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return newGRPCError(codes.Internal, fmt.Errorf("problem reading file %s: %w", opts.Path, err))
+		}
+	}
+}
+
+// fileChunkWriter sends everything written to it to the stream as file
+// chunks.
+type fileChunkWriter struct {
+	send func(*FileChunk) error
+}
+
+func (w fileChunkWriter) Write(p []byte) (int, error) {
+	if err := w.send(&FileChunk{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s *jasperService) CreateArchive(request *CreateArchiveInfo, stream JasperProcessManager_CreateArchiveServer) error {
+	opts := request.Export()
+	if err := opts.Validate(); err != nil {
+		return newGRPCError(codes.InvalidArgument, fmt.Errorf("problem validating create archive options: %w", err))
+	}
+
+	if info, err := roptions.StatFile(opts.Path); err != nil {
+		return newGRPCError(fileErrorCode(err), fmt.Errorf("problem getting file information for %s: %w", opts.Path, err))
+	} else if !info.IsDir {
+		return newGRPCError(codes.InvalidArgument, fmt.Errorf("%s is not a directory", opts.Path))
+	}
+
+	buf := bufio.NewWriterSize(fileChunkWriter{send: stream.Send}, fileChunkSize)
+	if err := opts.Write(buf); err != nil {
+		return newGRPCError(codes.Internal, fmt.Errorf("problem creating archive of %s: %w", opts.Path, err))
+	}
+	if err := buf.Flush(); err != nil {
+		return fmt.Errorf("problem sending archive contents: %w", err)
+	}
+
+	return nil
+}
+
+func (s *jasperService) StatFile(ctx context.Context, request *FilePath) (*FileInfo, error) {
+	info, err := roptions.StatFile(request.Path)
+	if err != nil {
+		return nil, newGRPCError(fileErrorCode(err), fmt.Errorf("problem getting file information for %s: %w", request.Path, err))
+	}
+	return ConvertFileInfo(info), nil
+}
+
+func (s *jasperService) ListDirectory(ctx context.Context, request *ListDirectoryInfo) (*FileInfoList, error) {
+	opts := request.Export()
+	if err := opts.Validate(); err != nil {
+		return nil, newGRPCError(codes.InvalidArgument, fmt.Errorf("problem validating list directory options: %w", err))
+	}
+
+	infos, err := opts.List()
+	if err != nil {
+		return nil, newGRPCError(fileErrorCode(err), fmt.Errorf("problem listing directory %s: %w", opts.Path, err))
+	}
+	return ConvertFileInfoList(infos), nil
+}
+
+func (s *jasperService) GlobFiles(ctx context.Context, request *FilePath) (*FilePathList, error) {
+	paths, err := roptions.GlobFiles(request.Path)
+	if err != nil {
+		return nil, newGRPCError(codes.InvalidArgument, err)
+	}
+	return &FilePathList{Paths: paths}, nil
+}
+
+func (s *jasperService) RemoveFile(ctx context.Context, request *RemoveFileInfo) (*OperationOutcome, error) {
+	opts := request.Export()
+	if err := opts.Validate(); err != nil {
+		err = fmt.Errorf("problem validating remove file options: %w", err)
+		return &OperationOutcome{Success: false, Text: err.Error(), ExitCode: -2}, nil
+	}
+
+	if err := opts.Remove(); err != nil {
+		err = fmt.Errorf("problem removing file %s: %w", opts.Path, err)
+		return &OperationOutcome{Success: false, Text: err.Error(), ExitCode: -3}, nil
+	}
+
+	return &OperationOutcome{
+		Success: true,
+		Text:    fmt.Sprintf("removed file %s", opts.Path),
+	}, nil
+}
+
+func (s *jasperService) RenameFile(ctx context.Context, request *RenameFileInfo) (*OperationOutcome, error) {
+	opts := request.Export()
+	if err := opts.Validate(); err != nil {
+		err = fmt.Errorf("problem validating rename file options: %w", err)
+		return &OperationOutcome{Success: false, Text: err.Error(), ExitCode: -2}, nil
+	}
+
+	if err := opts.Rename(); err != nil {
+		err = fmt.Errorf("problem renaming file %s to %s: %w", opts.OldPath, opts.NewPath, err)
+		return &OperationOutcome{Success: false, Text: err.Error(), ExitCode: -3}, nil
+	}
+
+	return &OperationOutcome{
+		Success: true,
+		Text:    fmt.Sprintf("renamed file %s to %s", opts.OldPath, opts.NewPath),
+	}, nil
+}
+
+func (s *jasperService) MakeDirectory(ctx context.Context, request *MakeDirectoryInfo) (*OperationOutcome, error) {
+	opts := request.Export()
+	if err := opts.Validate(); err != nil {
+		err = fmt.Errorf("problem validating make directory options: %w", err)
+		return &OperationOutcome{Success: false, Text: err.Error(), ExitCode: -2}, nil
+	}
+
+	if err := opts.Make(); err != nil {
+		err = fmt.Errorf("problem making directory %s: %w", opts.Path, err)
+		return &OperationOutcome{Success: false, Text: err.Error(), ExitCode: -3}, nil
+	}
+
+	return &OperationOutcome{
+		Success: true,
+		Text:    fmt.Sprintf("made directory %s", opts.Path),
+	}, nil
+}
+
+func (s *jasperService) ChecksumFile(ctx context.Context, request *FileChecksumInfo) (*FileChecksumResponse, error) {
+	opts := request.Export()
+	if err := opts.Validate(); err != nil {
+		return nil, newGRPCError(codes.InvalidArgument, fmt.Errorf("problem validating file checksum options: %w", err))
+	}
+
+	checksum, err := opts.Compute()
+	if err != nil {
+		return nil, newGRPCError(fileErrorCode(err), fmt.Errorf("problem computing checksum of file %s: %w", opts.Path, err))
+	}
+	return &FileChecksumResponse{Checksum: checksum}, nil
+}
+
 func (s *jasperService) ScriptingHarnessCreate(ctx context.Context, opts *ScriptingOptions) (*ScriptingHarnessID, error) {
 	xopts, err := opts.Export()
 	if err != nil {
@@ -0,0 +1,31 @@
+//go:build !windows
+
+package internal
+
+import "syscall"
+
+// exportUserSignal returns the user-defined signal that is analogous to the
+// protobuf RPC signal, if any.
+func exportUserSignal(s Signals) (syscall.Signal, bool) {
+	switch s {
+	case Signals_USER1:
+		return syscall.SIGUSR1, true
+	case Signals_USER2:
+		return syscall.SIGUSR2, true
+	default:
+		return syscall.Signal(0), false
+	}
+}
+
+// convertUserSignal returns the protobuf RPC signal that is equivalent to the
+// user-defined signal, if any.
+func convertUserSignal(s syscall.Signal) (Signals, bool) {
+	switch s {
+	case syscall.SIGUSR1:
+		return Signals_USER1, true
+	case syscall.SIGUSR2:
+		return Signals_USER2, true
+	default:
+		return Signals_UNKNOWN, false
+	}
+}
@@ -0,0 +1,11 @@
+package internal
+
+import "syscall"
+
+// exportUserSignal always returns false, since Windows has no user-defined
+// signals.
+func exportUserSignal(Signals) (syscall.Signal, bool) { return syscall.Signal(0), false }
+
+// convertUserSignal always returns false, since Windows has no user-defined
+// signals.
+func convertUserSignal(syscall.Signal) (Signals, bool) { return Signals_UNKNOWN, false }
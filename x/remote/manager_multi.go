@@ -0,0 +1,711 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tychoish/fun/erc"
+	"github.com/tychoish/gimlet"
+	"github.com/tychoish/grip"
+	"github.com/tychoish/grip/message"
+	"github.com/tychoish/jasper"
+	"github.com/tychoish/jasper/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PlacementStrategy determines the host on which a multi-host manager
+// creates new processes.
+type PlacementStrategy string
+
+const (
+	// PlacementRoundRobin creates processes on each host in turn.
+	PlacementRoundRobin PlacementStrategy = "round-robin"
+	// PlacementLeastLoaded creates processes on the host with the fewest
+	// running processes.
+	PlacementLeastLoaded PlacementStrategy = "least-loaded"
+	// PlacementLabelAffinity creates processes on the least loaded host
+	// with a label that matches one of the process's tags. If no host has a
+	// matching label, any host may be used.
+	PlacementLabelAffinity PlacementStrategy = "label-affinity"
+)
+
+// Validate checks that the placement strategy is recognized.
+func (s PlacementStrategy) Validate() error {
+	switch s {
+	case PlacementRoundRobin, PlacementLeastLoaded, PlacementLabelAffinity:
+		return nil
+	default:
+		return fmt.Errorf("unknown placement strategy '%s'", s)
+	}
+}
+
+// MultiHost is a jasper service that is managed by a multi-host manager.
+type MultiHost struct {
+	// Name identifies the host and must be unique among the hosts.
+	Name    string
+	Manager Manager
+	// Labels are matched against the tags of new processes by the label
+	// affinity placement strategy.
+	Labels []string
+}
+
+// MultiManagerOptions configure a multi-host manager.
+type MultiManagerOptions struct {
+	// ID is the ID of the manager. If unset, a random ID is used.
+	ID    string
+	Hosts []MultiHost
+	// Strategy determines where processes are created. It defaults to
+	// round robin.
+	Strategy PlacementStrategy
+	// HostTimeout bounds each request to a host when the manager makes
+	// requests to every host. It defaults to 10 seconds.
+	HostTimeout time.Duration
+	// RetryInterval is how long a host that could not be reached is passed
+	// over when placing new processes. It defaults to 30 seconds.
+	RetryInterval time.Duration
+}
+
+// Validate checks the options and sets defaults for unset values.
+func (opts *MultiManagerOptions) Validate() error {
+	if opts.ID == "" {
+		opts.ID = uuid.New().String()
+	}
+	if opts.Strategy == "" {
+		opts.Strategy = PlacementRoundRobin
+	}
+	if opts.HostTimeout == 0 {
+		opts.HostTimeout = 10 * time.Second
+	}
+	if opts.RetryInterval == 0 {
+		opts.RetryInterval = 30 * time.Second
+	}
+
+	catcher := &erc.Collector{}
+	catcher.If(len(opts.Hosts) == 0, errors.New("must specify at least one host"))
+	catcher.Push(opts.Strategy.Validate())
+	catcher.If(opts.HostTimeout < 0, errors.New("host timeout cannot be negative"))
+	catcher.If(opts.RetryInterval < 0, errors.New("retry interval cannot be negative"))
+
+	names := map[string]struct{}{}
+	for _, host := range opts.Hosts {
+		catcher.If(host.Name == "", errors.New("host name cannot be empty"))
+		catcher.If(host.Manager == nil, fmt.Errorf("host '%s' must have a manager", host.Name))
+		if _, ok := names[host.Name]; ok {
+			catcher.Push(fmt.Errorf("duplicate host name '%s'", host.Name))
+		}
+		names[host.Name] = struct{}{}
+	}
+
+	return catcher.Resolve()
+}
+
+// NewMultiManager returns a manager that creates processes on many remote
+// jasper services. New processes are placed on a host according to the
+// placement strategy, and other operations are routed to the host that owns
+// the process. Processes returned by the manager are annotated with the name
+// of their host, which can be retrieved with ProcessHost.
+//
+// Hosts that cannot be reached are skipped: listing processes returns the
+// processes on the hosts that responded, and processes are only created on
+// an unreachable host once its retry interval has passed. Creating a process
+// only moves on to the next host if a host is unreachable or unavailable;
+// other errors, such as invalid options, are returned immediately.
+func NewMultiManager(opts MultiManagerOptions) (jasper.Manager, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid multi-host manager options: %w", err)
+	}
+
+	m := &multiManager{
+		opts:   opts,
+		routes: map[string]*multiHost{},
+	}
+	for _, host := range opts.Hosts {
+		m.hosts = append(m.hosts, &multiHost{MultiHost: host})
+	}
+
+	return m, nil
+}
+
+type multiManager struct {
+	opts  MultiManagerOptions
+	hosts []*multiHost
+	next  atomic.Uint64
+
+	mu     sync.RWMutex
+	routes map[string]*multiHost
+}
+
+type multiHost struct {
+	MultiHost
+
+	mu       sync.Mutex
+	failedAt time.Time
+}
+
+func (h *multiHost) markFailed() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.failedAt = time.Now()
+}
+
+func (h *multiHost) markHealthy() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.failedAt = time.Time{}
+}
+
+func (h *multiHost) available(retryInterval time.Duration) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.failedAt.IsZero() || time.Since(h.failedAt) >= retryInterval
+}
+
+func (h *multiHost) hasLabel(tags []string) bool {
+	for _, label := range h.Labels {
+		if slices.Contains(tags, label) {
+			return true
+		}
+	}
+	return false
+}
+
+// multiHostProcess annotates a process with the host that owns it.
+type multiHostProcess struct {
+	jasper.Process
+	host    *multiHost
+	manager *multiManager
+}
+
+// Wait waits for the process and then drops the route to it, since a
+// complete process is no longer worth routing to directly.
+func (p *multiHostProcess) Wait(ctx context.Context) (int, error) {
+	exitCode, err := p.Process.Wait(ctx)
+	if ctx.Err() == nil && !isHostUnavailable(err) {
+		p.manager.forget(p.ID())
+	}
+	return exitCode, err
+}
+
+func (p *multiHostProcess) Respawn(ctx context.Context) (jasper.Process, error) {
+	proc, err := p.Process.Respawn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return p.manager.track(p.host, proc, true), nil
+}
+
+// ProcessHost returns the name of the host of a process returned by a
+// multi-host manager. It returns an empty string for other processes.
+func ProcessHost(proc jasper.Process) string {
+	if p, ok := proc.(*multiHostProcess); ok {
+		return p.host.Name
+	}
+	return ""
+}
+
+// track annotates the process with its host. If route is true, the host is
+// also recorded as the owner of the process. Only processes created through
+// the manager are recorded, so that the routes grow with the processes the
+// manager creates rather than with every process it lists.
+func (m *multiManager) track(host *multiHost, proc jasper.Process, route bool) jasper.Process {
+	if route {
+		m.mu.Lock()
+		m.routes[proc.ID()] = host
+		m.mu.Unlock()
+	}
+	return &multiHostProcess{Process: proc, host: host, manager: m}
+}
+
+// forget removes the route to the process.
+func (m *multiManager) forget(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.routes, id)
+}
+
+// prune removes the routes to processes that are complete or that their host
+// no longer has, given the processes matching the filter on each host. Routes
+// to processes on hosts that did not respond are kept. Since a process
+// without a route is still found by asking every host, pruning a route too
+// eagerly only makes looking up the process slower.
+func (m *multiManager) prune(f options.Filter, results [][]jasper.Process, responded []bool) {
+	listed := make([]map[string]struct{}, len(m.hosts))
+	for idx, procs := range results {
+		listed[idx] = make(map[string]struct{}, len(procs))
+		for _, proc := range procs {
+			listed[idx][proc.ID()] = struct{}{}
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, host := range m.routes {
+		idx := slices.Index(m.hosts, host)
+		if !responded[idx] {
+			continue
+		}
+		_, ok := listed[idx][id]
+		switch f {
+		case options.All, options.Running:
+			// Processes that are not listed are gone or complete.
+			if !ok {
+				delete(m.routes, id)
+			}
+		case options.Terminated, options.Successful, options.Failed:
+			if ok {
+				delete(m.routes, id)
+			}
+		}
+	}
+}
+
+// isHostUnavailable returns whether the error means that the host could not
+// be reached or is not currently accepting requests, so that the request may
+// succeed on another host.
+func isHostUnavailable(err error) bool {
+	if errors.Is(err, ErrDraining) || errors.Is(err, ErrCircuitOpen) {
+		return true
+	}
+	if status.Code(err) == codes.Unavailable {
+		return true
+	}
+	if resp := (gimlet.ErrorResponse{}); errors.As(err, &resp) {
+		switch resp.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// each calls the function concurrently for every host, bounding each call by
+// the host timeout. It returns the number of hosts for which the function
+// succeeded and the errors from the others.
+func (m *multiManager) each(ctx context.Context, hosts []*multiHost, fn func(ctx context.Context, idx int, host *multiHost) error) (int, error) {
+	catcher := &erc.Collector{}
+	wg := &sync.WaitGroup{}
+	for idx, host := range hosts {
+		wg.Add(1)
+		go func(idx int, host *multiHost) {
+			defer wg.Done()
+			hctx, cancel := context.WithTimeout(ctx, m.opts.HostTimeout)
+			defer cancel()
+			if err := fn(hctx, idx, host); err != nil {
+				catcher.Push(fmt.Errorf("host '%s': %w", host.Name, err))
+			}
+		}(idx, host)
+	}
+	wg.Wait()
+
+	return len(hosts) - catcher.Len(), catcher.Resolve()
+}
+
+// tolerate returns an error only if no hosts succeeded, and otherwise logs
+// the errors from the hosts that failed.
+func (m *multiManager) tolerate(op string, succeeded int, err error) error {
+	if err == nil {
+		return nil
+	}
+	if succeeded == 0 {
+		return fmt.Errorf("could not %s on any host: %w", op, err)
+	}
+
+	grip.Warning(message.WrapError(err, message.Fields{
+		"message": fmt.Sprintf("could not %s on some hosts", op),
+		"manager": m.opts.ID,
+	}))
+	return nil
+}
+
+func (m *multiManager) ID() string { return m.opts.ID }
+
+func (m *multiManager) CreateProcess(ctx context.Context, opts *options.Create) (jasper.Process, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid process options: %w", err)
+	}
+
+	catcher := &erc.Collector{}
+	for _, host := range m.place(ctx, opts) {
+		proc, err := host.Manager.CreateProcess(ctx, opts)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if !isHostUnavailable(err) {
+				return nil, fmt.Errorf("host '%s': %w", host.Name, err)
+			}
+			host.markFailed()
+			catcher.Push(fmt.Errorf("host '%s': %w", host.Name, err))
+			continue
+		}
+
+		host.markHealthy()
+		return m.track(host, proc, true), nil
+	}
+
+	return nil, fmt.Errorf("could not create process on any host: %w", catcher.Resolve())
+}
+
+// place returns the hosts on which to try creating the process, in order of
+// preference. Hosts that recently failed are only included if every
+// candidate host has failed.
+func (m *multiManager) place(ctx context.Context, opts *options.Create) []*multiHost {
+	candidates := m.hosts
+	if m.opts.Strategy == PlacementLabelAffinity {
+		var matched []*multiHost
+		for _, host := range m.hosts {
+			if host.hasLabel(opts.Tags) {
+				matched = append(matched, host)
+			}
+		}
+		if len(matched) != 0 {
+			candidates = matched
+		}
+	}
+
+	var hosts []*multiHost
+	for _, host := range candidates {
+		if host.available(m.opts.RetryInterval) {
+			hosts = append(hosts, host)
+		}
+	}
+	if len(hosts) == 0 {
+		hosts = slices.Clone(candidates)
+	}
+
+	switch m.opts.Strategy {
+	case PlacementLeastLoaded, PlacementLabelAffinity:
+		return m.sortByLoad(ctx, hosts)
+	default:
+		start := int(m.next.Add(1)-1) % len(hosts)
+		return append(hosts[start:len(hosts):len(hosts)], hosts[:start]...)
+	}
+}
+
+// sortByLoad orders the hosts by their number of running processes. Hosts
+// whose load cannot be determined are marked as failed and ordered last.
+func (m *multiManager) sortByLoad(ctx context.Context, hosts []*multiHost) []*multiHost {
+	loads := make([]int, len(hosts))
+	_, err := m.each(ctx, hosts, func(ctx context.Context, idx int, host *multiHost) error {
+		procs, err := host.Manager.List(ctx, options.Running)
+		if err != nil {
+			host.markFailed()
+			loads[idx] = -1
+			return err
+		}
+		loads[idx] = len(procs)
+		return nil
+	})
+	grip.Debug(message.WrapError(err, message.Fields{
+		"message": "could not get load of some hosts",
+		"manager": m.opts.ID,
+	}))
+
+	order := make([]int, len(hosts))
+	for idx := range order {
+		order[idx] = idx
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		li, lj := loads[order[i]], loads[order[j]]
+		if li < 0 || lj < 0 {
+			return lj < 0 && li >= 0
+		}
+		return li < lj
+	})
+
+	out := make([]*multiHost, 0, len(hosts))
+	for _, idx := range order {
+		out = append(out, hosts[idx])
+	}
+	return out
+}
+
+func (m *multiManager) CreateCommand(ctx context.Context) *jasper.Command {
+	return jasper.NewCommand().ProcConstructor(m.CreateProcess)
+}
+
+func (m *multiManager) Register(ctx context.Context, proc jasper.Process) error {
+	return errors.New("cannot register a local process on a multi-host manager")
+}
+
+// collect gets processes from every host and merges them in host order. If
+// the processes were selected by a filter, the routes are pruned using the
+// processes that were returned.
+func (m *multiManager) collect(ctx context.Context, op string, f options.Filter, fn func(context.Context, *multiHost) ([]jasper.Process, error)) ([]jasper.Process, error) {
+	results := make([][]jasper.Process, len(m.hosts))
+	responded := make([]bool, len(m.hosts))
+	succeeded, err := m.each(ctx, m.hosts, func(ctx context.Context, idx int, host *multiHost) error {
+		procs, err := fn(ctx, host)
+		if err != nil {
+			return err
+		}
+		results[idx] = procs
+		responded[idx] = true
+		return nil
+	})
+	if err = m.tolerate(op, succeeded, err); err != nil {
+		return nil, err
+	}
+	if f != "" {
+		m.prune(f, results, responded)
+	}
+
+	out := []jasper.Process{}
+	for idx, procs := range results {
+		for _, proc := range procs {
+			out = append(out, m.track(m.hosts[idx], proc, false))
+		}
+	}
+	return out, nil
+}
+
+func (m *multiManager) List(ctx context.Context, f options.Filter) ([]jasper.Process, error) {
+	if err := f.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	return m.collect(ctx, "list processes", f, func(ctx context.Context, host *multiHost) ([]jasper.Process, error) {
+		return host.Manager.List(ctx, f)
+	})
+}
+
+func (m *multiManager) Group(ctx context.Context, name string) ([]jasper.Process, error) {
+	return m.collect(ctx, "get process group", "", func(ctx context.Context, host *multiHost) ([]jasper.Process, error) {
+		return host.Manager.Group(ctx, name)
+	})
+}
+
+func (m *multiManager) Get(ctx context.Context, id string) (jasper.Process, error) {
+	m.mu.RLock()
+	host, ok := m.routes[id]
+	m.mu.RUnlock()
+
+	if ok {
+		proc, err := host.Manager.Get(ctx, id)
+		if err != nil {
+			if !isHostUnavailable(err) {
+				// The host no longer has the process.
+				m.forget(id)
+			}
+			return nil, fmt.Errorf("host '%s': %w", host.Name, err)
+		}
+		return m.track(host, proc, false), nil
+	}
+
+	// The process was not created through this manager, so look for it on
+	// every host.
+	procs := make([]jasper.Process, len(m.hosts))
+	_, err := m.each(ctx, m.hosts, func(ctx context.Context, idx int, host *multiHost) error {
+		proc, err := host.Manager.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		procs[idx] = proc
+		return nil
+	})
+	for idx, proc := range procs {
+		if proc != nil {
+			return m.track(m.hosts[idx], proc, false), nil
+		}
+	}
+
+	return nil, fmt.Errorf("process '%s' does not exist on any host: %w", id, err)
+}
+
+func (m *multiManager) Clear(ctx context.Context) {
+	results := make([][]jasper.Process, len(m.hosts))
+	responded := make([]bool, len(m.hosts))
+	_, err := m.each(ctx, m.hosts, func(ctx context.Context, idx int, host *multiHost) error {
+		host.Manager.Clear(ctx)
+		procs, err := host.Manager.List(ctx, options.All)
+		if err != nil {
+			return err
+		}
+		results[idx] = procs
+		responded[idx] = true
+		return nil
+	})
+	grip.Warning(message.WrapError(err, message.Fields{
+		"message": "could not clear processes on some hosts",
+		"manager": m.opts.ID,
+	}))
+
+	// Forget the processes that were cleared, keeping those on hosts that
+	// did not respond.
+	m.prune(options.All, results, responded)
+}
+
+// Close closes the manager on every host. Hosts that cannot be reached are
+// skipped, like in the other operations on every host, but errors from the
+// hosts that could be reached are returned.
+func (m *multiManager) Close(ctx context.Context) error {
+	catcher := &erc.Collector{}
+	succeeded, err := m.each(ctx, m.hosts, func(ctx context.Context, _ int, host *multiHost) error {
+		err := host.Manager.Close(ctx)
+		if err != nil && !isHostUnavailable(err) {
+			catcher.Push(fmt.Errorf("host '%s': %w", host.Name, err))
+			return nil
+		}
+		return err
+	})
+	catcher.Push(m.tolerate("close manager", succeeded, err))
+
+	m.mu.Lock()
+	m.routes = map[string]*multiHost{}
+	m.mu.Unlock()
+
+	return catcher.Resolve()
+}
+
+// WriteFile writes the file on every host.
+func (m *multiManager) WriteFile(ctx context.Context, opts options.WriteFile) error {
+	if err := opts.Validate(); err != nil {
+		return fmt.Errorf("invalid write options: %w", err)
+	}
+
+	_, err := m.each(ctx, m.hosts, func(ctx context.Context, _ int, host *multiHost) error {
+		return host.Manager.WriteFile(ctx, opts)
+	})
+	return err
+}
+
+func (m *multiManager) LoggingCache(ctx context.Context) jasper.LoggingCache {
+	return &multiLoggingCache{manager: m, ctx: ctx}
+}
+
+// multiLoggingCache routes operations on loggers to the host whose logging
+// cache has the logger. New loggers are created on the host that owns the
+// process with the logger's ID, if there is one, and otherwise on the host
+// where a new process would be placed.
+type multiLoggingCache struct {
+	manager *multiManager
+	ctx     context.Context
+}
+
+// find returns the logging cache of the host that has the logger.
+func (lc *multiLoggingCache) find(id string) (jasper.LoggingCache, error) {
+	caches := make([]jasper.LoggingCache, len(lc.manager.hosts))
+	_, err := lc.manager.each(lc.ctx, lc.manager.hosts, func(_ context.Context, idx int, host *multiHost) error {
+		// The cache is returned to the caller, so it uses the
+		// cache's context rather than the per-host timeout.
+		cache := host.Manager.LoggingCache(lc.ctx)
+		if cache == nil {
+			return fmt.Errorf("host '%s' does not have a logging cache", host.Name)
+		}
+		if cache.Get(id) != nil {
+			caches[idx] = cache
+		}
+		return nil
+	})
+	for _, cache := range caches {
+		if cache != nil {
+			return cache, nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("logger '%s' does not exist on any host that responded: %w", id, err)
+	}
+	return nil, fmt.Errorf("logger '%s' does not exist on any host", id)
+}
+
+// owner returns the logging cache in which to add the logger.
+func (lc *multiLoggingCache) owner(id string) (jasper.LoggingCache, error) {
+	if cache, err := lc.find(id); err == nil {
+		return cache, nil
+	}
+
+	lc.manager.mu.RLock()
+	host, ok := lc.manager.routes[id]
+	lc.manager.mu.RUnlock()
+	if !ok {
+		host = lc.manager.place(lc.ctx, &options.Create{})[0]
+	}
+
+	cache := host.Manager.LoggingCache(lc.ctx)
+	if cache == nil {
+		return nil, fmt.Errorf("host '%s' does not have a logging cache", host.Name)
+	}
+	return cache, nil
+}
+
+func (lc *multiLoggingCache) Create(id string, opts *options.Output) (*options.CachedLogger, error) {
+	cache, err := lc.owner(id)
+	if err != nil {
+		return nil, err
+	}
+	return cache.Create(id, opts)
+}
+
+func (lc *multiLoggingCache) Put(id string, logger *options.CachedLogger) error {
+	cache, err := lc.owner(id)
+	if err != nil {
+		return err
+	}
+	return cache.Put(id, logger)
+}
+
+func (lc *multiLoggingCache) Get(id string) *options.CachedLogger {
+	cache, err := lc.find(id)
+	if err != nil {
+		return nil
+	}
+	return cache.Get(id)
+}
+
+func (lc *multiLoggingCache) Remove(id string) {
+	if cache, err := lc.find(id); err == nil {
+		cache.Remove(id)
+	}
+}
+
+func (lc *multiLoggingCache) CloseAndRemove(ctx context.Context, id string) error {
+	cache, err := lc.find(id)
+	if err != nil {
+		return err
+	}
+	return cache.CloseAndRemove(ctx, id)
+}
+
+func (lc *multiLoggingCache) Clear(ctx context.Context) error {
+	_, err := lc.manager.each(ctx, lc.manager.hosts, func(ctx context.Context, _ int, host *multiHost) error {
+		if cache := host.Manager.LoggingCache(ctx); cache != nil {
+			return cache.Clear(ctx)
+		}
+		return nil
+	})
+	return err
+}
+
+func (lc *multiLoggingCache) Prune(lastAccessed time.Time) {
+	_, _ = lc.manager.each(lc.ctx, lc.manager.hosts, func(ctx context.Context, _ int, host *multiHost) error {
+		if cache := host.Manager.LoggingCache(ctx); cache != nil {
+			cache.Prune(lastAccessed)
+		}
+		return nil
+	})
+}
+
+func (lc *multiLoggingCache) Len() int {
+	var size atomic.Int64
+	_, _ = lc.manager.each(lc.ctx, lc.manager.hosts, func(ctx context.Context, _ int, host *multiHost) error {
+		if cache := host.Manager.LoggingCache(ctx); cache != nil {
+			if n := cache.Len(); n > 0 {
+				size.Add(int64(n))
+			}
+		}
+		return nil
+	})
+	return int(size.Load())
+}
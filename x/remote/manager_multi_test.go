@@ -0,0 +1,281 @@
+package remote
+
+import (
+	"context"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/testutil"
+)
+
+func makeTestMultiHosts(ctx context.Context, t *testing.T, names ...string) ([]MultiHost, map[string]jasper.Manager) {
+	hosts := []MultiHost{}
+	managers := map[string]jasper.Manager{}
+	for _, name := range names {
+		mngr := jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true}))
+		t.Cleanup(func() { check.NotError(t, mngr.Close(context.Background())) })
+		client, err := makeInsecureRPCServiceAndClient(ctx, mngr)
+		assert.NotError(t, err)
+		hosts = append(hosts, MultiHost{Name: name, Manager: client})
+		managers[name] = mngr
+	}
+	return hosts, managers
+}
+
+func TestMultiManager(t *testing.T) {
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T){
+		"FailsWithoutHosts": func(ctx context.Context, t *testing.T) {
+			_, err := NewMultiManager(MultiManagerOptions{})
+			check.Error(t, err)
+		},
+		"FailsWithDuplicateHostNames": func(ctx context.Context, t *testing.T) {
+			hosts, _ := makeTestMultiHosts(ctx, t, "a", "a")
+			_, err := NewMultiManager(MultiManagerOptions{Hosts: hosts})
+			check.Error(t, err)
+		},
+		"FailsWithInvalidStrategy": func(ctx context.Context, t *testing.T) {
+			hosts, _ := makeTestMultiHosts(ctx, t, "a")
+			_, err := NewMultiManager(MultiManagerOptions{Hosts: hosts, Strategy: "foo"})
+			check.Error(t, err)
+		},
+		"RoundRobinPlacesOnEachHost": func(ctx context.Context, t *testing.T) {
+			hosts, managers := makeTestMultiHosts(ctx, t, "a", "b")
+			m, err := NewMultiManager(MultiManagerOptions{Hosts: hosts})
+			assert.NotError(t, err)
+
+			for i := 0; i < 4; i++ {
+				_, err := m.CreateProcess(ctx, testutil.SleepCreateOpts(10))
+				assert.NotError(t, err)
+			}
+			for _, mngr := range managers {
+				procs, err := mngr.List(ctx, options.All)
+				assert.NotError(t, err)
+				check.Equal(t, len(procs), 2)
+			}
+		},
+		"LeastLoadedPlacesOnIdleHost": func(ctx context.Context, t *testing.T) {
+			hosts, managers := makeTestMultiHosts(ctx, t, "a", "b")
+			_, err := managers["a"].CreateProcess(ctx, testutil.SleepCreateOpts(10))
+			assert.NotError(t, err)
+
+			m, err := NewMultiManager(MultiManagerOptions{Hosts: hosts, Strategy: PlacementLeastLoaded})
+			assert.NotError(t, err)
+			proc, err := m.CreateProcess(ctx, testutil.SleepCreateOpts(10))
+			assert.NotError(t, err)
+			check.Equal(t, ProcessHost(proc), "b")
+		},
+		"LabelAffinityPlacesOnMatchingHost": func(ctx context.Context, t *testing.T) {
+			hosts, _ := makeTestMultiHosts(ctx, t, "a", "b")
+			hosts[1].Labels = []string{"gpu"}
+			m, err := NewMultiManager(MultiManagerOptions{Hosts: hosts, Strategy: PlacementLabelAffinity})
+			assert.NotError(t, err)
+
+			for i := 0; i < 2; i++ {
+				opts := testutil.SleepCreateOpts(10)
+				opts.Tags = []string{"gpu"}
+				proc, err := m.CreateProcess(ctx, opts)
+				assert.NotError(t, err)
+				check.Equal(t, ProcessHost(proc), "b")
+			}
+
+			proc, err := m.CreateProcess(ctx, testutil.SleepCreateOpts(10))
+			assert.NotError(t, err)
+			check.Equal(t, ProcessHost(proc), "a")
+		},
+		"ListAndGroupMergeHosts": func(ctx context.Context, t *testing.T) {
+			hosts, managers := makeTestMultiHosts(ctx, t, "a", "b")
+			for _, mngr := range managers {
+				opts := testutil.SleepCreateOpts(10)
+				opts.Tags = []string{"foo"}
+				_, err := mngr.CreateProcess(ctx, opts)
+				assert.NotError(t, err)
+			}
+
+			m, err := NewMultiManager(MultiManagerOptions{Hosts: hosts})
+			assert.NotError(t, err)
+
+			procs, err := m.List(ctx, options.Running)
+			assert.NotError(t, err)
+			assert.Equal(t, len(procs), 2)
+			check.Equal(t, ProcessHost(procs[0]), "a")
+			check.Equal(t, ProcessHost(procs[1]), "b")
+
+			procs, err = m.Group(ctx, "foo")
+			assert.NotError(t, err)
+			check.Equal(t, len(procs), 2)
+		},
+		"GetRoutesByID": func(ctx context.Context, t *testing.T) {
+			hosts, managers := makeTestMultiHosts(ctx, t, "a", "b")
+			local, err := managers["b"].CreateProcess(ctx, testutil.SleepCreateOpts(10))
+			assert.NotError(t, err)
+
+			m, err := NewMultiManager(MultiManagerOptions{Hosts: hosts})
+			assert.NotError(t, err)
+
+			proc, err := m.Get(ctx, local.ID())
+			assert.NotError(t, err)
+			check.Equal(t, proc.ID(), local.ID())
+			check.Equal(t, ProcessHost(proc), "b")
+
+			assert.NotError(t, proc.Signal(ctx, syscall.SIGKILL))
+			_, err = local.Wait(ctx)
+			check.Error(t, err)
+
+			_, err = m.Get(ctx, "foo")
+			check.Error(t, err)
+		},
+		"ToleratesHostsThatAreDown": func(ctx context.Context, t *testing.T) {
+			hosts, _ := makeTestMultiHosts(ctx, t, "a")
+
+			// Stop the service but keep the client, so that the host
+			// cannot be reached.
+			downCtx, cancel := context.WithCancel(ctx)
+			addr, err := tryStartRPCService(downCtx, func(ctx context.Context, addr net.Addr) error {
+				return startTestRPCService(ctx, jasper.NewManager(), addr, nil)
+			})
+			assert.NotError(t, err)
+			down, err := newTestRPCClient(ctx, addr, nil)
+			assert.NotError(t, err)
+			defer down.CloseConnection()
+			cancel()
+			hosts = append([]MultiHost{{Name: "down", Manager: down}}, hosts...)
+
+			m, err := NewMultiManager(MultiManagerOptions{Hosts: hosts, HostTimeout: time.Second})
+			assert.NotError(t, err)
+
+			for i := 0; i < 2; i++ {
+				proc, err := m.CreateProcess(ctx, testutil.SleepCreateOpts(10))
+				assert.NotError(t, err)
+				check.Equal(t, ProcessHost(proc), "a")
+			}
+
+			procs, err := m.List(ctx, options.All)
+			assert.NotError(t, err)
+			check.Equal(t, len(procs), 2)
+
+			check.NotError(t, m.Close(ctx))
+		},
+		"CreateOnlyFailsOverWhenHostIsUnavailable": func(ctx context.Context, t *testing.T) {
+			hosts, managers := makeTestMultiHosts(ctx, t, "a", "b")
+			m, err := NewMultiManager(MultiManagerOptions{Hosts: hosts})
+			assert.NotError(t, err)
+
+			opts := testutil.TrueCreateOpts()
+			opts.Args = []string{"/this/does/not/exist"}
+			_, err = m.CreateProcess(ctx, opts)
+			assert.Error(t, err)
+
+			for _, mngr := range managers {
+				procs, err := mngr.List(ctx, options.All)
+				assert.NotError(t, err)
+				check.Equal(t, len(procs), 0)
+			}
+			for _, host := range m.(*multiManager).hosts {
+				check.True(t, host.available(time.Hour))
+			}
+		},
+		"OnlyRoutesCreatedProcesses": func(ctx context.Context, t *testing.T) {
+			hosts, managers := makeTestMultiHosts(ctx, t, "a", "b")
+			for _, mngr := range managers {
+				_, err := mngr.CreateProcess(ctx, testutil.TrueCreateOpts())
+				assert.NotError(t, err)
+			}
+
+			m, err := NewMultiManager(MultiManagerOptions{Hosts: hosts})
+			assert.NotError(t, err)
+			mm := m.(*multiManager)
+
+			procs, err := m.List(ctx, options.All)
+			assert.NotError(t, err)
+			assert.Equal(t, len(procs), 2)
+			_, err = m.Get(ctx, procs[0].ID())
+			assert.NotError(t, err)
+			check.Equal(t, len(mm.routes), 0)
+
+			proc, err := m.CreateProcess(ctx, testutil.TrueCreateOpts())
+			assert.NotError(t, err)
+			check.Equal(t, len(mm.routes), 1)
+			local, err := managers[ProcessHost(proc)].Get(ctx, proc.ID())
+			assert.NotError(t, err)
+			_, err = local.Wait(ctx)
+			assert.NotError(t, err)
+
+			m.Clear(ctx)
+			check.Equal(t, len(mm.routes), 0)
+		},
+		"DropsRoutesToCompleteProcesses": func(ctx context.Context, t *testing.T) {
+			hosts, managers := makeTestMultiHosts(ctx, t, "a", "b")
+			m, err := NewMultiManager(MultiManagerOptions{Hosts: hosts})
+			assert.NotError(t, err)
+			mm := m.(*multiManager)
+
+			proc, err := m.CreateProcess(ctx, testutil.TrueCreateOpts())
+			assert.NotError(t, err)
+			check.Equal(t, len(mm.routes), 1)
+			_, err = proc.Wait(ctx)
+			assert.NotError(t, err)
+			check.Equal(t, len(mm.routes), 0)
+
+			proc, err = m.CreateProcess(ctx, testutil.TrueCreateOpts())
+			assert.NotError(t, err)
+			local, err := managers[ProcessHost(proc)].Get(ctx, proc.ID())
+			assert.NotError(t, err)
+			_, err = local.Wait(ctx)
+			assert.NotError(t, err)
+			check.Equal(t, len(mm.routes), 1)
+
+			_, err = m.List(ctx, options.Running)
+			assert.NotError(t, err)
+			check.Equal(t, len(mm.routes), 0)
+
+			// The process can still be found without a route.
+			found, err := m.Get(ctx, proc.ID())
+			assert.NotError(t, err)
+			check.Equal(t, ProcessHost(found), ProcessHost(proc))
+		},
+		"LoggingCacheRoutesLoggerIDs": func(ctx context.Context, t *testing.T) {
+			hosts, managers := makeTestMultiHosts(ctx, t, "a", "b")
+			m, err := NewMultiManager(MultiManagerOptions{Hosts: hosts})
+			assert.NotError(t, err)
+
+			lc := m.LoggingCache(ctx)
+			_, err = lc.Create("logger", &options.Output{})
+			assert.NotError(t, err)
+			check.True(t, lc.Get("logger") != nil)
+			check.Equal(t, lc.Len(), 1)
+
+			found := 0
+			for _, mngr := range managers {
+				if mngr.LoggingCache(ctx).Get("logger") != nil {
+					found++
+				}
+			}
+			check.Equal(t, found, 1)
+
+			assert.NotError(t, lc.CloseAndRemove(ctx, "logger"))
+			check.True(t, lc.Get("logger") == nil)
+			check.Error(t, lc.CloseAndRemove(ctx, "logger"))
+		},
+		"RegisterFails": func(ctx context.Context, t *testing.T) {
+			hosts, _ := makeTestMultiHosts(ctx, t, "a")
+			m, err := NewMultiManager(MultiManagerOptions{Hosts: hosts})
+			assert.NotError(t, err)
+
+			proc, err := jasper.NewProcess(ctx, testutil.TrueCreateOpts())
+			assert.NotError(t, err)
+			check.Error(t, m.Register(ctx, proc))
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.RPCTestTimeout)
+			defer cancel()
+			testCase(ctx, t)
+		})
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/tychoish/birch"
@@ -24,6 +27,7 @@ type mdbClient struct {
 	timeout     time.Duration
 	marshaler   options.Marshaler
 	unmarshaler options.Unmarshaler
+	auth        *roptions.AuthCredentials
 }
 
 const (
@@ -36,6 +40,7 @@ func (c *mdbClient) makeProcess(info jasper.ProcessInfo) *mdbProcess {
 		doRequest:   c.doRequest,
 		marshaler:   c.marshaler,
 		unmarshaler: c.unmarshaler,
+		auth:        c.auth,
 	}
 }
 
@@ -43,7 +48,14 @@ func (c *mdbClient) makeProcess(info jasper.ProcessInfo) *mdbProcess {
 // service. reqTimeout specifies the timeout for a request, or uses a default
 // timeout if zero.
 func NewMDBClient(ctx context.Context, addr net.Addr, reqTimeout time.Duration) (Manager, error) {
+	return NewMDBClientWithAuth(ctx, addr, reqTimeout, nil)
+}
+
+// NewMDBClientWithAuth is the same as NewMDBClient, but if creds is non-nil,
+// the credentials are added to every request.
+func NewMDBClientWithAuth(ctx context.Context, addr net.Addr, reqTimeout time.Duration, creds *roptions.AuthCredentials) (Manager, error) {
 	client := &mdbClient{
+		auth:        creds,
 		namespace:   namespace,
 		unmarshaler: options.GetGlobalLoggerRegistry().Unmarshaler(RawLoggerConfigFormatBSON),
 		marshaler:   options.GetGlobalLoggerRegistry().Marshaler(RawLoggerConfigFormatBSON),
@@ -60,7 +72,7 @@ func NewMDBClient(ctx context.Context, addr net.Addr, reqTimeout time.Duration)
 
 	dialer := net.Dialer{}
 	var err error
-	if client.conn, err = dialer.DialContext(ctx, "tcp", addr.String()); err != nil {
+	if client.conn, err = dialer.DialContext(ctx, addr.Network(), addr.String()); err != nil {
 		return nil, fmt.Errorf("could not establish connection to %s service at address %s: %w", addr.Network(), addr.String(), err)
 	}
 
@@ -127,6 +139,40 @@ func (c *mdbClient) makeRequest(in interface{}) (*birch.Document, error) {
 		return nil, err
 	}
 
+	return addMDBAuth(doc, c.auth, in)
+}
+
+// addMDBAuth appends the credentials to the request document for the command
+// in. The command name is the name of the first field of the request, and the
+// signature covers the document as it was before the credentials were added.
+func addMDBAuth(doc *birch.Document, creds *roptions.AuthCredentials, in interface{}) (*birch.Document, error) {
+	if creds == nil {
+		return doc, nil
+	}
+
+	var command string
+	if val := reflect.Indirect(reflect.ValueOf(in)); val.Kind() == reflect.Struct && val.NumField() > 0 {
+		command, _, _ = strings.Cut(val.Type().Field(0).Tag.Get("bson"), ",")
+	}
+
+	body, err := doc.MarshalBSON()
+	if err != nil {
+		return nil, fmt.Errorf("problem marshalling request for signature: %w", err)
+	}
+
+	auth := makeSignedAuthRequest(creds, command, body)
+	if auth.Token != "" {
+		doc.Append(birch.EC.String("auth_token", auth.Token))
+	}
+	if auth.Signature != "" {
+		doc.Append(
+			birch.EC.String("auth_key_id", auth.KeyID),
+			birch.EC.String("auth_timestamp", auth.Timestamp),
+			birch.EC.String("auth_nonce", auth.Nonce),
+			birch.EC.String("auth_signature", auth.Signature),
+		)
+	}
+
 	return doc, nil
 }
 
@@ -600,6 +646,153 @@ func (c *mdbClient) WriteFile(ctx context.Context, opts options.WriteFile) error
 	return opts.WriteBufferedContent(sendOpts)
 }
 
+// doFileCommand sends a filesystem command and reads the reply into resp.
+func (c *mdbClient) doFileCommand(ctx context.Context, in interface{}, resp interface{ SuccessOrError() error }) error {
+	payload, err := c.makeRequest(in)
+	if err != nil {
+		return fmt.Errorf("could not build request: %w", err)
+	}
+
+	req, err := shell.RequestToMessage(mongowire.OP_QUERY, payload)
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	msg, err := c.doRequest(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed during request: %w", err)
+	}
+
+	if err := c.readRequest(msg, resp); err != nil {
+		return fmt.Errorf("could not parse response document: %w", err)
+	}
+
+	return resp.SuccessOrError()
+}
+
+func (c *mdbClient) ReadFile(ctx context.Context, opts roptions.ReadFile) (io.ReadCloser, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid read file options: %w", err)
+	}
+
+	r := &mdbFileReader{ctx: ctx, client: c, opts: opts}
+	// Read the first chunk so that errors opening the file are returned here
+	// rather than on the first read.
+	if err := r.fetch(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// mdbFileReader reads the contents of a file in chunks, sending a read file
+// command for each chunk.
+type mdbFileReader struct {
+	ctx    context.Context
+	client *mdbClient
+	opts   roptions.ReadFile
+	buf    []byte
+	eof    bool
+}
+
+func (r *mdbFileReader) fetch() error {
+	opts := r.opts
+	if opts.Length == 0 || opts.Length > mdbFileChunkSize {
+		opts.Length = mdbFileChunkSize
+	}
+
+	resp := &readFileResponse{}
+	if err := r.client.doFileCommand(r.ctx, readFileRequest{Options: opts}, resp); err != nil {
+		return fmt.Errorf("problem reading file: %w", err)
+	}
+
+	r.buf = resp.Data
+	r.opts.Offset += int64(len(resp.Data))
+	if r.opts.Length != 0 {
+		r.opts.Length -= int64(len(resp.Data))
+		r.eof = r.opts.Length == 0
+	}
+	if int64(len(resp.Data)) < opts.Length {
+		r.eof = true
+	}
+
+	return nil
+}
+
+func (r *mdbFileReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.eof {
+			return 0, io.EOF
+		}
+		if err := r.fetch(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (r *mdbFileReader) Close() error {
+	r.buf = nil
+	r.eof = true
+	return nil
+}
+
+func (c *mdbClient) StatFile(ctx context.Context, path string) (roptions.FileInfo, error) {
+	resp := &fileInfoResponse{}
+	if err := c.doFileCommand(ctx, statFileRequest{Path: path}, resp); err != nil {
+		return roptions.FileInfo{}, err
+	}
+	return resp.Info, nil
+}
+
+func (c *mdbClient) ListDirectory(ctx context.Context, opts roptions.ListDirectory) ([]roptions.FileInfo, error) {
+	resp := &fileInfosResponse{}
+	if err := c.doFileCommand(ctx, listDirectoryRequest{Options: opts}, resp); err != nil {
+		return nil, err
+	}
+	if resp.Infos == nil {
+		return []roptions.FileInfo{}, nil
+	}
+	return resp.Infos, nil
+}
+
+func (c *mdbClient) GlobFiles(ctx context.Context, pattern string) ([]string, error) {
+	resp := &globFilesResponse{}
+	if err := c.doFileCommand(ctx, globFilesRequest{Pattern: pattern}, resp); err != nil {
+		return nil, err
+	}
+	if resp.Paths == nil {
+		return []string{}, nil
+	}
+	return resp.Paths, nil
+}
+
+func (c *mdbClient) RemoveFile(ctx context.Context, opts roptions.RemoveFile) error {
+	return c.doFileCommand(ctx, removeFileRequest{Options: opts}, &shell.ErrorResponse{})
+}
+
+func (c *mdbClient) RenameFile(ctx context.Context, opts roptions.RenameFile) error {
+	return c.doFileCommand(ctx, renameFileRequest{Options: opts}, &shell.ErrorResponse{})
+}
+
+func (c *mdbClient) MakeDirectory(ctx context.Context, opts roptions.MakeDirectory) error {
+	return c.doFileCommand(ctx, makeDirectoryRequest{Options: opts}, &shell.ErrorResponse{})
+}
+
+func (c *mdbClient) ChecksumFile(ctx context.Context, opts roptions.FileChecksum) (string, error) {
+	resp := &checksumFileResponse{}
+	if err := c.doFileCommand(ctx, checksumFileRequest{Options: opts}, resp); err != nil {
+		return "", err
+	}
+	return resp.Checksum, nil
+}
+
+func (c *mdbClient) CreateArchive(ctx context.Context, opts roptions.CreateArchive) (io.ReadCloser, error) {
+	return nil, errors.New("creating archives is not supported by the MongoDB wire protocol service")
+}
+
 // CloseConnection closes the client connection. Callers are expected to call
 // this when finished with the client.
 func (c *mdbClient) CloseConnection() error {
@@ -629,15 +822,59 @@ func (c *mdbClient) DownloadFile(ctx context.Context, opts roptions.Download) er
 	return resp.SuccessOrError()
 }
 
+func (c *mdbClient) GetDownloadCacheStats(ctx context.Context) (roptions.DownloadCacheStats, error) {
+	resp := &downloadCacheStatsResponse{}
+	if err := c.doFileCommand(ctx, downloadCacheStatsRequest{Value: 1}, resp); err != nil {
+		return roptions.DownloadCacheStats{}, err
+	}
+	return resp.Stats, nil
+}
+
+func (c *mdbClient) CheckHealth(ctx context.Context) (roptions.HealthReport, error) {
+	resp := &healthResponse{}
+	if err := c.doFileCommand(ctx, healthRequest{Value: 1}, resp); err != nil {
+		return roptions.HealthReport{}, err
+	}
+	return resp.Report, nil
+}
+
+func (c *mdbClient) Drain(ctx context.Context, opts roptions.Drain) (roptions.DrainReport, error) {
+	resp := &drainResponse{}
+	if err := c.doFileCommand(ctx, drainRequest{Options: opts}, resp); err != nil {
+		return roptions.DrainReport{}, err
+	}
+	return resp.Report, nil
+}
+
+func (c *mdbClient) Snapshot(ctx context.Context) (roptions.Snapshot, error) {
+	resp := &snapshotResponse{}
+	if err := c.doFileCommand(ctx, snapshotRequest{Value: 1}, resp); err != nil {
+		return roptions.Snapshot{}, err
+	}
+	return resp.Snapshot, nil
+}
+
+func (c *mdbClient) AddSchedule(ctx context.Context, opts options.Schedule) error {
+	return c.doFileCommand(ctx, addScheduleRequest{Options: opts}, &shell.ErrorResponse{})
+}
+
+func (c *mdbClient) RemoveSchedule(ctx context.Context, id string) error {
+	return c.doFileCommand(ctx, removeScheduleRequest{ID: id}, &shell.ErrorResponse{})
+}
+
+func (c *mdbClient) ListSchedules(ctx context.Context) ([]jasper.ScheduleInfo, error) {
+	resp := &listSchedulesResponse{}
+	if err := c.doFileCommand(ctx, listSchedulesRequest{Value: 1}, resp); err != nil {
+		return nil, err
+	}
+	return resp.Schedules, nil
+}
+
 func (c *mdbClient) GetLogStream(ctx context.Context, id string, count int) (jasper.LogStream, error) {
 	r := getLogStreamRequest{}
 	r.Params.ID = id
 	r.Params.Count = count
-	data, err := c.marshaler(r)
-	if err != nil {
-		return jasper.LogStream{}, fmt.Errorf("could not marshal request: %w", err)
-	}
-	payload, err := birch.ReadDocument(data)
+	payload, err := c.makeRequest(r)
 	if err != nil {
 		return jasper.LogStream{}, fmt.Errorf("could construct request payload: %w", err)
 	}
@@ -663,6 +900,14 @@ func (c *mdbClient) GetLogStream(ctx context.Context, id string, count int) (jas
 	return resp.LogStream, nil
 }
 
+func (c *mdbClient) FollowLogStream(ctx context.Context, id string, opts roptions.LogFollow, fn func(jasper.LogLine) error) error {
+	return errors.New("following logs is not supported by the MongoDB wire protocol service")
+}
+
+func (c *mdbClient) Exec(ctx context.Context, opts *roptions.Exec) (ExecSession, error) {
+	return nil, errors.New("interactive sessions are not supported by the MongoDB wire protocol service")
+}
+
 func (c *mdbClient) SignalEvent(ctx context.Context, name string) error {
 	payload, err := c.makeRequest(signalEventRequest{Name: name})
 	if err != nil {
@@ -78,6 +78,12 @@ func makeWaitResponse(exitCode int, err error) waitResponse {
 	return waitResponse{ExitCode: exitCode, ErrorResponse: shell.MakeErrorResponse(true, err)}
 }
 
+// waitReadyRequest represents a request to wait for the process given by ID to
+// be ready.
+type waitReadyRequest struct {
+	ID string `bson:"wait_ready"`
+}
+
 // respawnRequest represents a request to respawn the process given by ID.
 type respawnRequest struct {
 	ID string `bson:"respawn"`
@@ -101,6 +107,16 @@ type registerSignalTriggerIDRequest struct {
 	} `bson:"register_signal_trigger_id"`
 }
 
+// registerTriggerIDRequest represents a request to register the process
+// trigger ID, configured by the parameters, on the process given by ID.
+type registerTriggerIDRequest struct {
+	Params struct {
+		ID        string                  `bson:"id"`
+		TriggerID jasper.ProcessTriggerID `bson:"trigger_id"`
+		Params    map[string]string       `bson:"params,omitempty"`
+	} `bson:"register_trigger_id"`
+}
+
 // tagRequest represents a request to associate the process given by ID with the
 // tag.
 type tagRequest struct {
@@ -202,6 +218,79 @@ type downloadFileRequest struct {
 	Options roptions.Download `bson:"download_file"`
 }
 
+type downloadCacheStatsRequest struct {
+	Value int `bson:"download_cache_stats"`
+}
+
+type downloadCacheStatsResponse struct {
+	shell.ErrorResponse `bson:"error_response,inline"`
+	Stats               roptions.DownloadCacheStats `bson:"stats"`
+}
+
+func makeDownloadCacheStatsResponse(stats roptions.DownloadCacheStats) downloadCacheStatsResponse {
+	return downloadCacheStatsResponse{Stats: stats, ErrorResponse: shell.MakeSuccessResponse()}
+}
+
+type healthRequest struct {
+	Value int `bson:"health"`
+}
+
+type healthResponse struct {
+	shell.ErrorResponse `bson:"error_response,inline"`
+	Report              roptions.HealthReport `bson:"report"`
+}
+
+func makeHealthResponse(report roptions.HealthReport) healthResponse {
+	return healthResponse{Report: report, ErrorResponse: shell.MakeSuccessResponse()}
+}
+
+type drainRequest struct {
+	Options roptions.Drain `bson:"drain"`
+}
+
+type drainResponse struct {
+	shell.ErrorResponse `bson:"error_response,inline"`
+	Report              roptions.DrainReport `bson:"report"`
+}
+
+func makeDrainResponse(report roptions.DrainReport) drainResponse {
+	return drainResponse{Report: report, ErrorResponse: shell.MakeSuccessResponse()}
+}
+
+type snapshotRequest struct {
+	Value int `bson:"snapshot"`
+}
+
+type snapshotResponse struct {
+	shell.ErrorResponse `bson:"error_response,inline"`
+	Snapshot            roptions.Snapshot `bson:"snapshot"`
+}
+
+func makeSnapshotResponse(snapshot roptions.Snapshot) snapshotResponse {
+	return snapshotResponse{Snapshot: snapshot, ErrorResponse: shell.MakeSuccessResponse()}
+}
+
+type addScheduleRequest struct {
+	Options options.Schedule `bson:"add_schedule"`
+}
+
+type removeScheduleRequest struct {
+	ID string `bson:"remove_schedule"`
+}
+
+type listSchedulesRequest struct {
+	Value int `bson:"list_schedules"`
+}
+
+type listSchedulesResponse struct {
+	shell.ErrorResponse `bson:"error_response,inline"`
+	Schedules           []jasper.ScheduleInfo `bson:"schedules"`
+}
+
+func makeListSchedulesResponse(schedules []jasper.ScheduleInfo) listSchedulesResponse {
+	return listSchedulesResponse{Schedules: schedules, ErrorResponse: shell.MakeSuccessResponse()}
+}
+
 type getLogStreamRequest struct {
 	Params struct {
 		ID    string `bson:"id"`
@@ -221,6 +310,83 @@ func makeGetLogStreamResponse(logs []string, done bool) getLogStreamResponse {
 	}
 }
 
+type readFileRequest struct {
+	Options roptions.ReadFile `bson:"read_file"`
+}
+
+type readFileResponse struct {
+	shell.ErrorResponse `bson:"error_response,inline"`
+	Data                []byte `bson:"data"`
+}
+
+func makeReadFileResponse(data []byte) readFileResponse {
+	return readFileResponse{Data: data, ErrorResponse: shell.MakeSuccessResponse()}
+}
+
+type statFileRequest struct {
+	Path string `bson:"stat_file"`
+}
+
+type fileInfoResponse struct {
+	shell.ErrorResponse `bson:"error_response,inline"`
+	Info                roptions.FileInfo `bson:"info"`
+}
+
+func makeFileInfoResponse(info roptions.FileInfo) fileInfoResponse {
+	return fileInfoResponse{Info: info, ErrorResponse: shell.MakeSuccessResponse()}
+}
+
+type listDirectoryRequest struct {
+	Options roptions.ListDirectory `bson:"list_directory"`
+}
+
+type fileInfosResponse struct {
+	shell.ErrorResponse `bson:"error_response,inline"`
+	Infos               []roptions.FileInfo `bson:"infos"`
+}
+
+func makeFileInfosResponse(infos []roptions.FileInfo) fileInfosResponse {
+	return fileInfosResponse{Infos: infos, ErrorResponse: shell.MakeSuccessResponse()}
+}
+
+type globFilesRequest struct {
+	Pattern string `bson:"glob_files"`
+}
+
+type globFilesResponse struct {
+	shell.ErrorResponse `bson:"error_response,inline"`
+	Paths               []string `bson:"paths"`
+}
+
+func makeGlobFilesResponse(paths []string) globFilesResponse {
+	return globFilesResponse{Paths: paths, ErrorResponse: shell.MakeSuccessResponse()}
+}
+
+type removeFileRequest struct {
+	Options roptions.RemoveFile `bson:"remove_file"`
+}
+
+type renameFileRequest struct {
+	Options roptions.RenameFile `bson:"rename_file"`
+}
+
+type makeDirectoryRequest struct {
+	Options roptions.MakeDirectory `bson:"make_directory"`
+}
+
+type checksumFileRequest struct {
+	Options roptions.FileChecksum `bson:"checksum_file"`
+}
+
+type checksumFileResponse struct {
+	shell.ErrorResponse `bson:"error_response,inline"`
+	Checksum            string `bson:"checksum"`
+}
+
+func makeChecksumFileResponse(checksum string) checksumFileResponse {
+	return checksumFileResponse{Checksum: checksum, ErrorResponse: shell.MakeSuccessResponse()}
+}
+
 type signalEventRequest struct {
 	Name string `bson:"signal_event"`
 }
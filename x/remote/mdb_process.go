@@ -14,6 +14,7 @@ import (
 	"github.com/tychoish/grip/message"
 	"github.com/tychoish/jasper"
 	"github.com/tychoish/jasper/options"
+	roptions "github.com/tychoish/jasper/x/remote/options"
 )
 
 type mdbProcess struct {
@@ -21,6 +22,7 @@ type mdbProcess struct {
 	doRequest   func(context.Context, mongowire.Message) (mongowire.Message, error)
 	marshaler   options.Marshaler
 	unmarshaler options.Unmarshaler
+	auth        *roptions.AuthCredentials
 }
 
 func (p *mdbProcess) readRequest(msg mongowire.Message, in interface{}) error {
@@ -53,13 +55,25 @@ func (p *mdbProcess) makeRequest(in interface{}) (*birch.Document, error) {
 		return nil, err
 	}
 
-	return doc, nil
+	return addMDBAuth(doc, p.auth, in)
 }
 
 func (p *mdbProcess) ID() string { return p.info.ID }
 
+// hasPendingFollowUps returns true if any of the follow-up processes of the
+// process have not exited yet, in which case the info may still change after
+// the process itself exits.
+func hasPendingFollowUps(info jasper.ProcessInfo) bool {
+	for _, followUp := range info.FollowUps {
+		if followUp.ID != "" && !followUp.Complete {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *mdbProcess) Info(ctx context.Context) jasper.ProcessInfo {
-	if p.info.Complete {
+	if p.info.Complete && !hasPendingFollowUps(p.info) {
 		return p.info
 	}
 
@@ -214,6 +228,32 @@ func (p *mdbProcess) Wait(ctx context.Context) (int, error) {
 	return resp.ExitCode, nil
 }
 
+func (p *mdbProcess) WaitReady(ctx context.Context) error {
+	payload, err := p.makeRequest(waitReadyRequest{ID: p.ID()})
+	if err != nil {
+		return fmt.Errorf("problem marshalling request: %w", err)
+	}
+
+	req, err := shell.RequestToMessage(mongowire.OP_QUERY, payload)
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	msg, err := p.doRequest(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed during request: %w", err)
+	}
+
+	var resp shell.ErrorResponse
+	if err := p.readRequest(msg, &resp); err != nil {
+		return fmt.Errorf("problem reading response: %w", err)
+	}
+
+	if err := resp.SuccessOrError(); err != nil {
+		return fmt.Errorf("response: %w", err)
+	}
+	return nil
+}
+
 func (p *mdbProcess) Respawn(ctx context.Context) (jasper.Process, error) {
 	payload, err := p.makeRequest(respawnRequest{ID: p.ID()})
 	if err != nil {
@@ -233,7 +273,7 @@ func (p *mdbProcess) Respawn(ctx context.Context) (jasper.Process, error) {
 		return nil, fmt.Errorf("problem reading response: %w", err)
 	}
 
-	return &mdbProcess{info: resp.Info, doRequest: p.doRequest, marshaler: p.marshaler, unmarshaler: p.unmarshaler}, nil
+	return &mdbProcess{info: resp.Info, doRequest: p.doRequest, marshaler: p.marshaler, unmarshaler: p.unmarshaler, auth: p.auth}, nil
 }
 
 func (p *mdbProcess) RegisterTrigger(ctx context.Context, t jasper.ProcessTrigger) error {
@@ -274,6 +314,37 @@ func (p *mdbProcess) RegisterSignalTriggerID(ctx context.Context, sigID jasper.S
 	return nil
 }
 
+func (p *mdbProcess) RegisterTriggerID(ctx context.Context, triggerID jasper.ProcessTriggerID, params map[string]string) error {
+	r := registerTriggerIDRequest{}
+	r.Params.ID = p.ID()
+	r.Params.TriggerID = triggerID
+	r.Params.Params = params
+
+	payload, err := p.makeRequest(r)
+	if err != nil {
+		return fmt.Errorf("problem marshalling request: %w", err)
+	}
+
+	req, err := shell.RequestToMessage(mongowire.OP_QUERY, payload)
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	msg, err := p.doRequest(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed during request: %w", err)
+	}
+	var resp shell.ErrorResponse
+	if err := p.readRequest(msg, &resp); err != nil {
+		return fmt.Errorf("problem reading response: %w", err)
+	}
+
+	if err := resp.SuccessOrError(); err != nil {
+		return fmt.Errorf("response: %w", err)
+	}
+
+	return nil
+}
+
 func (p *mdbProcess) Tag(tag string) {
 	r := tagRequest{}
 	r.Params.ID = p.ID()
@@ -2,10 +2,12 @@ package remote
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"strconv"
+	"strings"
 
 	"github.com/tychoish/birch"
 	"github.com/tychoish/birch/x/mrpc"
@@ -17,6 +19,7 @@ import (
 	"github.com/tychoish/jasper/options"
 	"github.com/tychoish/jasper/scripting"
 	"github.com/tychoish/jasper/util"
+	roptions "github.com/tychoish/jasper/x/remote/options"
 )
 
 const RawLoggerConfigFormatBSON options.RawLoggerConfigFormat = "BSON"
@@ -24,15 +27,28 @@ const RawLoggerConfigFormatBSON options.RawLoggerConfigFormat = "BSON"
 type mdbService struct {
 	mrpc.Service
 	manager      jasper.Manager
+	health       *HealthManager
 	harnessCache scripting.HarnessCache
 	marshaler    options.Marshaler
 	unmarshaler  options.Unmarshaler
+	authorizer   *Authorizer
 }
 
 // StartMDBService wraps an existing Jasper manager in a MongoDB wire protocol
 // service and starts it. The caller is responsible for closing the connection
-// using the returned jasper.CloseFunc.
+// using the returned jasper.CloseFunc. The underlying mrpc service only
+// listens on TCP addresses, so the address cannot be a unix domain socket.
 func StartMDBService(ctx context.Context, m jasper.Manager, addr net.Addr) (util.CloseFunc, error) {
+	return StartMDBServiceWithAuth(ctx, m, addr, nil)
+}
+
+// StartMDBServiceWithAuth is the same as StartMDBService, but if the
+// authorizer is non-nil, every command must be authenticated and authorized
+// by it.
+func StartMDBServiceWithAuth(ctx context.Context, m jasper.Manager, addr net.Addr, a *Authorizer) (util.CloseFunc, error) {
+	if isUnixAddr(addr) {
+		return nil, errors.New("MongoDB wire protocol service cannot listen on a unix domain socket")
+	}
 	host, p, err := net.SplitHostPort(addr.String())
 	if err != nil {
 		return nil, fmt.Errorf("invalid address: %w", err)
@@ -46,12 +62,15 @@ func StartMDBService(ctx context.Context, m jasper.Manager, addr net.Addr) (util
 	if err != nil {
 		return nil, fmt.Errorf("could not create base service: %w", err)
 	}
+	hm := makeHealthManager(m)
 	svc := &mdbService{
 		Service:      baseSvc,
-		manager:      m,
+		manager:      hm,
+		health:       hm,
 		harnessCache: scripting.NewCache(),
 		unmarshaler:  options.GetGlobalLoggerRegistry().Unmarshaler(RawLoggerConfigFormatBSON),
 		marshaler:    options.GetGlobalLoggerRegistry().Marshaler(RawLoggerConfigFormatBSON),
+		authorizer:   a,
 	}
 	if err := svc.registerHandlers(); err != nil {
 		return nil, fmt.Errorf("error registering handlers: %w", err)
@@ -85,8 +104,10 @@ func (s *mdbService) registerHandlers() error {
 		RunningCommand:                 s.processRunning,
 		CompleteCommand:                s.processComplete,
 		WaitCommand:                    s.processWait,
+		WaitReadyCommand:               s.processWaitReady,
 		SignalCommand:                  s.processSignal,
 		RegisterSignalTriggerIDCommand: s.processRegisterSignalTriggerID,
+		RegisterTriggerIDCommand:       s.processRegisterTriggerID,
 		RespawnCommand:                 s.processRespawn,
 		TagCommand:                     s.processTag,
 		GetTagsCommand:                 s.processGetTags,
@@ -111,14 +132,31 @@ func (s *mdbService) registerHandlers() error {
 		LoggingSendMessagesCommand: s.loggingSendMessages,
 
 		// Remote client commands
-		DownloadFileCommand: s.downloadFile,
-		GetLogStreamCommand: s.getLogStream,
-		SignalEventCommand:  s.signalEvent,
+		DownloadFileCommand:       s.downloadFile,
+		DownloadCacheStatsCommand: s.downloadCacheStats,
+		GetLogStreamCommand:       s.getLogStream,
+		SignalEventCommand:        s.signalEvent,
+		HealthCommand:             s.checkHealth,
+		DrainCommand:              s.drain,
+		SnapshotCommand:           s.snapshot,
+		AddScheduleCommand:        s.addSchedule,
+		RemoveScheduleCommand:     s.removeSchedule,
+		ListSchedulesCommand:      s.listSchedules,
+
+		// Filesystem commands
+		ReadFileCommand:      s.readFile,
+		StatFileCommand:      s.statFile,
+		ListDirectoryCommand: s.listDirectory,
+		GlobFilesCommand:     s.globFiles,
+		RemoveFileCommand:    s.removeFile,
+		RenameFileCommand:    s.renameFile,
+		MakeDirectoryCommand: s.makeDirectory,
+		ChecksumFileCommand:  s.checksumFile,
 	} {
 		if err := s.RegisterOperation(&mongowire.OpScope{
 			Type:    mongowire.OP_COMMAND,
 			Command: name,
-		}, handler); err != nil {
+		}, s.authorize(name, handler)); err != nil {
 			return fmt.Errorf("could not register handler for %q: %w", name, err)
 		}
 	}
@@ -126,11 +164,147 @@ func (s *mdbService) registerHandlers() error {
 	return nil
 }
 
+// mdbCommandOperations maps each command to the operation used to authorize
+// it. Commands that are not listed require OperationAdmin.
+var mdbCommandOperations = map[string]roptions.Operation{
+	ManagerIDCommand:                  roptions.OperationRead,
+	CreateProcessCommand:              roptions.OperationCreate,
+	ListCommand:                       roptions.OperationRead,
+	GroupCommand:                      roptions.OperationRead,
+	GetProcessCommand:                 roptions.OperationRead,
+	ClearCommand:                      roptions.OperationAdmin,
+	CloseCommand:                      roptions.OperationAdmin,
+	WriteFileCommand:                  roptions.OperationFileWrite,
+	InfoCommand:                       roptions.OperationRead,
+	RunningCommand:                    roptions.OperationRead,
+	CompleteCommand:                   roptions.OperationRead,
+	WaitCommand:                       roptions.OperationRead,
+	WaitReadyCommand:                  roptions.OperationRead,
+	SignalCommand:                     roptions.OperationSignal,
+	RegisterSignalTriggerIDCommand:    roptions.OperationSignal,
+	RegisterTriggerIDCommand:          roptions.OperationCreate,
+	RespawnCommand:                    roptions.OperationCreate,
+	TagCommand:                        roptions.OperationSignal,
+	GetTagsCommand:                    roptions.OperationRead,
+	ResetTagsCommand:                  roptions.OperationSignal,
+	ScriptingGetCommand:               roptions.OperationRead,
+	ScriptingCreateCommand:            roptions.OperationCreate,
+	ScriptingSetupCommand:             roptions.OperationCreate,
+	ScriptingCleanupCommand:           roptions.OperationCreate,
+	ScriptingRunCommand:               roptions.OperationCreate,
+	ScriptingRunScriptCommand:         roptions.OperationCreate,
+	ScriptingBuildCommand:             roptions.OperationCreate,
+	ScriptingTestCommand:              roptions.OperationCreate,
+	LoggingCacheSizeCommand:           roptions.OperationLogging,
+	LoggingCacheCreateCommand:         roptions.OperationLogging,
+	LoggingCacheDeleteCommand:         roptions.OperationLogging,
+	LoggingCacheCloseAndRemoveCommand: roptions.OperationLogging,
+	LoggingCacheClearCommand:          roptions.OperationLogging,
+	LoggingCacheGetCommand:            roptions.OperationLogging,
+	LoggingCachePruneCommand:          roptions.OperationLogging,
+	LoggingSendMessagesCommand:        roptions.OperationLogging,
+	DownloadFileCommand:               roptions.OperationFileWrite,
+	DownloadCacheStatsCommand:         roptions.OperationRead,
+	HealthCommand:                     roptions.OperationRead,
+	DrainCommand:                      roptions.OperationAdmin,
+	SnapshotCommand:                   roptions.OperationRead,
+	AddScheduleCommand:                roptions.OperationCreate,
+	RemoveScheduleCommand:             roptions.OperationCreate,
+	ListSchedulesCommand:              roptions.OperationRead,
+	GetLogStreamCommand:               roptions.OperationRead,
+	SignalEventCommand:                roptions.OperationSignal,
+	ReadFileCommand:                   roptions.OperationFileRead,
+	StatFileCommand:                   roptions.OperationFileRead,
+	ListDirectoryCommand:              roptions.OperationFileRead,
+	GlobFilesCommand:                  roptions.OperationFileRead,
+	ChecksumFileCommand:               roptions.OperationFileRead,
+	RemoveFileCommand:                 roptions.OperationFileWrite,
+	RenameFileCommand:                 roptions.OperationFileWrite,
+	MakeDirectoryCommand:              roptions.OperationFileWrite,
+}
+
+// mdbAuthFields are the fields that a client adds to each command document to
+// authenticate the command.
+type mdbAuthFields struct {
+	Token     string `bson:"auth_token,omitempty"`
+	KeyID     string `bson:"auth_key_id,omitempty"`
+	Timestamp string `bson:"auth_timestamp,omitempty"`
+	Nonce     string `bson:"auth_nonce,omitempty"`
+	Signature string `bson:"auth_signature,omitempty"`
+}
+
+// authorize wraps the handler so that the command must be authorized if the
+// service has an authorizer.
+func (s *mdbService) authorize(command string, handler mrpc.HandlerFunc) mrpc.HandlerFunc {
+	if s.authorizer == nil {
+		return handler
+	}
+
+	op, ok := mdbCommandOperations[command]
+	if !ok {
+		op = roptions.OperationAdmin
+	}
+
+	return func(ctx context.Context, w io.Writer, msg mongowire.Message) {
+		fields := mdbAuthFields{}
+		if err := s.readRequest(msg, &fields); err != nil {
+			shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not parse request: %w", err), command)
+			return
+		}
+		body, err := readMDBAuthBody(msg)
+		if err != nil {
+			shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not parse request: %w", err), command)
+			return
+		}
+
+		id, err := s.authorizer.Authorize(AuthRequest{
+			Target:    command,
+			Body:      body,
+			Token:     fields.Token,
+			KeyID:     fields.KeyID,
+			Timestamp: fields.Timestamp,
+			Nonce:     fields.Nonce,
+			Signature: fields.Signature,
+		}, op)
+		if err != nil {
+			shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, err, command)
+			return
+		}
+
+		handler(withIdentity(ctx, id), w, msg)
+	}
+}
+
+// readMDBAuthBody returns the encoding of the command document without the
+// credentials, which is what the client signed.
+func readMDBAuthBody(msg mongowire.Message) ([]byte, error) {
+	doc, err := shell.RequestMessageToDocument(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	body := birch.DC.Make(doc.Len())
+	for elem := range doc.Iterator() {
+		if !strings.HasPrefix(elem.Key(), "auth_") {
+			body.Append(elem)
+		}
+	}
+
+	return body.MarshalBSON()
+}
+
 // Constants representing remote client commands.
 const (
-	DownloadFileCommand = "download_file"
-	GetLogStreamCommand = "get_log_stream"
-	SignalEventCommand  = "signal_event"
+	DownloadFileCommand       = "download_file"
+	DownloadCacheStatsCommand = "download_cache_stats"
+	GetLogStreamCommand       = "get_log_stream"
+	SignalEventCommand        = "signal_event"
+	HealthCommand             = "health"
+	DrainCommand              = "drain"
+	SnapshotCommand           = "snapshot"
+	AddScheduleCommand        = "add_schedule"
+	RemoveScheduleCommand     = "remove_schedule"
+	ListSchedulesCommand      = "list_schedules"
 )
 
 func (s *mdbService) readRequest(msg mongowire.Message, in interface{}) error {
@@ -175,6 +349,136 @@ func (s *mdbService) downloadFile(ctx context.Context, w io.Writer, msg mongowir
 	shell.WriteOKResponse(ctx, w, mongowire.OP_REPLY, DownloadFileCommand)
 }
 
+func (s *mdbService) downloadCacheStats(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	resp := makeDownloadCacheStatsResponse(roptions.GetGlobalDownloadCache().Stats())
+	payload, err := s.makePayload(resp)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not make response: %w", err), DownloadCacheStatsCommand)
+		return
+	}
+
+	shellResp, err := shell.ResponseToMessage(mongowire.OP_REPLY, payload)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not make response: %w", err), DownloadCacheStatsCommand)
+		return
+	}
+
+	shell.WriteResponse(ctx, w, shellResp, DownloadCacheStatsCommand)
+}
+
+func (s *mdbService) checkHealth(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	resp := makeHealthResponse(s.health.Check(ctx))
+	payload, err := s.makePayload(resp)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not make response: %w", err), HealthCommand)
+		return
+	}
+
+	shellResp, err := shell.ResponseToMessage(mongowire.OP_REPLY, payload)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not make response: %w", err), HealthCommand)
+		return
+	}
+
+	shell.WriteResponse(ctx, w, shellResp, HealthCommand)
+}
+
+func (s *mdbService) drain(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	req := drainRequest{}
+	if err := s.readRequest(msg, &req); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not parse request: %w", err), DrainCommand)
+		return
+	}
+
+	report, err := s.health.Drain(ctx, req.Options)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not drain service: %w", err), DrainCommand)
+		return
+	}
+
+	payload, err := s.makePayload(makeDrainResponse(report))
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not make response: %w", err), DrainCommand)
+		return
+	}
+
+	shellResp, err := shell.ResponseToMessage(mongowire.OP_REPLY, payload)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not make response: %w", err), DrainCommand)
+		return
+	}
+
+	shell.WriteResponse(ctx, w, shellResp, DrainCommand)
+}
+
+func (s *mdbService) snapshot(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	snapshot, err := roptions.NewSnapshot(ctx, s.manager, s.harnessCache)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not create snapshot: %w", err), SnapshotCommand)
+		return
+	}
+
+	payload, err := s.makePayload(makeSnapshotResponse(snapshot))
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not make response: %w", err), SnapshotCommand)
+		return
+	}
+
+	shellResp, err := shell.ResponseToMessage(mongowire.OP_REPLY, payload)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not make response: %w", err), SnapshotCommand)
+		return
+	}
+
+	shell.WriteResponse(ctx, w, shellResp, SnapshotCommand)
+}
+
+func (s *mdbService) addSchedule(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	req := addScheduleRequest{}
+	if err := s.readRequest(msg, &req); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not parse request: %w", err), AddScheduleCommand)
+		return
+	}
+
+	if err := s.health.Scheduler().Add(req.Options); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not add schedule: %w", err), AddScheduleCommand)
+		return
+	}
+
+	shell.WriteOKResponse(ctx, w, mongowire.OP_REPLY, AddScheduleCommand)
+}
+
+func (s *mdbService) removeSchedule(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	req := removeScheduleRequest{}
+	if err := s.readRequest(msg, &req); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not parse request: %w", err), RemoveScheduleCommand)
+		return
+	}
+
+	if err := s.health.Scheduler().Remove(req.ID); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not remove schedule: %w", err), RemoveScheduleCommand)
+		return
+	}
+
+	shell.WriteOKResponse(ctx, w, mongowire.OP_REPLY, RemoveScheduleCommand)
+}
+
+func (s *mdbService) listSchedules(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	payload, err := s.makePayload(makeListSchedulesResponse(s.health.Scheduler().List()))
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not make response: %w", err), ListSchedulesCommand)
+		return
+	}
+
+	shellResp, err := shell.ResponseToMessage(mongowire.OP_REPLY, payload)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not make response: %w", err), ListSchedulesCommand)
+		return
+	}
+
+	shell.WriteResponse(ctx, w, shellResp, ListSchedulesCommand)
+}
+
 func (s *mdbService) getLogStream(ctx context.Context, w io.Writer, msg mongowire.Message) {
 	req := getLogStreamRequest{}
 	if err := s.readRequest(msg, &req); err != nil {
@@ -0,0 +1,214 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/tychoish/birch/x/mrpc/mongowire"
+	"github.com/tychoish/birch/x/mrpc/shell"
+	roptions "github.com/tychoish/jasper/x/remote/options"
+)
+
+// Constants representing filesystem commands.
+const (
+	ReadFileCommand      = "read_file"
+	StatFileCommand      = "stat_file"
+	ListDirectoryCommand = "list_directory"
+	GlobFilesCommand     = "glob_files"
+	RemoveFileCommand    = "remove_file"
+	RenameFileCommand    = "rename_file"
+	MakeDirectoryCommand = "make_directory"
+	ChecksumFileCommand  = "checksum_file"
+)
+
+// mdbFileChunkSize is the maximum size of the file contents returned by each
+// read file command. Clients read larger files with multiple commands.
+const mdbFileChunkSize = 1024 * 1024
+
+func (s *mdbService) serviceFileResponse(ctx context.Context, w io.Writer, resp interface{}, command string) {
+	payload, err := s.makePayload(resp)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not make response: %w", err), command)
+		return
+	}
+
+	shellResp, err := shell.ResponseToMessage(mongowire.OP_REPLY, payload)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not make response: %w", err), command)
+		return
+	}
+
+	shell.WriteResponse(ctx, w, shellResp, command)
+}
+
+func (s *mdbService) readFile(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	req := readFileRequest{}
+	if err := s.readRequest(msg, &req); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not parse request: %w", err), ReadFileCommand)
+		return
+	}
+
+	opts := req.Options
+	if err := opts.Validate(); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("invalid read file options: %w", err), ReadFileCommand)
+		return
+	}
+	if opts.Length == 0 || opts.Length > mdbFileChunkSize {
+		opts.Length = mdbFileChunkSize
+	}
+
+	file, err := opts.Open()
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not open file: %w", err), ReadFileCommand)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not read file: %w", err), ReadFileCommand)
+		return
+	}
+
+	s.serviceFileResponse(ctx, w, makeReadFileResponse(data), ReadFileCommand)
+}
+
+func (s *mdbService) statFile(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	req := statFileRequest{}
+	if err := s.readRequest(msg, &req); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not parse request: %w", err), StatFileCommand)
+		return
+	}
+
+	info, err := roptions.StatFile(req.Path)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not get file information: %w", err), StatFileCommand)
+		return
+	}
+
+	s.serviceFileResponse(ctx, w, makeFileInfoResponse(info), StatFileCommand)
+}
+
+func (s *mdbService) listDirectory(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	req := listDirectoryRequest{}
+	if err := s.readRequest(msg, &req); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not parse request: %w", err), ListDirectoryCommand)
+		return
+	}
+
+	opts := req.Options
+	if err := opts.Validate(); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("invalid list directory options: %w", err), ListDirectoryCommand)
+		return
+	}
+
+	infos, err := opts.List()
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not list directory: %w", err), ListDirectoryCommand)
+		return
+	}
+
+	s.serviceFileResponse(ctx, w, makeFileInfosResponse(infos), ListDirectoryCommand)
+}
+
+func (s *mdbService) globFiles(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	req := globFilesRequest{}
+	if err := s.readRequest(msg, &req); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not parse request: %w", err), GlobFilesCommand)
+		return
+	}
+
+	paths, err := roptions.GlobFiles(req.Pattern)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, err, GlobFilesCommand)
+		return
+	}
+
+	s.serviceFileResponse(ctx, w, makeGlobFilesResponse(paths), GlobFilesCommand)
+}
+
+func (s *mdbService) removeFile(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	req := removeFileRequest{}
+	if err := s.readRequest(msg, &req); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not parse request: %w", err), RemoveFileCommand)
+		return
+	}
+
+	opts := req.Options
+	if err := opts.Validate(); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("invalid remove file options: %w", err), RemoveFileCommand)
+		return
+	}
+
+	if err := opts.Remove(); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not remove file: %w", err), RemoveFileCommand)
+		return
+	}
+
+	shell.WriteOKResponse(ctx, w, mongowire.OP_REPLY, RemoveFileCommand)
+}
+
+func (s *mdbService) renameFile(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	req := renameFileRequest{}
+	if err := s.readRequest(msg, &req); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not parse request: %w", err), RenameFileCommand)
+		return
+	}
+
+	opts := req.Options
+	if err := opts.Validate(); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("invalid rename file options: %w", err), RenameFileCommand)
+		return
+	}
+
+	if err := opts.Rename(); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not rename file: %w", err), RenameFileCommand)
+		return
+	}
+
+	shell.WriteOKResponse(ctx, w, mongowire.OP_REPLY, RenameFileCommand)
+}
+
+func (s *mdbService) makeDirectory(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	req := makeDirectoryRequest{}
+	if err := s.readRequest(msg, &req); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not parse request: %w", err), MakeDirectoryCommand)
+		return
+	}
+
+	opts := req.Options
+	if err := opts.Validate(); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("invalid make directory options: %w", err), MakeDirectoryCommand)
+		return
+	}
+
+	if err := opts.Make(); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not make directory: %w", err), MakeDirectoryCommand)
+		return
+	}
+
+	shell.WriteOKResponse(ctx, w, mongowire.OP_REPLY, MakeDirectoryCommand)
+}
+
+func (s *mdbService) checksumFile(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	req := checksumFileRequest{}
+	if err := s.readRequest(msg, &req); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not parse request: %w", err), ChecksumFileCommand)
+		return
+	}
+
+	opts := req.Options
+	if err := opts.Validate(); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("invalid file checksum options: %w", err), ChecksumFileCommand)
+		return
+	}
+
+	checksum, err := opts.Compute()
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not compute checksum: %w", err), ChecksumFileCommand)
+		return
+	}
+
+	s.serviceFileResponse(ctx, w, makeChecksumFileResponse(checksum), ChecksumFileCommand)
+}
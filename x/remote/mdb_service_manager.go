@@ -57,7 +57,7 @@ func (s *mdbService) managerCreateProcess(ctx context.Context, w io.Writer, msg
 	// Spawn a new context so that the process' context is not potentially
 	// canceled by the request's. See how rest_service.go's createProcess() does
 	// this same thing.
-	pctx, cancel := context.WithCancel(context.Background())
+	pctx, cancel := context.WithCancel(jasper.WithDetachedFollowUps(context.Background()))
 
 	proc, err := s.manager.CreateProcess(pctx, &opts)
 	if err != nil {
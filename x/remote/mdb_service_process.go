@@ -18,9 +18,11 @@ const (
 	RunningCommand                 = "running"
 	CompleteCommand                = "complete"
 	WaitCommand                    = "wait"
+	WaitReadyCommand               = "wait_ready"
 	RespawnCommand                 = "respawn"
 	SignalCommand                  = "signal"
 	RegisterSignalTriggerIDCommand = "register_signal_trigger_id"
+	RegisterTriggerIDCommand       = "register_trigger_id"
 	GetTagsCommand                 = "get_tags"
 	TagCommand                     = "add_tag"
 	ResetTagsCommand               = "reset_tags"
@@ -184,15 +186,10 @@ func (s *mdbService) processRespawn(ctx context.Context, w io.Writer, msg mongow
 		return
 	}
 
-	pctx, cancel := context.WithCancel(context.Background())
-	newProc, err := proc.Respawn(pctx)
+	pctx, cancel := context.WithCancel(jasper.WithDetachedFollowUps(context.Background()))
+	newProc, err := respawnProcess(pctx, s.manager, proc)
 	if err != nil {
-		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("failed to respawned process: %w", err), RespawnCommand)
-		cancel()
-		return
-	}
-	if err = s.manager.Register(ctx, newProc); err != nil {
-		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("failed to register respawned process: %w", err), RespawnCommand)
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("failed to respawn process: %w", err), RespawnCommand)
 		cancel()
 		return
 	}
@@ -222,6 +219,32 @@ func (s *mdbService) processRespawn(ctx context.Context, w io.Writer, msg mongow
 	shell.WriteResponse(ctx, w, resp, RespawnCommand)
 }
 
+func (s *mdbService) processWaitReady(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	doc, err := shell.RequestMessageToDocument(msg)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not read request: %w", err), WaitReadyCommand)
+		return
+	}
+	req := waitReadyRequest{}
+	if err = s.readPayload(doc, &req); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not parse request: %w", err), WaitReadyCommand)
+		return
+	}
+
+	proc, err := s.manager.Get(ctx, req.ID)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not get process: %w", err), WaitReadyCommand)
+		return
+	}
+
+	if err := proc.WaitReady(ctx); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not wait for process to be ready: %w", err), WaitReadyCommand)
+		return
+	}
+
+	shell.WriteOKResponse(ctx, w, mongowire.OP_REPLY, WaitReadyCommand)
+}
+
 func (s *mdbService) processSignal(ctx context.Context, w io.Writer, msg mongowire.Message) {
 	doc, err := shell.RequestMessageToDocument(msg)
 	if err != nil {
@@ -287,6 +310,33 @@ func (s *mdbService) processRegisterSignalTriggerID(ctx context.Context, w io.Wr
 	shell.WriteOKResponse(ctx, w, mongowire.OP_REPLY, RegisterSignalTriggerIDCommand)
 }
 
+func (s *mdbService) processRegisterTriggerID(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	doc, err := shell.RequestMessageToDocument(msg)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not read request: %w", err), RegisterTriggerIDCommand)
+		return
+	}
+
+	req := registerTriggerIDRequest{}
+	if err = s.readPayload(doc, &req); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not parse request: %w", err), RegisterTriggerIDCommand)
+		return
+	}
+
+	proc, err := s.manager.Get(ctx, req.Params.ID)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not get process: %w", err), RegisterTriggerIDCommand)
+		return
+	}
+
+	if err := proc.RegisterTriggerID(ctx, req.Params.TriggerID, req.Params.Params); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, fmt.Errorf("could not register trigger: %w", err), RegisterTriggerIDCommand)
+		return
+	}
+
+	shell.WriteOKResponse(ctx, w, mongowire.OP_REPLY, RegisterTriggerIDCommand)
+}
+
 func (s *mdbService) processTag(ctx context.Context, w io.Writer, msg mongowire.Message) {
 	doc, err := shell.RequestMessageToDocument(msg)
 	if err != nil {
@@ -1,14 +1,18 @@
 package mock
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"runtime"
+	"slices"
 
 	"github.com/tychoish/jasper"
 	"github.com/tychoish/jasper/mock"
 	"github.com/tychoish/jasper/options"
 	"github.com/tychoish/jasper/scripting"
+	"github.com/tychoish/jasper/x/remote"
 	roptions "github.com/tychoish/jasper/x/remote/options"
 )
 
@@ -19,22 +23,82 @@ type RemoteClient struct {
 	FailCloseConnection bool
 	FailDownloadFile    bool
 	FailGetLogStream    bool
+	FailFollowLogStream bool
+	FailExec            bool
 	FailSignalEvent     bool
 	FailCreateScripting bool
 	FailGetScripting    bool
 	FailSendMessages    bool
+	FailReadFile        bool
+	FailStatFile        bool
+	FailListDirectory   bool
+	FailGlobFiles       bool
+	FailRemoveFile      bool
+	FailRenameFile      bool
+	FailMakeDirectory   bool
+	FailChecksumFile    bool
+	FailCreateArchive   bool
+	FailDownloadCache   bool
+	FailCheckHealth     bool
+	FailDrain           bool
+	FailSnapshot        bool
+	FailAddSchedule     bool
+	FailRemoveSchedule  bool
+	FailListSchedules   bool
 
 	// DownloadFile input
 	DownloadOptions roptions.Download
 
+	// GetDownloadCacheStats output
+	DownloadCacheStats roptions.DownloadCacheStats
+
+	// CheckHealth output
+	HealthReport roptions.HealthReport
+
+	// Drain input/output
+	DrainOptions roptions.Drain
+	DrainReport  roptions.DrainReport
+
+	// Snapshot output
+	ManagerSnapshot roptions.Snapshot
+
+	// AddSchedule, RemoveSchedule and ListSchedules input/output
+	Schedules []jasper.ScheduleInfo
+
 	// LogStream input/output
 	LogStreamID    string
 	LogStreamCount int
 	jasper.LogStream
 
+	// FollowLogStream input/output
+	LogFollowID      string
+	LogFollowOptions roptions.LogFollow
+	LogLines         []jasper.LogLine
+
+	// Exec input/output
+	ExecOptions *roptions.Exec
+	ExecSession remote.ExecSession
+
 	EventName string
 
 	SendMessagePayload options.LoggingPayload
+
+	// Filesystem input/output
+	ReadFileOptions      roptions.ReadFile
+	FileContent          []byte
+	StatFilePath         string
+	FileInfo             roptions.FileInfo
+	ListDirectoryOptions roptions.ListDirectory
+	FileInfos            []roptions.FileInfo
+	GlobPattern          string
+	GlobMatches          []string
+	RemoveFileOptions    roptions.RemoveFile
+	RenameFileOptions    roptions.RenameFile
+	MakeDirectoryOptions roptions.MakeDirectory
+	ChecksumOptions      roptions.FileChecksum
+	Checksum             string
+	ArchiveOptions       roptions.CreateArchive
+	ArchiveContent       []byte
 }
 
 // CloseConnection is a no-op. If FailCloseConnection is set, it returns an
@@ -58,6 +122,86 @@ func (c *RemoteClient) DownloadFile(ctx context.Context, opts roptions.Download)
 	return nil
 }
 
+// GetDownloadCacheStats returns DownloadCacheStats. If FailDownloadCache is
+// set, it returns an error.
+func (c *RemoteClient) GetDownloadCacheStats(ctx context.Context) (roptions.DownloadCacheStats, error) {
+	if c.FailDownloadCache {
+		return roptions.DownloadCacheStats{}, mockFail()
+	}
+
+	return c.DownloadCacheStats, nil
+}
+
+// CheckHealth returns HealthReport. If FailCheckHealth is set, it returns an
+// error.
+func (c *RemoteClient) CheckHealth(ctx context.Context) (roptions.HealthReport, error) {
+	if c.FailCheckHealth {
+		return roptions.HealthReport{}, mockFail()
+	}
+
+	return c.HealthReport, nil
+}
+
+// Drain stores the given drain options and returns DrainReport. If FailDrain
+// is set, it returns an error.
+func (c *RemoteClient) Drain(ctx context.Context, opts roptions.Drain) (roptions.DrainReport, error) {
+	if c.FailDrain {
+		return roptions.DrainReport{}, mockFail()
+	}
+
+	c.DrainOptions = opts
+
+	return c.DrainReport, nil
+}
+
+// Snapshot returns ManagerSnapshot. If FailSnapshot is set, it returns an
+// error.
+func (c *RemoteClient) Snapshot(ctx context.Context) (roptions.Snapshot, error) {
+	if c.FailSnapshot {
+		return roptions.Snapshot{}, mockFail()
+	}
+
+	return c.ManagerSnapshot, nil
+}
+
+// AddSchedule adds a schedule with the given options to Schedules. If
+// FailAddSchedule is set, it returns an error.
+func (c *RemoteClient) AddSchedule(ctx context.Context, opts options.Schedule) error {
+	if c.FailAddSchedule {
+		return mockFail()
+	}
+
+	c.Schedules = append(c.Schedules, jasper.ScheduleInfo{Schedule: opts})
+	return nil
+}
+
+// RemoveSchedule removes the schedule with the given ID from Schedules. If
+// FailRemoveSchedule is set or there is no such schedule, it returns an
+// error.
+func (c *RemoteClient) RemoveSchedule(ctx context.Context, id string) error {
+	if c.FailRemoveSchedule {
+		return mockFail()
+	}
+
+	for idx, info := range c.Schedules {
+		if info.Schedule.ID == id {
+			c.Schedules = append(c.Schedules[:idx], c.Schedules[idx+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("schedule '%s' does not exist", id)
+}
+
+// ListSchedules returns Schedules. If FailListSchedules is set, it returns an
+// error.
+func (c *RemoteClient) ListSchedules(ctx context.Context) ([]jasper.ScheduleInfo, error) {
+	if c.FailListSchedules {
+		return nil, mockFail()
+	}
+
+	return c.Schedules, nil
+}
+
 // GetLogStream stores the given log stream ID and count and returns a
 // jasper.LogStream indicating that it is done. If FailGetLogStream is set, it
 // returns an error.
@@ -72,6 +216,41 @@ func (c *RemoteClient) GetLogStream(ctx context.Context, id string, count int) (
 	return c.LogStream, nil
 }
 
+// FollowLogStream stores the given process ID and options and calls fn with
+// each of the LogLines at or after the offset on the requested streams. If FailFollowLogStream is set,
+// it returns an error.
+func (c *RemoteClient) FollowLogStream(ctx context.Context, id string, opts roptions.LogFollow, fn func(jasper.LogLine) error) error {
+	if c.FailFollowLogStream {
+		return mockFail()
+	}
+
+	c.LogFollowID = id
+	c.LogFollowOptions = opts
+
+	for _, line := range c.LogLines {
+		if line.Offset < opts.Offset || (len(opts.Streams) != 0 && !slices.Contains(opts.Streams, line.Stream)) {
+			continue
+		}
+		if err := fn(line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Exec stores the given session options and returns ExecSession. If FailExec
+// is set, it returns an error.
+func (c *RemoteClient) Exec(ctx context.Context, opts *roptions.Exec) (remote.ExecSession, error) {
+	if c.FailExec {
+		return nil, mockFail()
+	}
+
+	c.ExecOptions = opts
+
+	return c.ExecSession, nil
+}
+
 // SignalEvent stores the given event name. If FailSignalEvent is set, it
 // returns an error.
 func (c *RemoteClient) SignalEvent(ctx context.Context, name string) error {
@@ -84,6 +263,114 @@ func (c *RemoteClient) SignalEvent(ctx context.Context, name string) error {
 	return nil
 }
 
+// ReadFile stores the given read file options and returns a reader for
+// FileContent. If FailReadFile is set, it returns an error.
+func (c *RemoteClient) ReadFile(ctx context.Context, opts roptions.ReadFile) (io.ReadCloser, error) {
+	if c.FailReadFile {
+		return nil, mockFail()
+	}
+
+	c.ReadFileOptions = opts
+
+	return io.NopCloser(bytes.NewReader(c.FileContent)), nil
+}
+
+// StatFile stores the given path and returns FileInfo. If FailStatFile is
+// set, it returns an error.
+func (c *RemoteClient) StatFile(ctx context.Context, path string) (roptions.FileInfo, error) {
+	if c.FailStatFile {
+		return roptions.FileInfo{}, mockFail()
+	}
+
+	c.StatFilePath = path
+
+	return c.FileInfo, nil
+}
+
+// ListDirectory stores the given list directory options and returns
+// FileInfos. If FailListDirectory is set, it returns an error.
+func (c *RemoteClient) ListDirectory(ctx context.Context, opts roptions.ListDirectory) ([]roptions.FileInfo, error) {
+	if c.FailListDirectory {
+		return nil, mockFail()
+	}
+
+	c.ListDirectoryOptions = opts
+
+	return c.FileInfos, nil
+}
+
+// GlobFiles stores the given pattern and returns GlobMatches. If
+// FailGlobFiles is set, it returns an error.
+func (c *RemoteClient) GlobFiles(ctx context.Context, pattern string) ([]string, error) {
+	if c.FailGlobFiles {
+		return nil, mockFail()
+	}
+
+	c.GlobPattern = pattern
+
+	return c.GlobMatches, nil
+}
+
+// RemoveFile stores the given remove file options. If FailRemoveFile is set,
+// it returns an error.
+func (c *RemoteClient) RemoveFile(ctx context.Context, opts roptions.RemoveFile) error {
+	if c.FailRemoveFile {
+		return mockFail()
+	}
+
+	c.RemoveFileOptions = opts
+
+	return nil
+}
+
+// RenameFile stores the given rename file options. If FailRenameFile is set,
+// it returns an error.
+func (c *RemoteClient) RenameFile(ctx context.Context, opts roptions.RenameFile) error {
+	if c.FailRenameFile {
+		return mockFail()
+	}
+
+	c.RenameFileOptions = opts
+
+	return nil
+}
+
+// MakeDirectory stores the given make directory options. If
+// FailMakeDirectory is set, it returns an error.
+func (c *RemoteClient) MakeDirectory(ctx context.Context, opts roptions.MakeDirectory) error {
+	if c.FailMakeDirectory {
+		return mockFail()
+	}
+
+	c.MakeDirectoryOptions = opts
+
+	return nil
+}
+
+// ChecksumFile stores the given file checksum options and returns Checksum.
+// If FailChecksumFile is set, it returns an error.
+func (c *RemoteClient) ChecksumFile(ctx context.Context, opts roptions.FileChecksum) (string, error) {
+	if c.FailChecksumFile {
+		return "", mockFail()
+	}
+
+	c.ChecksumOptions = opts
+
+	return c.Checksum, nil
+}
+
+// CreateArchive stores the given create archive options and returns a reader
+// for ArchiveContent. If FailCreateArchive is set, it returns an error.
+func (c *RemoteClient) CreateArchive(ctx context.Context, opts roptions.CreateArchive) (io.ReadCloser, error) {
+	if c.FailCreateArchive {
+		return nil, mockFail()
+	}
+
+	c.ArchiveOptions = opts
+
+	return io.NopCloser(bytes.NewReader(c.ArchiveContent)), nil
+}
+
 // SendMessages stores the given logging payload. If FailSendMessages is set, it
 // returns an error.
 func (c *RemoteClient) SendMessages(ctx context.Context, opts options.LoggingPayload) error {
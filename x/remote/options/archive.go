@@ -1,11 +1,21 @@
 package options
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"compress/bzip2"
+	"compress/gzip"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path"
 	"path/filepath"
+	"strings"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/tychoish/fun/erc"
+	"github.com/ulikunitz/xz"
 )
 
 // ArchiveFormat represents an archive file type.
@@ -19,23 +29,86 @@ const (
 	ArchiveTarGz ArchiveFormat = "targz"
 	// ArchiveZip is an ArchiveFormat for Zip archives.
 	ArchiveZip ArchiveFormat = "zip"
+	// ArchiveTar is an ArchiveFormat for uncompressed tar archives.
+	ArchiveTar ArchiveFormat = "tar"
+	// ArchiveTarXz is an ArchiveFormat for xz-compressed tar archives.
+	ArchiveTarXz ArchiveFormat = "tarxz"
+	// ArchiveTarZst is an ArchiveFormat for zstd-compressed tar archives.
+	ArchiveTarZst ArchiveFormat = "tarzst"
+	// ArchiveTarBz2 is an ArchiveFormat for bzip2-compressed tar archives,
+	// which can be extracted but not created.
+	ArchiveTarBz2 ArchiveFormat = "tarbz2"
 )
 
 // Validate checks that the ArchiveFormat is a recognized format.
 func (f ArchiveFormat) Validate() error {
 	switch f {
-	case ArchiveTarGz, ArchiveZip, ArchiveAuto:
+	case ArchiveTarGz, ArchiveZip, ArchiveAuto, ArchiveTar, ArchiveTarXz, ArchiveTarZst, ArchiveTarBz2:
 		return nil
 	default:
 		return fmt.Errorf("unknown archive format %s", f)
 	}
 }
 
+// DetectArchiveFormat returns the archive format of the file based on its
+// extension.
+func DetectArchiveFormat(fileName string) (ArchiveFormat, error) {
+	name := strings.ToLower(fileName)
+	for _, format := range []struct {
+		format     ArchiveFormat
+		extensions []string
+	}{
+		{format: ArchiveTarGz, extensions: []string{".tar.gz", ".tgz"}},
+		{format: ArchiveTarXz, extensions: []string{".tar.xz", ".txz"}},
+		{format: ArchiveTarZst, extensions: []string{".tar.zst", ".tzst"}},
+		{format: ArchiveTarBz2, extensions: []string{".tar.bz2", ".tbz2"}},
+		{format: ArchiveTar, extensions: []string{".tar"}},
+		{format: ArchiveZip, extensions: []string{".zip"}},
+	} {
+		for _, ext := range format.extensions {
+			if strings.HasSuffix(name, ext) {
+				return format.format, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("could not detect archive format for %s", fileName)
+}
+
+// decompress returns a reader for the tar stream in the archive.
+func (f ArchiveFormat) decompress(r io.Reader) (io.ReadCloser, error) {
+	switch f {
+	case ArchiveTar:
+		return io.NopCloser(r), nil
+	case ArchiveTarGz:
+		return gzip.NewReader(r)
+	case ArchiveTarBz2:
+		return io.NopCloser(bzip2.NewReader(r)), nil
+	case ArchiveTarXz:
+		xr, err := xz.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return io.NopCloser(xr), nil
+	case ArchiveTarZst:
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return zr.IOReadCloser(), nil
+	default:
+		return nil, fmt.Errorf("%s is not a tar archive format", f)
+	}
+}
+
 // Archive encapsulates options related to management of archive files.
 type Archive struct {
 	ShouldExtract bool          `bson:"should_extract" json:"should_extract" yaml:"should_extract"`
 	Format        ArchiveFormat `bson:"format" json:"format" yaml:"format"`
 	TargetPath    string        `bson:"target_path" json:"target_path" yaml:"target_path"`
+	// StripComponents removes the given number of leading path components
+	// from each archive entry. Entries with fewer components are skipped.
+	StripComponents int `bson:"strip_components,omitempty" json:"strip_components,omitempty" yaml:"strip_components,omitempty"`
 }
 
 // Validate checks the archive file options.
@@ -51,6 +124,257 @@ func (opts Archive) Validate() error {
 	}
 
 	catcher.Push(opts.Format.Validate())
+	catcher.If(opts.StripComponents < 0, errors.New("strip components cannot be negative"))
 
 	return catcher.Resolve()
 }
+
+// Extract extracts the archive at the given path into the target path.
+// Entries that would be written outside of the target path, either directly
+// or through a symbolic link, cause extraction to fail.
+func (opts Archive) Extract(archivePath string) error {
+	format := opts.Format
+	if format == ArchiveAuto || format == "" {
+		var err error
+		if format, err = DetectArchiveFormat(archivePath); err != nil {
+			return err
+		}
+	}
+
+	if err := makeEnclosingDirectories(opts.TargetPath); err != nil {
+		return fmt.Errorf("problem making target directory: %w", err)
+	}
+
+	x := &archiveExtractor{root: filepath.Clean(opts.TargetPath), strip: opts.StripComponents}
+
+	if format == ArchiveZip {
+		return x.extractZip(archivePath)
+	}
+
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	r, err := format.decompress(file)
+	if err != nil {
+		return fmt.Errorf("problem reading %s archive: %w", format, err)
+	}
+	defer r.Close()
+
+	return x.extractTar(r)
+}
+
+type archiveExtractor struct {
+	root  string
+	strip int
+}
+
+func (x *archiveExtractor) extractTar(r io.Reader) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("problem reading archive: %w", err)
+		}
+
+		dest, err := x.resolve(hdr.Name)
+		if err != nil {
+			return err
+		}
+		if dest == "" {
+			continue
+		}
+
+		mode := hdr.FileInfo().Mode()
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			err = x.makeDirectory(dest, mode)
+		case tar.TypeReg:
+			err = x.writeFile(dest, tr, mode)
+		case tar.TypeSymlink:
+			err = x.makeSymlink(dest, hdr.Linkname)
+		case tar.TypeLink:
+			err = x.makeHardLink(dest, hdr.Linkname)
+		default:
+			// Devices, FIFOs and other special files are not extracted.
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("problem extracting %q: %w", hdr.Name, err)
+		}
+	}
+}
+
+func (x *archiveExtractor) extractZip(archivePath string) error {
+	zr, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return fmt.Errorf("problem reading zip archive: %w", err)
+	}
+	defer zr.Close()
+
+	for _, file := range zr.File {
+		dest, err := x.resolve(file.Name)
+		if err != nil {
+			return err
+		}
+		if dest == "" {
+			continue
+		}
+
+		if err = x.extractZipFile(dest, file); err != nil {
+			return fmt.Errorf("problem extracting %q: %w", file.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (x *archiveExtractor) extractZipFile(dest string, file *zip.File) error {
+	mode := file.Mode()
+	if mode.IsDir() {
+		return x.makeDirectory(dest, mode)
+	}
+
+	r, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	switch {
+	case mode&os.ModeSymlink != 0:
+		target, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		return x.makeSymlink(dest, string(target))
+	case mode.IsRegular():
+		return x.writeFile(dest, r, mode)
+	default:
+		return nil
+	}
+}
+
+// resolve returns the path to which the archive entry is extracted, or an
+// empty path if the entry is skipped because all of its components are
+// stripped.
+func (x *archiveExtractor) resolve(name string) (string, error) {
+	clean := path.Clean(strings.ReplaceAll(name, `\`, "/"))
+	if path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", fmt.Errorf("archive entry %q is outside of the target directory", name)
+	}
+
+	var parts []string
+	if clean != "." {
+		parts = strings.Split(clean, "/")
+	}
+	if len(parts) <= x.strip {
+		return "", nil
+	}
+
+	dest := filepath.Join(x.root, filepath.FromSlash(path.Join(parts[x.strip:]...)))
+	if err := x.checkParents(dest); err != nil {
+		return "", fmt.Errorf("archive entry %q: %w", name, err)
+	}
+
+	return dest, nil
+}
+
+func (x *archiveExtractor) contains(p string) bool {
+	rel, err := filepath.Rel(x.root, p)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// checkParents ensures that none of the directories between the root and the
+// destination are symbolic links, so that extracted files cannot be written
+// outside of the root through links extracted earlier.
+func (x *archiveExtractor) checkParents(dest string) error {
+	rel, err := filepath.Rel(x.root, filepath.Dir(dest))
+	if err != nil {
+		return err
+	}
+	if rel == "." {
+		return nil
+	}
+
+	current := x.root
+	for _, part := range strings.Split(rel, string(filepath.Separator)) {
+		current = filepath.Join(current, part)
+		info, err := os.Lstat(current)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return fmt.Errorf("cannot extract through symbolic link %q", current)
+		}
+	}
+
+	return nil
+}
+
+func (x *archiveExtractor) makeDirectory(dest string, mode os.FileMode) error {
+	return os.MkdirAll(dest, mode.Perm()|0o700)
+}
+
+// prepare creates the parent directories for the destination and removes any
+// existing file there, so that it is replaced rather than written through.
+func (x *archiveExtractor) prepare(dest string) error {
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return err
+	}
+	if info, err := os.Lstat(dest); err == nil && !info.IsDir() {
+		return os.Remove(dest)
+	}
+	return nil
+}
+
+func (x *archiveExtractor) writeFile(dest string, r io.Reader, mode os.FileMode) error {
+	if err := x.prepare(dest); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_EXCL, mode.Perm())
+	if err != nil {
+		return err
+	}
+
+	catcher := &erc.Collector{}
+	_, err = io.Copy(file, r)
+	catcher.Push(err)
+	catcher.Push(file.Close())
+	return catcher.Resolve()
+}
+
+func (x *archiveExtractor) makeSymlink(dest, target string) error {
+	if filepath.IsAbs(target) || !x.contains(filepath.Join(filepath.Dir(dest), target)) {
+		return fmt.Errorf("symbolic link target %q is outside of the target directory", target)
+	}
+
+	if err := x.prepare(dest); err != nil {
+		return err
+	}
+	return os.Symlink(target, dest)
+}
+
+func (x *archiveExtractor) makeHardLink(dest, target string) error {
+	src, err := x.resolve(target)
+	if err != nil {
+		return err
+	}
+	if src == "" {
+		return fmt.Errorf("hard link target %q is stripped from the archive", target)
+	}
+
+	if err := x.prepare(dest); err != nil {
+		return err
+	}
+	return os.Link(src, dest)
+}
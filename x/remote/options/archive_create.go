@@ -0,0 +1,232 @@
+package options
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/tychoish/fun/erc"
+	"github.com/ulikunitz/xz"
+)
+
+// CreateArchive represents the options to create an archive of the contents
+// of a directory.
+type CreateArchive struct {
+	Path string `json:"path" bson:"path"`
+	// Format is the format of the archive, which defaults to a gzipped tar
+	// archive. Archives cannot be created in the bzip2 format.
+	Format ArchiveFormat `json:"format" bson:"format"`
+	// Include, if set, limits the archive to files matching at least one of
+	// the patterns.
+	Include []string `json:"include,omitempty" bson:"include,omitempty"`
+	// Exclude omits files and directories matching any of the patterns.
+	Exclude []string `json:"exclude,omitempty" bson:"exclude,omitempty"`
+}
+
+// Validate checks the create archive options and sets the default format if
+// necessary. Patterns use the syntax of filepath.Match and are matched
+// against both the path of each file relative to the directory and its base
+// name.
+func (opts *CreateArchive) Validate() error {
+	if opts.Format == "" {
+		opts.Format = ArchiveTarGz
+	}
+
+	catcher := &erc.Collector{}
+	catcher.Push(validateFilePath(opts.Path))
+	switch opts.Format {
+	case ArchiveTar, ArchiveTarGz, ArchiveTarXz, ArchiveTarZst, ArchiveZip:
+	default:
+		catcher.Push(fmt.Errorf("cannot create archives in format '%s'", opts.Format))
+	}
+	for _, pattern := range append(append([]string{}, opts.Include...), opts.Exclude...) {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			catcher.Push(fmt.Errorf("invalid pattern '%s': %w", pattern, err))
+		}
+	}
+	return catcher.Resolve()
+}
+
+func matchesAny(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, rel); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(pattern, filepath.Base(rel)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// archiveEntry is a file to add to an archive.
+type archiveEntry struct {
+	name string
+	path string
+	info fs.FileInfo
+	link string
+}
+
+// Write writes the archive to the writer.
+func (opts CreateArchive) Write(w io.Writer) error {
+	info, err := os.Stat(opts.Path)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("'%s' is not a directory", opts.Path)
+	}
+
+	if opts.Format == ArchiveZip {
+		return opts.writeZip(w)
+	}
+	return opts.writeTar(w)
+}
+
+// walk calls the function for each file in the directory that matches the
+// include and exclude patterns. Symbolic links are added as links rather
+// than followed.
+func (opts CreateArchive) walk(fn func(archiveEntry) error) error {
+	return filepath.WalkDir(opts.Path, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == opts.Path {
+			return nil
+		}
+
+		rel, err := filepath.Rel(opts.Path, path)
+		if err != nil {
+			return err
+		}
+		if matchesAny(opts.Exclude, rel) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		ae := archiveEntry{name: filepath.ToSlash(rel), path: path, info: info}
+		switch {
+		case entry.IsDir():
+			// Directories are created implicitly when only some files are
+			// included.
+			if len(opts.Include) != 0 {
+				return nil
+			}
+			ae.name += "/"
+		case len(opts.Include) != 0 && !matchesAny(opts.Include, rel):
+			return nil
+		case info.Mode()&os.ModeSymlink != 0:
+			if ae.link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		case !info.Mode().IsRegular():
+			return nil
+		}
+
+		return fn(ae)
+	})
+}
+
+func (opts CreateArchive) compress(w io.Writer) (io.WriteCloser, error) {
+	switch opts.Format {
+	case ArchiveTar:
+		return nopWriteCloser{Writer: w}, nil
+	case ArchiveTarGz:
+		return gzip.NewWriter(w), nil
+	case ArchiveTarXz:
+		return xz.NewWriter(w)
+	case ArchiveTarZst:
+		return zstd.NewWriter(w)
+	default:
+		return nil, fmt.Errorf("cannot create archives in format '%s'", opts.Format)
+	}
+}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
+
+func (opts CreateArchive) writeTar(w io.Writer) error {
+	cw, err := opts.compress(w)
+	if err != nil {
+		return err
+	}
+	tw := tar.NewWriter(cw)
+
+	catcher := &erc.Collector{}
+	catcher.Push(opts.walk(func(entry archiveEntry) error {
+		hdr, err := tar.FileInfoHeader(entry.info, entry.link)
+		if err != nil {
+			return fmt.Errorf("problem making header for '%s': %w", entry.path, err)
+		}
+		hdr.Name = entry.name
+		if err = tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if !entry.info.Mode().IsRegular() {
+			return nil
+		}
+		return copyFileTo(tw, entry.path)
+	}))
+	catcher.Push(tw.Close())
+	catcher.Push(cw.Close())
+	return catcher.Resolve()
+}
+
+func (opts CreateArchive) writeZip(w io.Writer) error {
+	zw := zip.NewWriter(w)
+
+	catcher := &erc.Collector{}
+	catcher.Push(opts.walk(func(entry archiveEntry) error {
+		hdr, err := zip.FileInfoHeader(entry.info)
+		if err != nil {
+			return fmt.Errorf("problem making header for '%s': %w", entry.path, err)
+		}
+		hdr.Name = entry.name
+		if !entry.info.IsDir() {
+			hdr.Method = zip.Deflate
+		}
+
+		fw, err := zw.CreateHeader(hdr)
+		if err != nil {
+			return err
+		}
+		switch {
+		case entry.link != "":
+			_, err = io.WriteString(fw, entry.link)
+			return err
+		case entry.info.Mode().IsRegular():
+			return copyFileTo(fw, entry.path)
+		default:
+			return nil
+		}
+	}))
+	catcher.Push(zw.Close())
+	return catcher.Resolve()
+}
+
+func copyFileTo(w io.Writer, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if _, err = io.Copy(w, file); err != nil {
+		return fmt.Errorf("problem archiving '%s': %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+package options
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/tychoish/fun/erc"
+)
+
+// Operation identifies a class of remote manager operations for the purposes
+// of authorization.
+type Operation string
+
+const (
+	// OperationRead covers read-only operations, such as listing processes,
+	// getting process information, tags, output and metrics, and checking
+	// the state of scripting harnesses.
+	OperationRead Operation = "read"
+	// OperationCreate covers operations that start processes, including
+	// respawning processes and creating or running scripting harnesses.
+	OperationCreate Operation = "create"
+	// OperationSignal covers operations that modify existing processes,
+	// including signaling them, registering triggers and tagging them.
+	OperationSignal Operation = "signal"
+	// OperationFileRead covers operations that read files or file metadata
+	// on the remote host.
+	OperationFileRead Operation = "file_read"
+	// OperationFileWrite covers operations that write, remove, rename or
+	// create files and directories on the remote host, including downloads.
+	OperationFileWrite Operation = "file_write"
+	// OperationLogging covers operations on the logging cache and sending
+	// messages to cached loggers.
+	OperationLogging Operation = "logging"
+	// OperationAdmin covers operations that affect the whole manager, such
+	// as clearing or closing it. Operations that have no explicit mapping
+	// require this permission.
+	OperationAdmin Operation = "admin"
+	// OperationAll grants permission for every operation.
+	OperationAll Operation = "*"
+)
+
+// Validate checks that the operation is a recognized operation.
+func (op Operation) Validate() error {
+	switch op {
+	case OperationRead, OperationCreate, OperationSignal, OperationFileRead, OperationFileWrite, OperationLogging, OperationAdmin, OperationAll:
+		return nil
+	default:
+		return fmt.Errorf("unrecognized operation '%s'", op)
+	}
+}
+
+// Names of the built-in roles, which are available to all policies unless
+// the policy defines a role with the same name.
+const (
+	RoleReadOnly = "read_only"
+	RoleOperator = "operator"
+	RoleAdmin    = "admin"
+)
+
+var builtinRoles = map[string][]Operation{
+	RoleReadOnly: {OperationRead},
+	RoleOperator: {OperationRead, OperationCreate, OperationSignal, OperationLogging},
+	RoleAdmin:    {OperationAll},
+}
+
+// AuthPolicy maps authenticated identities to the operations they are
+// allowed to perform.
+type AuthPolicy struct {
+	// Roles maps role names to the operations the role grants.
+	Roles map[string][]Operation `bson:"roles" json:"roles" yaml:"roles"`
+	// Identities maps identity names to the roles granted to that identity.
+	Identities map[string][]string `bson:"identities" json:"identities" yaml:"identities"`
+	// DefaultRoles are granted to authenticated identities that have no
+	// entry in Identities.
+	DefaultRoles []string `bson:"default_roles" json:"default_roles" yaml:"default_roles"`
+}
+
+// Validate checks that all operations are valid and that all roles granted
+// to identities are defined.
+func (p *AuthPolicy) Validate() error {
+	catcher := &erc.Collector{}
+	for name, ops := range p.Roles {
+		catcher.If(name == "", errors.New("role name cannot be empty"))
+		for _, op := range ops {
+			catcher.Wrapf(op.Validate(), "role '%s'", name)
+		}
+	}
+
+	for id, roles := range p.Identities {
+		catcher.If(id == "", errors.New("identity name cannot be empty"))
+		for _, role := range roles {
+			if _, ok := p.role(role); !ok {
+				catcher.Push(fmt.Errorf("identity '%s' has undefined role '%s'", id, role))
+			}
+		}
+	}
+
+	for _, role := range p.DefaultRoles {
+		if _, ok := p.role(role); !ok {
+			catcher.Push(fmt.Errorf("undefined default role '%s'", role))
+		}
+	}
+
+	return catcher.Resolve()
+}
+
+func (p *AuthPolicy) role(name string) ([]Operation, bool) {
+	if ops, ok := p.Roles[name]; ok {
+		return ops, true
+	}
+	ops, ok := builtinRoles[name]
+	return ops, ok
+}
+
+// Allowed returns true if the identity is granted a role that permits the
+// operation.
+func (p *AuthPolicy) Allowed(identity string, op Operation) bool {
+	roles, ok := p.Identities[identity]
+	if !ok {
+		roles = p.DefaultRoles
+	}
+
+	for _, role := range roles {
+		ops, _ := p.role(role)
+		for _, allowed := range ops {
+			if allowed == op || allowed == OperationAll {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// DefaultAuthClockSkew is the maximum difference between the timestamp of a
+// signed request and the time the service receives it, if not otherwise
+// specified.
+const DefaultAuthClockSkew = 5 * time.Minute
+
+// AuthConfig describes the authentication methods accepted by a remote
+// service and the policy used to authorize authenticated requests.
+type AuthConfig struct {
+	// Tokens maps identity names to static bearer tokens.
+	Tokens map[string]string `bson:"tokens" json:"tokens" yaml:"tokens"`
+	// HMACKeys maps key IDs to shared secrets used to sign requests. The key
+	// ID is used as the identity name.
+	HMACKeys map[string]string `bson:"hmac_keys" json:"hmac_keys" yaml:"hmac_keys"`
+	// ClientCertificates enables authentication using the common name of
+	// verified TLS client certificates as the identity name.
+	ClientCertificates bool `bson:"client_certificates" json:"client_certificates" yaml:"client_certificates"`
+	// PeerCredentials enables authentication of requests to services
+	// listening on a unix domain socket using the operating system user of
+	// the connected process as the identity name.
+	PeerCredentials bool `bson:"peer_credentials" json:"peer_credentials" yaml:"peer_credentials"`
+	// MaxClockSkew is the maximum age of a signed request. If zero,
+	// DefaultAuthClockSkew is used.
+	MaxClockSkew time.Duration `bson:"max_clock_skew" json:"max_clock_skew" yaml:"max_clock_skew"`
+	// Policy determines which operations authenticated identities can
+	// perform.
+	Policy AuthPolicy `bson:"policy" json:"policy" yaml:"policy"`
+}
+
+// NewAuthConfigFromFile parses the JSON-encoded auth configuration in the
+// file at path.
+func NewAuthConfigFromFile(path string) (*AuthConfig, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading auth config file: %w", err)
+	}
+
+	conf := AuthConfig{}
+	if err := json.Unmarshal(contents, &conf); err != nil {
+		return nil, fmt.Errorf("error unmarshalling contents of auth config file: %w", err)
+	}
+
+	if err := conf.Validate(); err != nil {
+		return nil, fmt.Errorf("read invalid auth config from file: %w", err)
+	}
+
+	return &conf, nil
+}
+
+// Validate checks that at least one authentication method is configured and
+// that the policy is valid.
+func (conf *AuthConfig) Validate() error {
+	catcher := &erc.Collector{}
+	catcher.If(len(conf.Tokens) == 0 && len(conf.HMACKeys) == 0 && !conf.ClientCertificates && !conf.PeerCredentials,
+		errors.New("must specify at least one authentication method"))
+	catcher.If(conf.MaxClockSkew < 0, errors.New("max clock skew cannot be negative"))
+	for id, token := range conf.Tokens {
+		catcher.If(token == "", fmt.Errorf("token for identity '%s' cannot be empty", id))
+	}
+	for id, secret := range conf.HMACKeys {
+		catcher.If(secret == "", fmt.Errorf("secret for key '%s' cannot be empty", id))
+	}
+	catcher.Push(conf.Policy.Validate())
+
+	return catcher.Resolve()
+}
+
+// AuthCredentials are the credentials a remote client presents to a service.
+// Either the token or the key ID and secret must be set.
+type AuthCredentials struct {
+	// Token is a static bearer token.
+	Token string `bson:"token" json:"token" yaml:"token"`
+	// KeyID and Secret are used to sign requests.
+	KeyID  string `bson:"key_id" json:"key_id" yaml:"key_id"`
+	Secret string `bson:"secret" json:"secret" yaml:"secret"`
+}
+
+// NewAuthCredentialsFromFile parses the JSON-encoded client credentials in
+// the file at path.
+func NewAuthCredentialsFromFile(path string) (*AuthCredentials, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading auth credentials file: %w", err)
+	}
+
+	creds := AuthCredentials{}
+	if err := json.Unmarshal(contents, &creds); err != nil {
+		return nil, fmt.Errorf("error unmarshalling contents of auth credentials file: %w", err)
+	}
+
+	if err := creds.Validate(); err != nil {
+		return nil, fmt.Errorf("read invalid auth credentials from file: %w", err)
+	}
+
+	return &creds, nil
+}
+
+// Validate checks that exactly one kind of credential is set.
+func (c *AuthCredentials) Validate() error {
+	catcher := &erc.Collector{}
+	hasKey := c.KeyID != "" || c.Secret != ""
+	catcher.If(c.Token == "" && !hasKey, errors.New("must specify either a token or a signing key"))
+	catcher.If(c.Token != "" && hasKey, errors.New("cannot specify both a token and a signing key"))
+	catcher.If(hasKey && (c.KeyID == "" || c.Secret == ""), errors.New("signing key must have both a key ID and a secret"))
+	return catcher.Resolve()
+}
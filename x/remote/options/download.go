@@ -2,24 +2,110 @@ package options
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/mholt/archiver"
 	"github.com/tychoish/fun/erc"
 )
 
 // Download represents the options to download a file to a given path and
 // optionally extract its contents.
 type Download struct {
-	URL         string       `json:"url" bson:"url"`
-	Path        string       `json:"path" bson:"path"`
-	ArchiveOpts Archive      `json:"archive_opts" bson:"archive_opts"`
-	HTTPClient  *http.Client `json:"-" bson:"-"`
+	URL         string  `json:"url" bson:"url"`
+	Path        string  `json:"path" bson:"path"`
+	ArchiveOpts Archive `json:"archive_opts" bson:"archive_opts"`
+	// Checksum, if set, is verified before the file is moved to the path.
+	Checksum DownloadChecksum `json:"checksum,omitempty" bson:"checksum,omitempty"`
+	// Headers are added to every request.
+	Headers map[string]string `json:"headers,omitempty" bson:"headers,omitempty"`
+	// Username and Password, if set, are sent using basic authentication.
+	Username string `json:"username,omitempty" bson:"username,omitempty"`
+	Password string `json:"password,omitempty" bson:"password,omitempty"`
+	// Resume continues a partial download left by a previous attempt, using
+	// HTTP range requests, rather than starting over. If the download
+	// fails, the partial download is kept so that it can be resumed later.
+	Resume bool          `json:"resume,omitempty" bson:"resume,omitempty"`
+	Retry  DownloadRetry `json:"retry,omitempty" bson:"retry,omitempty"`
+	// UseCache satisfies the download from the global download cache, if
+	// one is set. Only downloads with a checksum are cached.
+	UseCache bool `json:"use_cache,omitempty" bson:"use_cache,omitempty"`
+
+	HTTPClient *http.Client `json:"-" bson:"-"`
+}
+
+// DownloadChecksum is the expected digest of a downloaded file.
+type DownloadChecksum struct {
+	Algorithm ChecksumAlgorithm `json:"algorithm" bson:"algorithm"`
+	// Digest is the hex-encoded digest.
+	Digest string `json:"digest" bson:"digest"`
+}
+
+// IsZero returns whether the checksum is unset.
+func (c DownloadChecksum) IsZero() bool {
+	return c.Algorithm == "" && c.Digest == ""
+}
+
+// Validate checks that the algorithm is supported and the digest is set.
+func (c DownloadChecksum) Validate() error {
+	if c.IsZero() {
+		return nil
+	}
+
+	catcher := &erc.Collector{}
+	catcher.Push(c.Algorithm.Validate())
+	if _, err := hex.DecodeString(c.Digest); err != nil || c.Digest == "" {
+		catcher.Push(fmt.Errorf("checksum digest '%s' is not a hex-encoded digest", c.Digest))
+	}
+	return catcher.Resolve()
+}
+
+// DownloadRetry represents the options to retry failed download attempts.
+// Attempts that fail because of a client error, such as a missing file, are
+// not retried.
+type DownloadRetry struct {
+	// MaxAttempts is the maximum number of attempts. If zero, the download
+	// is attempted once.
+	MaxAttempts int `json:"max_attempts,omitempty" bson:"max_attempts,omitempty"`
+	// Backoff is the delay before the first retry, which doubles after each
+	// subsequent attempt. Defaults to one second.
+	Backoff time.Duration `json:"backoff,omitempty" bson:"backoff,omitempty"`
+	// MaxBackoff is the longest delay between attempts. Defaults to 30
+	// seconds.
+	MaxBackoff time.Duration `json:"max_backoff,omitempty" bson:"max_backoff,omitempty"`
+}
+
+// Validate checks the retry options.
+func (r DownloadRetry) Validate() error {
+	catcher := &erc.Collector{}
+	catcher.If(r.MaxAttempts < 0, errors.New("max attempts cannot be negative"))
+	catcher.If(r.Backoff < 0, errors.New("backoff cannot be negative"))
+	catcher.If(r.MaxBackoff < 0, errors.New("max backoff cannot be negative"))
+	return catcher.Resolve()
+}
+
+func (r DownloadRetry) delay(attempt int) time.Duration {
+	backoff := r.Backoff
+	if backoff == 0 {
+		backoff = time.Second
+	}
+	maxBackoff := r.MaxBackoff
+	if maxBackoff == 0 {
+		maxBackoff = 30 * time.Second
+	}
+
+	for i := 1; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxBackoff)
 }
 
 // Validate checks the download options.
@@ -35,34 +121,67 @@ func (opts Download) Validate() error {
 	}
 
 	catcher.Push(opts.ArchiveOpts.Validate())
+	catcher.Push(opts.Checksum.Validate())
+	catcher.Push(opts.Retry.Validate())
 
 	return catcher.Resolve()
 }
 
-// Download executes the download operation.
+// partialPath returns the path to which the file is written until the
+// download is complete.
+func (opts Download) partialPath() string {
+	return opts.Path + ".partial"
+}
+
+// Download executes the download operation. The file is written to a
+// temporary file next to the path, which is moved to the path once the
+// download is complete and its checksum has been verified.
 func (opts Download) Download(ctx context.Context) error {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, opts.URL, nil)
-	if err != nil {
-		return fmt.Errorf("problem building request: %w", err)
+	if opts.UseCache {
+		if cache := GetGlobalDownloadCache(); cache != nil {
+			return cache.Download(ctx, opts)
+		}
 	}
 
 	if opts.HTTPClient == nil {
 		opts.HTTPClient = http.DefaultClient
 	}
 
-	resp, err := opts.HTTPClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("problem downloading file for url %q: %w", opts.URL, err)
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("%s: could not download %s to path %s", resp.Status, opts.URL, opts.Path)
+	if err := makeEnclosingDirectories(filepath.Dir(opts.Path)); err != nil {
+		return fmt.Errorf("problem making enclosing directories: %w", err)
 	}
 
-	if err = writeFile(resp.Body, opts.Path); err != nil {
+	maxAttempts := max(opts.Retry.MaxAttempts, 1)
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if attempt > 1 {
+			timer := time.NewTimer(opts.Retry.delay(attempt - 1))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return fmt.Errorf("download canceled after %d attempts: %w", attempt-1, err)
+			case <-timer.C:
+			}
+		}
+
+		if err = opts.attempt(ctx); err == nil {
+			break
+		}
+		if !isRetryableDownloadError(err) {
+			break
+		}
+	}
+	if err != nil {
+		if !opts.Resume {
+			_ = os.Remove(opts.partialPath())
+		}
 		return err
 	}
 
+	if err = os.Rename(opts.partialPath(), opts.Path); err != nil {
+		return fmt.Errorf("problem moving downloaded file into place: %w", err)
+	}
+
 	if opts.ArchiveOpts.ShouldExtract {
 		if err = opts.Extract(); err != nil {
 			return fmt.Errorf("problem extracting file %q to path %q: %w", opts.Path, opts.ArchiveOpts.TargetPath, err)
@@ -72,66 +191,178 @@ func (opts Download) Download(ctx context.Context) error {
 	return nil
 }
 
-// Extract extracts the download to the path specified, using the archive format
-// specified.
-func (opts Download) Extract() error {
-	var archiveHandler archiver.Unarchiver
-	switch opts.ArchiveOpts.Format {
-	case ArchiveAuto:
-		unzipper, _ := archiver.ByExtension(opts.Path)
-		if unzipper == nil {
-			return fmt.Errorf("could not detect archive format for %s", opts.Path)
-		}
-		var ok bool
-		archiveHandler, ok = unzipper.(archiver.Unarchiver)
-		if !ok {
-			return fmt.Errorf("%s was not a supported archive format [%T]", opts.Path, unzipper)
-		}
-	case ArchiveTarGz:
-		archiveHandler = archiver.NewTarGz()
-	case ArchiveZip:
-		archiveHandler = archiver.NewZip()
-	default:
-		return fmt.Errorf("unrecognized archive format %s", opts.ArchiveOpts.Format)
+// downloadStatusError is returned when the server responds to a download
+// request with an unexpected status.
+type downloadStatusError struct {
+	status string
+	code   int
+	url    string
+}
+
+func (e *downloadStatusError) Error() string {
+	return fmt.Sprintf("%s: could not download %s", e.status, e.url)
+}
+
+// errChecksumMismatch is returned when the downloaded file does not match
+// the expected checksum.
+var errChecksumMismatch = errors.New("checksum mismatch")
+
+func isRetryableDownloadError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
 	}
 
-	if err := archiveHandler.Unarchive(opts.Path, opts.ArchiveOpts.TargetPath); err != nil {
-		return fmt.Errorf("problem extracting archive %q to %q: %w", opts.Path, opts.ArchiveOpts.TargetPath, err)
+	var statusErr *downloadStatusError
+	if errors.As(err, &statusErr) {
+		switch statusErr.code {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests:
+			return true
+		default:
+			return statusErr.code >= http.StatusInternalServerError
+		}
 	}
 
-	return nil
+	var pathErr *fs.PathError
+	return !errors.As(err, &pathErr)
 }
 
-func makeEnclosingDirectories(path string) error {
-	info, err := os.Stat(path)
-	if os.IsNotExist(err) {
-		if err = os.MkdirAll(path, os.ModeDir|os.ModePerm); err != nil {
-			return err
+// attempt makes a single attempt to download the file to the partial path
+// and verify its checksum.
+func (opts Download) attempt(ctx context.Context) error {
+	var offset int64
+	if opts.Resume {
+		if info, err := os.Stat(opts.partialPath()); err == nil {
+			offset = info.Size()
 		}
-	} else if !info.IsDir() {
-		return fmt.Errorf("'%s' already exists and is not a directory", path)
 	}
-	return nil
+
+	return opts.download(ctx, offset)
 }
 
-func writeFile(reader io.Reader, path string) error {
-	if err := makeEnclosingDirectories(filepath.Dir(path)); err != nil {
-		return fmt.Errorf("problem making enclosing directories: %w", err)
+// download requests the content of the file starting at the offset and writes
+// it to the partial path. If the server cannot resume the download at the
+// offset, the download starts over from the beginning.
+func (opts Download) download(ctx context.Context, offset int64) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, opts.URL, nil)
+	if err != nil {
+		return fmt.Errorf("problem building request: %w", err)
+	}
+	for key, value := range opts.Headers {
+		req.Header.Set(key, value)
+	}
+	if opts.Username != "" || opts.Password != "" {
+		req.SetBasicAuth(opts.Username, opts.Password)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := opts.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("problem downloading file for url %q: %w", opts.URL, err)
+	}
+	defer resp.Body.Close()
+
+	flags := os.O_WRONLY | os.O_CREATE
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		flags |= os.O_TRUNC
+	case resp.StatusCode == http.StatusPartialContent && offset > 0:
+		if start, ok := parseContentRangeStart(resp.Header.Get("Content-Range")); !ok || start != offset {
+			// The server did not resume at the end of the partial
+			// download, so appending its content would corrupt the file.
+			return opts.download(ctx, 0)
+		}
+		flags |= os.O_APPEND
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0:
+		// The partial download may already have all of the content, but
+		// without a checksum there is no way to tell it apart from a stale
+		// or unrelated partial download.
+		if opts.Checksum.IsZero() {
+			_ = os.Remove(opts.partialPath())
+			return opts.download(ctx, 0)
+		}
+		return opts.verify()
+	default:
+		return &downloadStatusError{status: resp.Status, code: resp.StatusCode, url: opts.URL}
 	}
 
-	file, err := os.Create(path)
+	file, err := os.OpenFile(opts.partialPath(), flags, 0o666)
 	if err != nil {
 		return fmt.Errorf("problem creating file: %w", err)
 	}
 
 	catcher := &erc.Collector{}
-	if _, err := io.Copy(file, reader); err != nil {
+	if _, err := io.Copy(file, resp.Body); err != nil {
 		catcher.Push(fmt.Errorf("problem writing file: %w", err))
 	}
-
 	if err := file.Close(); err != nil {
-		catcher.Push(fmt.Errorf("problem closing %q: %w", path, err))
+		catcher.Push(fmt.Errorf("problem closing %q: %w", opts.partialPath(), err))
+	}
+	if err := catcher.Resolve(); err != nil {
+		return err
 	}
 
-	return catcher.Resolve()
+	return opts.verify()
+}
+
+// parseContentRangeStart returns the first byte position of a Content-Range
+// header of the form "bytes start-end/size".
+func parseContentRangeStart(header string) (int64, bool) {
+	rng, ok := strings.CutPrefix(header, "bytes ")
+	if !ok {
+		return 0, false
+	}
+	start, _, ok := strings.Cut(rng, "-")
+	if !ok {
+		return 0, false
+	}
+	offset, err := strconv.ParseInt(strings.TrimSpace(start), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return offset, true
+}
+
+// verify checks the partial download against the expected checksum. If it
+// does not match, the partial download is removed so that the next attempt
+// starts over.
+func (opts Download) verify() error {
+	if opts.Checksum.IsZero() {
+		return nil
+	}
+
+	digest, err := FileChecksum{Path: opts.partialPath(), Algorithm: opts.Checksum.Algorithm}.Compute()
+	if err != nil {
+		return fmt.Errorf("problem computing checksum: %w", err)
+	}
+
+	if !strings.EqualFold(digest, opts.Checksum.Digest) {
+		_ = os.Remove(opts.partialPath())
+		return fmt.Errorf("%w: expected %s digest %s for %s, got %s", errChecksumMismatch, opts.Checksum.Algorithm, opts.Checksum.Digest, opts.URL, digest)
+	}
+
+	return nil
+}
+
+// Extract extracts the download to the path specified, using the archive format
+// specified.
+func (opts Download) Extract() error {
+	if err := opts.ArchiveOpts.Extract(opts.Path); err != nil {
+		return fmt.Errorf("problem extracting archive %q to %q: %w", opts.Path, opts.ArchiveOpts.TargetPath, err)
+	}
+
+	return nil
+}
+
+func makeEnclosingDirectories(path string) error {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		if err = os.MkdirAll(path, os.ModeDir|os.ModePerm); err != nil {
+			return err
+		}
+	} else if !info.IsDir() {
+		return fmt.Errorf("'%s' already exists and is not a directory", path)
+	}
+	return nil
 }
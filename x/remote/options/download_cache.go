@@ -0,0 +1,388 @@
+package options
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/tychoish/fun/erc"
+	"github.com/tychoish/grip"
+	"github.com/tychoish/grip/message"
+)
+
+// DownloadCacheOptions configures a download cache.
+type DownloadCacheOptions struct {
+	// Directory is where the cached files are stored. Files already in the
+	// directory are added to the cache when it is created.
+	Directory string `json:"directory" bson:"directory"`
+	// MaxSize is the maximum total size in bytes of the cached files. When
+	// it is exceeded, the least recently used files are evicted. If zero,
+	// the cache size is unbounded.
+	MaxSize int64 `json:"max_size" bson:"max_size"`
+}
+
+// Validate checks the download cache options.
+func (opts DownloadCacheOptions) Validate() error {
+	catcher := &erc.Collector{}
+	catcher.If(!filepath.IsAbs(opts.Directory), errors.New("download cache directory must be an absolute path"))
+	catcher.If(opts.MaxSize < 0, errors.New("download cache max size cannot be negative"))
+	return catcher.Resolve()
+}
+
+// DownloadCacheStats describes the state of a download cache.
+type DownloadCacheStats struct {
+	Enabled   bool   `json:"enabled" bson:"enabled"`
+	Directory string `json:"directory" bson:"directory"`
+	Entries   int    `json:"entries" bson:"entries"`
+	Size      int64  `json:"size" bson:"size"`
+	MaxSize   int64  `json:"max_size" bson:"max_size"`
+	Hits      int64  `json:"hits" bson:"hits"`
+	Misses    int64  `json:"misses" bson:"misses"`
+	Evictions int64  `json:"evictions" bson:"evictions"`
+}
+
+// DownloadCache is a local cache of downloaded files. Files are keyed by
+// their expected checksum, so downloads of different URLs with the same
+// checksum share a file. Concurrent downloads of the same file are only
+// fetched once.
+//
+// Downloads without a checksum are not cached: the cache cannot tell when the
+// content behind a URL changes, and the response may depend on who requested
+// it. Since a cached file is identified by its content, it never needs to be
+// revalidated. Files found in the directory when the cache is created are
+// verified against the checksum in their name, and files that do not match
+// are removed. Cached files are copied to the download path, so changes to a
+// downloaded file do not affect the cache.
+type DownloadCache struct {
+	opts DownloadCacheOptions
+
+	mu       sync.Mutex
+	entries  map[string]*list.Element
+	order    *list.List
+	inflight map[string]*downloadCacheFetch
+	size     int64
+
+	hits      int64
+	misses    int64
+	evictions int64
+}
+
+type downloadCacheEntry struct {
+	key  string
+	size int64
+	refs int
+}
+
+type downloadCacheFetch struct {
+	done chan struct{}
+	err  error
+}
+
+// NewDownloadCache creates a download cache in the directory, which is
+// created if it does not exist.
+func NewDownloadCache(opts DownloadCacheOptions) (*DownloadCache, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid download cache options: %w", err)
+	}
+	if err := os.MkdirAll(opts.Directory, 0o755); err != nil {
+		return nil, fmt.Errorf("problem creating download cache directory: %w", err)
+	}
+
+	c := &DownloadCache{
+		opts:     opts,
+		entries:  map[string]*list.Element{},
+		order:    list.New(),
+		inflight: map[string]*downloadCacheFetch{},
+	}
+
+	dirEntries, err := os.ReadDir(opts.Directory)
+	if err != nil {
+		return nil, fmt.Errorf("problem reading download cache directory: %w", err)
+	}
+
+	infos := make([]os.FileInfo, 0, len(dirEntries))
+	for _, entry := range dirEntries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		if strings.HasSuffix(entry.Name(), ".partial") {
+			// Incomplete downloads cannot be resumed without the
+			// original request, so they are discarded.
+			grip.Warning(os.Remove(filepath.Join(opts.Directory, entry.Name())))
+			continue
+		}
+		if err := verifyDownloadCacheFile(opts.Directory, entry.Name()); err != nil {
+			grip.Warning(message.WrapError(err, message.Fields{
+				"message": "removing invalid file from download cache",
+				"key":     entry.Name(),
+			}))
+			grip.Warning(os.Remove(filepath.Join(opts.Directory, entry.Name())))
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("problem getting file information for '%s': %w", entry.Name(), err)
+		}
+		infos = append(infos, info)
+	}
+
+	// Add the files from least to most recently modified, so that the
+	// oldest files are evicted first.
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ModTime().Before(infos[j].ModTime()) })
+	for _, info := range infos {
+		c.entries[info.Name()] = c.order.PushFront(&downloadCacheEntry{key: info.Name(), size: info.Size()})
+		c.size += info.Size()
+	}
+	c.evict()
+
+	return c, nil
+}
+
+var (
+	globalDownloadCacheMu sync.RWMutex
+	globalDownloadCache   *DownloadCache
+)
+
+// SetGlobalDownloadCache sets the cache used by downloads that set UseCache.
+// If the cache is nil, those downloads are not cached.
+func SetGlobalDownloadCache(c *DownloadCache) {
+	globalDownloadCacheMu.Lock()
+	defer globalDownloadCacheMu.Unlock()
+	globalDownloadCache = c
+}
+
+// GetGlobalDownloadCache returns the cache used by downloads that set
+// UseCache, which may be nil.
+func GetGlobalDownloadCache() *DownloadCache {
+	globalDownloadCacheMu.RLock()
+	defer globalDownloadCacheMu.RUnlock()
+	return globalDownloadCache
+}
+
+// Stats returns the current state of the cache. A nil cache reports that
+// caching is disabled.
+func (c *DownloadCache) Stats() DownloadCacheStats {
+	if c == nil {
+		return DownloadCacheStats{}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return DownloadCacheStats{
+		Enabled:   true,
+		Directory: c.opts.Directory,
+		Entries:   len(c.entries),
+		Size:      c.size,
+		MaxSize:   c.opts.MaxSize,
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+	}
+}
+
+func downloadCacheKey(checksum DownloadChecksum) string {
+	return fmt.Sprintf("%s-%s", checksum.Algorithm, strings.ToLower(checksum.Digest))
+}
+
+// verifyDownloadCacheFile checks that the file in the cache directory has
+// the checksum given by its name.
+func verifyDownloadCacheFile(dir, name string) error {
+	algorithm, digest, ok := strings.Cut(name, "-")
+	if !ok {
+		return fmt.Errorf("file name '%s' is not a cache key", name)
+	}
+	checksum := DownloadChecksum{Algorithm: ChecksumAlgorithm(algorithm), Digest: digest}
+	if err := checksum.Validate(); err != nil {
+		return fmt.Errorf("file name '%s' is not a cache key: %w", name, err)
+	}
+
+	actual, err := FileChecksum{Path: filepath.Join(dir, name), Algorithm: checksum.Algorithm}.Compute()
+	if err != nil {
+		return fmt.Errorf("problem computing checksum: %w", err)
+	}
+	if !strings.EqualFold(actual, checksum.Digest) {
+		return fmt.Errorf("%w: expected %s digest %s, got %s", errChecksumMismatch, checksum.Algorithm, checksum.Digest, actual)
+	}
+	return nil
+}
+
+// Download satisfies the download from the cache, fetching the file first if
+// it is not cached, and then extracts it if the options require it. Downloads
+// without a checksum bypass the cache.
+func (c *DownloadCache) Download(ctx context.Context, opts Download) error {
+	if opts.Checksum.IsZero() {
+		opts.UseCache = false
+		return opts.Download(ctx)
+	}
+
+	key := downloadCacheKey(opts.Checksum)
+	if err := c.acquire(ctx, key, opts); err != nil {
+		return err
+	}
+	defer c.release(key)
+
+	if err := makeEnclosingDirectories(filepath.Dir(opts.Path)); err != nil {
+		return fmt.Errorf("problem making enclosing directories: %w", err)
+	}
+	if err := replaceWithCopy(filepath.Join(c.opts.Directory, key), opts.Path); err != nil {
+		return fmt.Errorf("problem copying cached file to %q: %w", opts.Path, err)
+	}
+
+	if opts.ArchiveOpts.ShouldExtract {
+		if err := opts.Extract(); err != nil {
+			return fmt.Errorf("problem extracting file %q to path %q: %w", opts.Path, opts.ArchiveOpts.TargetPath, err)
+		}
+	}
+
+	return nil
+}
+
+// acquire ensures that the file is in the cache and prevents it from being
+// evicted until it is released. Only one caller fetches a missing file, and
+// the others wait for it.
+func (c *DownloadCache) acquire(ctx context.Context, key string, opts Download) error {
+	for {
+		c.mu.Lock()
+		if elem, ok := c.entries[key]; ok {
+			elem.Value.(*downloadCacheEntry).refs++
+			c.order.MoveToFront(elem)
+			c.hits++
+			c.mu.Unlock()
+			return nil
+		}
+
+		if fetch, ok := c.inflight[key]; ok {
+			c.mu.Unlock()
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-fetch.done:
+			}
+			if fetch.err != nil {
+				// If the fetch was only canceled by its caller, try
+				// fetching the file again.
+				if ctx.Err() == nil && (errors.Is(fetch.err, context.Canceled) || errors.Is(fetch.err, context.DeadlineExceeded)) {
+					continue
+				}
+				return fetch.err
+			}
+			continue
+		}
+
+		fetch := &downloadCacheFetch{done: make(chan struct{})}
+		c.inflight[key] = fetch
+		c.misses++
+		c.mu.Unlock()
+
+		fetch.err = c.fetch(ctx, key, opts)
+
+		c.mu.Lock()
+		delete(c.inflight, key)
+		c.mu.Unlock()
+		close(fetch.done)
+
+		return fetch.err
+	}
+}
+
+// fetch downloads the file into the cache and adds it as an acquired entry.
+func (c *DownloadCache) fetch(ctx context.Context, key string, opts Download) error {
+	dl := opts
+	dl.Path = filepath.Join(c.opts.Directory, key)
+	dl.ArchiveOpts = Archive{}
+	dl.UseCache = false
+	if err := dl.Download(ctx); err != nil {
+		return err
+	}
+
+	info, err := os.Stat(dl.Path)
+	if err != nil {
+		return fmt.Errorf("problem getting file information for cached file: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = c.order.PushFront(&downloadCacheEntry{key: key, size: info.Size(), refs: 1})
+	c.size += info.Size()
+	c.evict()
+
+	return nil
+}
+
+func (c *DownloadCache) release(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*downloadCacheEntry).refs--
+	}
+	c.evict()
+}
+
+// evict removes the least recently used files that are not in use until the
+// cache is within its maximum size. The caller must hold the lock.
+func (c *DownloadCache) evict() {
+	if c.opts.MaxSize == 0 {
+		return
+	}
+
+	for elem := c.order.Back(); elem != nil && c.size > c.opts.MaxSize; {
+		entry := elem.Value.(*downloadCacheEntry)
+		prev := elem.Prev()
+		if entry.refs == 0 {
+			if err := os.Remove(filepath.Join(c.opts.Directory, entry.key)); err != nil && !os.IsNotExist(err) {
+				grip.Warning(message.WrapError(err, message.Fields{
+					"message": "problem removing evicted file from download cache",
+					"key":     entry.key,
+				}))
+			}
+			c.order.Remove(elem)
+			delete(c.entries, entry.key)
+			c.size -= entry.size
+			c.evictions++
+		}
+		elem = prev
+	}
+}
+
+// replaceWithCopy replaces the file at dst with a copy of src.
+func replaceWithCopy(src, dst string) error {
+	tmp := dst + ".cache"
+	if err := os.Remove(tmp); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if err := copyFile(src, tmp); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+
+	return os.Rename(tmp, dst)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o666)
+	if err != nil {
+		return err
+	}
+
+	catcher := &erc.Collector{}
+	_, err = io.Copy(out, in)
+	catcher.Push(err)
+	catcher.Push(out.Close())
+	return catcher.Resolve()
+}
@@ -0,0 +1,67 @@
+package options
+
+import (
+	"errors"
+	"time"
+
+	"github.com/tychoish/fun/erc"
+)
+
+const (
+	// DefaultDrainTimeout is how long running processes have to finish
+	// when a service is drained if no timeout is specified.
+	DefaultDrainTimeout = 5 * time.Minute
+	// DefaultDrainGracePeriod is how long terminated processes have to exit
+	// before they are killed if no grace period is specified.
+	DefaultDrainGracePeriod = 10 * time.Second
+)
+
+// Drain configures how a service is drained before it shuts down. A draining
+// service rejects requests to create new processes and waits for its running
+// processes to finish.
+type Drain struct {
+	// Timeout is the maximum time to wait for running processes to finish.
+	// If zero, DefaultDrainTimeout is used.
+	Timeout time.Duration `json:"timeout" bson:"timeout"`
+	// Terminate signals the processes that are still running once the
+	// timeout expires to terminate, and kills those that have not exited
+	// after the grace period. Otherwise, they are left running.
+	Terminate bool `json:"terminate" bson:"terminate"`
+	// GracePeriod is how long terminated processes have to exit before they
+	// are killed. If zero, DefaultDrainGracePeriod is used.
+	GracePeriod time.Duration `json:"grace_period" bson:"grace_period"`
+}
+
+// Validate checks the drain options and sets the defaults.
+func (opts *Drain) Validate() error {
+	catcher := &erc.Collector{}
+	catcher.If(opts.Timeout < 0, errors.New("drain timeout cannot be negative"))
+	catcher.If(opts.GracePeriod < 0, errors.New("drain grace period cannot be negative"))
+	if !catcher.Ok() {
+		return catcher.Resolve()
+	}
+
+	if opts.Timeout == 0 {
+		opts.Timeout = DefaultDrainTimeout
+	}
+	if opts.GracePeriod == 0 {
+		opts.GracePeriod = DefaultDrainGracePeriod
+	}
+	return nil
+}
+
+// DrainReport describes the outcome of draining a service. Each field lists
+// the IDs of the processes it describes.
+type DrainReport struct {
+	// Completed are the processes that finished on their own.
+	Completed []string `json:"completed,omitempty" bson:"completed,omitempty"`
+	// Terminated are the processes that exited after they were signaled to
+	// terminate.
+	Terminated []string `json:"terminated,omitempty" bson:"terminated,omitempty"`
+	// Killed are the processes that were killed because they did not exit
+	// within the grace period.
+	Killed []string `json:"killed,omitempty" bson:"killed,omitempty"`
+	// Running are the processes that were still running when the drain
+	// ended.
+	Running []string `json:"running,omitempty" bson:"running,omitempty"`
+}
@@ -0,0 +1,77 @@
+package options
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tychoish/fun/erc"
+	"github.com/tychoish/jasper/options"
+)
+
+// Exec describes an interactive session with a process on a remote service.
+// The standard input and output of the process are attached to the session
+// instead of being configured by the creation options.
+type Exec struct {
+	Create *options.Create `bson:"create" json:"create" yaml:"create"`
+	// TTY runs the process in a pseudo-terminal. The process's standard
+	// output and standard error are both sent as standard output.
+	TTY bool `bson:"tty,omitempty" json:"tty,omitempty" yaml:"tty,omitempty"`
+	// WindowSize is the initial size of the pseudo-terminal. It is ignored
+	// if it is zero.
+	WindowSize WindowSize `bson:"window_size,omitempty" json:"window_size,omitempty" yaml:"window_size,omitempty"`
+}
+
+// Validate checks that the session options are valid.
+func (opts *Exec) Validate() error {
+	if opts.Create == nil {
+		return errors.New("must specify process creation options")
+	}
+
+	catcher := &erc.Collector{}
+	if err := opts.Create.Validate(); err != nil {
+		catcher.Push(fmt.Errorf("invalid creation options: %w", err))
+	}
+	catcher.If(opts.Create.StandardInput != nil || len(opts.Create.StandardInputBytes) != 0,
+		errors.New("cannot specify standard input for an interactive session"))
+	catcher.If(!opts.TTY && !opts.WindowSize.IsZero(), errors.New("cannot specify a window size without a TTY"))
+
+	return catcher.Resolve()
+}
+
+// WindowSize is the size of a pseudo-terminal in characters.
+type WindowSize struct {
+	Rows uint16 `bson:"rows" json:"rows" yaml:"rows"`
+	Cols uint16 `bson:"cols" json:"cols" yaml:"cols"`
+}
+
+// IsZero returns whether the window size is unset.
+func (s WindowSize) IsZero() bool { return s.Rows == 0 && s.Cols == 0 }
+
+// ExecInput is a message sent by a client in an interactive session. The
+// first message of a session must set Options. Each later message sets
+// exactly one of Stdin, CloseStdin or Resize.
+type ExecInput struct {
+	Options *Exec `bson:"options,omitempty" json:"options,omitempty" yaml:"options,omitempty"`
+	// Stdin is written to the standard input of the process.
+	Stdin []byte `bson:"stdin,omitempty" json:"stdin,omitempty" yaml:"stdin,omitempty"`
+	// CloseStdin closes the standard input of the process.
+	CloseStdin bool `bson:"close_stdin,omitempty" json:"close_stdin,omitempty" yaml:"close_stdin,omitempty"`
+	// Resize changes the size of the process's pseudo-terminal.
+	Resize *WindowSize `bson:"resize,omitempty" json:"resize,omitempty" yaml:"resize,omitempty"`
+}
+
+// ExecOutput is a message sent by a service in an interactive session. The
+// first message of a session sets the ID of the process that was started,
+// and the last message sets Exited.
+type ExecOutput struct {
+	ID     string `bson:"id,omitempty" json:"id,omitempty" yaml:"id,omitempty"`
+	Stdout []byte `bson:"stdout,omitempty" json:"stdout,omitempty" yaml:"stdout,omitempty"`
+	Stderr []byte `bson:"stderr,omitempty" json:"stderr,omitempty" yaml:"stderr,omitempty"`
+	// Exited is set once the process has exited and all of its output has
+	// been sent.
+	Exited   bool `bson:"exited,omitempty" json:"exited,omitempty" yaml:"exited,omitempty"`
+	ExitCode int  `bson:"exit_code,omitempty" json:"exit_code,omitempty" yaml:"exit_code,omitempty"`
+	// Error describes why the session failed or the error returned by
+	// waiting for the process, if any.
+	Error string `bson:"error,omitempty" json:"error,omitempty" yaml:"error,omitempty"`
+}
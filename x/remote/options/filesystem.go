@@ -0,0 +1,344 @@
+package options
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/tychoish/fun/erc"
+)
+
+// FileInfo describes a file on the remote host.
+type FileInfo struct {
+	Path    string      `json:"path" bson:"path"`
+	Name    string      `json:"name" bson:"name"`
+	Size    int64       `json:"size" bson:"size"`
+	Mode    os.FileMode `json:"mode" bson:"mode"`
+	ModTime time.Time   `json:"mod_time" bson:"mod_time"`
+	IsDir   bool        `json:"is_dir" bson:"is_dir"`
+}
+
+// NewFileInfo returns the file information for the file at the given path.
+func NewFileInfo(path string, info fs.FileInfo) FileInfo {
+	return FileInfo{
+		Path:    path,
+		Name:    info.Name(),
+		Size:    info.Size(),
+		Mode:    info.Mode(),
+		ModTime: info.ModTime(),
+		IsDir:   info.IsDir(),
+	}
+}
+
+// StatFile returns information about the file at the given path. Symbolic
+// links are not followed.
+func StatFile(path string) (FileInfo, error) {
+	if err := validateFilePath(path); err != nil {
+		return FileInfo{}, err
+	}
+
+	info, err := os.Lstat(path)
+	if err != nil {
+		return FileInfo{}, err
+	}
+
+	return NewFileInfo(path, info), nil
+}
+
+// GlobFiles returns the paths of all files matching the pattern, using the
+// syntax of filepath.Match. The pattern must be an absolute path.
+func GlobFiles(pattern string) ([]string, error) {
+	if err := validateFilePath(pattern); err != nil {
+		return nil, err
+	}
+
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern '%s': %w", pattern, err)
+	}
+	if matches == nil {
+		matches = []string{}
+	}
+
+	return matches, nil
+}
+
+func validateFilePath(path string) error {
+	if path == "" {
+		return errors.New("path cannot be empty")
+	}
+	if !filepath.IsAbs(path) {
+		return fmt.Errorf("path '%s' must be an absolute path", path)
+	}
+	return nil
+}
+
+// ReadFile represents the options to read the contents of a file.
+type ReadFile struct {
+	Path string `json:"path" bson:"path"`
+	// Offset is the number of bytes to skip at the beginning of the file.
+	Offset int64 `json:"offset" bson:"offset"`
+	// Length is the maximum number of bytes to read. If zero, the file is
+	// read to the end.
+	Length int64 `json:"length" bson:"length"`
+}
+
+// Validate checks the read file options.
+func (opts ReadFile) Validate() error {
+	catcher := &erc.Collector{}
+	catcher.Push(validateFilePath(opts.Path))
+	catcher.If(opts.Offset < 0, errors.New("offset cannot be negative"))
+	catcher.If(opts.Length < 0, errors.New("length cannot be negative"))
+	return catcher.Resolve()
+}
+
+// Open opens the file for reading from the offset, limiting the contents to
+// the length in the options. The caller must close the returned reader.
+func (opts ReadFile) Open() (io.ReadCloser, error) {
+	file, err := os.Open(opts.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if info, err := file.Stat(); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("problem getting file information: %w", err)
+	} else if info.IsDir() {
+		file.Close()
+		return nil, fmt.Errorf("'%s' is a directory", opts.Path)
+	}
+
+	if opts.Offset > 0 {
+		if _, err = file.Seek(opts.Offset, io.SeekStart); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("problem seeking to offset %d: %w", opts.Offset, err)
+		}
+	}
+
+	if opts.Length == 0 {
+		return file, nil
+	}
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{Reader: io.LimitReader(file, opts.Length), Closer: file}, nil
+}
+
+// ListDirectory represents the options to list the contents of a directory.
+type ListDirectory struct {
+	Path string `json:"path" bson:"path"`
+	// Recursive lists the contents of all subdirectories as well.
+	Recursive bool `json:"recursive" bson:"recursive"`
+}
+
+// Validate checks the list directory options.
+func (opts ListDirectory) Validate() error {
+	return validateFilePath(opts.Path)
+}
+
+// List returns information about each file in the directory, in lexical
+// order. The directory itself is not included.
+func (opts ListDirectory) List() ([]FileInfo, error) {
+	if !opts.Recursive {
+		entries, err := os.ReadDir(opts.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		out := make([]FileInfo, 0, len(entries))
+		for _, entry := range entries {
+			info, err := entry.Info()
+			if err != nil {
+				return nil, fmt.Errorf("problem getting file information for '%s': %w", entry.Name(), err)
+			}
+			out = append(out, NewFileInfo(filepath.Join(opts.Path, entry.Name()), info))
+		}
+		return out, nil
+	}
+
+	out := []FileInfo{}
+	err := filepath.WalkDir(opts.Path, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == opts.Path {
+			if !entry.IsDir() {
+				return fmt.Errorf("'%s' is not a directory", path)
+			}
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("problem getting file information for '%s': %w", path, err)
+		}
+		out = append(out, NewFileInfo(path, info))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// RemoveFile represents the options to remove a file or directory.
+type RemoveFile struct {
+	Path string `json:"path" bson:"path"`
+	// Recursive removes directories along with all of their contents.
+	// Otherwise, only files and empty directories can be removed.
+	Recursive bool `json:"recursive" bson:"recursive"`
+}
+
+// Validate checks the remove file options.
+func (opts RemoveFile) Validate() error {
+	catcher := &erc.Collector{}
+	catcher.Push(validateFilePath(opts.Path))
+	catcher.If(filepath.Clean(opts.Path) == string(filepath.Separator), errors.New("cannot remove the root directory"))
+	return catcher.Resolve()
+}
+
+// Remove removes the file.
+func (opts RemoveFile) Remove() error {
+	if opts.Recursive {
+		if _, err := os.Lstat(opts.Path); err != nil {
+			return err
+		}
+		return os.RemoveAll(opts.Path)
+	}
+	return os.Remove(opts.Path)
+}
+
+// RenameFile represents the options to rename or move a file.
+type RenameFile struct {
+	OldPath string `json:"old_path" bson:"old_path"`
+	NewPath string `json:"new_path" bson:"new_path"`
+}
+
+// Validate checks the rename file options.
+func (opts RenameFile) Validate() error {
+	catcher := &erc.Collector{}
+	if err := validateFilePath(opts.OldPath); err != nil {
+		catcher.Push(fmt.Errorf("invalid old path: %w", err))
+	}
+	if err := validateFilePath(opts.NewPath); err != nil {
+		catcher.Push(fmt.Errorf("invalid new path: %w", err))
+	}
+	return catcher.Resolve()
+}
+
+// Rename renames the file, replacing any existing file at the new path.
+func (opts RenameFile) Rename() error {
+	return os.Rename(opts.OldPath, opts.NewPath)
+}
+
+// MakeDirectory represents the options to create a directory.
+type MakeDirectory struct {
+	Path string      `json:"path" bson:"path"`
+	Perm os.FileMode `json:"perm" bson:"perm"`
+	// Parents creates any missing parent directories and succeeds if the
+	// directory already exists.
+	Parents bool `json:"parents" bson:"parents"`
+}
+
+// Validate checks the make directory options and sets the default
+// permissions if necessary.
+func (opts *MakeDirectory) Validate() error {
+	if opts.Perm == 0 {
+		opts.Perm = 0o777
+	}
+
+	catcher := &erc.Collector{}
+	catcher.Push(validateFilePath(opts.Path))
+	catcher.If(opts.Perm&^os.ModePerm != 0, fmt.Errorf("invalid permissions %s", opts.Perm))
+	return catcher.Resolve()
+}
+
+// Make creates the directory.
+func (opts MakeDirectory) Make() error {
+	if opts.Parents {
+		return os.MkdirAll(opts.Path, opts.Perm)
+	}
+	return os.Mkdir(opts.Path, opts.Perm)
+}
+
+// ChecksumAlgorithm is the hash function used to compute a file checksum.
+type ChecksumAlgorithm string
+
+// Supported checksum algorithms.
+const (
+	ChecksumMD5    ChecksumAlgorithm = "md5"
+	ChecksumSHA1   ChecksumAlgorithm = "sha1"
+	ChecksumSHA256 ChecksumAlgorithm = "sha256"
+	ChecksumSHA512 ChecksumAlgorithm = "sha512"
+)
+
+// Validate checks that the checksum algorithm is supported.
+func (a ChecksumAlgorithm) Validate() error {
+	switch a {
+	case ChecksumMD5, ChecksumSHA1, ChecksumSHA256, ChecksumSHA512:
+		return nil
+	default:
+		return fmt.Errorf("unsupported checksum algorithm '%s'", a)
+	}
+}
+
+func (a ChecksumAlgorithm) hash() hash.Hash {
+	switch a {
+	case ChecksumMD5:
+		return md5.New()
+	case ChecksumSHA1:
+		return sha1.New()
+	case ChecksumSHA512:
+		return sha512.New()
+	default:
+		return sha256.New()
+	}
+}
+
+// FileChecksum represents the options to compute the checksum of a file.
+type FileChecksum struct {
+	Path string `json:"path" bson:"path"`
+	// Algorithm is the hash function to use, which defaults to SHA-256.
+	Algorithm ChecksumAlgorithm `json:"algorithm" bson:"algorithm"`
+}
+
+// Validate checks the file checksum options and sets the default algorithm
+// if necessary.
+func (opts *FileChecksum) Validate() error {
+	if opts.Algorithm == "" {
+		opts.Algorithm = ChecksumSHA256
+	}
+
+	catcher := &erc.Collector{}
+	catcher.Push(validateFilePath(opts.Path))
+	catcher.Push(opts.Algorithm.Validate())
+	return catcher.Resolve()
+}
+
+// Compute returns the hex-encoded checksum of the contents of the file.
+func (opts FileChecksum) Compute() (string, error) {
+	file, err := os.Open(opts.Path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	h := opts.Algorithm.hash()
+	if _, err = io.Copy(h, file); err != nil {
+		return "", fmt.Errorf("problem reading file: %w", err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,16 @@
+package options
+
+// HealthReport describes the health and readiness of a service.
+type HealthReport struct {
+	// Healthy is true if the service and the dependencies it relies on are
+	// working.
+	Healthy bool `json:"healthy" bson:"healthy"`
+	// Ready is true if the service is healthy and able to create new
+	// processes. A service that is closed, at capacity or draining is not
+	// ready.
+	Ready bool `json:"ready" bson:"ready"`
+	// Failures maps the name of each failed check to the reason it failed.
+	// Services that only report their status, such as those using the gRPC
+	// health checking protocol, do not report failures.
+	Failures map[string]string `json:"failures,omitempty" bson:"failures,omitempty"`
+}
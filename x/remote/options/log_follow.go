@@ -0,0 +1,30 @@
+package options
+
+import (
+	"errors"
+
+	"github.com/tychoish/fun/erc"
+	"github.com/tychoish/jasper"
+)
+
+// LogFollow represents the options to follow the output of a process as it
+// is produced.
+type LogFollow struct {
+	// Offset is the offset of the first line to receive. To resume following
+	// after reconnecting, set it to one more than the offset of the last
+	// line received.
+	Offset int64 `json:"offset" bson:"offset"`
+	// Streams restricts the output to the given streams. If empty, lines
+	// from both standard output and standard error are received.
+	Streams []jasper.OutputStream `json:"streams,omitempty" bson:"streams,omitempty"`
+}
+
+// Validate checks the log follow options.
+func (opts LogFollow) Validate() error {
+	catcher := &erc.Collector{}
+	catcher.If(opts.Offset < 0, errors.New("offset cannot be negative"))
+	for _, stream := range opts.Streams {
+		catcher.Push(stream.Validate())
+	}
+	return catcher.Resolve()
+}
@@ -0,0 +1,121 @@
+package options
+
+import (
+	"errors"
+	"time"
+
+	"github.com/tychoish/fun/erc"
+)
+
+// Resilience configures how a client handles an unreliable connection to a
+// service. The zero value keeps the default behavior, in which requests are
+// not retried.
+type Resilience struct {
+	// Retry is the policy for retrying requests that do not modify the state
+	// of the service.
+	Retry RetryPolicy `json:"retry" bson:"retry"`
+	// CircuitBreaker stops requests to a service that is repeatedly
+	// unreachable.
+	CircuitBreaker CircuitBreaker `json:"circuit_breaker" bson:"circuit_breaker"`
+	// Keepalive configures how idle connections to the service are checked.
+	Keepalive Keepalive `json:"keepalive" bson:"keepalive"`
+	// MaxReconnectDelay is the maximum delay between attempts to reconnect
+	// to an RPC service after the connection is lost. If zero, the gRPC
+	// default is used.
+	MaxReconnectDelay time.Duration `json:"max_reconnect_delay" bson:"max_reconnect_delay"`
+	// ResumeWait re-issues requests to wait for a process after transient
+	// failures, such as the service restarting, until the request's context
+	// is done, regardless of the retry policy's maximum attempts.
+	ResumeWait bool `json:"resume_wait" bson:"resume_wait"`
+}
+
+// Validate checks the resilience options.
+func (opts Resilience) Validate() error {
+	catcher := &erc.Collector{}
+	catcher.Push(opts.Retry.Validate())
+	catcher.Push(opts.CircuitBreaker.Validate())
+	catcher.Push(opts.Keepalive.Validate())
+	catcher.If(opts.MaxReconnectDelay < 0, errors.New("max reconnect delay cannot be negative"))
+	return catcher.Resolve()
+}
+
+// RetryPolicy configures retries of requests that fail because the service
+// could not be reached.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts for a request, including
+	// the first. If it is zero or one, requests are not retried.
+	MaxAttempts int `json:"max_attempts" bson:"max_attempts"`
+	// Backoff is the delay before the first retry, which doubles with each
+	// subsequent retry. It defaults to 100 milliseconds.
+	Backoff time.Duration `json:"backoff" bson:"backoff"`
+	// MaxBackoff is the maximum delay between retries. It defaults to 5
+	// seconds.
+	MaxBackoff time.Duration `json:"max_backoff" bson:"max_backoff"`
+}
+
+// Validate checks the retry policy.
+func (p RetryPolicy) Validate() error {
+	catcher := &erc.Collector{}
+	catcher.If(p.MaxAttempts < 0, errors.New("max attempts cannot be negative"))
+	catcher.If(p.Backoff < 0, errors.New("backoff cannot be negative"))
+	catcher.If(p.MaxBackoff < 0, errors.New("max backoff cannot be negative"))
+	return catcher.Resolve()
+}
+
+// Delay returns the delay before the given retry, starting from 1.
+func (p RetryPolicy) Delay(retry int) time.Duration {
+	backoff := p.Backoff
+	if backoff == 0 {
+		backoff = 100 * time.Millisecond
+	}
+	maxBackoff := p.MaxBackoff
+	if maxBackoff == 0 {
+		maxBackoff = 5 * time.Second
+	}
+
+	for i := 1; i < retry && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxBackoff)
+}
+
+// CircuitBreaker configures a circuit breaker, which fails requests
+// immediately once the service has been unreachable for several consecutive
+// requests. After the reset timeout, a single request is allowed through to
+// check whether the service has recovered.
+type CircuitBreaker struct {
+	// Threshold is the number of consecutive failed requests that opens the
+	// circuit. If zero, the circuit breaker is disabled.
+	Threshold int `json:"threshold" bson:"threshold"`
+	// ResetTimeout is how long the circuit stays open before a request is
+	// allowed through. It defaults to 30 seconds.
+	ResetTimeout time.Duration `json:"reset_timeout" bson:"reset_timeout"`
+}
+
+// Validate checks the circuit breaker options.
+func (cb CircuitBreaker) Validate() error {
+	catcher := &erc.Collector{}
+	catcher.If(cb.Threshold < 0, errors.New("circuit breaker threshold cannot be negative"))
+	catcher.If(cb.ResetTimeout < 0, errors.New("circuit breaker reset timeout cannot be negative"))
+	return catcher.Resolve()
+}
+
+// Keepalive configures how idle connections are checked. For RPC clients, the
+// service must permit pings at the given interval, which by default must be
+// at least 5 minutes.
+type Keepalive struct {
+	// Time is the interval between keepalive pings for RPC clients and TCP
+	// keepalive probes for REST clients. If zero, the defaults are used.
+	Time time.Duration `json:"time" bson:"time"`
+	// Timeout is how long an RPC client waits for a response to a keepalive
+	// ping before closing the connection. If zero, the default is used.
+	Timeout time.Duration `json:"timeout" bson:"timeout"`
+}
+
+// Validate checks the keepalive options.
+func (k Keepalive) Validate() error {
+	catcher := &erc.Collector{}
+	catcher.If(k.Time < 0, errors.New("keepalive time cannot be negative"))
+	catcher.If(k.Timeout < 0, errors.New("keepalive timeout cannot be negative"))
+	return catcher.Resolve()
+}
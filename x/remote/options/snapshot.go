@@ -0,0 +1,89 @@
+package options
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/tychoish/jasper"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/scripting"
+)
+
+// SnapshotVersion is the version of the snapshot format. It is incremented
+// whenever the format changes in a way that older versions of Jasper cannot
+// import.
+const SnapshotVersion = 1
+
+// Snapshot is a portable, versioned representation of the state of a manager
+// that can be used to debug a service or to migrate its process history to
+// another host.
+type Snapshot struct {
+	Version   int       `json:"version" bson:"version"`
+	ManagerID string    `json:"manager_id" bson:"manager_id"`
+	Hostname  string    `json:"hostname" bson:"hostname"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+	// Processes are all of the processes in the manager, including those
+	// that are still running.
+	Processes []ProcessSnapshot `json:"processes" bson:"processes"`
+	// SignalTriggerIDs are the signal triggers that can be registered with
+	// processes by ID.
+	SignalTriggerIDs []jasper.SignalTriggerID `json:"signal_trigger_ids" bson:"signal_trigger_ids"`
+	// LoggingCache are the loggers in the manager's logging cache.
+	LoggingCache []options.CachedLogger `json:"logging_cache" bson:"logging_cache"`
+	// ScriptingHarnessIDs are the IDs of the scripting harnesses that the
+	// service has created.
+	ScriptingHarnessIDs []string `json:"scripting_harness_ids" bson:"scripting_harness_ids"`
+}
+
+// ProcessSnapshot is the state of a single process in a snapshot.
+type ProcessSnapshot struct {
+	Info jasper.ProcessInfo `json:"info" bson:"info"`
+	Tags []string           `json:"tags,omitempty" bson:"tags,omitempty"`
+}
+
+// Validate checks that the snapshot is in a format that can be imported.
+func (s *Snapshot) Validate() error {
+	if s.Version <= 0 || s.Version > SnapshotVersion {
+		return fmt.Errorf("snapshot version %d is not supported, must be between 1 and %d", s.Version, SnapshotVersion)
+	}
+	return nil
+}
+
+// NewSnapshot captures the current state of the manager and of the scripting
+// harnesses in the cache, which may be nil.
+func NewSnapshot(ctx context.Context, mngr jasper.Manager, harnesses scripting.HarnessCache) (Snapshot, error) {
+	procs, err := mngr.List(ctx, options.All)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("problem listing processes: %w", err)
+	}
+
+	snapshot := Snapshot{
+		Version:          SnapshotVersion,
+		ManagerID:        mngr.ID(),
+		CreatedAt:        time.Now(),
+		Processes:        make([]ProcessSnapshot, 0, len(procs)),
+		SignalTriggerIDs: jasper.SignalTriggerIDs(),
+		LoggingCache:     []options.CachedLogger{},
+	}
+	snapshot.Hostname, _ = os.Hostname()
+
+	for _, proc := range procs {
+		snapshot.Processes = append(snapshot.Processes, ProcessSnapshot{
+			Info: proc.Info(ctx),
+			Tags: proc.GetTags(),
+		})
+	}
+
+	if lister, ok := mngr.LoggingCache(ctx).(jasper.LoggingCacheLister); ok {
+		snapshot.LoggingCache = lister.List()
+	}
+
+	snapshot.ScriptingHarnessIDs = []string{}
+	if harnesses != nil {
+		snapshot.ScriptingHarnessIDs = harnesses.IDs()
+	}
+
+	return snapshot, nil
+}
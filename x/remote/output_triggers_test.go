@@ -0,0 +1,41 @@
+package remote
+
+import (
+	"context"
+	"syscall"
+	"testing"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/testutil"
+	"github.com/tychoish/jasper/x/remote/internal"
+)
+
+func TestRPCOutputTriggerSignals(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.RPCTestTimeout)
+	defer cancel()
+
+	client, err := makeInsecureRPCServiceAndClient(ctx, makeTestHealthManager(t, HealthOptions{}))
+	assert.NotError(t, err)
+
+	for sig, supported := range map[syscall.Signal]bool{
+		syscall.Signal(0): true,
+		syscall.SIGTERM:   true,
+		syscall.SIGABRT:   true,
+		syscall.SIGUSR1:   true,
+		syscall.SIGUSR2:   true,
+		syscall.SIGQUIT:   false,
+	} {
+		converted, err := internal.ConvertSignal(sig)
+		check.Equal(t, err == nil, supported)
+		if supported {
+			check.Equal(t, converted.Export(), sig)
+		}
+
+		opts := testutil.TrueCreateOpts()
+		opts.OutputTriggers = []options.OutputTrigger{{Pattern: "foo", Tag: "foo", Signal: sig}}
+		_, err = client.CreateProcess(ctx, opts)
+		check.Equal(t, err == nil, supported)
+	}
+}
@@ -0,0 +1,306 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	internal "github.com/tychoish/jasper/x/remote/internal"
+	roptions "github.com/tychoish/jasper/x/remote/options"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+)
+
+// ErrCircuitOpen is returned by clients configured with a circuit breaker
+// when requests are not sent because the service has been unreachable.
+var ErrCircuitOpen = errors.New("circuit breaker is open because the service is unreachable")
+
+// circuitBreaker tracks consecutive failed requests. A nil circuit breaker
+// allows every request.
+type circuitBreaker struct {
+	opts roptions.CircuitBreaker
+
+	mu       sync.Mutex
+	failures int
+	open     bool
+	openedAt time.Time
+	trial    bool
+}
+
+func newCircuitBreaker(opts roptions.CircuitBreaker) *circuitBreaker {
+	if opts.Threshold == 0 {
+		return nil
+	}
+	if opts.ResetTimeout == 0 {
+		opts.ResetTimeout = 30 * time.Second
+	}
+	return &circuitBreaker{opts: opts}
+}
+
+// allow returns an error if the circuit is open. Once the reset timeout has
+// passed, a single trial request is allowed until its outcome is recorded.
+func (cb *circuitBreaker) allow() error {
+	if cb == nil {
+		return nil
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if !cb.open {
+		return nil
+	}
+	if cb.trial || time.Since(cb.openedAt) < cb.opts.ResetTimeout {
+		return ErrCircuitOpen
+	}
+	cb.trial = true
+	return nil
+}
+
+// record records the outcome of an allowed request.
+func (cb *circuitBreaker) record(failed bool) {
+	if cb == nil {
+		return
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.trial = false
+	if !failed {
+		cb.failures = 0
+		cb.open = false
+		return
+	}
+
+	cb.failures++
+	if cb.open || cb.failures >= cb.opts.Threshold {
+		cb.open = true
+		cb.openedAt = time.Now()
+	}
+}
+
+// retryRequest makes the request until it succeeds, fails with an error that
+// is not transient, or the attempts allowed by the retry policy are
+// exhausted. If resume is true, transient failures are retried until the
+// context is done.
+func retryRequest(ctx context.Context, opts roptions.Resilience, breaker *circuitBreaker, resume bool, request func() (transient bool, err error)) error {
+	for attempt := 1; ; attempt++ {
+		err := breaker.allow()
+		if err == nil {
+			var transient bool
+			transient, err = request()
+			breaker.record(transient)
+			if !transient {
+				return err
+			}
+		} else if !resume {
+			return err
+		}
+
+		if ctx.Err() != nil || (!resume && attempt >= opts.Retry.MaxAttempts) {
+			return err
+		}
+
+		timer := time.NewTimer(opts.Retry.Delay(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// ResilientDialOptions returns the dial options that configure an RPC client
+// to retry requests, stop sending requests to an unreachable service and
+// check idle connections according to the options. Pass them to
+// NewRPCClient.
+//
+// Only unary requests that do not modify the state of the service are
+// retried; streaming requests are subject only to the circuit breaker. When
+// retries or the circuit breaker are enabled, requests fail as soon as
+// the service is unreachable rather than waiting for the connection to be
+// reestablished, so that the retry policy determines how long to wait.
+func ResilientDialOptions(opts roptions.Resilience) ([]grpc.DialOption, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	dialOpts := []grpc.DialOption{}
+	if opts.Keepalive.Time != 0 || opts.Keepalive.Timeout != 0 {
+		dialOpts = append(dialOpts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                opts.Keepalive.Time,
+			Timeout:             opts.Keepalive.Timeout,
+			PermitWithoutStream: true,
+		}))
+	}
+	if opts.MaxReconnectDelay != 0 {
+		conf := backoff.DefaultConfig
+		conf.MaxDelay = opts.MaxReconnectDelay
+		conf.BaseDelay = min(conf.BaseDelay, conf.MaxDelay)
+		dialOpts = append(dialOpts, grpc.WithConnectParams(grpc.ConnectParams{Backoff: conf}))
+	}
+
+	if opts.Retry.MaxAttempts <= 1 && opts.CircuitBreaker.Threshold == 0 && !opts.ResumeWait {
+		return dialOpts, nil
+	}
+
+	breaker := newCircuitBreaker(opts.CircuitBreaker)
+	return append(dialOpts,
+		grpc.WithDefaultCallOptions(grpc.WaitForReady(false)),
+		grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption) error {
+			invoke := func() (bool, error) {
+				err := invoker(ctx, method, req, reply, cc, callOpts...)
+				return isTransientRPCError(ctx, err), err
+			}
+			if !isIdempotentRPC(method) {
+				if err := breaker.allow(); err != nil {
+					return err
+				}
+				transient, err := invoke()
+				breaker.record(transient)
+				return err
+			}
+			resume := opts.ResumeWait && method == internal.JasperProcessManager_Wait_FullMethodName
+			return retryRequest(ctx, opts, breaker, resume, invoke)
+		}),
+		grpc.WithChainStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, callOpts ...grpc.CallOption) (grpc.ClientStream, error) {
+			if err := breaker.allow(); err != nil {
+				return nil, err
+			}
+			stream, err := streamer(ctx, desc, cc, method, callOpts...)
+			breaker.record(isTransientRPCError(ctx, err))
+			return stream, err
+		}),
+	), nil
+}
+
+// isIdempotentRPC returns whether the RPC method does not modify the state of
+// the service, and can therefore be retried.
+func isIdempotentRPC(method string) bool {
+	if _, ok := rpcUnauthenticatedMethods[method]; ok {
+		return true
+	}
+	switch rpcMethodOperations[method] {
+	case roptions.OperationRead, roptions.OperationFileRead:
+		return true
+	default:
+		return false
+	}
+}
+
+func isTransientRPCError(ctx context.Context, err error) bool {
+	return err != nil && ctx.Err() == nil && status.Code(err) == codes.Unavailable
+}
+
+// NewResilientTransport returns an http.RoundTripper that retries requests,
+// stops sending requests to an unreachable service and checks idle
+// connections according to the options before sending requests with the base
+// round tripper, or a copy of http.DefaultTransport if base is nil. Use it as
+// the transport of the http.Client passed to MakeRestClient.
+//
+// Only requests that do not modify the state of the service are retried. A
+// request is retried if the service cannot be reached or responds that it is
+// temporarily unavailable.
+func NewResilientTransport(opts roptions.Resilience, base http.RoundTripper) (http.RoundTripper, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if transport, ok := base.(*http.Transport); ok && opts.Keepalive.Time != 0 {
+		transport = transport.Clone()
+		transport.DialContext = (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: opts.Keepalive.Time,
+		}).DialContext
+		base = transport
+	}
+
+	return &resilientTransport{
+		opts:    opts,
+		base:    base,
+		breaker: newCircuitBreaker(opts.CircuitBreaker),
+	}, nil
+}
+
+type resilientTransport struct {
+	opts    roptions.Resilience
+	base    http.RoundTripper
+	breaker *circuitBreaker
+}
+
+func (t *resilientTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if isHealthRequest(r) {
+		// Unhealthy services respond to health checks with 503, which
+		// should be reported rather than retried or count against the
+		// service.
+		return t.base.RoundTrip(r)
+	}
+
+	var resp *http.Response
+	roundTrip := func() (bool, error) {
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		var err error
+		resp, err = t.base.RoundTrip(r)
+		if r.Context().Err() != nil {
+			return false, err
+		}
+		if err != nil {
+			return true, err
+		}
+		switch resp.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true, nil
+		default:
+			return false, nil
+		}
+	}
+
+	if !isIdempotentRequest(r) {
+		if err := t.breaker.allow(); err != nil {
+			return nil, err
+		}
+		transient, err := roundTrip()
+		t.breaker.record(transient)
+		return resp, err
+	}
+
+	resume := t.opts.ResumeWait && strings.HasSuffix(r.URL.Path, "/wait")
+	if err := retryRequest(r.Context(), t.opts, t.breaker, resume, roundTrip); err != nil {
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return nil, err
+	}
+	return resp, nil
+}
+
+// isIdempotentRequest returns whether the request to the REST service does
+// not modify the state of the service, and can therefore be retried.
+func isIdempotentRequest(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		// Respawning a process is the only GET route with side effects.
+		return !strings.HasSuffix(r.URL.Path, "/respawn")
+	default:
+		return false
+	}
+}
+
+func isHealthRequest(r *http.Request) bool {
+	return strings.HasSuffix(r.URL.Path, "/healthz") || strings.HasSuffix(r.URL.Path, "/readyz")
+}
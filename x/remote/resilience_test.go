@@ -0,0 +1,255 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper"
+	"github.com/tychoish/jasper/testutil"
+	ropts "github.com/tychoish/jasper/x/remote/options"
+)
+
+func TestResilientTransport(t *testing.T) {
+	makeClient := func(t *testing.T, srv *httptest.Server, opts ropts.Resilience) Manager {
+		transport, err := NewResilientTransport(opts, nil)
+		assert.NotError(t, err)
+		addr, err := net.ResolveTCPAddr("tcp", strings.TrimPrefix(srv.URL, "http://"))
+		assert.NotError(t, err)
+		return MakeRestClient(addr, &http.Client{Transport: transport})
+	}
+	retry := ropts.RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond}
+
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T){
+		"RetriesUnavailableService": func(ctx context.Context, t *testing.T) {
+			var requests atomic.Int64
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				if requests.Add(1) < 3 {
+					rw.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_, _ = io.WriteString(rw, `"foo"`)
+			}))
+			defer srv.Close()
+
+			client := makeClient(t, srv, ropts.Resilience{Retry: retry})
+			check.Equal(t, client.ID(), "foo")
+			check.Equal(t, requests.Load(), 3)
+		},
+		"DoesNotRetryRequestsWithSideEffects": func(ctx context.Context, t *testing.T) {
+			var requests atomic.Int64
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				rw.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer srv.Close()
+
+			client := makeClient(t, srv, ropts.Resilience{Retry: retry})
+			_, err := client.CreateProcess(ctx, testutil.TrueCreateOpts())
+			check.Error(t, err)
+			check.Equal(t, requests.Load(), 1)
+		},
+		"StopsRetryingAfterMaxAttempts": func(ctx context.Context, t *testing.T) {
+			var requests atomic.Int64
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				rw.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer srv.Close()
+
+			client := makeClient(t, srv, ropts.Resilience{Retry: retry})
+			_, err := client.List(ctx, "all")
+			check.Error(t, err)
+			check.Equal(t, requests.Load(), 3)
+		},
+		"CircuitBreakerStopsRequests": func(ctx context.Context, t *testing.T) {
+			var requests atomic.Int64
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				rw.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer srv.Close()
+
+			client := makeClient(t, srv, ropts.Resilience{
+				CircuitBreaker: ropts.CircuitBreaker{Threshold: 2, ResetTimeout: time.Hour},
+			})
+			for i := 0; i < 2; i++ {
+				_, err := client.List(ctx, "all")
+				check.Error(t, err)
+			}
+			_, err := client.List(ctx, "all")
+			check.True(t, errors.Is(err, ErrCircuitOpen))
+			check.Equal(t, requests.Load(), 2)
+		},
+		"CircuitBreakerAllowsTrialAfterReset": func(ctx context.Context, t *testing.T) {
+			var healthy atomic.Bool
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				if !healthy.Load() {
+					rw.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				_, _ = io.WriteString(rw, `"foo"`)
+			}))
+			defer srv.Close()
+
+			client := makeClient(t, srv, ropts.Resilience{
+				CircuitBreaker: ropts.CircuitBreaker{Threshold: 1, ResetTimeout: 10 * time.Millisecond},
+			})
+			check.Equal(t, client.ID(), "")
+			healthy.Store(true)
+			check.Equal(t, client.ID(), "")
+
+			time.Sleep(20 * time.Millisecond)
+			check.Equal(t, client.ID(), "foo")
+			check.Equal(t, client.ID(), "foo")
+		},
+		"ResumesWait": func(ctx context.Context, t *testing.T) {
+			var requests atomic.Int64
+			srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				if requests.Add(1) < 5 {
+					rw.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				_, _ = io.WriteString(rw, "0")
+			}))
+			defer srv.Close()
+
+			client := makeClient(t, srv, ropts.Resilience{
+				Retry:      ropts.RetryPolicy{Backoff: time.Millisecond},
+				ResumeWait: true,
+			})
+			proc := &restProcess{id: "foo", client: client.(*restClient)}
+			exitCode, err := proc.Wait(ctx)
+			assert.NotError(t, err)
+			check.Equal(t, exitCode, 0)
+			check.Equal(t, requests.Load(), 5)
+		},
+		"FailsWithInvalidOptions": func(ctx context.Context, t *testing.T) {
+			_, err := NewResilientTransport(ropts.Resilience{Retry: ropts.RetryPolicy{MaxAttempts: -1}}, nil)
+			check.Error(t, err)
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+			defer cancel()
+			testCase(ctx, t)
+		})
+	}
+}
+
+func TestResilientDialOptions(t *testing.T) {
+	startService := func(ctx context.Context, t *testing.T, mngr jasper.Manager, addr net.Addr) func() error {
+		closeService, err := StartRPCService(ctx, mngr, addr, nil)
+		assert.NotError(t, err)
+		return closeService
+	}
+	dial := func(ctx context.Context, t *testing.T, addr net.Addr, opts ropts.Resilience) Manager {
+		dialOpts, err := ResilientDialOptions(opts)
+		assert.NotError(t, err)
+		client, err := NewRPCClient(ctx, addr, nil, dialOpts...)
+		assert.NotError(t, err)
+		t.Cleanup(func() { check.NotError(t, client.CloseConnection()) })
+		return client
+	}
+
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, mngr jasper.Manager, addr net.Addr){
+		"ResumesWaitAfterServiceRestarts": func(ctx context.Context, t *testing.T, mngr jasper.Manager, addr net.Addr) {
+			closeService := startService(ctx, t, mngr, addr)
+			client := dial(ctx, t, addr, ropts.Resilience{
+				Retry:      ropts.RetryPolicy{Backoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond},
+				ResumeWait: true,
+			})
+
+			proc, err := client.CreateProcess(ctx, testutil.SleepCreateOpts(1))
+			assert.NotError(t, err)
+
+			waitErr := make(chan error, 1)
+			go func() {
+				_, err := proc.Wait(ctx)
+				waitErr <- err
+			}()
+
+			time.Sleep(100 * time.Millisecond)
+			assert.NotError(t, closeService())
+			time.Sleep(100 * time.Millisecond)
+			closeService = startService(ctx, t, mngr, addr)
+			defer func() { check.NotError(t, closeService()) }()
+
+			select {
+			case err := <-waitErr:
+				check.NotError(t, err)
+			case <-ctx.Done():
+				t.Fatal("wait did not resume after service restarted")
+			}
+		},
+		"CircuitBreakerStopsRequests": func(ctx context.Context, t *testing.T, mngr jasper.Manager, addr net.Addr) {
+			closeService := startService(ctx, t, mngr, addr)
+			client := dial(ctx, t, addr, ropts.Resilience{
+				CircuitBreaker: ropts.CircuitBreaker{Threshold: 1, ResetTimeout: time.Hour},
+			})
+			assert.NotError(t, closeService())
+
+			_, err := client.Get(ctx, "foo")
+			check.Error(t, err)
+			check.True(t, !errors.Is(err, ErrCircuitOpen))
+
+			_, err = client.Get(ctx, "foo")
+			check.True(t, errors.Is(err, ErrCircuitOpen))
+		},
+		"RetriesUnavailableService": func(ctx context.Context, t *testing.T, mngr jasper.Manager, addr net.Addr) {
+			proc, err := mngr.CreateProcess(ctx, testutil.SleepCreateOpts(10))
+			assert.NotError(t, err)
+
+			closeService := startService(ctx, t, mngr, addr)
+			client := dial(ctx, t, addr, ropts.Resilience{
+				Retry:             ropts.RetryPolicy{MaxAttempts: 20, Backoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond},
+				MaxReconnectDelay: 50 * time.Millisecond,
+			})
+			assert.NotError(t, closeService())
+
+			go func() {
+				time.Sleep(100 * time.Millisecond)
+				closeService := startService(ctx, t, mngr, addr)
+				<-ctx.Done()
+				check.NotError(t, closeService())
+			}()
+
+			remoteProc, err := client.Get(ctx, proc.ID())
+			assert.NotError(t, err)
+			check.Equal(t, remoteProc.ID(), proc.ID())
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.RPCTestTimeout)
+			defer cancel()
+
+			mngr := jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true}))
+			defer func() { check.NotError(t, mngr.Close(ctx)) }()
+
+			addr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("localhost:%d", testutil.GetPortNumber()))
+			assert.NotError(t, err)
+			testCase(ctx, t, mngr, addr)
+		})
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	check.Equal(t, ropts.RetryPolicy{}.Delay(1), 100*time.Millisecond)
+	check.Equal(t, ropts.RetryPolicy{}.Delay(3), 400*time.Millisecond)
+	check.Equal(t, ropts.RetryPolicy{}.Delay(100), 5*time.Second)
+
+	policy := ropts.RetryPolicy{Backoff: time.Second, MaxBackoff: 3 * time.Second}
+	check.Equal(t, policy.Delay(1), time.Second)
+	check.Equal(t, policy.Delay(2), 2*time.Second)
+	check.Equal(t, policy.Delay(3), 3*time.Second)
+}
@@ -3,15 +3,19 @@ package remote
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"syscall"
 
+	"github.com/tychoish/fun/erc"
 	"github.com/tychoish/gimlet"
 	"github.com/tychoish/grip"
 	"github.com/tychoish/grip/message"
@@ -19,27 +23,174 @@ import (
 	"github.com/tychoish/jasper/options"
 	"github.com/tychoish/jasper/scripting"
 	roptions "github.com/tychoish/jasper/x/remote/options"
+	"golang.org/x/net/websocket"
 )
 
 // NewRestClient creates a REST client that connects to the given address
 // running the Jasper REST service. This function uses the http
-// package's default client.
+// package's default client, or a client that connects to the socket if the
+// address is a unix domain socket.
 func NewRestClient(addr net.Addr) Manager {
+	if isUnixAddr(addr) {
+		return MakeRestClient(addr, &http.Client{Transport: NewUnixSocketTransport(addr.String(), nil)})
+	}
 	return MakeRestClient(addr, http.DefaultClient)
 }
 
 // MakeRestClient constructs a REST client that connects to the given
-// address running the Jasper REST service and the specified HTTP client.
+// address running the Jasper REST service and the specified HTTP client. If
+// the address is a unix domain socket, the HTTP client's transport must
+// connect to the socket, such as a transport returned by
+// NewUnixSocketTransport.
 func MakeRestClient(addr net.Addr, client *http.Client) Manager {
-	return &restClient{
-		prefix: fmt.Sprintf("http://%s/jasper/v1", addr),
-		client: client,
+	return makeRestClient("http", addr, client)
+}
+
+// MakeSecureRestClient is the same as MakeRestClient, but connects to the
+// service over HTTPS. The HTTP client's transport must trust the service's
+// certificate, such as a transport returned by NewRestTLSTransport.
+func MakeSecureRestClient(addr net.Addr, client *http.Client) Manager {
+	return makeRestClient("https", addr, client)
+}
+
+func makeRestClient(scheme string, addr net.Addr, client *http.Client) *restClient {
+	c := &restClient{client: client}
+	if isUnixAddr(addr) {
+		// The host is ignored by transports that connect to the socket.
+		c.prefix = fmt.Sprintf("%s://localhost/jasper/v1", scheme)
+		c.socket = addr.String()
+	} else {
+		c.prefix = fmt.Sprintf("%s://%s/jasper/v1", scheme, addr)
+	}
+	return c
+}
+
+// NewRestClientWithCredentials constructs a REST client that connects to the
+// given address over HTTPS using the credentials to verify the service and to
+// present a client certificate. If creds is nil, it is the same as
+// NewRestClient.
+func NewRestClientWithCredentials(addr net.Addr, creds *options.CertificateCredentials) (Manager, error) {
+	if creds == nil {
+		return NewRestClient(addr), nil
+	}
+
+	transport, err := NewRestTLSTransport(creds)
+	if err != nil {
+		return nil, err
+	}
+	if isUnixAddr(addr) {
+		transport = NewUnixSocketTransport(addr.String(), transport)
+	}
+
+	return MakeSecureRestClient(addr, &http.Client{Transport: transport}), nil
+}
+
+// NewRestClientWithFile is the same as NewRestClientWithCredentials, but the
+// credentials will be read from the file given by filePath if the filePath is
+// non-empty. The credentials file should contain the JSON-encoded bytes from
+// (*options.CertificateCredentials).Export().
+func NewRestClientWithFile(addr net.Addr, filePath string) (Manager, error) {
+	var creds *options.CertificateCredentials
+	if filePath != "" {
+		var err error
+		creds, err = options.NewCredentialsFromFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("error getting credentials from file: %w", err)
+		}
+	}
+
+	return NewRestClientWithCredentials(addr, creds)
+}
+
+// NewRestTLSTransport returns an HTTP transport that uses the credentials to
+// verify the REST service's certificate and to present a client certificate.
+func NewRestTLSTransport(creds *options.CertificateCredentials) (*http.Transport, error) {
+	tlsConf, err := creds.Resolve()
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve credentials into TLS config: %w", err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConf
+	return transport, nil
+}
+
+// NewUnixSocketTransport returns an HTTP transport that connects to the REST
+// service listening on the unix domain socket at the path. The transport is
+// a copy of base, such as a transport returned by NewRestTLSTransport, or of
+// http.DefaultTransport if base is nil.
+func NewUnixSocketTransport(path string, base *http.Transport) *http.Transport {
+	if base == nil {
+		base = http.DefaultTransport.(*http.Transport)
+	}
+	transport := base.Clone()
+	transport.DialContext = dialUnixSocket(path)
+	transport.DialTLSContext = nil
+	transport.Proxy = nil
+	return transport
+}
+
+// NewAuthTransport returns an http.RoundTripper that adds the credentials to
+// each request before sending it with the base round tripper, or
+// http.DefaultTransport if base is nil. Use it as the transport of the
+// http.Client passed to MakeRestClient to connect to a service that requires
+// authentication. Signed requests carry a new nonce each time they are sent,
+// which the service refuses to accept twice, so any transport that retries
+// requests must wrap the auth transport rather than be wrapped by it. Signed
+// request bodies must not exceed 32 MiB.
+func NewAuthTransport(creds roptions.AuthCredentials, base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &authTransport{creds: creds, base: base}
+}
+
+type authTransport struct {
+	creds roptions.AuthCredentials
+	base  http.RoundTripper
+}
+
+func (t *authTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	var body []byte
+	if t.creds.KeyID != "" && r.Body != nil && r.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("problem reading request body: %w", err)
+		}
+	}
+
+	req := r.Clone(r.Context())
+	if body != nil {
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+	}
+	t.setCredentials(req, body)
+
+	return t.base.RoundTrip(req)
+}
+
+// setCredentials adds the credentials for the request with the given body to
+// its headers.
+func (t *authTransport) setCredentials(req *http.Request, body []byte) {
+	auth := makeSignedAuthRequest(&t.creds, req.Method+" "+req.URL.RequestURI(), body)
+	if auth.Token != "" {
+		req.Header.Set(authorizationHeader, bearerPrefix+auth.Token)
+	}
+	if auth.Signature != "" {
+		req.Header.Set(authKeyIDHeader, auth.KeyID)
+		req.Header.Set(authTimestampHeader, auth.Timestamp)
+		req.Header.Set(authNonceHeader, auth.Nonce)
+		req.Header.Set(authSignatureHeader, auth.Signature)
 	}
 }
 
 type restClient struct {
 	prefix string
 	client *http.Client
+	// socket is the path to the service's unix domain socket, if any.
+	socket string
 }
 
 func (c *restClient) CloseConnection() error {
@@ -99,6 +250,69 @@ func (c *restClient) doRequest(ctx context.Context, method string, url string, b
 	return resp, nil
 }
 
+// dialWebSocket opens a WebSocket connection to the URL using the TLS
+// configuration and credentials of the client's transport.
+func (c *restClient) dialWebSocket(ctx context.Context, url string) (*websocket.Conn, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("problem building request: %w", err)
+	}
+
+	transport := c.client.Transport
+	for unwrapped := false; !unwrapped; {
+		switch t := transport.(type) {
+		case *authTransport:
+			t.setCredentials(req, nil)
+			transport = t.base
+		case *resilientTransport:
+			transport = t.base
+		default:
+			unwrapped = true
+		}
+	}
+
+	wsURL := "ws" + strings.TrimPrefix(url, "http")
+	conf, err := websocket.NewConfig(wsURL, c.prefix)
+	if err != nil {
+		return nil, fmt.Errorf("problem configuring connection: %w", err)
+	}
+	conf.Header = req.Header
+	if httpTransport, ok := transport.(*http.Transport); ok {
+		conf.TlsConfig = httpTransport.TLSClientConfig
+	}
+
+	if c.socket == "" {
+		conn, err := conf.DialContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("problem opening connection: %w", err)
+		}
+		return conn, nil
+	}
+
+	netConn, err := dialUnixSocket(c.socket)(ctx, "unix", c.socket)
+	if err != nil {
+		return nil, fmt.Errorf("problem opening connection: %w", err)
+	}
+	if conf.Location.Scheme == "wss" {
+		tlsConf := &tls.Config{}
+		if conf.TlsConfig != nil {
+			tlsConf = conf.TlsConfig.Clone()
+		}
+		if tlsConf.ServerName == "" {
+			tlsConf.ServerName = conf.Location.Hostname()
+		}
+		netConn = tls.Client(netConn, tlsConf)
+	}
+	conn, err := websocket.NewClient(conf, netConn)
+	if err != nil {
+		catcher := &erc.Collector{}
+		catcher.Push(fmt.Errorf("problem opening connection: %w", err))
+		catcher.Push(netConn.Close())
+		return nil, catcher.Resolve()
+	}
+	return conn, nil
+}
+
 func (c *restClient) ID() string {
 	resp, err := c.doRequest(context.Background(), http.MethodGet, c.getURL("/id"), nil)
 	if err != nil {
@@ -324,6 +538,94 @@ func (c *restClient) GetLogStream(ctx context.Context, id string, count int) (ja
 	return stream, nil
 }
 
+func (c *restClient) FollowLogStream(ctx context.Context, id string, opts roptions.LogFollow, fn func(jasper.LogLine) error) error {
+	if err := opts.Validate(); err != nil {
+		return fmt.Errorf("invalid log follow options: %w", err)
+	}
+
+	query := url.Values{}
+	query.Set("offset", strconv.FormatInt(opts.Offset, 10))
+	for _, stream := range opts.Streams {
+		query.Add("stream", string(stream))
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	resp, err := c.doRequest(ctx, http.MethodGet, c.getURL("/process/%s/follow?%s", id, query.Encode()), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	done := false
+	if err = readServerSentEvents(resp.Body, func(event string, data []byte) error {
+		switch event {
+		case logFollowLineEvent:
+			line := jasper.LogLine{}
+			if err := json.Unmarshal(data, &line); err != nil {
+				return fmt.Errorf("problem reading log line: %w", err)
+			}
+			return fn(line)
+		case logFollowErrorEvent:
+			gimerr := gimlet.ErrorResponse{}
+			if err := json.Unmarshal(data, &gimerr); err != nil {
+				return fmt.Errorf("problem reading error: %w", err)
+			}
+			return gimerr
+		case logFollowDoneEvent:
+			done = true
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if !done {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return errors.New("log stream ended before the process's output was complete")
+	}
+
+	return nil
+}
+
+func (c *restClient) Exec(ctx context.Context, opts *roptions.Exec) (ExecSession, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid session options: %w", err)
+	}
+
+	conn, err := c.dialWebSocket(ctx, c.getURL("/exec"))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+
+	session, err := newExecClientSession(opts,
+		func(in roptions.ExecInput) error { return websocket.JSON.Send(conn, in) },
+		func() (roptions.ExecOutput, error) {
+			out := roptions.ExecOutput{}
+			err := websocket.JSON.Receive(conn, &out)
+			return out, err
+		},
+		func() error {
+			cancel()
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
 func (c *restClient) DownloadFile(ctx context.Context, opts roptions.Download) error {
 	body, err := makeBody(opts)
 	if err != nil {
@@ -339,6 +641,124 @@ func (c *restClient) DownloadFile(ctx context.Context, opts roptions.Download) e
 	return nil
 }
 
+func (c *restClient) GetDownloadCacheStats(ctx context.Context) (roptions.DownloadCacheStats, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, c.getURL("/download/cache"), nil)
+	if err != nil {
+		return roptions.DownloadCacheStats{}, fmt.Errorf("problem getting download cache stats: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var stats roptions.DownloadCacheStats
+	if err = gimlet.GetJSON(resp.Body, &stats); err != nil {
+		return roptions.DownloadCacheStats{}, fmt.Errorf("problem reading download cache stats from response: %w", err)
+	}
+
+	return stats, nil
+}
+
+func (c *restClient) Snapshot(ctx context.Context) (roptions.Snapshot, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, c.getURL("/snapshot"), nil)
+	if err != nil {
+		return roptions.Snapshot{}, fmt.Errorf("problem getting snapshot: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var snapshot roptions.Snapshot
+	if err = gimlet.GetJSON(resp.Body, &snapshot); err != nil {
+		return roptions.Snapshot{}, fmt.Errorf("problem reading snapshot from response: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+func (c *restClient) AddSchedule(ctx context.Context, opts options.Schedule) error {
+	body, err := makeBody(opts)
+	if err != nil {
+		return fmt.Errorf("problem building request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, c.getURL("/schedule"), body)
+	if err != nil {
+		return fmt.Errorf("problem adding schedule: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *restClient) RemoveSchedule(ctx context.Context, id string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, c.getURL("/schedule/%s", id), nil)
+	if err != nil {
+		return fmt.Errorf("problem removing schedule: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *restClient) ListSchedules(ctx context.Context) ([]jasper.ScheduleInfo, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, c.getURL("/schedule"), nil)
+	if err != nil {
+		return nil, fmt.Errorf("problem listing schedules: %w", err)
+	}
+	defer resp.Body.Close()
+
+	infos := []jasper.ScheduleInfo{}
+	if err = gimlet.GetJSON(resp.Body, &infos); err != nil {
+		return nil, fmt.Errorf("problem reading schedules from response: %w", err)
+	}
+
+	return infos, nil
+}
+
+func (c *restClient) CheckHealth(ctx context.Context) (roptions.HealthReport, error) {
+	req, err := http.NewRequest(http.MethodGet, c.getURL("/readyz"), nil)
+	if err != nil {
+		return roptions.HealthReport{}, fmt.Errorf("problem building request: %w", err)
+	}
+
+	resp, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return roptions.HealthReport{}, fmt.Errorf("problem making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// The service responds with 503 if it is not ready, but still reports
+	// its health.
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		if err = handleError(resp); err != nil {
+			return roptions.HealthReport{}, err
+		}
+	}
+
+	var report roptions.HealthReport
+	if err = gimlet.GetJSON(resp.Body, &report); err != nil {
+		return roptions.HealthReport{}, fmt.Errorf("problem reading health report from response: %w", err)
+	}
+
+	return report, nil
+}
+
+func (c *restClient) Drain(ctx context.Context, opts roptions.Drain) (roptions.DrainReport, error) {
+	body, err := makeBody(opts)
+	if err != nil {
+		return roptions.DrainReport{}, fmt.Errorf("problem building request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, c.getURL("/drain"), body)
+	if err != nil {
+		return roptions.DrainReport{}, fmt.Errorf("problem draining service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var report roptions.DrainReport
+	if err = gimlet.GetJSON(resp.Body, &report); err != nil {
+		return roptions.DrainReport{}, fmt.Errorf("problem reading drain report from response: %w", err)
+	}
+
+	return report, nil
+}
+
 func (c *restClient) SignalEvent(ctx context.Context, name string) error {
 	resp, err := c.doRequest(ctx, http.MethodPatch, c.getURL("/signal/event/%s", name), nil)
 	if err != nil {
@@ -368,6 +788,167 @@ func (c *restClient) WriteFile(ctx context.Context, opts options.WriteFile) erro
 	return opts.WriteBufferedContent(sendOpts)
 }
 
+func (c *restClient) ReadFile(ctx context.Context, opts roptions.ReadFile) (io.ReadCloser, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid read file options: %w", err)
+	}
+
+	body, err := makeBody(opts)
+	if err != nil {
+		return nil, fmt.Errorf("problem building request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, c.getURL("/file/read"), body)
+	if err != nil {
+		return nil, fmt.Errorf("problem reading file: %w", err)
+	}
+
+	return resp.Body, nil
+}
+
+func (c *restClient) StatFile(ctx context.Context, path string) (roptions.FileInfo, error) {
+	body, err := makeBody(filePathRequest{Path: path})
+	if err != nil {
+		return roptions.FileInfo{}, fmt.Errorf("problem building request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, c.getURL("/file/stat"), body)
+	if err != nil {
+		return roptions.FileInfo{}, fmt.Errorf("problem getting file information: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var info roptions.FileInfo
+	if err = gimlet.GetJSON(resp.Body, &info); err != nil {
+		return roptions.FileInfo{}, fmt.Errorf("problem reading file information from response: %w", err)
+	}
+
+	return info, nil
+}
+
+func (c *restClient) ListDirectory(ctx context.Context, opts roptions.ListDirectory) ([]roptions.FileInfo, error) {
+	body, err := makeBody(opts)
+	if err != nil {
+		return nil, fmt.Errorf("problem building request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, c.getURL("/file/list"), body)
+	if err != nil {
+		return nil, fmt.Errorf("problem listing directory: %w", err)
+	}
+	defer resp.Body.Close()
+
+	infos := []roptions.FileInfo{}
+	if err = gimlet.GetJSON(resp.Body, &infos); err != nil {
+		return nil, fmt.Errorf("problem reading directory listing from response: %w", err)
+	}
+
+	return infos, nil
+}
+
+func (c *restClient) GlobFiles(ctx context.Context, pattern string) ([]string, error) {
+	body, err := makeBody(filePathRequest{Path: pattern})
+	if err != nil {
+		return nil, fmt.Errorf("problem building request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, c.getURL("/file/glob"), body)
+	if err != nil {
+		return nil, fmt.Errorf("problem matching files: %w", err)
+	}
+	defer resp.Body.Close()
+
+	paths := []string{}
+	if err = gimlet.GetJSON(resp.Body, &paths); err != nil {
+		return nil, fmt.Errorf("problem reading matching files from response: %w", err)
+	}
+
+	return paths, nil
+}
+
+func (c *restClient) RemoveFile(ctx context.Context, opts roptions.RemoveFile) error {
+	body, err := makeBody(opts)
+	if err != nil {
+		return fmt.Errorf("problem building request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, c.getURL("/file/remove"), body)
+	if err != nil {
+		return fmt.Errorf("problem removing file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *restClient) RenameFile(ctx context.Context, opts roptions.RenameFile) error {
+	body, err := makeBody(opts)
+	if err != nil {
+		return fmt.Errorf("problem building request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, c.getURL("/file/rename"), body)
+	if err != nil {
+		return fmt.Errorf("problem renaming file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *restClient) MakeDirectory(ctx context.Context, opts roptions.MakeDirectory) error {
+	body, err := makeBody(opts)
+	if err != nil {
+		return fmt.Errorf("problem building request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, c.getURL("/file/mkdir"), body)
+	if err != nil {
+		return fmt.Errorf("problem making directory: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *restClient) ChecksumFile(ctx context.Context, opts roptions.FileChecksum) (string, error) {
+	body, err := makeBody(opts)
+	if err != nil {
+		return "", fmt.Errorf("problem building request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, c.getURL("/file/checksum"), body)
+	if err != nil {
+		return "", fmt.Errorf("problem computing checksum: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var checksum string
+	if err = gimlet.GetJSON(resp.Body, &checksum); err != nil {
+		return "", fmt.Errorf("problem reading checksum from response: %w", err)
+	}
+
+	return checksum, nil
+}
+
+func (c *restClient) CreateArchive(ctx context.Context, opts roptions.CreateArchive) (io.ReadCloser, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid create archive options: %w", err)
+	}
+
+	body, err := makeBody(opts)
+	if err != nil {
+		return nil, fmt.Errorf("problem building request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, http.MethodPost, c.getURL("/file/archive"), body)
+	if err != nil {
+		return nil, fmt.Errorf("problem creating archive: %w", err)
+	}
+
+	return resp.Body, nil
+}
+
 func (c *restClient) SendMessages(ctx context.Context, lp options.LoggingPayload) error {
 	body, err := makeBody(lp)
 	if err != nil {
@@ -446,6 +1027,16 @@ func (p *restProcess) Wait(ctx context.Context) (int, error) {
 	return exitCode, nil
 }
 
+func (p *restProcess) WaitReady(ctx context.Context) error {
+	resp, err := p.client.doRequest(ctx, http.MethodGet, p.client.getURL("/process/%s/ready", p.id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
 func (p *restProcess) Respawn(ctx context.Context) (jasper.Process, error) {
 	resp, err := p.client.doRequest(ctx, http.MethodGet, p.client.getURL("/process/%s/respawn", p.id), nil)
 	if err != nil {
@@ -482,6 +1073,21 @@ func (p *restProcess) RegisterSignalTriggerID(ctx context.Context, triggerID jas
 	return nil
 }
 
+func (p *restProcess) RegisterTriggerID(ctx context.Context, triggerID jasper.ProcessTriggerID, params map[string]string) error {
+	body, err := makeBody(params)
+	if err != nil {
+		return fmt.Errorf("problem building request: %w", err)
+	}
+
+	resp, err := p.client.doRequest(ctx, http.MethodPatch, p.client.getURL("/process/%s/trigger/process/%s", p.id, triggerID), body)
+	if err != nil {
+		return fmt.Errorf("request returned error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
 func (p *restProcess) Tag(t string) {
 	resp, err := p.client.doRequest(context.Background(), http.MethodPost, p.client.getURL("/process/%s/tags?add=%s", p.id, t), nil)
 	if err != nil {
@@ -1,42 +1,140 @@
 package remote
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"net"
 	"net/http"
 	"os"
 	"strconv"
 	"syscall"
 	"time"
 
+	"github.com/tychoish/fun/erc"
 	"github.com/tychoish/gimlet"
+	"github.com/tychoish/grip"
+	"github.com/tychoish/grip/message"
+	"github.com/tychoish/grip/recovery"
 	"github.com/tychoish/grip/x/metrics"
 	"github.com/tychoish/jasper"
 	"github.com/tychoish/jasper/options"
 	"github.com/tychoish/jasper/scripting"
+	"github.com/tychoish/jasper/util"
+	"github.com/tychoish/jasper/x/remote/internal"
 	roptions "github.com/tychoish/jasper/x/remote/options"
+	"golang.org/x/net/websocket"
 )
 
 // Service defines a REST service that provides a remote manager, using
 // gimlet to publish routes.
 type Service struct {
-	hostID    string
-	manager   jasper.Manager
-	harnesses scripting.HarnessCache
+	hostID     string
+	manager    jasper.Manager
+	health     *HealthManager
+	outputs    *jasper.OutputBufferRegistry
+	harnesses  scripting.HarnessCache
+	authorizer *Authorizer
 }
 
 // NewManagerService creates a service object around an existing
 // manager. You must access the application and routes via the App()
 // method separately. The constructor wraps basic managers with a
-// manager implementation that does locking.
+// manager implementation that does locking. The /healthz and /readyz routes
+// report health and readiness as determined by the manager if it is a
+// HealthManager.
 func NewRestService(m jasper.Manager) *Service {
+	hm := makeHealthManager(m)
 	return &Service{
-		manager:   m,
+		manager:   hm,
+		health:    hm,
+		outputs:   jasper.NewOutputBufferRegistry(hm, jasper.DefaultOutputBufferSize),
 		harnesses: scripting.NewCache(),
 	}
 }
 
+// NewRestServiceWithAuth is the same as NewRestService, but every route
+// requires that requests are authenticated and authorized by the given
+// authorizer.
+func NewRestServiceWithAuth(m jasper.Manager, a *Authorizer) *Service {
+	s := NewRestService(m)
+	s.authorizer = a
+	return s
+}
+
+// StartRestService starts a REST server with the specified address addr
+// around the given service. If creds is non-nil, the credentials will be used
+// to serve HTTPS and, if requireClientCert is true, clients must present a
+// certificate signed by the credentials' CA; otherwise, it will serve plain
+// HTTP. The service stops when the context is canceled or the returned
+// util.CloseFunc is called.
+//
+// If addr is a unix domain socket, the socket's permissions are set
+// according to the address (see UnixSocketAddr) and the peer credentials of
+// each connection are available to the service's authorizer.
+func StartRestService(ctx context.Context, s *Service, addr net.Addr, creds *options.CertificateCredentials, requireClientCert bool) (util.CloseFunc, error) {
+	app := s.App(ctx)
+	app.SetPrefix("jasper")
+	handler, err := app.Handler()
+	if err != nil {
+		return nil, fmt.Errorf("error resolving REST routes: %w", err)
+	}
+
+	lis, err := listen(addr)
+	if err != nil {
+		return nil, fmt.Errorf("error listening on %s: %w", addr.String(), err)
+	}
+
+	if creds != nil {
+		tlsConf, err := creds.Resolve()
+		if err != nil {
+			catcher := &erc.Collector{}
+			catcher.Push(fmt.Errorf("error generating TLS config from server credentials: %w", err))
+			catcher.Push(lis.Close())
+			return nil, catcher.Resolve()
+		}
+		if !requireClientCert {
+			tlsConf.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+		lis = tls.NewListener(lis, tlsConf)
+	}
+
+	srv := &http.Server{Handler: handler, ConnContext: withPeerCredentials}
+	go func() {
+		defer recovery.LogStackTraceAndContinue("REST service")
+		if err := srv.Serve(lis); !errors.Is(err, http.ErrServerClosed) {
+			grip.Notice(err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		grip.Debug(srv.Close())
+	}()
+
+	return srv.Close, nil
+}
+
+// StartRestServiceWithFile is the same as StartRestService, but the
+// credentials will be read from the file given by filePath if the filePath is
+// non-empty. The credentials file should contain the JSON-encoded bytes from
+// (*options.CertificateCredentials).Export().
+func StartRestServiceWithFile(ctx context.Context, s *Service, addr net.Addr, filePath string, requireClientCert bool) (util.CloseFunc, error) {
+	var creds *options.CertificateCredentials
+	if filePath != "" {
+		var err error
+		creds, err = options.NewCredentialsFromFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("error getting credentials from file: %w", err)
+		}
+	}
+
+	return StartRestService(ctx, s, addr, creds, requireClientCert)
+}
+
 // App constructs and returns a gimlet application for this
 // service. It attaches no middleware and does not start the service.
 func (s *Service) App(ctx context.Context) *gimlet.APIApp {
@@ -44,42 +142,65 @@ func (s *Service) App(ctx context.Context) *gimlet.APIApp {
 
 	app := gimlet.NewApp()
 
-	app.AddRoute("/").Version(1).Get().Handler(s.rootRoute)
-	app.AddRoute("/id").Version(1).Get().Handler(s.id)
-	app.AddRoute("/create").Version(1).Post().Handler(s.createProcess)
-	app.AddRoute("/download").Version(1).Post().Handler(s.downloadFile)
-	app.AddRoute("/list/{filter}").Version(1).Get().Handler(s.listProcesses)
-	app.AddRoute("/list/group/{name}").Version(1).Get().Handler(s.listGroupMembers)
-	app.AddRoute("/process/{id}").Version(1).Get().Handler(s.getProcess)
-	app.AddRoute("/process/{id}/tags").Version(1).Get().Handler(s.getProcessTags)
-	app.AddRoute("/process/{id}/tags").Version(1).Delete().Handler(s.deleteProcessTags)
-	app.AddRoute("/process/{id}/tags").Version(1).Post().Handler(s.addProcessTag)
-	app.AddRoute("/process/{id}/wait").Version(1).Get().Handler(s.waitForProcess)
-	app.AddRoute("/process/{id}/respawn").Version(1).Get().Handler(s.respawnProcess)
-	app.AddRoute("/process/{id}/metrics").Version(1).Get().Handler(s.processMetrics)
-	app.AddRoute("/process/{id}/logs/{count}").Version(1).Get().Handler(s.getLogStream)
-	app.AddRoute("/process/{id}/signal/{signal}").Version(1).Patch().Handler(s.signalProcess)
-	app.AddRoute("/process/{id}/trigger/signal/{trigger-id}").Version(1).Patch().Handler(s.registerSignalTriggerID)
-	app.AddRoute("/signal/event/{name}").Version(1).Patch().Handler(s.signalEvent)
-	app.AddRoute("/scripting/create/{type}").Version(1).Post().Handler(s.scriptingCreate)
-	app.AddRoute("/scripting/{id}").Version(1).Get().Handler(s.scriptingCheck)
-	app.AddRoute("/scripting/{id}").Version(1).Delete().Handler(s.scriptingCleanup)
-	app.AddRoute("/scripting/{id}/setup").Version(1).Post().Handler(s.scriptingSetup)
-	app.AddRoute("/scripting/{id}/run").Version(1).Post().Handler(s.scriptingRun)
-	app.AddRoute("/scripting/{id}/script").Version(1).Post().Handler(s.scriptingRunScript)
-	app.AddRoute("/scripting/{id}/build").Version(1).Post().Handler(s.scriptingBuild)
-	app.AddRoute("/scripting/{id}/test").Version(1).Post().Handler(s.scriptingTest)
-	app.AddRoute("/logging/id/{id}").Version(1).Post().Handler(s.loggingCacheCreate)
-	app.AddRoute("/logging/id/{id}").Version(1).Get().Handler(s.loggingCacheGet)
-	app.AddRoute("/logging/id/{id}").Version(1).Delete().Handler(s.loggingCacheDelete)
-	app.AddRoute("/logging/id/{id}/close").Version(1).Delete().Handler(s.loggingCacheCloseAndRemove)
-	app.AddRoute("/logging/id/{id}/send").Version(1).Post().Handler(s.loggingSendMessages)
-	app.AddRoute("/logging/clear").Version(1).Delete().Handler(s.loggingCacheClear)
-	app.AddRoute("/logging/size").Version(1).Delete().Handler(s.loggingCacheSize)
-	app.AddRoute("/logging/prune/{time}").Version(1).Delete().Handler(s.loggingCachePrune)
-	app.AddRoute("/file/write").Version(1).Put().Handler(s.writeFile)
-	app.AddRoute("/clear").Version(1).Post().Handler(s.clearManager)
-	app.AddRoute("/close").Version(1).Delete().Handler(s.closeManager)
+	app.AddRoute("/").Version(1).Get().Handler(s.authorize(roptions.OperationRead, s.rootRoute))
+	// The health routes do not require authorization so that standard
+	// probes can check the service.
+	app.AddRoute("/healthz").Version(1).Get().Handler(s.healthz)
+	app.AddRoute("/readyz").Version(1).Get().Handler(s.readyz)
+	app.AddRoute("/drain").Version(1).Post().Handler(s.authorize(roptions.OperationAdmin, s.drain))
+	app.AddRoute("/id").Version(1).Get().Handler(s.authorize(roptions.OperationRead, s.id))
+	app.AddRoute("/snapshot").Version(1).Get().Handler(s.authorize(roptions.OperationRead, s.snapshot))
+	app.AddRoute("/schedule").Version(1).Get().Handler(s.authorize(roptions.OperationRead, s.listSchedules))
+	app.AddRoute("/schedule").Version(1).Post().Handler(s.authorize(roptions.OperationCreate, s.addSchedule))
+	app.AddRoute("/schedule/{id}").Version(1).Delete().Handler(s.authorize(roptions.OperationCreate, s.removeSchedule))
+	app.AddRoute("/create").Version(1).Post().Handler(s.authorize(roptions.OperationCreate, s.createProcess))
+	app.AddRoute("/exec").Version(1).Get().Handler(s.authorize(roptions.OperationCreate, s.exec))
+	app.AddRoute("/download").Version(1).Post().Handler(s.authorize(roptions.OperationFileWrite, s.downloadFile))
+	app.AddRoute("/download/cache").Version(1).Get().Handler(s.authorize(roptions.OperationRead, s.downloadCacheStats))
+	app.AddRoute("/list/{filter}").Version(1).Get().Handler(s.authorize(roptions.OperationRead, s.listProcesses))
+	app.AddRoute("/list/group/{name}").Version(1).Get().Handler(s.authorize(roptions.OperationRead, s.listGroupMembers))
+	app.AddRoute("/process/{id}").Version(1).Get().Handler(s.authorize(roptions.OperationRead, s.getProcess))
+	app.AddRoute("/process/{id}/tags").Version(1).Get().Handler(s.authorize(roptions.OperationRead, s.getProcessTags))
+	app.AddRoute("/process/{id}/tags").Version(1).Delete().Handler(s.authorize(roptions.OperationSignal, s.deleteProcessTags))
+	app.AddRoute("/process/{id}/tags").Version(1).Post().Handler(s.authorize(roptions.OperationSignal, s.addProcessTag))
+	app.AddRoute("/process/{id}/wait").Version(1).Get().Handler(s.authorize(roptions.OperationRead, s.waitForProcess))
+	app.AddRoute("/process/{id}/ready").Version(1).Get().Handler(s.authorize(roptions.OperationRead, s.waitForProcessReady))
+	app.AddRoute("/process/{id}/respawn").Version(1).Get().Handler(s.authorize(roptions.OperationCreate, s.respawnProcess))
+	app.AddRoute("/process/{id}/metrics").Version(1).Get().Handler(s.authorize(roptions.OperationRead, s.processMetrics))
+	app.AddRoute("/process/{id}/logs/{count}").Version(1).Get().Handler(s.authorize(roptions.OperationRead, s.getLogStream))
+	app.AddRoute("/process/{id}/follow").Version(1).Get().Handler(s.authorize(roptions.OperationRead, s.followLogStream))
+	app.AddRoute("/process/{id}/signal/{signal}").Version(1).Patch().Handler(s.authorize(roptions.OperationSignal, s.signalProcess))
+	app.AddRoute("/process/{id}/trigger/signal/{trigger-id}").Version(1).Patch().Handler(s.authorize(roptions.OperationSignal, s.registerSignalTriggerID))
+	app.AddRoute("/process/{id}/trigger/process/{trigger-id}").Version(1).Patch().Handler(s.authorize(roptions.OperationCreate, s.registerTriggerID))
+	app.AddRoute("/signal/event/{name}").Version(1).Patch().Handler(s.authorize(roptions.OperationSignal, s.signalEvent))
+	app.AddRoute("/scripting/create/{type}").Version(1).Post().Handler(s.authorize(roptions.OperationCreate, s.scriptingCreate))
+	app.AddRoute("/scripting/{id}").Version(1).Get().Handler(s.authorize(roptions.OperationRead, s.scriptingCheck))
+	app.AddRoute("/scripting/{id}").Version(1).Delete().Handler(s.authorize(roptions.OperationCreate, s.scriptingCleanup))
+	app.AddRoute("/scripting/{id}/setup").Version(1).Post().Handler(s.authorize(roptions.OperationCreate, s.scriptingSetup))
+	app.AddRoute("/scripting/{id}/run").Version(1).Post().Handler(s.authorize(roptions.OperationCreate, s.scriptingRun))
+	app.AddRoute("/scripting/{id}/script").Version(1).Post().Handler(s.authorize(roptions.OperationCreate, s.scriptingRunScript))
+	app.AddRoute("/scripting/{id}/build").Version(1).Post().Handler(s.authorize(roptions.OperationCreate, s.scriptingBuild))
+	app.AddRoute("/scripting/{id}/test").Version(1).Post().Handler(s.authorize(roptions.OperationCreate, s.scriptingTest))
+	app.AddRoute("/logging/id/{id}").Version(1).Post().Handler(s.authorize(roptions.OperationLogging, s.loggingCacheCreate))
+	app.AddRoute("/logging/id/{id}").Version(1).Get().Handler(s.authorize(roptions.OperationLogging, s.loggingCacheGet))
+	app.AddRoute("/logging/id/{id}").Version(1).Delete().Handler(s.authorize(roptions.OperationLogging, s.loggingCacheDelete))
+	app.AddRoute("/logging/id/{id}/close").Version(1).Delete().Handler(s.authorize(roptions.OperationLogging, s.loggingCacheCloseAndRemove))
+	app.AddRoute("/logging/id/{id}/send").Version(1).Post().Handler(s.authorize(roptions.OperationLogging, s.loggingSendMessages))
+	app.AddRoute("/logging/clear").Version(1).Delete().Handler(s.authorize(roptions.OperationLogging, s.loggingCacheClear))
+	app.AddRoute("/logging/size").Version(1).Delete().Handler(s.authorize(roptions.OperationLogging, s.loggingCacheSize))
+	app.AddRoute("/logging/prune/{time}").Version(1).Delete().Handler(s.authorize(roptions.OperationLogging, s.loggingCachePrune))
+	app.AddRoute("/file/write").Version(1).Put().Handler(s.authorize(roptions.OperationFileWrite, s.writeFile))
+	app.AddRoute("/file/read").Version(1).Post().Handler(s.authorize(roptions.OperationFileRead, s.readFile))
+	app.AddRoute("/file/stat").Version(1).Post().Handler(s.authorize(roptions.OperationFileRead, s.statFile))
+	app.AddRoute("/file/list").Version(1).Post().Handler(s.authorize(roptions.OperationFileRead, s.listDirectory))
+	app.AddRoute("/file/glob").Version(1).Post().Handler(s.authorize(roptions.OperationFileRead, s.globFiles))
+	app.AddRoute("/file/checksum").Version(1).Post().Handler(s.authorize(roptions.OperationFileRead, s.checksumFile))
+	app.AddRoute("/file/archive").Version(1).Post().Handler(s.authorize(roptions.OperationFileRead, s.createArchive))
+	app.AddRoute("/file/remove").Version(1).Post().Handler(s.authorize(roptions.OperationFileWrite, s.removeFile))
+	app.AddRoute("/file/rename").Version(1).Post().Handler(s.authorize(roptions.OperationFileWrite, s.renameFile))
+	app.AddRoute("/file/mkdir").Version(1).Post().Handler(s.authorize(roptions.OperationFileWrite, s.makeDirectory))
+	app.AddRoute("/clear").Version(1).Post().Handler(s.authorize(roptions.OperationAdmin, s.clearManager))
+	app.AddRoute("/close").Version(1).Delete().Handler(s.authorize(roptions.OperationAdmin, s.closeManager))
 
 	return app
 }
@@ -94,6 +215,83 @@ func writeError(rw http.ResponseWriter, err gimlet.ErrorResponse) {
 	gimlet.WriteJSONResponse(rw, err.StatusCode, err)
 }
 
+// authorize wraps the handler so that requests must be authorized to perform
+// the operation if the service has an authorizer.
+func (s *Service) authorize(op roptions.Operation, handler http.HandlerFunc) http.HandlerFunc {
+	if s.authorizer == nil {
+		return handler
+	}
+
+	return func(rw http.ResponseWriter, r *http.Request) {
+		req, err := makeHTTPAuthRequest(rw, r)
+		if err != nil {
+			code := http.StatusBadRequest
+			if maxErr := (*http.MaxBytesError)(nil); errors.As(err, &maxErr) {
+				code = http.StatusRequestEntityTooLarge
+			} else if errors.Is(err, ErrNotAuthenticated) {
+				code = http.StatusUnauthorized
+			}
+			writeError(rw, gimlet.ErrorResponse{
+				StatusCode: code,
+				Message:    fmt.Errorf("problem reading request: %w", err).Error(),
+			})
+			return
+		}
+
+		id, err := s.authorizer.Authorize(req, op)
+		if err != nil {
+			code := http.StatusForbidden
+			if errors.Is(err, ErrNotAuthenticated) {
+				code = http.StatusUnauthorized
+			}
+			writeError(rw, gimlet.ErrorResponse{
+				StatusCode: code,
+				Message:    err.Error(),
+			})
+			return
+		}
+
+		handler(rw, r.WithContext(withIdentity(r.Context(), id)))
+	}
+}
+
+// maxSignedRequestBodySize is the largest request body that the service reads
+// into memory to verify the signature of a request.
+const maxSignedRequestBodySize = 32 * 1024 * 1024
+
+// makeHTTPAuthRequest extracts the credentials from the request. The body is
+// read so that it can be verified against a signature and then replaced.
+func makeHTTPAuthRequest(rw http.ResponseWriter, r *http.Request) (AuthRequest, error) {
+	token, err := bearerToken(r.Header.Get(authorizationHeader))
+	if err != nil {
+		return AuthRequest{}, err
+	}
+
+	req := AuthRequest{
+		Target:    r.Method + " " + r.URL.RequestURI(),
+		Token:     token,
+		KeyID:     r.Header.Get(authKeyIDHeader),
+		Timestamp: r.Header.Get(authTimestampHeader),
+		Nonce:     r.Header.Get(authNonceHeader),
+		Signature: r.Header.Get(authSignatureHeader),
+	}
+	if r.TLS != nil {
+		req.PeerCertificates = r.TLS.PeerCertificates
+	}
+	req.PeerCredentials = peerCredentialsFromContext(r.Context())
+
+	if req.Signature != "" && r.Body != nil {
+		body, err := io.ReadAll(http.MaxBytesReader(rw, r.Body, maxSignedRequestBodySize))
+		if err != nil {
+			return req, err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		req.Body = body
+	}
+
+	return req, nil
+}
+
 func (s *Service) rootRoute(rw http.ResponseWriter, r *http.Request) {
 	gimlet.WriteJSON(rw, struct {
 		HostID string `json:"host_id"`
@@ -104,6 +302,52 @@ func (s *Service) rootRoute(rw http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// healthz writes the health report, with a 503 status if the service is not
+// healthy.
+func (s *Service) healthz(rw http.ResponseWriter, r *http.Request) {
+	report := s.health.Check(r.Context())
+	code := http.StatusOK
+	if !report.Healthy {
+		code = http.StatusServiceUnavailable
+	}
+	gimlet.WriteJSONResponse(rw, code, report)
+}
+
+// readyz writes the health report, with a 503 status if the service is not
+// ready.
+func (s *Service) readyz(rw http.ResponseWriter, r *http.Request) {
+	report := s.health.Check(r.Context())
+	code := http.StatusOK
+	if !report.Ready {
+		code = http.StatusServiceUnavailable
+	}
+	gimlet.WriteJSONResponse(rw, code, report)
+}
+
+// drain drains the service and writes the drain report once the running
+// processes are done.
+func (s *Service) drain(rw http.ResponseWriter, r *http.Request) {
+	opts := roptions.Drain{}
+	if err := gimlet.GetJSON(r.Body, &opts); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem reading request: %w", err).Error(),
+		})
+		return
+	}
+
+	report, err := s.health.Drain(r.Context(), opts)
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    fmt.Errorf("problem draining service: %w", err).Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, report)
+}
+
 func (s *Service) id(rw http.ResponseWriter, r *http.Request) {
 	gimlet.WriteJSON(rw, s.manager.ID())
 }
@@ -127,13 +371,17 @@ func (s *Service) createProcess(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pctx, cancel := context.WithCancel(context.Background())
+	pctx, cancel := context.WithCancel(jasper.WithDetachedFollowUps(context.Background()))
 
-	proc, err := s.manager.CreateProcess(pctx, opts)
+	proc, err := s.outputs.CreateProcess(pctx, opts)
 	if err != nil {
 		cancel()
+		code := http.StatusBadRequest
+		if errors.Is(err, ErrDraining) {
+			code = http.StatusServiceUnavailable
+		}
 		writeError(rw, gimlet.ErrorResponse{
-			StatusCode: http.StatusBadRequest,
+			StatusCode: code,
 			Message:    fmt.Errorf("problem submitting request: %w", err).Error(),
 		})
 		return
@@ -325,6 +573,29 @@ func (s *Service) waitForProcess(rw http.ResponseWriter, r *http.Request) {
 	gimlet.WriteJSON(rw, exitCode)
 }
 
+func (s *Service) waitForProcessReady(rw http.ResponseWriter, r *http.Request) {
+	id := gimlet.GetVars(r)["id"]
+	ctx := r.Context()
+	proc, err := s.manager.Get(ctx, id)
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("no process '%s' found: %q", id, err.Error()),
+		})
+		return
+	}
+
+	if err := proc.WaitReady(ctx); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, struct{}{})
+}
+
 func (s *Service) respawnProcess(rw http.ResponseWriter, r *http.Request) {
 	id := gimlet.GetVars(r)["id"]
 	ctx := r.Context()
@@ -340,24 +611,20 @@ func (s *Service) respawnProcess(rw http.ResponseWriter, r *http.Request) {
 
 	// Spawn a new context so that the process' context is not potentially
 	// canceled by the request's. See how createProcess() does this same thing.
-	pctx, cancel := context.WithCancel(context.Background())
-	newProc, err := proc.Respawn(pctx)
+	pctx, cancel := context.WithCancel(jasper.WithDetachedFollowUps(context.Background()))
+	newProc, err := respawnProcess(pctx, s.manager, proc)
 	if err != nil {
+		code := http.StatusBadRequest
+		if errors.Is(err, ErrDraining) {
+			code = http.StatusServiceUnavailable
+		}
 		writeError(rw, gimlet.ErrorResponse{
-			StatusCode: http.StatusBadRequest,
+			StatusCode: code,
 			Message:    err.Error(),
 		})
 		cancel()
 		return
 	}
-	if err := s.manager.Register(ctx, newProc); err != nil {
-		writeError(rw, gimlet.ErrorResponse{
-			StatusCode: http.StatusInternalServerError,
-			Message:    fmt.Sprintf("failed to register respawned process: %q", err.Error()),
-		})
-		cancel()
-		return
-	}
 
 	if err := newProc.RegisterTrigger(ctx, func(_ jasper.ProcessInfo) {
 		cancel()
@@ -439,6 +706,61 @@ func (s *Service) downloadFile(rw http.ResponseWriter, r *http.Request) {
 	gimlet.WriteJSON(rw, struct{}{})
 }
 
+func (s *Service) snapshot(rw http.ResponseWriter, r *http.Request) {
+	snapshot, err := roptions.NewSnapshot(r.Context(), s.manager, s.harnesses)
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    fmt.Errorf("problem creating snapshot: %w", err).Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, snapshot)
+}
+
+func (s *Service) listSchedules(rw http.ResponseWriter, r *http.Request) {
+	gimlet.WriteJSON(rw, s.health.Scheduler().List())
+}
+
+func (s *Service) addSchedule(rw http.ResponseWriter, r *http.Request) {
+	opts := options.Schedule{}
+	if err := gimlet.GetJSON(r.Body, &opts); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem reading request: %w", err).Error(),
+		})
+		return
+	}
+
+	if err := s.health.Scheduler().Add(opts); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem adding schedule: %w", err).Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, struct{}{})
+}
+
+func (s *Service) removeSchedule(rw http.ResponseWriter, r *http.Request) {
+	id := gimlet.GetVars(r)["id"]
+	if err := s.health.Scheduler().Remove(id); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Errorf("problem removing schedule '%s': %w", id, err).Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, struct{}{})
+}
+
+func (s *Service) downloadCacheStats(rw http.ResponseWriter, r *http.Request) {
+	gimlet.WriteJSON(rw, roptions.GetGlobalDownloadCache().Stats())
+}
+
 func (s *Service) getLogStream(rw http.ResponseWriter, r *http.Request) {
 	vars := gimlet.GetVars(r)
 	id := vars["id"]
@@ -478,6 +800,118 @@ func (s *Service) getLogStream(rw http.ResponseWriter, r *http.Request) {
 	gimlet.WriteJSON(rw, stream)
 }
 
+// followLogStream streams the output of a process as server-sent events. Each
+// line of output is sent as a "line" event whose ID is the line's offset, and
+// a final "done" event is sent once the process has exited and all of its
+// output has been sent. The offset to start from is given by the "offset"
+// query parameter or, when reconnecting, the Last-Event-ID header. The
+// "stream" query parameter restricts the output to the given streams.
+// exec runs an interactive session over a WebSocket connection. Each
+// WebSocket message contains a single JSON-encoded ExecInput from the client
+// or ExecOutput from the service.
+func (s *Service) exec(rw http.ResponseWriter, r *http.Request) {
+	websocket.Server{Handler: func(conn *websocket.Conn) {
+		err := internal.ServeExec(r.Context(), s.outputs.CreateProcess,
+			func() (roptions.ExecInput, error) {
+				in := roptions.ExecInput{}
+				if err := websocket.JSON.Receive(conn, &in); err != nil {
+					// WebSocket clients close standard input explicitly,
+					// so the end of the connection is a disconnection.
+					return in, fmt.Errorf("problem receiving message: %s", err)
+				}
+				return in, nil
+			},
+			func(out roptions.ExecOutput) error { return websocket.JSON.Send(conn, out) },
+		)
+		if err != nil {
+			grip.Warning(websocket.JSON.Send(conn, roptions.ExecOutput{Exited: true, ExitCode: -1, Error: err.Error()}))
+		}
+	}}.ServeHTTP(rw, r)
+}
+
+func (s *Service) followLogStream(rw http.ResponseWriter, r *http.Request) {
+	id := gimlet.GetVars(r)["id"]
+	opts, err := makeLogFollowOptions(r)
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("invalid log follow options: %w", err).Error(),
+		})
+		return
+	}
+
+	ctx := r.Context()
+
+	if _, err = s.manager.Get(ctx, id); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("no process '%s' found: %q", id, err.Error()),
+		})
+		return
+	}
+
+	buf, err := s.outputs.Get(id)
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+		return
+	}
+
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    "response does not support streaming",
+		})
+		return
+	}
+
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	err = buf.Follow(ctx, opts.Offset, opts.Streams, func(line jasper.LogLine) error {
+		return writeServerSentEvent(rw, flusher, strconv.FormatInt(line.Offset, 10), logFollowLineEvent, line)
+	})
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		grip.Warning(writeServerSentEvent(rw, flusher, "", logFollowErrorEvent, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    fmt.Sprintf("could not follow logs for process '%s': %q", id, err.Error()),
+		}))
+		return
+	}
+
+	grip.Warning(writeServerSentEvent(rw, flusher, "", logFollowDoneEvent, struct{}{}))
+}
+
+func makeLogFollowOptions(r *http.Request) (roptions.LogFollow, error) {
+	opts := roptions.LogFollow{}
+	query := r.URL.Query()
+	if offset := query.Get("offset"); offset != "" {
+		var err error
+		if opts.Offset, err = strconv.ParseInt(offset, 10, 64); err != nil {
+			return opts, fmt.Errorf("problem converting offset '%s': %w", offset, err)
+		}
+	} else if lastID := r.Header.Get("Last-Event-ID"); lastID != "" {
+		last, err := strconv.ParseInt(lastID, 10, 64)
+		if err != nil {
+			return opts, fmt.Errorf("problem converting last event ID '%s': %w", lastID, err)
+		}
+		opts.Offset = last + 1
+	}
+	for _, stream := range query["stream"] {
+		opts.Streams = append(opts.Streams, jasper.OutputStream(stream))
+	}
+
+	return opts, opts.Validate()
+}
+
 func (s *Service) signalEvent(rw http.ResponseWriter, r *http.Request) {
 	vars := gimlet.GetVars(r)
 	name := vars["name"]
@@ -531,13 +965,310 @@ func (s *Service) writeFile(rw http.ResponseWriter, r *http.Request) {
 	gimlet.WriteJSON(rw, struct{}{})
 }
 
+// fileErrorStatus returns the HTTP status code for an error from a
+// filesystem operation.
+func fileErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return http.StatusNotFound
+	case errors.Is(err, fs.ErrExist):
+		return http.StatusConflict
+	case errors.Is(err, fs.ErrPermission):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// filePathRequest is the request body for filesystem operations that only
+// take a path.
+type filePathRequest struct {
+	Path string `json:"path"`
+}
+
+func (s *Service) readFile(rw http.ResponseWriter, r *http.Request) {
+	var opts roptions.ReadFile
+	if err := gimlet.GetJSON(r.Body, &opts); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem reading request: %w", err).Error(),
+		})
+		return
+	}
+
+	if err := opts.Validate(); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem validating read file options: %w", err).Error(),
+		})
+		return
+	}
+
+	file, err := opts.Open()
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: fileErrorStatus(err),
+			Message:    fmt.Sprintf("problem opening file %s: %q", opts.Path, err.Error()),
+		})
+		return
+	}
+	defer file.Close()
+
+	rw.Header().Set("Content-Type", "application/octet-stream")
+	rw.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(rw, file); err != nil {
+		grip.Warning(message.WrapError(err, message.Fields{
+			"message": "problem writing file contents to response",
+			"path":    opts.Path,
+		}))
+	}
+}
+
+func (s *Service) statFile(rw http.ResponseWriter, r *http.Request) {
+	var req filePathRequest
+	if err := gimlet.GetJSON(r.Body, &req); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem reading request: %w", err).Error(),
+		})
+		return
+	}
+
+	info, err := roptions.StatFile(req.Path)
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: fileErrorStatus(err),
+			Message:    fmt.Sprintf("problem getting file information for %s: %q", req.Path, err.Error()),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, info)
+}
+
+func (s *Service) listDirectory(rw http.ResponseWriter, r *http.Request) {
+	var opts roptions.ListDirectory
+	if err := gimlet.GetJSON(r.Body, &opts); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem reading request: %w", err).Error(),
+		})
+		return
+	}
+
+	if err := opts.Validate(); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem validating list directory options: %w", err).Error(),
+		})
+		return
+	}
+
+	infos, err := opts.List()
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: fileErrorStatus(err),
+			Message:    fmt.Sprintf("problem listing directory %s: %q", opts.Path, err.Error()),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, infos)
+}
+
+func (s *Service) globFiles(rw http.ResponseWriter, r *http.Request) {
+	var req filePathRequest
+	if err := gimlet.GetJSON(r.Body, &req); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem reading request: %w", err).Error(),
+		})
+		return
+	}
+
+	paths, err := roptions.GlobFiles(req.Path)
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, paths)
+}
+
+func (s *Service) checksumFile(rw http.ResponseWriter, r *http.Request) {
+	var opts roptions.FileChecksum
+	if err := gimlet.GetJSON(r.Body, &opts); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem reading request: %w", err).Error(),
+		})
+		return
+	}
+
+	if err := opts.Validate(); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem validating file checksum options: %w", err).Error(),
+		})
+		return
+	}
+
+	checksum, err := opts.Compute()
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: fileErrorStatus(err),
+			Message:    fmt.Sprintf("problem computing checksum of file %s: %q", opts.Path, err.Error()),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, checksum)
+}
+
+func (s *Service) createArchive(rw http.ResponseWriter, r *http.Request) {
+	var opts roptions.CreateArchive
+	if err := gimlet.GetJSON(r.Body, &opts); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem reading request: %w", err).Error(),
+		})
+		return
+	}
+
+	if err := opts.Validate(); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem validating create archive options: %w", err).Error(),
+		})
+		return
+	}
+
+	info, err := roptions.StatFile(opts.Path)
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: fileErrorStatus(err),
+			Message:    fmt.Sprintf("problem getting file information for %s: %q", opts.Path, err.Error()),
+		})
+		return
+	}
+	if !info.IsDir {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("%s is not a directory", opts.Path),
+		})
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/octet-stream")
+	rw.WriteHeader(http.StatusOK)
+	if err := opts.Write(rw); err != nil {
+		grip.Warning(message.WrapError(err, message.Fields{
+			"message": "problem writing archive to response",
+			"path":    opts.Path,
+		}))
+		// Abort the response so that the client does not mistake the
+		// partial archive for a complete one.
+		panic(http.ErrAbortHandler)
+	}
+}
+
+func (s *Service) removeFile(rw http.ResponseWriter, r *http.Request) {
+	var opts roptions.RemoveFile
+	if err := gimlet.GetJSON(r.Body, &opts); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem reading request: %w", err).Error(),
+		})
+		return
+	}
+
+	if err := opts.Validate(); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem validating remove file options: %w", err).Error(),
+		})
+		return
+	}
+
+	if err := opts.Remove(); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: fileErrorStatus(err),
+			Message:    fmt.Sprintf("problem removing file %s: %q", opts.Path, err.Error()),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, struct{}{})
+}
+
+func (s *Service) renameFile(rw http.ResponseWriter, r *http.Request) {
+	var opts roptions.RenameFile
+	if err := gimlet.GetJSON(r.Body, &opts); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem reading request: %w", err).Error(),
+		})
+		return
+	}
+
+	if err := opts.Validate(); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem validating rename file options: %w", err).Error(),
+		})
+		return
+	}
+
+	if err := opts.Rename(); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: fileErrorStatus(err),
+			Message:    fmt.Sprintf("problem renaming file %s to %s: %q", opts.OldPath, opts.NewPath, err.Error()),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, struct{}{})
+}
+
+func (s *Service) makeDirectory(rw http.ResponseWriter, r *http.Request) {
+	var opts roptions.MakeDirectory
+	if err := gimlet.GetJSON(r.Body, &opts); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem reading request: %w", err).Error(),
+		})
+		return
+	}
+
+	if err := opts.Validate(); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem validating make directory options: %w", err).Error(),
+		})
+		return
+	}
+
+	if err := opts.Make(); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: fileErrorStatus(err),
+			Message:    fmt.Sprintf("problem making directory %s: %q", opts.Path, err.Error()),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, struct{}{})
+}
+
 func (s *Service) clearManager(rw http.ResponseWriter, r *http.Request) {
-	s.manager.Clear(r.Context())
+	s.outputs.Clear(r.Context())
 	gimlet.WriteJSON(rw, struct{}{})
 }
 
 func (s *Service) closeManager(rw http.ResponseWriter, r *http.Request) {
-	if err := s.manager.Close(r.Context()); err != nil {
+	if err := s.outputs.Close(r.Context()); err != nil {
 		writeError(rw, gimlet.ErrorResponse{
 			StatusCode: http.StatusBadRequest,
 			Message:    err.Error(),
@@ -584,6 +1315,41 @@ func (s *Service) registerSignalTriggerID(rw http.ResponseWriter, r *http.Reques
 	gimlet.WriteJSON(rw, struct{}{})
 }
 
+func (s *Service) registerTriggerID(rw http.ResponseWriter, r *http.Request) {
+	vars := gimlet.GetVars(r)
+	id := vars["id"]
+	triggerID := jasper.ProcessTriggerID(vars["trigger-id"])
+	ctx := r.Context()
+
+	params := map[string]string{}
+	if err := gimlet.GetJSON(r.Body, &params); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Errorf("problem reading trigger parameters: %w", err).Error(),
+		})
+		return
+	}
+
+	proc, err := s.manager.Get(ctx, id)
+	if err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("no process '%s' found: %q", id, err.Error()),
+		})
+		return
+	}
+
+	if err := proc.RegisterTriggerID(ctx, triggerID, params); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("problem registering trigger with id '%s': %q", triggerID, err.Error()),
+		})
+		return
+	}
+
+	gimlet.WriteJSON(rw, struct{}{})
+}
+
 type restLoggingCacheSize struct {
 	Size int `json:"size"`
 }
@@ -3,9 +3,12 @@ package remote
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -466,3 +469,53 @@ func TestRestService(t *testing.T) {
 		})
 	}
 }
+
+func TestRestServiceTLS(t *testing.T) {
+	serverCreds, err := readTestCredentials("server")
+	assert.NotError(t, err)
+	clientCreds, err := readTestCredentials("client")
+	assert.NotError(t, err)
+
+	caCerts := x509.NewCertPool()
+	assert.True(t, caCerts.AppendCertsFromPEM(serverCreds.CACert))
+	noCertClient := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: caCerts}}}
+
+	startService := func(ctx context.Context, t *testing.T, requireClientCert bool) net.Addr {
+		addr, err := tryStartRPCService(ctx, func(ctx context.Context, addr net.Addr) error {
+			_, err := StartRestService(ctx, NewRestService(jasper.NewManager()), addr, serverCreds, requireClientCert)
+			return err
+		})
+		assert.NotError(t, err)
+		return addr
+	}
+
+	t.Run("ClientCertificate", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+		defer cancel()
+		addr := startService(ctx, t, true)
+
+		client, err := NewRestClientWithCredentials(addr, clientCreds)
+		assert.NotError(t, err)
+		check.NotZero(t, client.ID())
+		_, err = client.List(ctx, options.All)
+		check.NotError(t, err)
+
+		_, err = MakeSecureRestClient(addr, noCertClient).List(ctx, options.All)
+		check.Error(t, err)
+		_, err = NewRestClient(addr).List(ctx, options.All)
+		check.Error(t, err)
+	})
+	t.Run("OptionalClientCertificate", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), testutil.TestTimeout)
+		defer cancel()
+		addr := startService(ctx, t, false)
+
+		_, err = MakeSecureRestClient(addr, noCertClient).List(ctx, options.All)
+		check.NotError(t, err)
+
+		client, err := NewRestClientWithCredentials(addr, clientCreds)
+		assert.NotError(t, err)
+		_, err = client.List(ctx, options.All)
+		check.NotError(t, err)
+	})
+}
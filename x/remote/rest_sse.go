@@ -0,0 +1,76 @@
+package remote
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Event types sent by the REST service when following process output as
+// server-sent events.
+const (
+	logFollowLineEvent  = "line"
+	logFollowDoneEvent  = "done"
+	logFollowErrorEvent = "error"
+)
+
+// writeServerSentEvent writes the JSON-encoded payload as a single
+// server-sent event and flushes it to the client.
+func writeServerSentEvent(rw http.ResponseWriter, flusher http.Flusher, id, event string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("problem encoding event: %w", err)
+	}
+
+	buf := &bytes.Buffer{}
+	if id != "" {
+		fmt.Fprintf(buf, "id: %s\n", id)
+	}
+	fmt.Fprintf(buf, "event: %s\ndata: %s\n\n", event, data)
+	if _, err := rw.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("problem writing event: %w", err)
+	}
+	flusher.Flush()
+
+	return nil
+}
+
+// readServerSentEvents calls fn with the type and data of each server-sent
+// event in the stream until the stream ends or fn returns an error.
+func readServerSentEvents(r io.Reader, fn func(event string, data []byte) error) error {
+	reader := bufio.NewReader(r)
+	var event string
+	var data []byte
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("problem reading event stream: %w", err)
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case line == "":
+			if event != "" || len(data) != 0 {
+				if err := fn(event, data); err != nil {
+					return err
+				}
+			}
+			event, data = "", nil
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			if len(data) != 0 {
+				data = append(data, '\n')
+			}
+			data = append(data, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " ")...)
+		}
+	}
+}
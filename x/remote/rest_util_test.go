@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/tychoish/grip"
 	"github.com/tychoish/jasper"
+	"github.com/tychoish/jasper/options"
 	"github.com/tychoish/jasper/testutil"
 )
 
@@ -69,3 +72,22 @@ tryStartService:
 		}
 	}
 }
+
+// readTestCredentials reads the CA certificate and the named certificate and
+// key pair from the testdata directory.
+func readTestCredentials(name string) (*options.CertificateCredentials, error) {
+	caCert, err := os.ReadFile(filepath.Join("testdata", "ca.crt"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cert file: %w", err)
+	}
+	cert, err := os.ReadFile(filepath.Join("testdata", name+".crt"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cert file: %w", err)
+	}
+	key, err := os.ReadFile(filepath.Join("testdata", name+".key"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	return options.NewCredentials(caCert, cert, key)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"strings"
 	"syscall"
 
 	empty "github.com/golang/protobuf/ptypes/empty"
@@ -20,10 +21,13 @@ import (
 	roptions "github.com/tychoish/jasper/x/remote/options"
 	grpc "google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 )
 
 type rpcClient struct {
 	client       internal.JasperProcessManagerClient
+	health       healthpb.HealthClient
 	clientCloser util.CloseFunc
 }
 
@@ -31,12 +35,13 @@ type rpcClient struct {
 // addr. If creds is non-nil, the credentials will be used to establish a secure
 // TLS connection with the service; otherwise, it will establish an insecure
 // connection. The caller is responsible for closing the connection using the
-// returned jasper.CloseFunc.
-func NewRPCClient(ctx context.Context, addr net.Addr, creds *options.CertificateCredentials) (Manager, error) {
-	opts := []grpc.DialOption{
+// returned jasper.CloseFunc. Additional dial options, such as those returned by
+// AuthDialOptions, are passed to the connection.
+func NewRPCClient(ctx context.Context, addr net.Addr, creds *options.CertificateCredentials, dialOpts ...grpc.DialOption) (Manager, error) {
+	opts := append([]grpc.DialOption{
 		grpc.WithBlock(),
 		grpc.WithDefaultCallOptions(grpc.WaitForReady(true)),
-	}
+	}, dialOpts...)
 	if creds != nil {
 		tlsConf, err := creds.Resolve()
 		if err != nil {
@@ -47,7 +52,12 @@ func NewRPCClient(ctx context.Context, addr net.Addr, creds *options.Certificate
 		opts = append(opts, grpc.WithInsecure())
 	}
 
-	conn, err := grpc.DialContext(ctx, addr.String(), opts...)
+	target := addr.String()
+	if isUnixAddr(addr) {
+		target = "unix:" + target
+	}
+
+	conn, err := grpc.DialContext(ctx, target, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("could not establish connection to %s service at address %s: %w", addr.Network(), addr.String(), err)
 	}
@@ -59,7 +69,7 @@ func NewRPCClient(ctx context.Context, addr net.Addr, creds *options.Certificate
 // be read from the file given by filePath if the filePath is non-empty. The
 // credentials file should contain the JSON-encoded bytes from
 // (*certdepot.Credentials).Export().
-func NewRPCClientWithFile(ctx context.Context, addr net.Addr, filePath string) (Manager, error) {
+func NewRPCClientWithFile(ctx context.Context, addr net.Addr, filePath string, dialOpts ...grpc.DialOption) (Manager, error) {
 	var creds *options.CertificateCredentials
 	if filePath != "" {
 		var err error
@@ -69,13 +79,53 @@ func NewRPCClientWithFile(ctx context.Context, addr net.Addr, filePath string) (
 		}
 	}
 
-	return NewRPCClient(ctx, addr, creds)
+	return NewRPCClient(ctx, addr, creds, dialOpts...)
+}
+
+// AuthDialOptions returns the dial options that add the credentials to every
+// request made by an RPC client. Pass them to NewRPCClient. Each call is
+// signed with a new nonce, which the service refuses to accept twice, so pass
+// them after ResilientDialOptions so that every retry is signed again. The
+// signatures of unary calls cover the request message, but the signatures of
+// streaming calls only cover the method, so their messages are only protected
+// from tampering if the connection uses TLS.
+func AuthDialOptions(creds roptions.AuthCredentials) []grpc.DialOption {
+	withAuth := func(ctx context.Context, method string, body []byte) context.Context {
+		auth := makeSignedAuthRequest(&creds, method, body)
+		pairs := []string{}
+		if auth.Token != "" {
+			pairs = append(pairs, strings.ToLower(authorizationHeader), bearerPrefix+auth.Token)
+		}
+		if auth.Signature != "" {
+			pairs = append(pairs,
+				strings.ToLower(authKeyIDHeader), auth.KeyID,
+				strings.ToLower(authTimestampHeader), auth.Timestamp,
+				strings.ToLower(authNonceHeader), auth.Nonce,
+				strings.ToLower(authSignatureHeader), auth.Signature,
+			)
+		}
+		return metadata.AppendToOutgoingContext(ctx, pairs...)
+	}
+
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			body, err := marshalRPCAuthBody(req)
+			if err != nil {
+				return err
+			}
+			return invoker(withAuth(ctx, method, body), method, req, reply, cc, opts...)
+		}),
+		grpc.WithChainStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return streamer(withAuth(ctx, method, nil), desc, cc, method, opts...)
+		}),
+	}
 }
 
 // newRPCClient is a constructor for an RPC client.
 func newRPCClient(cc *grpc.ClientConn) Manager {
 	return &rpcClient{
 		client:       internal.NewJasperProcessManagerClient(cc),
+		health:       healthpb.NewHealthClient(cc),
 		clientCloser: cc.Close,
 	}
 }
@@ -215,6 +265,89 @@ func (c *rpcClient) Status(ctx context.Context) (string, bool, error) {
 	return resp.HostId, resp.Active, nil
 }
 
+func (c *rpcClient) Drain(ctx context.Context, opts roptions.Drain) (roptions.DrainReport, error) {
+	report, err := c.client.Drain(ctx, internal.ConvertDrainOptions(opts))
+	if err != nil {
+		return roptions.DrainReport{}, fmt.Errorf("problem draining service: %w", err)
+	}
+	return report.Export(), nil
+}
+
+func (c *rpcClient) Snapshot(ctx context.Context) (roptions.Snapshot, error) {
+	snapshot, err := c.client.GetSnapshot(ctx, &empty.Empty{})
+	if err != nil {
+		return roptions.Snapshot{}, fmt.Errorf("problem getting snapshot: %w", err)
+	}
+
+	out, err := snapshot.Export()
+	if err != nil {
+		return roptions.Snapshot{}, fmt.Errorf("problem exporting snapshot: %w", err)
+	}
+	return out, nil
+}
+
+func (c *rpcClient) AddSchedule(ctx context.Context, opts options.Schedule) error {
+	args, err := internal.ConvertScheduleOptions(opts)
+	if err != nil {
+		return fmt.Errorf("problem converting schedule options: %w", err)
+	}
+
+	resp, err := c.client.AddSchedule(ctx, args)
+	if err != nil {
+		return fmt.Errorf("problem adding schedule: %w", err)
+	}
+	if !resp.Success {
+		return errors.New(resp.Text)
+	}
+
+	return nil
+}
+
+func (c *rpcClient) RemoveSchedule(ctx context.Context, id string) error {
+	resp, err := c.client.RemoveSchedule(ctx, &internal.ScheduleID{Value: id})
+	if err != nil {
+		return fmt.Errorf("problem removing schedule: %w", err)
+	}
+	if !resp.Success {
+		return errors.New(resp.Text)
+	}
+
+	return nil
+}
+
+func (c *rpcClient) ListSchedules(ctx context.Context) ([]jasper.ScheduleInfo, error) {
+	resp, err := c.client.ListSchedules(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("problem listing schedules: %w", err)
+	}
+
+	infos := make([]jasper.ScheduleInfo, 0, len(resp.Schedules))
+	for _, info := range resp.Schedules {
+		exported, err := info.Export()
+		if err != nil {
+			return nil, fmt.Errorf("problem exporting schedule: %w", err)
+		}
+		infos = append(infos, exported)
+	}
+	return infos, nil
+}
+
+func (c *rpcClient) CheckHealth(ctx context.Context) (roptions.HealthReport, error) {
+	health, err := c.health.Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		return roptions.HealthReport{}, fmt.Errorf("problem checking health: %w", err)
+	}
+	readiness, err := c.health.Check(ctx, &healthpb.HealthCheckRequest{Service: rpcReadinessService})
+	if err != nil {
+		return roptions.HealthReport{}, fmt.Errorf("problem checking readiness: %w", err)
+	}
+
+	return roptions.HealthReport{
+		Healthy: health.Status == healthpb.HealthCheckResponse_SERVING,
+		Ready:   readiness.Status == healthpb.HealthCheckResponse_SERVING,
+	}, nil
+}
+
 func (c *rpcClient) CloseConnection() error {
 	return c.clientCloser()
 }
@@ -232,6 +365,15 @@ func (c *rpcClient) DownloadFile(ctx context.Context, opts roptions.Download) er
 	return nil
 }
 
+func (c *rpcClient) GetDownloadCacheStats(ctx context.Context) (roptions.DownloadCacheStats, error) {
+	resp, err := c.client.GetDownloadCacheStats(ctx, &empty.Empty{})
+	if err != nil {
+		return roptions.DownloadCacheStats{}, err
+	}
+
+	return resp.Export(), nil
+}
+
 func (c *rpcClient) GetLogStream(ctx context.Context, id string, count int) (jasper.LogStream, error) {
 	stream, err := c.client.GetLogStream(ctx, &internal.LogRequest{
 		Id:    &internal.JasperProcessID{Value: id},
@@ -243,6 +385,72 @@ func (c *rpcClient) GetLogStream(ctx context.Context, id string, count int) (jas
 	return stream.Export(), nil
 }
 
+func (c *rpcClient) FollowLogStream(ctx context.Context, id string, opts roptions.LogFollow, fn func(jasper.LogLine) error) error {
+	if err := opts.Validate(); err != nil {
+		return fmt.Errorf("invalid log follow options: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.client.FollowLogStream(ctx, internal.ConvertLogFollow(id, opts))
+	if err != nil {
+		return fmt.Errorf("problem getting streaming client: %w", err)
+	}
+
+	for {
+		line, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("problem following logs: %w", err)
+		}
+
+		if err := fn(line.Export()); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *rpcClient) Exec(ctx context.Context, opts *roptions.Exec) (ExecSession, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid session options: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.client.Exec(ctx)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("problem getting streaming client: %w", err)
+	}
+
+	session, err := newExecClientSession(opts,
+		func(in roptions.ExecInput) error {
+			msg, err := internal.ConvertExecInput(in)
+			if err != nil {
+				return err
+			}
+			return stream.Send(msg)
+		},
+		func() (roptions.ExecOutput, error) {
+			out, err := stream.Recv()
+			if err != nil {
+				return roptions.ExecOutput{}, err
+			}
+			return out.Export(), nil
+		},
+		func() error {
+			cancel()
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
 func (c *rpcClient) SignalEvent(ctx context.Context, name string) error {
 	resp, err := c.client.SignalEvent(ctx, &internal.EventName{Value: name})
 	if err != nil {
@@ -285,6 +493,158 @@ func (c *rpcClient) WriteFile(ctx context.Context, jopts options.WriteFile) erro
 	return nil
 }
 
+func (c *rpcClient) ReadFile(ctx context.Context, opts roptions.ReadFile) (io.ReadCloser, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid read file options: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.client.ReadFile(ctx, internal.ConvertReadFileOptions(opts))
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("problem getting streaming client: %w", err)
+	}
+
+	// Receive the first chunk so that errors opening the file are returned
+	// here rather than on the first read.
+	first, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		cancel()
+		return nil, fmt.Errorf("problem reading file: %w", err)
+	}
+
+	return &rpcFileReader{stream: stream, buf: first.GetData(), eof: err == io.EOF, cancel: cancel}, nil
+}
+
+func (c *rpcClient) CreateArchive(ctx context.Context, opts roptions.CreateArchive) (io.ReadCloser, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid create archive options: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.client.CreateArchive(ctx, internal.ConvertCreateArchiveOptions(opts))
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("problem getting streaming client: %w", err)
+	}
+
+	first, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		cancel()
+		return nil, fmt.Errorf("problem creating archive: %w", err)
+	}
+
+	return &rpcFileReader{stream: stream, buf: first.GetData(), eof: err == io.EOF, cancel: cancel}, nil
+}
+
+// rpcFileReader reads the contents of a file from a ReadFile or CreateArchive
+// stream.
+type rpcFileReader struct {
+	stream internal.JasperProcessManager_ReadFileClient
+	buf    []byte
+	eof    bool
+	cancel context.CancelFunc
+}
+
+func (r *rpcFileReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.eof {
+			return 0, io.EOF
+		}
+		chunk, err := r.stream.Recv()
+		if err == io.EOF {
+			r.eof = true
+			continue
+		} else if err != nil {
+			return 0, fmt.Errorf("problem reading file: %w", err)
+		}
+		r.buf = chunk.Data
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (r *rpcFileReader) Close() error {
+	r.cancel()
+	return nil
+}
+
+func (c *rpcClient) StatFile(ctx context.Context, path string) (roptions.FileInfo, error) {
+	info, err := c.client.StatFile(ctx, &internal.FilePath{Path: path})
+	if err != nil {
+		return roptions.FileInfo{}, err
+	}
+	return info.Export(), nil
+}
+
+func (c *rpcClient) ListDirectory(ctx context.Context, opts roptions.ListDirectory) ([]roptions.FileInfo, error) {
+	infos, err := c.client.ListDirectory(ctx, internal.ConvertListDirectoryOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return infos.Export(), nil
+}
+
+func (c *rpcClient) GlobFiles(ctx context.Context, pattern string) ([]string, error) {
+	paths, err := c.client.GlobFiles(ctx, &internal.FilePath{Path: pattern})
+	if err != nil {
+		return nil, err
+	}
+	if paths.Paths == nil {
+		return []string{}, nil
+	}
+	return paths.Paths, nil
+}
+
+func (c *rpcClient) RemoveFile(ctx context.Context, opts roptions.RemoveFile) error {
+	resp, err := c.client.RemoveFile(ctx, internal.ConvertRemoveFileOptions(opts))
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return errors.New(resp.Text)
+	}
+
+	return nil
+}
+
+func (c *rpcClient) RenameFile(ctx context.Context, opts roptions.RenameFile) error {
+	resp, err := c.client.RenameFile(ctx, internal.ConvertRenameFileOptions(opts))
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return errors.New(resp.Text)
+	}
+
+	return nil
+}
+
+func (c *rpcClient) MakeDirectory(ctx context.Context, opts roptions.MakeDirectory) error {
+	resp, err := c.client.MakeDirectory(ctx, internal.ConvertMakeDirectoryOptions(opts))
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return errors.New(resp.Text)
+	}
+
+	return nil
+}
+
+func (c *rpcClient) ChecksumFile(ctx context.Context, opts roptions.FileChecksum) (string, error) {
+	resp, err := c.client.ChecksumFile(ctx, internal.ConvertFileChecksumOptions(opts))
+	if err != nil {
+		return "", err
+	}
+	return resp.Checksum, nil
+}
+
 func (c *rpcClient) SendMessages(ctx context.Context, lp options.LoggingPayload) error {
 	resp, err := c.client.SendMessages(ctx, internal.ConvertLoggingPayload(lp))
 	if err != nil {
@@ -310,7 +670,7 @@ type rpcProcess struct {
 func (p *rpcProcess) ID() string { return p.info.Id }
 
 func (p *rpcProcess) Info(ctx context.Context) jasper.ProcessInfo {
-	if p.info.Complete {
+	if p.info.Complete && !p.info.HasPendingFollowUps() {
 		exportedInfo, err := p.info.Export()
 		grip.Warning(message.WrapError(err, message.Fields{
 			"message": "could not convert info for process",
@@ -363,9 +723,13 @@ func (p *rpcProcess) Complete(ctx context.Context) bool {
 }
 
 func (p *rpcProcess) Signal(ctx context.Context, sig syscall.Signal) error {
+	convertedSig, err := internal.ConvertSignal(sig)
+	if err != nil {
+		return err
+	}
 	resp, err := p.client.Signal(ctx, &internal.SignalProcess{
 		ProcessID: &internal.JasperProcessID{Value: p.info.Id},
-		Signal:    internal.ConvertSignal(sig),
+		Signal:    convertedSig,
 	})
 	if err != nil {
 		return err
@@ -391,6 +755,19 @@ func (p *rpcProcess) Wait(ctx context.Context) (int, error) {
 	return int(resp.ExitCode), nil
 }
 
+func (p *rpcProcess) WaitReady(ctx context.Context) error {
+	resp, err := p.client.WaitReady(ctx, &internal.JasperProcessID{Value: p.info.Id})
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return errors.New(resp.Text)
+	}
+
+	return nil
+}
+
 func (p *rpcProcess) Respawn(ctx context.Context) (jasper.Process, error) {
 	newProc, err := p.client.Respawn(ctx, &internal.JasperProcessID{Value: p.info.Id})
 	if err != nil {
@@ -424,6 +801,19 @@ func (p *rpcProcess) RegisterSignalTriggerID(ctx context.Context, sigID jasper.S
 	return nil
 }
 
+func (p *rpcProcess) RegisterTriggerID(ctx context.Context, triggerID jasper.ProcessTriggerID, params map[string]string) error {
+	resp, err := p.client.RegisterTriggerID(ctx, internal.ConvertProcessTriggerParams(p.info.Id, triggerID, params))
+	if err != nil {
+		return err
+	}
+
+	if !resp.Success {
+		return errors.New(resp.Text)
+	}
+
+	return nil
+}
+
 func (p *rpcProcess) Tag(tag string) {
 	_, _ = p.client.TagProcess(context.Background(), &internal.ProcessTags{
 		ProcessID: p.info.Id,
@@ -2,6 +2,7 @@ package remote
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 
@@ -11,15 +12,33 @@ import (
 	"github.com/tychoish/jasper/options"
 	"github.com/tychoish/jasper/util"
 	"github.com/tychoish/jasper/x/remote/internal"
+	roptions "github.com/tychoish/jasper/x/remote/options"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 // AttachService attaches the jasper GRPC server to the given manager. After
 // this function successfully returns, calls to Manager functions will be sent
 // over GRPC to the Jasper GRPC server.
+//
+// The server also implements the gRPC health checking protocol
+// (grpc.health.v1). The empty service name reports whether the service is
+// healthy and the "jasper.JasperProcessManager" service name reports whether
+// it is ready, as determined by the manager if it is a HealthManager.
 func AttachService(ctx context.Context, manager jasper.Manager, s *grpc.Server) error {
-	return internal.AttachService(ctx, manager, s)
+	hm := makeHealthManager(manager)
+	if err := internal.AttachService(ctx, hm, s); err != nil {
+		return err
+	}
+	healthpb.RegisterHealthServer(s, &rpcHealthService{manager: hm})
+	return nil
 }
 
 // StartRPCService starts an RPC server with the specified address addr around the
@@ -28,22 +47,36 @@ func AttachService(ctx context.Context, manager jasper.Manager, s *grpc.Server)
 // service. The caller is responsible for closing the connection using the
 // returned jasper.CloseFunc.
 //
+// If addr is a unix domain socket, the socket's permissions are set
+// according to the address (see UnixSocketAddr) and the peer credentials of
+// each connection are available to the authorizer in AuthServerOptions.
+//
 // This service does not have any kind of interceptors (middleware) or
 // logging configured, and panics are not handled. Passing
 // interceptors from the aviation package or grpc-middleware as
 // gprc.ServerOptions to this function can handle that.
 func StartRPCService(ctx context.Context, manager jasper.Manager, addr net.Addr, creds *options.CertificateCredentials, opts ...grpc.ServerOption) (util.CloseFunc, error) {
-	lis, err := net.Listen(addr.Network(), addr.String())
-	if err != nil {
-		return nil, fmt.Errorf("error listening on %s: %w", addr.String(), err)
-	}
-
+	var transportCreds credentials.TransportCredentials
 	if creds != nil {
 		tlsConf, err := creds.Resolve()
 		if err != nil {
 			return nil, fmt.Errorf("error generating TLS config from server credentials: %w", err)
 		}
-		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConf)))
+		transportCreds = credentials.NewTLS(tlsConf)
+	}
+	if isUnixAddr(addr) {
+		if transportCreds == nil {
+			transportCreds = insecure.NewCredentials()
+		}
+		transportCreds = peerCredentialsTransport{TransportCredentials: transportCreds}
+	}
+	if transportCreds != nil {
+		opts = append(opts, grpc.Creds(transportCreds))
+	}
+
+	lis, err := listen(addr)
+	if err != nil {
+		return nil, fmt.Errorf("error listening on %s: %w", addr.String(), err)
 	}
 
 	service := grpc.NewServer(opts...)
@@ -77,3 +110,179 @@ func StartRPCServiceWithFile(ctx context.Context, manager jasper.Manager, addr n
 
 	return StartRPCService(ctx, manager, addr, creds, opts...)
 }
+
+// rpcMethodOperations maps each RPC method to the operation used to authorize
+// it. Methods that are not listed require OperationAdmin.
+var rpcMethodOperations = map[string]roptions.Operation{
+	internal.JasperProcessManager_Status_FullMethodName:                     roptions.OperationRead,
+	internal.JasperProcessManager_ID_FullMethodName:                         roptions.OperationRead,
+	internal.JasperProcessManager_Create_FullMethodName:                     roptions.OperationCreate,
+	internal.JasperProcessManager_List_FullMethodName:                       roptions.OperationRead,
+	internal.JasperProcessManager_Group_FullMethodName:                      roptions.OperationRead,
+	internal.JasperProcessManager_Get_FullMethodName:                        roptions.OperationRead,
+	internal.JasperProcessManager_Signal_FullMethodName:                     roptions.OperationSignal,
+	internal.JasperProcessManager_Clear_FullMethodName:                      roptions.OperationAdmin,
+	internal.JasperProcessManager_Close_FullMethodName:                      roptions.OperationAdmin,
+	internal.JasperProcessManager_TagProcess_FullMethodName:                 roptions.OperationSignal,
+	internal.JasperProcessManager_ResetTags_FullMethodName:                  roptions.OperationSignal,
+	internal.JasperProcessManager_GetTags_FullMethodName:                    roptions.OperationRead,
+	internal.JasperProcessManager_RegisterSignalTriggerID_FullMethodName:    roptions.OperationSignal,
+	internal.JasperProcessManager_RegisterTriggerID_FullMethodName:          roptions.OperationCreate,
+	internal.JasperProcessManager_Wait_FullMethodName:                       roptions.OperationRead,
+	internal.JasperProcessManager_WaitReady_FullMethodName:                  roptions.OperationRead,
+	internal.JasperProcessManager_Respawn_FullMethodName:                    roptions.OperationCreate,
+	internal.JasperProcessManager_ScriptingHarnessCreate_FullMethodName:     roptions.OperationCreate,
+	internal.JasperProcessManager_ScriptingHarnessCheck_FullMethodName:      roptions.OperationRead,
+	internal.JasperProcessManager_ScriptingHarnessSetup_FullMethodName:      roptions.OperationCreate,
+	internal.JasperProcessManager_ScriptingHarnessCleanup_FullMethodName:    roptions.OperationCreate,
+	internal.JasperProcessManager_ScriptingHarnessRun_FullMethodName:        roptions.OperationCreate,
+	internal.JasperProcessManager_ScriptingHarnessBuild_FullMethodName:      roptions.OperationCreate,
+	internal.JasperProcessManager_ScriptingHarnessRunScript_FullMethodName:  roptions.OperationCreate,
+	internal.JasperProcessManager_ScriptingHarnessTest_FullMethodName:       roptions.OperationCreate,
+	internal.JasperProcessManager_LoggingCacheCreate_FullMethodName:         roptions.OperationLogging,
+	internal.JasperProcessManager_LoggingCacheGet_FullMethodName:            roptions.OperationLogging,
+	internal.JasperProcessManager_LoggingCacheRemove_FullMethodName:         roptions.OperationLogging,
+	internal.JasperProcessManager_LoggingCacheCloseAndRemove_FullMethodName: roptions.OperationLogging,
+	internal.JasperProcessManager_LoggingCacheClear_FullMethodName:          roptions.OperationLogging,
+	internal.JasperProcessManager_LoggingCacheLen_FullMethodName:            roptions.OperationLogging,
+	internal.JasperProcessManager_LoggingCachePrune_FullMethodName:          roptions.OperationLogging,
+	internal.JasperProcessManager_SendMessages_FullMethodName:               roptions.OperationLogging,
+	internal.JasperProcessManager_DownloadFile_FullMethodName:               roptions.OperationFileWrite,
+	internal.JasperProcessManager_WriteFile_FullMethodName:                  roptions.OperationFileWrite,
+	internal.JasperProcessManager_ReadFile_FullMethodName:                   roptions.OperationFileRead,
+	internal.JasperProcessManager_StatFile_FullMethodName:                   roptions.OperationFileRead,
+	internal.JasperProcessManager_ListDirectory_FullMethodName:              roptions.OperationFileRead,
+	internal.JasperProcessManager_GlobFiles_FullMethodName:                  roptions.OperationFileRead,
+	internal.JasperProcessManager_ChecksumFile_FullMethodName:               roptions.OperationFileRead,
+	internal.JasperProcessManager_CreateArchive_FullMethodName:              roptions.OperationFileRead,
+	internal.JasperProcessManager_GetDownloadCacheStats_FullMethodName:      roptions.OperationRead,
+	internal.JasperProcessManager_Drain_FullMethodName:                      roptions.OperationAdmin,
+	internal.JasperProcessManager_AddSchedule_FullMethodName:                roptions.OperationCreate,
+	internal.JasperProcessManager_RemoveSchedule_FullMethodName:             roptions.OperationCreate,
+	internal.JasperProcessManager_ListSchedules_FullMethodName:              roptions.OperationRead,
+	internal.JasperProcessManager_GetSnapshot_FullMethodName:                roptions.OperationRead,
+	internal.JasperProcessManager_RemoveFile_FullMethodName:                 roptions.OperationFileWrite,
+	internal.JasperProcessManager_RenameFile_FullMethodName:                 roptions.OperationFileWrite,
+	internal.JasperProcessManager_MakeDirectory_FullMethodName:              roptions.OperationFileWrite,
+	internal.JasperProcessManager_GetLogStream_FullMethodName:               roptions.OperationRead,
+	internal.JasperProcessManager_FollowLogStream_FullMethodName:            roptions.OperationRead,
+	internal.JasperProcessManager_Exec_FullMethodName:                       roptions.OperationCreate,
+	internal.JasperProcessManager_SignalEvent_FullMethodName:                roptions.OperationSignal,
+}
+
+// rpcUnauthenticatedMethods are the RPC methods that do not require
+// authorization, so that standard health probes can check the service.
+var rpcUnauthenticatedMethods = map[string]struct{}{
+	healthpb.Health_Check_FullMethodName: {},
+	healthpb.Health_List_FullMethodName:  {},
+	healthpb.Health_Watch_FullMethodName: {},
+}
+
+// AuthServerOptions returns the server options that install interceptors to
+// authenticate and authorize every request to the RPC service using the
+// given authorizer. Pass them to StartRPCService. Client certificates are
+// only available to the authorizer if the service uses TLS credentials, and
+// peer credentials if it listens on a unix domain socket. The methods of the
+// standard health service do not require authorization. If the authorizer
+// is nil, no options are returned.
+func AuthServerOptions(a *Authorizer) []grpc.ServerOption {
+	if a == nil {
+		return nil
+	}
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if _, ok := rpcUnauthenticatedMethods[info.FullMethod]; ok {
+				return handler(ctx, req)
+			}
+			body, err := marshalRPCAuthBody(req)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			ctx, err = authorizeRPC(ctx, a, info.FullMethod, body)
+			if err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.ChainStreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if _, ok := rpcUnauthenticatedMethods[info.FullMethod]; ok {
+				return handler(srv, ss)
+			}
+			ctx, err := authorizeRPC(ss.Context(), a, info.FullMethod, nil)
+			if err != nil {
+				return err
+			}
+			return handler(srv, &authorizedServerStream{ServerStream: ss, ctx: ctx})
+		}),
+	}
+}
+
+func authorizeRPC(ctx context.Context, a *Authorizer, method string, body []byte) (context.Context, error) {
+	req := AuthRequest{Target: method, Body: body}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		get := func(key string) string {
+			if vals := md.Get(key); len(vals) > 0 {
+				return vals[0]
+			}
+			return ""
+		}
+		token, err := bearerToken(get(authorizationHeader))
+		if err != nil {
+			return ctx, status.Error(codes.Unauthenticated, err.Error())
+		}
+		req.Token = token
+		req.KeyID = get(authKeyIDHeader)
+		req.Timestamp = get(authTimestampHeader)
+		req.Nonce = get(authNonceHeader)
+		req.Signature = get(authSignatureHeader)
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		info := p.AuthInfo
+		if peerInfo, ok := info.(peerCredentialsAuthInfo); ok {
+			req.PeerCredentials = peerInfo.creds
+			info = peerInfo.AuthInfo
+		}
+		if tlsInfo, ok := info.(credentials.TLSInfo); ok {
+			req.PeerCertificates = tlsInfo.State.PeerCertificates
+		}
+	}
+
+	op, ok := rpcMethodOperations[method]
+	if !ok {
+		op = roptions.OperationAdmin
+	}
+
+	id, err := a.Authorize(req, op)
+	if err != nil {
+		if errors.Is(err, ErrNotAuthenticated) {
+			return ctx, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return ctx, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return withIdentity(ctx, id), nil
+}
+
+// marshalRPCAuthBody returns the encoding of the request message of a unary
+// call that is covered by its signature. The encoding is deterministic, so
+// that the client and the service produce the same bytes for the same
+// message.
+func marshalRPCAuthBody(req interface{}) ([]byte, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil, nil
+	}
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("problem marshalling request for signature: %w", err)
+	}
+	return body, nil
+}
+
+type authorizedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedServerStream) Context() context.Context { return s.ctx }
@@ -0,0 +1,140 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"syscall"
+
+	"github.com/tychoish/jasper"
+	roptions "github.com/tychoish/jasper/x/remote/options"
+)
+
+// ImportSnapshot registers the completed processes in the snapshot with the
+// manager, so that the process history of one manager can be migrated to
+// another. Processes that were still running when the snapshot was taken and
+// processes that already exist in the manager are skipped. It returns the IDs
+// of the processes that were imported.
+func ImportSnapshot(ctx context.Context, mngr jasper.Manager, snapshot roptions.Snapshot) ([]string, error) {
+	if err := snapshot.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid snapshot: %w", err)
+	}
+
+	ids := []string{}
+	for _, ps := range snapshot.Processes {
+		if !ps.Info.Complete || ps.Info.ID == "" {
+			continue
+		}
+		if _, err := mngr.Get(ctx, ps.Info.ID); err == nil {
+			continue
+		}
+
+		if err := mngr.Register(ctx, newSnapshotProcess(ps)); err != nil {
+			return ids, fmt.Errorf("problem importing process '%s': %w", ps.Info.ID, err)
+		}
+		ids = append(ids, ps.Info.ID)
+	}
+
+	return ids, nil
+}
+
+// snapshotProcess is a completed process that was imported from a snapshot.
+// It only reports the state of the process when it was captured.
+type snapshotProcess struct {
+	info jasper.ProcessInfo
+	tags map[string]struct{}
+	mu   sync.RWMutex
+}
+
+func newSnapshotProcess(ps roptions.ProcessSnapshot) *snapshotProcess {
+	p := &snapshotProcess{
+		info: ps.Info,
+		tags: make(map[string]struct{}, len(ps.Tags)),
+	}
+	for _, tag := range ps.Tags {
+		p.tags[tag] = struct{}{}
+	}
+	return p
+}
+
+func (p *snapshotProcess) ID() string { return p.info.ID }
+
+func (p *snapshotProcess) Info(context.Context) jasper.ProcessInfo {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.info
+}
+
+func (p *snapshotProcess) Running(context.Context) bool { return false }
+
+func (p *snapshotProcess) Complete(context.Context) bool { return true }
+
+func (p *snapshotProcess) Signal(context.Context, syscall.Signal) error {
+	return errors.New("cannot signal an imported process")
+}
+
+func (p *snapshotProcess) Wait(context.Context) (int, error) {
+	if !p.info.Successful {
+		return p.info.ExitCode, fmt.Errorf("process exited with code %d", p.info.ExitCode)
+	}
+	return p.info.ExitCode, nil
+}
+
+func (p *snapshotProcess) WaitReady(context.Context) error {
+	if !p.info.Ready {
+		return errors.New("imported process was not ready")
+	}
+	return nil
+}
+
+func (p *snapshotProcess) Respawn(context.Context) (jasper.Process, error) {
+	return nil, errors.New("cannot respawn an imported process")
+}
+
+func (p *snapshotProcess) RegisterSignalTrigger(context.Context, jasper.SignalTrigger) error {
+	return errors.New("cannot register signal trigger on an imported process")
+}
+
+func (p *snapshotProcess) RegisterSignalTriggerID(context.Context, jasper.SignalTriggerID) error {
+	return errors.New("cannot register signal trigger on an imported process")
+}
+
+func (p *snapshotProcess) RegisterTrigger(context.Context, jasper.ProcessTrigger) error {
+	return errors.New("cannot register trigger after process exits")
+}
+
+func (p *snapshotProcess) RegisterTriggerID(context.Context, jasper.ProcessTriggerID, map[string]string) error {
+	return errors.New("cannot register trigger after process exits")
+}
+
+func (p *snapshotProcess) Tag(tag string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.tags[tag]; ok {
+		return
+	}
+	p.tags[tag] = struct{}{}
+	p.info.Options.Tags = append(p.info.Options.Tags, tag)
+}
+
+func (p *snapshotProcess) GetTags() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	tags := make([]string, 0, len(p.tags))
+	for tag := range p.tags {
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+func (p *snapshotProcess) ResetTags() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.tags = map[string]struct{}{}
+	p.info.Options.Tags = []string{}
+}
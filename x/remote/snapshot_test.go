@@ -0,0 +1,172 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/testutil"
+	roptions "github.com/tychoish/jasper/x/remote/options"
+)
+
+func TestSnapshot(t *testing.T) {
+	for clientName, makeClient := range testClientFactories() {
+		t.Run(clientName, func(t *testing.T) {
+			for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, mngr jasper.Manager, client Manager){
+				"IncludesManagerState": func(ctx context.Context, t *testing.T, mngr jasper.Manager, client Manager) {
+					proc, err := client.CreateProcess(ctx, testutil.TrueCreateOpts())
+					assert.NotError(t, err)
+					proc.Tag("foo")
+					_, err = proc.Wait(ctx)
+					assert.NotError(t, err)
+
+					_, err = mngr.LoggingCache(ctx).Create("logger", &options.Output{})
+					assert.NotError(t, err)
+
+					snapshot, err := client.Snapshot(ctx)
+					assert.NotError(t, err)
+					check.Equal(t, snapshot.Version, roptions.SnapshotVersion)
+					check.Equal(t, snapshot.ManagerID, mngr.ID())
+					check.True(t, !snapshot.CreatedAt.IsZero())
+
+					assert.Equal(t, len(snapshot.Processes), 1)
+					check.Equal(t, snapshot.Processes[0].Info.ID, proc.ID())
+					check.True(t, snapshot.Processes[0].Info.Complete)
+					check.EqualItems(t, snapshot.Processes[0].Tags, []string{"foo"})
+
+					loggerIDs := []string{}
+					for _, logger := range snapshot.LoggingCache {
+						loggerIDs = append(loggerIDs, logger.ID)
+					}
+					check.Contains(t, loggerIDs, "logger")
+
+					check.EqualItems(t, snapshot.SignalTriggerIDs, jasper.SignalTriggerIDs())
+					check.Equal(t, len(snapshot.ScriptingHarnessIDs), 0)
+				},
+				"CanBeImported": func(ctx context.Context, t *testing.T, mngr jasper.Manager, client Manager) {
+					proc, err := client.CreateProcess(ctx, testutil.TrueCreateOpts())
+					assert.NotError(t, err)
+					_, err = proc.Wait(ctx)
+					assert.NotError(t, err)
+
+					snapshot, err := client.Snapshot(ctx)
+					assert.NotError(t, err)
+
+					dst := jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true}))
+					defer func() { check.NotError(t, dst.Close(ctx)) }()
+					ids, err := ImportSnapshot(ctx, dst, snapshot)
+					assert.NotError(t, err)
+					check.EqualItems(t, ids, []string{proc.ID()})
+				},
+			} {
+				t.Run(testName, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), testutil.RPCTestTimeout)
+					defer cancel()
+
+					mngr := jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true}))
+					t.Cleanup(func() { check.NotError(t, mngr.Close(context.Background())) })
+					testCase(ctx, t, mngr, makeClient(ctx, t, mngr))
+				})
+			}
+		})
+	}
+}
+
+func TestImportSnapshot(t *testing.T) {
+	makeSnapshot := func(ctx context.Context, t *testing.T) (roptions.Snapshot, jasper.Process, jasper.Process) {
+		src := jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true}))
+		t.Cleanup(func() { check.NotError(t, src.Close(context.Background())) })
+
+		completed, err := src.CreateProcess(ctx, testutil.TrueCreateOpts())
+		assert.NotError(t, err)
+		completed.Tag("foo")
+		_, err = completed.Wait(ctx)
+		assert.NotError(t, err)
+
+		running, err := src.CreateProcess(ctx, testutil.SleepCreateOpts(10))
+		assert.NotError(t, err)
+
+		snapshot, err := roptions.NewSnapshot(ctx, src, nil)
+		assert.NotError(t, err)
+		return snapshot, completed, running
+	}
+
+	for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, mngr jasper.Manager){
+		"ImportsCompletedProcesses": func(ctx context.Context, t *testing.T, mngr jasper.Manager) {
+			snapshot, completed, running := makeSnapshot(ctx, t)
+
+			ids, err := ImportSnapshot(ctx, mngr, snapshot)
+			assert.NotError(t, err)
+			check.EqualItems(t, ids, []string{completed.ID()})
+
+			proc, err := mngr.Get(ctx, completed.ID())
+			assert.NotError(t, err)
+			check.True(t, proc.Complete(ctx))
+			check.True(t, !proc.Running(ctx))
+			check.EqualItems(t, proc.GetTags(), []string{"foo"})
+			check.Equal(t, proc.Info(ctx).ExitCode, 0)
+			check.EqualItems(t, proc.Info(ctx).Options.Args, completed.Info(ctx).Options.Args)
+			exitCode, err := proc.Wait(ctx)
+			check.NotError(t, err)
+			check.Equal(t, exitCode, 0)
+
+			check.Error(t, proc.Signal(ctx, 9))
+			_, err = proc.Respawn(ctx)
+			check.Error(t, err)
+
+			_, err = mngr.Get(ctx, running.ID())
+			check.Error(t, err)
+
+			procs, err := mngr.List(ctx, options.Successful)
+			assert.NotError(t, err)
+			check.Equal(t, len(procs), 1)
+		},
+		"SkipsExistingProcesses": func(ctx context.Context, t *testing.T, mngr jasper.Manager) {
+			snapshot, _, _ := makeSnapshot(ctx, t)
+
+			ids, err := ImportSnapshot(ctx, mngr, snapshot)
+			assert.NotError(t, err)
+			check.Equal(t, len(ids), 1)
+
+			ids, err = ImportSnapshot(ctx, mngr, snapshot)
+			assert.NotError(t, err)
+			check.Equal(t, len(ids), 0)
+		},
+		"ImportsAfterSerialization": func(ctx context.Context, t *testing.T, mngr jasper.Manager) {
+			snapshot, completed, _ := makeSnapshot(ctx, t)
+
+			data, err := json.Marshal(snapshot)
+			assert.NotError(t, err)
+			decoded := roptions.Snapshot{}
+			assert.NotError(t, json.Unmarshal(data, &decoded))
+
+			ids, err := ImportSnapshot(ctx, mngr, decoded)
+			assert.NotError(t, err)
+			check.EqualItems(t, ids, []string{completed.ID()})
+		},
+		"FailsWithUnsupportedVersion": func(ctx context.Context, t *testing.T, mngr jasper.Manager) {
+			snapshot, _, _ := makeSnapshot(ctx, t)
+			snapshot.Version = roptions.SnapshotVersion + 1
+
+			_, err := ImportSnapshot(ctx, mngr, snapshot)
+			check.Error(t, err)
+
+			procs, err := mngr.List(ctx, options.All)
+			assert.NotError(t, err)
+			check.Equal(t, len(procs), 0)
+		},
+	} {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), testutil.ManagerTestTimeout)
+			defer cancel()
+
+			mngr := jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true}))
+			t.Cleanup(func() { check.NotError(t, mngr.Close(context.Background())) })
+			testCase(ctx, t, mngr)
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package remote
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"strconv"
+	"time"
+
+	"github.com/tychoish/fun/erc"
+	"google.golang.org/grpc/credentials"
+)
+
+// DefaultUnixSocketMode is the file mode of the unix sockets created by the
+// services if the address does not specify one, which only allows the user
+// running the service to connect.
+const DefaultUnixSocketMode os.FileMode = 0600
+
+// UnixSocketAddr is the address of a unix domain socket. The REST and RPC
+// services also accept a *net.UnixAddr, but a UnixSocketAddr can set the
+// permissions of the socket file.
+type UnixSocketAddr struct {
+	Path string
+	// Mode is the file mode of the socket. Only users who can write to the
+	// socket can connect to the service. If zero, DefaultUnixSocketMode is
+	// used.
+	Mode os.FileMode
+}
+
+// Network returns "unix".
+func (a UnixSocketAddr) Network() string { return "unix" }
+
+// String returns the path to the socket.
+func (a UnixSocketAddr) String() string { return a.Path }
+
+// isUnixAddr returns true if the address is a unix domain socket.
+func isUnixAddr(addr net.Addr) bool { return addr.Network() == "unix" }
+
+// listen listens on the address. If the address is a unix domain socket, any
+// stale socket at the path is removed before listening and the socket's
+// permissions are set once it is created.
+func listen(addr net.Addr) (net.Listener, error) {
+	if !isUnixAddr(addr) {
+		return net.Listen(addr.Network(), addr.String())
+	}
+
+	mode := DefaultUnixSocketMode
+	switch a := addr.(type) {
+	case UnixSocketAddr:
+		if a.Mode != 0 {
+			mode = a.Mode
+		}
+	case *UnixSocketAddr:
+		if a.Mode != 0 {
+			mode = a.Mode
+		}
+	}
+
+	path := addr.String()
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		catcher := &erc.Collector{}
+		catcher.Push(fmt.Errorf("problem setting socket permissions: %w", err))
+		catcher.Push(lis.Close())
+		return nil, catcher.Resolve()
+	}
+
+	return lis, nil
+}
+
+// removeStaleSocket removes the unix socket at the path if no service is
+// listening on it. It returns an error if the path exists but is not a
+// socket, or if a service is still listening on it.
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("problem checking for existing socket: %w", err)
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("'%s' already exists and is not a socket", path)
+	}
+
+	if conn, err := net.DialTimeout("unix", path, time.Second); err == nil {
+		catcher := &erc.Collector{}
+		catcher.Push(fmt.Errorf("socket '%s' is already in use", path))
+		catcher.Push(conn.Close())
+		return catcher.Resolve()
+	}
+
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("problem removing stale socket: %w", err)
+	}
+	return nil
+}
+
+// dialUnixSocket returns a dial function that connects to the unix socket at
+// the path regardless of the address it is given.
+func dialUnixSocket(path string) func(context.Context, string, string) (net.Conn, error) {
+	return func(ctx context.Context, _, _ string) (net.Conn, error) {
+		dialer := net.Dialer{}
+		return dialer.DialContext(ctx, "unix", path)
+	}
+}
+
+// PeerCredentials identify the process on the other end of a unix domain
+// socket connection, as reported by the operating system.
+type PeerCredentials struct {
+	PID int32
+	UID uint32
+	GID uint32
+}
+
+// getPeerCredentials returns the credentials of the process that connected
+// to the service over a unix domain socket. TLS connections are unwrapped to
+// the underlying connection.
+func getPeerCredentials(conn net.Conn) (*PeerCredentials, error) {
+	if tlsConn, ok := conn.(*tls.Conn); ok {
+		conn = tlsConn.NetConn()
+	}
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return nil, errors.New("connection is not a unix domain socket")
+	}
+	return getUnixPeerCredentials(unixConn)
+}
+
+type peerCredentialsContextKey struct{}
+
+// withPeerCredentials adds the peer credentials of the connection to the
+// context if it is a unix domain socket connection. It can be used as the
+// http.Server's ConnContext.
+func withPeerCredentials(ctx context.Context, conn net.Conn) context.Context {
+	creds, err := getPeerCredentials(conn)
+	if err != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, peerCredentialsContextKey{}, creds)
+}
+
+func peerCredentialsFromContext(ctx context.Context) *PeerCredentials {
+	creds, _ := ctx.Value(peerCredentialsContextKey{}).(*PeerCredentials)
+	return creds
+}
+
+// peerCredentialsTransport wraps the server's transport credentials to
+// record the peer credentials of unix domain socket connections in the
+// AuthInfo of each gRPC peer.
+type peerCredentialsTransport struct {
+	credentials.TransportCredentials
+}
+
+func (t peerCredentialsTransport) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	secureConn, info, err := t.TransportCredentials.ServerHandshake(conn)
+	if err != nil {
+		return nil, nil, err
+	}
+	creds, err := getPeerCredentials(conn)
+	if err != nil {
+		return secureConn, info, nil
+	}
+	return secureConn, peerCredentialsAuthInfo{AuthInfo: info, creds: creds}, nil
+}
+
+func (t peerCredentialsTransport) Clone() credentials.TransportCredentials {
+	return peerCredentialsTransport{TransportCredentials: t.TransportCredentials.Clone()}
+}
+
+// peerCredentialsAuthInfo is the AuthInfo of a gRPC peer connected over a
+// unix domain socket.
+type peerCredentialsAuthInfo struct {
+	credentials.AuthInfo
+	creds *PeerCredentials
+}
+
+// GetCommonAuthInfo reports the security level of the wrapped AuthInfo so
+// that gRPC can check it.
+func (i peerCredentialsAuthInfo) GetCommonAuthInfo() credentials.CommonAuthInfo {
+	if common, ok := i.AuthInfo.(interface {
+		GetCommonAuthInfo() credentials.CommonAuthInfo
+	}); ok {
+		return common.GetCommonAuthInfo()
+	}
+	return credentials.CommonAuthInfo{SecurityLevel: credentials.InvalidSecurityLevel}
+}
+
+type peerCredentialsAuthenticator struct {
+	lookupUser func(uid string) (*user.User, error)
+}
+
+// NewPeerCredentialsAuthenticator returns an Authenticator that uses the
+// operating system user of the process connected to the service over a unix
+// domain socket as the identity. The identity name is the user's username,
+// or its numeric user ID if the user cannot be looked up. Requests over
+// other transports have no peer credentials.
+func NewPeerCredentialsAuthenticator() Authenticator {
+	return &peerCredentialsAuthenticator{lookupUser: user.LookupId}
+}
+
+func (a *peerCredentialsAuthenticator) Authenticate(req AuthRequest) (Identity, error) {
+	if req.PeerCredentials == nil {
+		return Identity{}, ErrNoCredentials
+	}
+
+	uid := strconv.FormatUint(uint64(req.PeerCredentials.UID), 10)
+	name := uid
+	if u, err := a.lookupUser(uid); err == nil && u.Username != "" {
+		name = u.Username
+	}
+
+	return Identity{Name: name, Method: "peer"}, nil
+}
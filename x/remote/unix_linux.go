@@ -0,0 +1,30 @@
+package remote
+
+import (
+	"fmt"
+	"net"
+
+	"golang.org/x/sys/unix"
+)
+
+func getUnixPeerCredentials(conn *net.UnixConn) (*PeerCredentials, error) {
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		return nil, fmt.Errorf("problem getting raw connection: %w", err)
+	}
+
+	var (
+		ucred    *unix.Ucred
+		ucredErr error
+	)
+	if err := raw.Control(func(fd uintptr) {
+		ucred, ucredErr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	}); err != nil {
+		return nil, fmt.Errorf("problem accessing socket: %w", err)
+	}
+	if ucredErr != nil {
+		return nil, fmt.Errorf("problem getting peer credentials: %w", ucredErr)
+	}
+
+	return &PeerCredentials{PID: ucred.Pid, UID: ucred.Uid, GID: ucred.Gid}, nil
+}
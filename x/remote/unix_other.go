@@ -0,0 +1,12 @@
+//go:build !linux
+
+package remote
+
+import (
+	"errors"
+	"net"
+)
+
+func getUnixPeerCredentials(*net.UnixConn) (*PeerCredentials, error) {
+	return nil, errors.New("peer credentials are not supported on this platform")
+}
@@ -0,0 +1,166 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"os"
+	"os/user"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/tychoish/fun/assert"
+	"github.com/tychoish/fun/assert/check"
+	"github.com/tychoish/jasper"
+	"github.com/tychoish/jasper/options"
+	"github.com/tychoish/jasper/testutil"
+	roptions "github.com/tychoish/jasper/x/remote/options"
+)
+
+// makeTestSocketPath returns a path for a unix socket in a new temporary
+// directory. The directory is kept short because socket paths are limited
+// to about 100 bytes.
+func makeTestSocketPath(t *testing.T) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("unix domain sockets are not supported on windows")
+	}
+
+	dir, err := os.MkdirTemp("", "jasper")
+	assert.NotError(t, err)
+	t.Cleanup(func() { check.NotError(t, os.RemoveAll(dir)) })
+	return filepath.Join(dir, "jasper.sock")
+}
+
+func TestUnixSocketServices(t *testing.T) {
+	for serviceName, startService := range map[string]func(ctx context.Context, t *testing.T, addr net.Addr, a *Authorizer) Manager{
+		"REST": func(ctx context.Context, t *testing.T, addr net.Addr, a *Authorizer) Manager {
+			closeService, err := StartRestService(ctx, NewRestServiceWithAuth(jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true})), a), addr, nil, false)
+			assert.NotError(t, err)
+			t.Cleanup(func() { check.NotError(t, closeService()) })
+			return NewRestClient(addr)
+		},
+		"RPC": func(ctx context.Context, t *testing.T, addr net.Addr, a *Authorizer) Manager {
+			closeService, err := StartRPCService(ctx, jasper.NewManager(jasper.ManagerOptionSet(jasper.ManagerOptions{Synchronized: true})), addr, nil, AuthServerOptions(a)...)
+			assert.NotError(t, err)
+			t.Cleanup(func() { check.NotError(t, closeService()) })
+
+			client, err := NewRPCClient(ctx, addr, nil)
+			assert.NotError(t, err)
+			t.Cleanup(func() { check.NotError(t, client.CloseConnection()) })
+			return client
+		},
+	} {
+		t.Run(serviceName, func(t *testing.T) {
+			for testName, testCase := range map[string]func(ctx context.Context, t *testing.T, path string){
+				"CreatesProcesses": func(ctx context.Context, t *testing.T, path string) {
+					client := startService(ctx, t, UnixSocketAddr{Path: path}, nil)
+
+					proc, err := client.CreateProcess(ctx, &options.Create{Args: []string{"echo", "hello"}})
+					assert.NotError(t, err)
+					exitCode, err := proc.Wait(ctx)
+					assert.NotError(t, err)
+					check.Equal(t, exitCode, 0)
+				},
+				"StreamsExecSessions": func(ctx context.Context, t *testing.T, path string) {
+					client := startService(ctx, t, UnixSocketAddr{Path: path}, nil)
+
+					session, err := client.Exec(ctx, &roptions.Exec{Create: &options.Create{Args: []string{"cat"}}})
+					assert.NotError(t, err)
+					defer session.Close()
+
+					stdout := &bytes.Buffer{}
+					exitCode, err := AttachExecSession(ctx, session, strings.NewReader("hello\n"), stdout, io.Discard)
+					assert.NotError(t, err)
+					check.Equal(t, exitCode, 0)
+					check.Equal(t, stdout.String(), "hello\n")
+				},
+				"SetsSocketPermissions": func(ctx context.Context, t *testing.T, path string) {
+					startService(ctx, t, UnixSocketAddr{Path: path, Mode: 0660}, nil)
+
+					info, err := os.Stat(path)
+					assert.NotError(t, err)
+					check.Equal(t, info.Mode().Perm(), os.FileMode(0660))
+				},
+				"DefaultsSocketPermissions": func(ctx context.Context, t *testing.T, path string) {
+					startService(ctx, t, &net.UnixAddr{Name: path, Net: "unix"}, nil)
+
+					info, err := os.Stat(path)
+					assert.NotError(t, err)
+					check.Equal(t, info.Mode().Perm(), DefaultUnixSocketMode)
+				},
+				"ReplacesStaleSocket": func(ctx context.Context, t *testing.T, path string) {
+					lis, err := net.Listen("unix", path)
+					assert.NotError(t, err)
+					lis.(*net.UnixListener).SetUnlinkOnClose(false)
+					assert.NotError(t, lis.Close())
+
+					client := startService(ctx, t, UnixSocketAddr{Path: path}, nil)
+					_, err = client.List(ctx, options.All)
+					check.NotError(t, err)
+				},
+				"AuthenticatesPeerCredentials": func(ctx context.Context, t *testing.T, path string) {
+					if runtime.GOOS != "linux" {
+						t.Skip("peer credentials are only supported on linux")
+					}
+					current, err := user.Current()
+					assert.NotError(t, err)
+
+					authorizer, err := NewAuthorizerFromConfig(roptions.AuthConfig{
+						PeerCredentials: true,
+						Policy: roptions.AuthPolicy{
+							Identities: map[string][]string{current.Username: {roptions.RoleReadOnly}},
+						},
+					})
+					assert.NotError(t, err)
+
+					client := startService(ctx, t, UnixSocketAddr{Path: path}, authorizer)
+					_, err = client.List(ctx, options.All)
+					check.NotError(t, err)
+					_, err = client.CreateProcess(ctx, &options.Create{Args: []string{"echo", "hello"}})
+					check.Error(t, err)
+				},
+			} {
+				t.Run(testName, func(t *testing.T) {
+					ctx, cancel := context.WithTimeout(context.Background(), testutil.RPCTestTimeout)
+					defer cancel()
+					testCase(ctx, t, makeTestSocketPath(t))
+				})
+			}
+		})
+	}
+}
+
+func TestUnixSocketListen(t *testing.T) {
+	t.Run("FailsForExistingFile", func(t *testing.T) {
+		path := makeTestSocketPath(t)
+		assert.NotError(t, os.WriteFile(path, []byte("foo"), 0600))
+
+		_, err := listen(UnixSocketAddr{Path: path})
+		check.Error(t, err)
+	})
+	t.Run("FailsForSocketInUse", func(t *testing.T) {
+		path := makeTestSocketPath(t)
+		lis, err := listen(UnixSocketAddr{Path: path})
+		assert.NotError(t, err)
+		defer func() { check.NotError(t, lis.Close()) }()
+
+		_, err = listen(UnixSocketAddr{Path: path})
+		check.Error(t, err)
+	})
+	t.Run("RemovesSocketOnClose", func(t *testing.T) {
+		path := makeTestSocketPath(t)
+		lis, err := listen(UnixSocketAddr{Path: path})
+		assert.NotError(t, err)
+		assert.NotError(t, lis.Close())
+
+		_, err = os.Stat(path)
+		check.ErrorIs(t, err, os.ErrNotExist)
+	})
+	t.Run("MDBServiceRejectsSocket", func(t *testing.T) {
+		_, err := StartMDBService(context.Background(), jasper.NewManager(), UnixSocketAddr{Path: makeTestSocketPath(t)})
+		check.Error(t, err)
+	})
+}